@@ -19,6 +19,7 @@ const (
 type Alert struct {
 	ID        int       `json:"_id"`
 	Status    Status    `json:"status"`
+	Severity  Severity  `json:"severity"`
 	Summary   string    `json:"summary"`
 	Details   string    `json:"details"`
 	Source    Source    `json:"source"`
@@ -45,7 +46,7 @@ func (a *Alert) DedupKey() *DedupID {
 }
 
 func (a *Alert) scanFrom(scanFn func(...interface{}) error) error {
-	return scanFn(&a.ID, &a.Summary, &a.Details, &a.ServiceID, &a.Source, &a.Status, &a.CreatedAt, &a.Dedup)
+	return scanFn(&a.ID, &a.Summary, &a.Details, &a.ServiceID, &a.Source, &a.Status, &a.CreatedAt, &a.Dedup, &a.Severity)
 }
 
 func (a Alert) Normalize() (*Alert, error) {
@@ -55,6 +56,9 @@ func (a Alert) Normalize() (*Alert, error) {
 	if string(a.Status) == "" {
 		a.Status = StatusTriggered
 	}
+	if string(a.Severity) == "" {
+		a.Severity = DefaultSeverity
+	}
 	a.Summary = strings.ReplaceAll(a.Summary, "\n", " ")
 	a.Summary = strings.ReplaceAll(a.Summary, "  ", " ")
 
@@ -64,6 +68,7 @@ func (a Alert) Normalize() (*Alert, error) {
 		validate.OneOf("Source", a.Source, SourceManual, SourceGrafana, SourceSite24x7, SourcePrometheusAlertmanager, SourceEmail, SourceGeneric, SourceUniversal),
 		validate.OneOf("Status", a.Status, StatusTriggered, StatusActive, StatusClosed),
 		validate.UUID("ServiceID", a.ServiceID),
+		a.Severity.Valid(),
 	)
 	if err != nil {
 		return nil, err
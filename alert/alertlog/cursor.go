@@ -0,0 +1,121 @@
+package alertlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/search"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	// pendingTimeout is how long a skipped ID is re-checked, in case the transaction that inserted it commits late.
+	// IDs of rolled-back transactions are never used, so they are dropped after this time.
+	pendingTimeout = 2 * time.Minute
+
+	// maxPending limits the number of skipped IDs a Cursor will re-check.
+	maxPending = 10000
+)
+
+// A Cursor tracks the position of a reader of new log entries.
+//
+// IDs are assigned when an entry is inserted, but entries only become visible when their transaction
+// commits, so a reader can see an ID before a lower one. IDs skipped this way are re-checked on
+// following reads, so entries that commit late are still returned, after newer ones.
+type Cursor struct {
+	lastID  int
+	pending map[int]time.Time
+}
+
+// NewCursor creates a Cursor that starts reading after the given log entry ID.
+func NewCursor(afterID int) *Cursor {
+	return &Cursor{lastID: afterID, pending: make(map[int]time.Time)}
+}
+
+// LastID returns the highest log entry ID read.
+func (c *Cursor) LastID() int { return c.lastID }
+
+// pendingIDs returns the skipped IDs to re-check, dropping those older than pendingTimeout.
+func (c *Cursor) pendingIDs(now time.Time) []int {
+	var ids []int
+	for id, t := range c.pending {
+		if now.Sub(t) > pendingTimeout {
+			delete(c.pending, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// found marks an ID as read, recording any IDs skipped since the last one.
+func (c *Cursor) found(id int, now time.Time) {
+	if id <= c.lastID {
+		delete(c.pending, id)
+		return
+	}
+
+	for skipped := c.lastID + 1; skipped < id && len(c.pending) < maxPending; skipped++ {
+		c.pending[skipped] = now
+	}
+	c.lastID = id
+}
+
+// FindNext returns log entries not yet read by the cursor: entries with IDs that were skipped
+// by an earlier read, followed by up to limit new entries, oldest first.
+func (s *Store) FindNext(ctx context.Context, c *Cursor, limit int) ([]Entry, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.Range("Limit", limit, 1, search.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var result []Entry
+	if ids := c.pendingIDs(now); len(ids) > 0 {
+		late, err := s.findMany(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range late {
+			c.found(e.ID(), now)
+		}
+		result = append(result, late...)
+	}
+
+	entries, err := s.FindAllAfter(ctx, c.lastID, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		c.found(e.ID(), now)
+	}
+
+	return append(result, entries...), nil
+}
+
+func (s *Store) findMany(ctx context.Context, ids []int) ([]Entry, error) {
+	rows, err := s.findManyByID.QueryContext(ctx, sqlutil.IntArray(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Entry
+	for rows.Next() {
+		var e Entry
+		err = e.scanWith(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+
+	return result, rows.Err()
+}
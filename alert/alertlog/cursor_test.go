@@ -0,0 +1,28 @@
+package alertlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	now := time.Now()
+	c := NewCursor(10)
+
+	c.found(11, now)
+	c.found(14, now)
+	assert.Equal(t, 14, c.LastID())
+	assert.ElementsMatch(t, []int{12, 13}, c.pendingIDs(now), "skipped IDs are re-checked")
+
+	// a late entry is no longer pending, and does not move the cursor back
+	c.found(12, now)
+	assert.Equal(t, 14, c.LastID())
+	assert.Equal(t, []int{13}, c.pendingIDs(now))
+
+	assert.Empty(t, c.pendingIDs(now.Add(pendingTimeout+time.Second)), "skipped IDs expire")
+
+	c.found(14+maxPending*2, now)
+	assert.Len(t, c.pendingIDs(now), maxPending, "pending IDs are limited")
+}
@@ -44,6 +44,8 @@ func (e Entry) Meta(ctx context.Context) interface{} {
 		dest = &CreatedMetaData{}
 	case TypeClosed:
 		dest = &AutoClose{}
+	case TypeSnoozed:
+		dest = &SnoozeMetaData{}
 	default:
 		return nil
 	}
@@ -133,16 +135,40 @@ func escalationMsg(m *EscalationMetaData) string {
 	} else if m.OldDelayMinutes > 0 {
 		msg += fmt.Sprintf(" automatically after %d minutes", m.OldDelayMinutes)
 	}
+	if m.Skipped {
+		msg += " (skipped, step conditions not met)"
+	}
 
 	return msg
 }
 
+func snoozeMsg(m *SnoozeMetaData) string {
+	switch {
+	case m.DurationMinutes == 60:
+		return " for 1 hour"
+	case m.DurationMinutes%60 == 0:
+		return fmt.Sprintf(" for %d hours", m.DurationMinutes/60)
+	case m.DurationMinutes == 1:
+		return " for 1 minute"
+	}
+
+	return fmt.Sprintf(" for %d minutes", m.DurationMinutes)
+}
+
 func (e Entry) String(ctx context.Context) string {
 	var msg string
 	var infinitive bool
 	switch e.Type() {
 	case TypeCreated:
 		msg = "Created"
+		meta, ok := e.Meta(ctx).(*CreatedMetaData)
+		if ok && meta.MaintenanceWindowID != "" {
+			msg = "Created during maintenance window (not escalated)"
+		} else if ok && meta.IncidentID != 0 {
+			msg = fmt.Sprintf("Created and grouped into incident #%d (not escalated)", meta.IncidentID)
+		} else if ok && meta.RoutingRule != "" {
+			msg = fmt.Sprintf("Created and routed by rule '%s'", meta.RoutingRule)
+		}
 	case TypeAcknowledged:
 		msg = "Acknowledged"
 	case TypeClosed:
@@ -170,6 +196,14 @@ func (e Entry) String(ctx context.Context) string {
 		msg = "Suppressed duplicate: created"
 	case TypeEscalationRequest:
 		msg = "Escalation requested"
+	case TypeSnoozed:
+		msg = "Snoozed"
+		meta, ok := e.Meta(ctx).(*SnoozeMetaData)
+		if ok && meta.DurationMinutes > 0 {
+			msg += snoozeMsg(meta)
+		}
+	case TypeSnoozeExpired:
+		msg = "Snooze expired, re-triggered"
 	default:
 		return "Error"
 	}
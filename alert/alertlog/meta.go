@@ -7,6 +7,9 @@ type EscalationMetaData struct {
 	Deleted         bool
 	OldDelayMinutes int
 	NoOneOnCall     bool
+
+	// Skipped indicates the conditions of the new step were not met, so no one was notified.
+	Skipped bool `json:",omitempty"`
 }
 
 type NotificationMetaData struct {
@@ -15,8 +18,21 @@ type NotificationMetaData struct {
 
 type CreatedMetaData struct {
 	EPNoSteps bool
+
+	// MaintenanceWindowID is set if the alert was created during a maintenance window, and will not be escalated.
+	MaintenanceWindowID string `json:",omitempty"`
+
+	// IncidentID is set if the alert was added to an existing incident, and will not be escalated.
+	IncidentID int64 `json:",omitempty"`
+
+	// RoutingRule is the name of the service routing rule that selected the escalation policy, if any.
+	RoutingRule string `json:",omitempty"`
 }
 
 type AutoClose struct {
 	AlertAutoCloseDays int
 }
+
+type SnoozeMetaData struct {
+	DurationMinutes int
+}
@@ -1,5 +1,5 @@
 -- name: AlertLog_InsertEP :exec
--- Inserts a new alert log for all alerts in the escalation policy that are not closed.
+-- Inserts a new alert log for all alerts escalated by the escalation policy that are not closed.
 INSERT INTO alert_logs(alert_id, event, sub_type, sub_user_id, sub_integration_key_id, sub_hb_monitor_id, sub_channel_id, sub_classifier, meta, message)
 SELECT
     a.id,
@@ -14,8 +14,8 @@ SELECT
     $10
 FROM
     alerts a
-    JOIN services svc ON svc.id = a.service_id
-        AND svc.escalation_policy_id = $1
+    JOIN escalation_policy_state state ON state.alert_id = a.id
+        AND state.escalation_policy_id = $1
 WHERE
     a.status != 'closed';
 
@@ -13,6 +13,7 @@ import (
 	"github.com/target/goalert/integrationkey"
 	"github.com/target/goalert/notification/nfydest"
 	"github.com/target/goalert/permission"
+	"github.com/target/goalert/search"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/validation/validate"
@@ -26,6 +27,9 @@ type Store struct {
 	findAll       *sql.Stmt
 	findAllByType *sql.Stmt
 	findOne       *sql.Stmt
+	findAllAfter  *sql.Stmt
+	findManyByID  *sql.Stmt
+	lastID        *sql.Stmt
 
 	lookupIKeyType *sql.Stmt
 
@@ -118,6 +122,60 @@ func NewStore(ctx context.Context, db *sql.DB, reg *nfydest.Registry) (*Store, e
 			order by id DESC
 			limit 1
 		`),
+		findAllAfter: p.P(`
+			select
+				log.id,
+				log.alert_id,
+				log.timestamp,
+				log.event,
+				log.message,
+				log.sub_type,
+				log.sub_user_id,
+				usr.name,
+				log.sub_integration_key_id,
+				ikey.name,
+				log.sub_hb_monitor_id,
+				hb.name,
+				log.sub_channel_id,
+				nc.name,
+				log.sub_classifier,
+				log.meta
+			from alert_logs log
+			left join users usr on usr.id = log.sub_user_id
+			left join integration_keys ikey on ikey.id = log.sub_integration_key_id
+			left join heartbeat_monitors hb on hb.id = log.sub_hb_monitor_id
+			left join notification_channels nc on nc.id = log.sub_channel_id
+			where log.id > $1
+			order by id
+			limit $2
+		`),
+		findManyByID: p.P(`
+			select
+				log.id,
+				log.alert_id,
+				log.timestamp,
+				log.event,
+				log.message,
+				log.sub_type,
+				log.sub_user_id,
+				usr.name,
+				log.sub_integration_key_id,
+				ikey.name,
+				log.sub_hb_monitor_id,
+				hb.name,
+				log.sub_channel_id,
+				nc.name,
+				log.sub_classifier,
+				log.meta
+			from alert_logs log
+			left join users usr on usr.id = log.sub_user_id
+			left join integration_keys ikey on ikey.id = log.sub_integration_key_id
+			left join heartbeat_monitors hb on hb.id = log.sub_hb_monitor_id
+			left join notification_channels nc on nc.id = log.sub_channel_id
+			where log.id = any($1)
+			order by id
+		`),
+		lastID: p.P(`select coalesce(max(id), 0) from alert_logs`),
 	}, p.Err
 }
 
@@ -416,6 +474,52 @@ func (s *Store) FindOne(ctx context.Context, logID int) (*Entry, error) {
 	return &e, nil
 }
 
+// LastID returns the ID of the most recent log entry, or 0 if there are none.
+func (s *Store) LastID(ctx context.Context) (int, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int
+	err = s.lastID.QueryRowContext(ctx).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// FindAllAfter returns up to limit log entries with an ID greater than afterID, oldest first.
+func (s *Store) FindAllAfter(ctx context.Context, afterID, limit int) ([]Entry, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+	err = validate.Range("Limit", limit, 1, search.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.findAllAfter.QueryContext(ctx, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Entry
+	for rows.Next() {
+		var e Entry
+		err = e.scanWith(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+
+	return result, rows.Err()
+}
+
 // FindLatestByType returns the latest Log Entry given alertID and status type
 func (s *Store) FindLatestByType(ctx context.Context, alertID int, status Type) (*Entry, error) {
 	err := permission.LimitCheckAny(ctx, permission.All)
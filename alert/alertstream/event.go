@@ -0,0 +1,76 @@
+package alertstream
+
+import (
+	"context"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/alert/alertlog"
+)
+
+// Event types.
+const (
+	TypeCreated      = "created"
+	TypeAcknowledged = "acknowledged"
+	TypeClosed       = "closed"
+	TypeEscalated    = "escalated"
+	TypeLog          = "log"
+)
+
+// Event is a change to an alert.
+type Event struct {
+	// ID is the ID of the alert log entry, and can be used to resume a stream.
+	ID        int       `json:"id"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// Message describes the event, e.g., "Acknowledged by Joe".
+	Message string `json:"message"`
+
+	// Alert is the state of the alert when the event was sent.
+	Alert Alert `json:"alert"`
+}
+
+// Alert is the state of an alert.
+type Alert struct {
+	ID        int            `json:"id"`
+	ServiceID string         `json:"serviceID"`
+	Status    alert.Status   `json:"status"`
+	Severity  alert.Severity `json:"severity"`
+	Summary   string         `json:"summary"`
+	Details   string         `json:"details"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+func eventType(t alertlog.Type) string {
+	switch t {
+	case alertlog.TypeCreated:
+		return TypeCreated
+	case alertlog.TypeAcknowledged:
+		return TypeAcknowledged
+	case alertlog.TypeClosed:
+		return TypeClosed
+	case alertlog.TypeEscalated:
+		return TypeEscalated
+	}
+
+	return TypeLog
+}
+
+func newEvent(ctx context.Context, e alertlog.Entry, a alert.Alert) Event {
+	return Event{
+		ID:        e.ID(),
+		Type:      eventType(e.Type()),
+		Timestamp: e.Timestamp(),
+		Message:   e.String(ctx),
+		Alert: Alert{
+			ID:        a.ID,
+			ServiceID: a.ServiceID,
+			Status:    a.Status,
+			Severity:  a.Severity,
+			Summary:   a.Summary,
+			Details:   a.Details,
+			CreatedAt: a.CreatedAt,
+		},
+	}
+}
@@ -0,0 +1,200 @@
+package alertstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	pingInterval = 30 * time.Second
+
+	// maxBackfill is the maximum number of log entries replayed when a client resumes a stream.
+	maxBackfill = 1000
+
+	maxServiceFilter = 50
+)
+
+// ServeHTTP streams alert events as server-sent events.
+//
+// Events can be limited with the following query parameters, which must all match:
+//   - service: a service ID, may be repeated
+//   - favorites: if "1" or "true", only services favorited by the current user
+//   - label: a "key=value" pair services must have
+//
+// Clients resume a stream by sending the Last-Event-ID header (or lastEventID query parameter).
+func (h *Hub) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	q := req.URL.Query()
+	filter, err := h.filter(ctx, q)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	lastEventID := req.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = q.Get("lastEventID")
+	}
+	var afterID int
+	if lastEventID != "" {
+		afterID, err = strconv.Atoi(lastEventID)
+		if err != nil {
+			errutil.HTTPError(ctx, w, validation.NewFieldError("Last-Event-ID", "must be an integer"))
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	sub, startID, err := h.Subscribe(ctx, filter)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	defer h.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// reconnect quickly, as the server may end the request at any time (e.g., on timeout)
+	_, err = fmt.Fprint(w, "retry: 1000\n\n")
+	if err != nil {
+		return
+	}
+
+	if afterID > 0 && afterID < startID {
+		afterID = max(afterID, startID-maxBackfill)
+		missed, err := h.Events(ctx, afterID, startID)
+		if err != nil {
+			log.Log(ctx, err)
+			return
+		}
+		for _, e := range missed {
+			if filter != nil && !filter(e) {
+				continue
+			}
+			err = writeEvent(w, e)
+			if err != nil {
+				return
+			}
+		}
+	}
+	flusher.Flush()
+
+	t := time.NewTicker(pingInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		case e, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			err = writeEvent(w, e)
+		}
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func writeEvent(w http.ResponseWriter, e Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
+
+// filter returns a filter for the service, favorites, and label query parameters, or nil if none are set.
+func (h *Hub) filter(ctx context.Context, q url.Values) (func(Event) bool, error) {
+	var sets []map[string]bool
+	addSet := func(ids []uuid.UUID) {
+		set := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			set[id.String()] = true
+		}
+		sets = append(sets, set)
+	}
+
+	if svcIDs := q["service"]; len(svcIDs) > 0 {
+		err := validate.ManyUUID("service", svcIDs, maxServiceFilter)
+		if err != nil {
+			return nil, err
+		}
+		set := make(map[string]bool, len(svcIDs))
+		for _, id := range svcIDs {
+			set[strings.ToLower(id)] = true
+		}
+		sets = append(sets, set)
+	}
+
+	if fav, _ := strconv.ParseBool(q.Get("favorites")); fav {
+		userID, err := validate.ParseUUID("UserID", permission.UserID(ctx))
+		if err != nil {
+			return nil, err
+		}
+		ids, err := gadb.New(h.cfg.DB).AlertStream_FavoriteServiceIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		addSet(ids)
+	}
+
+	if l := q.Get("label"); l != "" {
+		key, value, ok := strings.Cut(l, "=")
+		if !ok {
+			return nil, validation.NewFieldError("label", "must be in the format key=value")
+		}
+		ids, err := gadb.New(h.cfg.DB).AlertStream_LabelServiceIDs(ctx, gadb.AlertStream_LabelServiceIDsParams{
+			Key:   key,
+			Value: value,
+		})
+		if err != nil {
+			return nil, err
+		}
+		addSet(ids)
+	}
+
+	if len(sets) == 0 {
+		return nil, nil
+	}
+
+	return func(e Event) bool {
+		for _, set := range sets {
+			if !set[e.Alert.ServiceID] {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
@@ -0,0 +1,42 @@
+package alertstream
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_filter(t *testing.T) {
+	h := NewHub(Config{})
+	ctx := context.Background()
+
+	filter, err := h.filter(ctx, url.Values{})
+	require.NoError(t, err)
+	assert.Nil(t, filter, "no parameters should not filter")
+
+	const svcA = "a5f2b3e4-1c2d-4e5f-8a9b-0c1d2e3f4a5b"
+	const svcB = "b5f2b3e4-1c2d-4e5f-8a9b-0c1d2e3f4a5b"
+	filter, err = h.filter(ctx, url.Values{"service": {svcA}})
+	require.NoError(t, err)
+	require.NotNil(t, filter)
+	assert.True(t, filter(Event{Alert: Alert{ServiceID: svcA}}))
+	assert.False(t, filter(Event{Alert: Alert{ServiceID: svcB}}))
+
+	_, err = h.filter(ctx, url.Values{"service": {"foo"}})
+	assert.Error(t, err, "invalid service ID")
+
+	_, err = h.filter(ctx, url.Values{"label": {"foo"}})
+	assert.Error(t, err, "label without value")
+}
+
+func TestWriteEvent(t *testing.T) {
+	rec := httptest.NewRecorder()
+	err := writeEvent(rec, Event{ID: 5, Type: TypeClosed, Alert: Alert{ID: 1}})
+	require.NoError(t, err)
+
+	assert.Regexp(t, `^id: 5\nevent: closed\ndata: \{"id":5,"type":"closed",.*"alert":\{"id":1,.*\}\}\n\n$`, rec.Body.String())
+}
@@ -0,0 +1,225 @@
+// Package alertstream broadcasts alert events (creation, status changes, and other log entries)
+// to connected clients as server-sent events.
+package alertstream
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/log"
+)
+
+const (
+	// pollInterval is how often new log entries are checked for, in case a notification is missed.
+	pollInterval = 5 * time.Second
+
+	batchSize = 100
+
+	// subBuffer is the number of events buffered for each subscriber. Subscribers that fall
+	// behind are disconnected, and can resume with the last event ID they received.
+	subBuffer = 100
+)
+
+// Config configures a Hub.
+type Config struct {
+	DB         *sql.DB
+	AlertStore *alert.Store
+	LogStore   *alertlog.Store
+}
+
+// Hub reads new alert log entries and sends them to subscribers.
+type Hub struct {
+	cfg Config
+
+	notifyCh chan struct{}
+
+	mx   sync.Mutex
+	subs map[*Subscription]struct{}
+	cur  *alertlog.Cursor
+}
+
+// A Subscription receives events from a Hub.
+type Subscription struct {
+	C <-chan Event
+
+	ch     chan Event
+	filter func(Event) bool
+}
+
+// NewHub creates a new Hub. Run must be called to start sending events.
+func NewHub(cfg Config) *Hub {
+	return &Hub{
+		cfg:      cfg,
+		notifyCh: make(chan struct{}, 1),
+		subs:     make(map[*Subscription]struct{}),
+	}
+}
+
+// Notify indicates new log entries may be available.
+func (h *Hub) Notify() {
+	select {
+	case h.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Subscribe registers a new subscription for events matching filter (or all events, if nil),
+// and returns the ID of the last event before the subscription began.
+func (h *Hub) Subscribe(ctx context.Context, filter func(Event) bool) (*Subscription, int, error) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if len(h.subs) == 0 {
+		// no entries are read without subscribers, so skip to the latest one
+		id, err := h.cfg.LogStore.LastID(permission.SystemContext(ctx, "AlertStream"))
+		if err != nil {
+			return nil, 0, err
+		}
+		h.cur = alertlog.NewCursor(id)
+	}
+
+	ch := make(chan Event, subBuffer)
+	sub := &Subscription{C: ch, ch: ch, filter: filter}
+	h.subs[sub] = struct{}{}
+
+	return sub, h.cur.LastID(), nil
+}
+
+// Unsubscribe removes the subscription. It is safe to call more than once.
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	close(sub.ch)
+}
+
+// Run reads and sends new events until ctx is canceled.
+func (h *Hub) Run(ctx context.Context) {
+	ctx = permission.SystemContext(ctx, "AlertStream")
+	t := time.NewTicker(pollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.notifyCh:
+		case <-t.C:
+		}
+
+		err := h.update(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Log(ctx, err)
+		}
+	}
+}
+
+func (h *Hub) update(ctx context.Context) error {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	for len(h.subs) > 0 {
+		entries, err := h.cfg.LogStore.FindNext(ctx, h.cur, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		events, err := h.events(ctx, entries)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if e.Alert.ID == 0 {
+				continue
+			}
+			for sub := range h.subs {
+				if sub.filter != nil && !sub.filter(e) {
+					continue
+				}
+				select {
+				case sub.ch <- e:
+				default:
+					// too slow, the client will reconnect and resume
+					delete(h.subs, sub)
+					close(sub.ch)
+				}
+			}
+		}
+		if len(events) < batchSize {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Events returns events after afterID, up to and including untilID, oldest first.
+func (h *Hub) Events(ctx context.Context, afterID, untilID int) ([]Event, error) {
+	ctx = permission.SystemContext(ctx, "AlertStream")
+
+	var result []Event
+	for afterID < untilID {
+		entries, err := h.cfg.LogStore.FindAllAfter(ctx, afterID, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 {
+			break
+		}
+		events, err := h.events(ctx, entries)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			if e.ID > untilID {
+				return result, nil
+			}
+			if e.Alert.ID != 0 {
+				result = append(result, e)
+			}
+		}
+		afterID = events[len(events)-1].ID
+	}
+
+	return result, nil
+}
+
+// events returns the events for log entries. Events for deleted alerts have an empty Alert.
+func (h *Hub) events(ctx context.Context, entries []alertlog.Entry) ([]Event, error) {
+	var ids []int
+	seen := make(map[int]bool)
+	for _, e := range entries {
+		if seen[e.AlertID()] {
+			continue
+		}
+		seen[e.AlertID()] = true
+		ids = append(ids, e.AlertID())
+	}
+	alerts, err := h.cfg.AlertStore.FindMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]alert.Alert, len(alerts))
+	for _, a := range alerts {
+		byID[a.ID] = a
+	}
+
+	events := make([]Event, len(entries))
+	for i, e := range entries {
+		events[i] = newEvent(ctx, e, byID[e.AlertID()])
+	}
+
+	return events, nil
+}
@@ -0,0 +1,18 @@
+-- name: AlertStream_FavoriteServiceIDs :many
+SELECT
+    tgt_service_id::uuid
+FROM
+    user_favorites
+WHERE
+    user_id = $1
+    AND tgt_service_id NOTNULL;
+
+-- name: AlertStream_LabelServiceIDs :many
+SELECT
+    tgt_service_id::uuid
+FROM
+    labels
+WHERE
+    key = $1
+    AND value = $2
+    AND tgt_service_id NOTNULL;
@@ -18,6 +18,9 @@ const (
 	DedupTypeUser      = DedupType("user")
 	DedupTypeAuto      = DedupType("auto")
 	DedupTypeHeartbeat = DedupType("heartbeat")
+
+	// DedupTypeScheduleGap is used for alerts created when a schedule has a gap in on-call coverage.
+	DedupTypeScheduleGap = DedupType("schedule-gap")
 )
 
 // DedupID represents a de-duplication ID for alerts.
@@ -0,0 +1,258 @@
+package alert
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/lock"
+	"github.com/target/goalert/util/log"
+)
+
+// DefaultIncidentWindow is the number of minutes an incident accepts new alerts when not configured.
+const DefaultIncidentWindow = 30
+
+// incidentGroup identifies the incident a new alert belongs to.
+type incidentGroup struct {
+	Key   string
+	Title string
+}
+
+// groupingService is the service information available to incident grouping rules.
+type groupingService struct {
+	ID     string
+	Name   string
+	Labels map[string]string
+}
+
+// incidentExpr is a compiled incident grouping expression.
+type incidentExpr struct {
+	Name string
+	Prog *vm.Program
+}
+
+// incidentExprCache holds the compiled incident grouping expressions for the most recently seen config.
+type incidentExprCache struct {
+	mx    sync.Mutex
+	hash  [sha256.Size]byte
+	exprs []incidentExpr
+	err   error
+}
+
+// Compile returns the compiled grouping expressions from cfg, re-compiling only when they have changed.
+func (c *incidentExprCache) Compile(cfg config.Config) ([]incidentExpr, error) {
+	hash := sha256.Sum256([]byte(strings.Join(cfg.Incidents.Expressions, "\n")))
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if c.hash == hash && (c.exprs != nil || c.err != nil) {
+		return c.exprs, c.err
+	}
+
+	c.hash = hash
+	c.exprs, c.err = compileIncidentExprs(cfg.Incidents.Expressions)
+	return c.exprs, c.err
+}
+
+func compileIncidentExprs(strs []string) ([]incidentExpr, error) {
+	exprs := make([]incidentExpr, 0, len(strs))
+	for _, str := range strs {
+		name, src, _ := strings.Cut(str, "=")
+		prog, err := expr.Compile(src, expr.AllowUndefinedVariables(), expr.AsBool())
+		if err != nil {
+			return nil, fmt.Errorf("compile incident expression '%s': %w", name, err)
+		}
+		exprs = append(exprs, incidentExpr{Name: name, Prog: prog})
+	}
+
+	return exprs, nil
+}
+
+// incidentGroupFor returns the incident group of a new alert using the first matching rule, or nil if no rule matches.
+//
+// Rules are checked in order: service label keys, then dedup key prefixes, then expressions.
+func incidentGroupFor(cfg config.Config, exprs []incidentExpr, a Alert, svc groupingService) (*incidentGroup, error) {
+	for _, key := range cfg.Incidents.LabelKeys {
+		val, ok := svc.Labels[key]
+		if !ok {
+			continue
+		}
+
+		return &incidentGroup{Key: "label:" + key + "=" + val, Title: key + "=" + val}, nil
+	}
+
+	var dedup string
+	if a.Dedup != nil && a.Dedup.Type == DedupTypeUser {
+		dedup = a.Dedup.Payload
+	}
+	for _, prefix := range cfg.Incidents.DedupPrefixes {
+		if dedup != "" && strings.HasPrefix(dedup, prefix) {
+			return &incidentGroup{Key: "dedup:" + prefix, Title: prefix + "*"}, nil
+		}
+	}
+
+	if len(exprs) == 0 {
+		return nil, nil
+	}
+
+	env := map[string]any{
+		"alert": map[string]any{
+			"summary": a.Summary,
+			"details": a.Details,
+			"source":  string(a.Source),
+			"dedup":   dedup,
+		},
+		"service": map[string]any{
+			"id":     svc.ID,
+			"name":   svc.Name,
+			"labels": svc.Labels,
+		},
+	}
+	for _, e := range exprs {
+		res, err := expr.Run(e.Prog, env)
+		if err != nil {
+			return nil, fmt.Errorf("run incident expression '%s': %w", e.Name, err)
+		}
+		if match, _ := res.(bool); match {
+			return &incidentGroup{Key: "expr:" + e.Name, Title: e.Name}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// groupIncidentTx adds a newly created alert to an incident, if incident grouping is enabled and a rule matches.
+//
+// If a matching incident is still open, the alert is added to it without escalation and the incident ID
+// is returned. Otherwise a new incident is started with the alert as its primary alert, and 0 is returned.
+func (s *Store) groupIncidentTx(ctx context.Context, tx *sql.Tx, a *Alert) (int64, error) {
+	cfg := config.FromContext(ctx)
+	if !cfg.Incidents.Enable {
+		return 0, nil
+	}
+
+	q := gadb.New(tx)
+	rows, err := q.Alert_ServiceGroupingInfo(ctx, uuid.MustParse(a.ServiceID))
+	if err != nil {
+		return 0, err
+	}
+	svc := groupingService{ID: a.ServiceID, Labels: make(map[string]string, len(rows))}
+	for _, r := range rows {
+		svc.Name = r.Name
+		if r.Key != "" {
+			svc.Labels[r.Key] = r.Value
+		}
+	}
+
+	exprs, err := s.incidentExprs.Compile(cfg)
+	if err != nil {
+		// a bad expression should not prevent the alert from being created
+		log.Log(ctx, errors.Wrap(err, "group alert into incident"))
+		return 0, nil
+	}
+
+	grp, err := incidentGroupFor(cfg, exprs, *a, svc)
+	if err != nil {
+		// a bad expression should not prevent the alert from being created
+		log.Log(ctx, errors.Wrap(err, "group alert into incident"))
+		return 0, nil
+	}
+	if grp == nil {
+		return 0, nil
+	}
+
+	err = q.Alert_LockIncidentGroup(ctx, gadb.Alert_LockIncidentGroupParams{
+		LockID:   int32(lock.IncidentGrouping),
+		GroupKey: grp.Key,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	window := cfg.Incidents.WindowMinutes
+	if window == 0 {
+		window = DefaultIncidentWindow
+	}
+	incID, err := q.Alert_FindOpenIncident(ctx, gadb.Alert_FindOpenIncidentParams{
+		GroupKey:      grp.Key,
+		WindowMinutes: int32(window),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err = q.Alert_CreateIncident(ctx, gadb.Alert_CreateIncidentParams{
+			Title:          grp.Title,
+			GroupKey:       grp.Key,
+			PrimaryAlertID: int64(a.ID),
+		})
+		return 0, err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	err = q.Alert_AddIncidentAlert(ctx, gadb.Alert_AddIncidentAlertParams{
+		AlertID:    int64(a.ID),
+		IncidentID: incID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// only the primary alert of an incident is escalated
+	err = q.Alert_DeleteEPState(ctx, int64(a.ID))
+	if err != nil {
+		return 0, err
+	}
+
+	return incID, nil
+}
+
+// cascadeIncidentTx applies a status change of primary alerts to the other alerts of their incidents.
+func (s *Store) cascadeIncidentTx(ctx context.Context, tx *sql.Tx, alertIDs []int64, stat Status) error {
+	if len(alertIDs) == 0 || (stat != StatusActive && stat != StatusClosed) {
+		return nil
+	}
+
+	childIDs, err := gadb.New(tx).Alert_IncidentChildAlerts(ctx, alertIDs)
+	if err != nil {
+		return err
+	}
+	if len(childIDs) == 0 {
+		return nil
+	}
+
+	rows, err := tx.StmtContext(ctx, s.updateByIDAndStatus).QueryContext(ctx, stat, childIDs)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var updatedIDs []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return err
+		}
+		updatedIDs = append(updatedIDs, id)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	t := alertlog.TypeAcknowledged
+	if stat == StatusClosed {
+		t = alertlog.TypeClosed
+	}
+
+	return s.logDB.LogManyTx(ctx, tx, updatedIDs, t, nil)
+}
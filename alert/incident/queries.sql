@@ -0,0 +1,38 @@
+-- name: Incident_FindOne :one
+-- Returns the incident, with the least-resolved status of its alerts.
+SELECT
+    inc.id,
+    inc.title,
+    inc.primary_alert_id,
+    inc.created_at,
+    min(a.status)::enum_alert_status AS status
+FROM
+    incidents inc
+    JOIN incident_alerts ia ON ia.incident_id = inc.id
+    JOIN alerts a ON a.id = ia.alert_id
+WHERE
+    inc.id = @id
+GROUP BY
+    inc.id;
+
+-- name: Incident_FindByAlert :one
+-- Returns the ID of the incident the alert belongs to.
+SELECT
+    incident_id
+FROM
+    incident_alerts
+WHERE
+    alert_id = @alert_id;
+
+-- name: Incident_AlertIDs :many
+-- Returns the IDs of all alerts of the incident, primary alert first.
+SELECT
+    ia.alert_id
+FROM
+    incident_alerts ia
+    JOIN incidents inc ON inc.id = ia.incident_id
+WHERE
+    ia.incident_id = @incident_id
+ORDER BY
+    ia.alert_id != inc.primary_alert_id,
+    ia.alert_id;
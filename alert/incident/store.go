@@ -0,0 +1,110 @@
+package incident
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation/validate"
+)
+
+// An Incident is a group of related alerts. Only the primary alert of an incident is escalated.
+type Incident struct {
+	ID             int
+	Title          string
+	PrimaryAlertID int
+	CreatedAt      time.Time
+
+	// Status is the least-resolved status of the alerts in the incident.
+	Status alert.Status
+}
+
+// Store manages incidents.
+type Store struct {
+	db     *sql.DB
+	alerts *alert.Store
+}
+
+// NewStore creates a new Store. Status updates are applied through the alert store.
+func NewStore(ctx context.Context, db *sql.DB, alerts *alert.Store) (*Store, error) {
+	return &Store{db: db, alerts: alerts}, nil
+}
+
+// FindOne returns the incident with the given ID.
+func (s *Store) FindOne(ctx context.Context, id int) (*Incident, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := gadb.New(s.db).Incident_FindOne(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Incident{
+		ID:             int(row.ID),
+		Title:          row.Title,
+		PrimaryAlertID: int(row.PrimaryAlertID),
+		CreatedAt:      row.CreatedAt,
+		Status:         alert.Status(row.Status),
+	}, nil
+}
+
+// FindByAlert returns the incident the alert belongs to, or nil if it is not part of one.
+func (s *Store) FindByAlert(ctx context.Context, alertID int) (*Incident, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := gadb.New(s.db).Incident_FindByAlert(ctx, int64(alertID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FindOne(ctx, int(id))
+}
+
+// AlertIDs returns the IDs of the alerts in the incident, primary alert first.
+func (s *Store) AlertIDs(ctx context.Context, id int) ([]int, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := gadb.New(s.db).Incident_AlertIDs(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(rows))
+	for i, id := range rows {
+		ids[i] = int(id)
+	}
+
+	return ids, nil
+}
+
+// UpdateStatus will acknowledge or close all alerts of the incident.
+func (s *Store) UpdateStatus(ctx context.Context, id int, status alert.Status) error {
+	err := validate.OneOf("Status", status, alert.StatusActive, alert.StatusClosed)
+	if err != nil {
+		return err
+	}
+
+	ids, err := s.AlertIDs(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.alerts.UpdateManyAlertStatus(ctx, status, ids, nil)
+	return err
+}
@@ -0,0 +1,62 @@
+package alert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+)
+
+func TestIncidentGroupFor(t *testing.T) {
+	var cfg config.Config
+	cfg.Incidents.LabelKeys = []string{"example.com/team"}
+	cfg.Incidents.DedupPrefixes = []string{"db-"}
+	cfg.Incidents.Expressions = []string{"disk=alert.summary contains 'disk'", "bad=alert.summary.foo()"}
+
+	var cache incidentExprCache
+	exprs, err := cache.Compile(cfg)
+	require.NoError(t, err)
+
+	svc := groupingService{ID: "svc", Name: "Service", Labels: map[string]string{}}
+	withTeam := groupingService{ID: "svc", Name: "Service", Labels: map[string]string{"example.com/team": "ops"}}
+
+	grp, err := incidentGroupFor(cfg, exprs, Alert{Summary: "disk full"}, withTeam)
+	require.NoError(t, err)
+	assert.Equal(t, &incidentGroup{Key: "label:example.com/team=ops", Title: "example.com/team=ops"}, grp, "label rules first")
+
+	grp, err = incidentGroupFor(cfg, exprs, Alert{Summary: "disk full", Dedup: NewUserDedup("db-primary")}, svc)
+	require.NoError(t, err)
+	assert.Equal(t, &incidentGroup{Key: "dedup:db-", Title: "db-*"}, grp, "dedup rules before expressions")
+
+	grp, err = incidentGroupFor(cfg, exprs, Alert{Summary: "disk full"}, svc)
+	require.NoError(t, err)
+	assert.Equal(t, &incidentGroup{Key: "expr:disk", Title: "disk"}, grp)
+
+	_, err = incidentGroupFor(cfg, exprs, Alert{Summary: "cpu high"}, svc)
+	assert.Error(t, err, "runtime expression error")
+
+	cfg.Incidents.Expressions = cfg.Incidents.Expressions[:1]
+	exprs, err = cache.Compile(cfg)
+	require.NoError(t, err)
+	require.Len(t, exprs, 1, "expressions re-compiled after config change")
+	grp, err = incidentGroupFor(cfg, exprs, Alert{Summary: "cpu high", Dedup: NewUserDedup("web-1")}, svc)
+	require.NoError(t, err)
+	assert.Nil(t, grp, "no match")
+}
+
+func TestIncidentExprCache(t *testing.T) {
+	var cfg config.Config
+	cfg.Incidents.Expressions = []string{"disk=alert.summary contains 'disk'"}
+
+	var cache incidentExprCache
+	a, err := cache.Compile(cfg)
+	require.NoError(t, err)
+	b, err := cache.Compile(cfg)
+	require.NoError(t, err)
+	assert.Same(t, a[0].Prog, b[0].Prog, "unchanged config should reuse compiled expressions")
+
+	cfg.Incidents.Expressions = []string{"bad=alert.summary contains"}
+	_, err = cache.Compile(cfg)
+	assert.Error(t, err, "compile error")
+}
@@ -10,10 +10,11 @@ import (
 const (
 	DestTypeAlert = "builtin-alert"
 
-	ParamSummary = "summary"
-	ParamDetails = "details"
-	ParamDedup   = "dedup"
-	ParamClose   = "close"
+	ParamSummary  = "summary"
+	ParamDetails  = "details"
+	ParamDedup    = "dedup"
+	ParamClose    = "close"
+	ParamSeverity = "severity"
 
 	FallbackIconURL = "builtin://alert"
 )
@@ -43,6 +44,10 @@ func (s *Store) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
 			ParamID: ParamClose,
 			Label:   "Close",
 			Hint:    "If true, close an existing alert.",
+		}, {
+			ParamID: ParamSeverity,
+			Label:   "Severity",
+			Hint:    "One of critical, high, medium, low, or info (default medium).",
 		}},
 	}, nil
 }
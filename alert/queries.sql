@@ -149,12 +149,13 @@ WHERE
 FOR UPDATE;
 
 -- name: Alert_GetEscalationPolicyID :one
--- Returns the escalation policy ID associated with the alert.
+-- Returns the escalation policy ID associated with the alert, which may differ from the service's if the alert was routed.
 SELECT
-    escalation_policy_id
+    coalesce(state.escalation_policy_id, svc.escalation_policy_id)::uuid
 FROM
     alerts a
     JOIN services svc ON svc.id = a.service_id
+    LEFT JOIN escalation_policy_state state ON state.alert_id = a.id
 WHERE
     a.id = @id::bigint;
 
@@ -171,3 +172,123 @@ SELECT
         WHERE
             state.alert_id = $1
             AND step.multi_ack) AS multi_ack;
+
+-- name: Alert_SnoozeManyAlerts :many
+-- Acknowledges the (non-closed) alerts and sets the time they will be re-triggered.
+WITH acked AS (
+    UPDATE
+        alerts
+    SET
+        status = 'active'
+    WHERE
+        id = ANY (@alert_ids::bigint[])
+        AND status != 'closed'
+    RETURNING
+        id)
+INSERT INTO alert_snoozes(alert_id, snoozed_until)
+SELECT
+    id,
+    now() + make_interval(mins => @duration_minutes::int)
+FROM
+    acked
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        snoozed_until = excluded.snoozed_until,
+        created_at = now()
+    RETURNING
+        alert_id;
+
+-- name: Alert_ClearSnoozes :execrows
+-- Removes any pending snooze for the alerts, so they will not be re-triggered.
+DELETE FROM alert_snoozes
+WHERE alert_id = ANY (@alert_ids::bigint[]);
+
+-- name: Alert_DeleteEPState :exec
+-- Removes the escalation policy state of the alert, so it will not be escalated.
+DELETE FROM escalation_policy_state
+WHERE alert_id = $1;
+
+-- name: Alert_ServiceGroupingInfo :many
+-- Returns the name and labels of a service, for incident grouping. A row with empty key and value is returned for services without labels.
+SELECT
+    svc.name,
+    coalesce(l.key, '')::text AS key,
+    coalesce(l.value, '')::text AS value
+FROM
+    services svc
+    LEFT JOIN labels l ON l.tgt_service_id = svc.id
+WHERE
+    svc.id = @service_id
+ORDER BY
+    l.key;
+
+-- name: Alert_LockIncidentGroup :exec
+-- Locks the incident group key for the current transaction, so concurrent alerts are grouped into the same incident.
+SELECT
+    pg_advisory_xact_lock(@lock_id::int, hashtext(@group_key::text));
+
+-- name: Alert_FindOpenIncident :one
+-- Returns the ID of the most recent incident for the group key that was created within the window and is still open.
+SELECT
+    inc.id
+FROM
+    incidents inc
+    JOIN alerts a ON a.id = inc.primary_alert_id
+WHERE
+    inc.group_key = @group_key
+    AND inc.created_at > now() - make_interval(mins => @window_minutes::int)
+    AND a.status != 'closed'
+ORDER BY
+    inc.created_at DESC
+LIMIT 1;
+
+-- name: Alert_CreateIncident :one
+-- Creates a new incident with the provided alert as the primary (escalated) alert.
+WITH inc AS (
+INSERT INTO incidents(title, group_key, primary_alert_id)
+        VALUES (@title, @group_key, @primary_alert_id)
+    RETURNING
+        id, primary_alert_id)
+    INSERT INTO incident_alerts(alert_id, incident_id)
+    SELECT
+        primary_alert_id,
+        id
+    FROM
+        inc
+    RETURNING
+        incident_id;
+
+-- name: Alert_AddIncidentAlert :exec
+-- Adds an alert to an existing incident.
+INSERT INTO incident_alerts(alert_id, incident_id)
+    VALUES (@alert_id, @incident_id);
+
+-- name: Alert_IncidentChildAlerts :many
+-- Returns the IDs of the non-primary alerts of incidents where the primary alert is one of the provided alerts.
+SELECT
+    ia.alert_id
+FROM
+    incidents inc
+    JOIN incident_alerts ia ON ia.incident_id = inc.id
+        AND ia.alert_id != inc.primary_alert_id
+WHERE
+    inc.primary_alert_id = ANY (@alert_ids::bigint[]);
+
+-- name: Alert_SetEscalationPolicy :exec
+-- Sets the escalation policy of a new alert, overriding the one of its service.
+INSERT INTO escalation_policy_state(alert_id, escalation_policy_id)
+    VALUES (@alert_id, @escalation_policy_id)
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        escalation_policy_id = excluded.escalation_policy_id, escalation_policy_step_id = NULL, escalation_policy_step_number = 0, loop_count = 0, last_escalation = NULL, next_escalation = NULL, force_escalation = FALSE;
+
+-- name: Alert_EPHasSteps :one
+-- Returns true if the Escalation Policy has at least one step.
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            escalation_policy_steps
+        WHERE
+            escalation_policy_id = @escalation_policy_id);
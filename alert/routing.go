@@ -0,0 +1,31 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/target/goalert/service/routing"
+	"github.com/target/goalert/util/log"
+)
+
+// routeTx returns the first routing rule of the service that matches the new alert, or nil if there is none.
+func (s *Store) routeTx(ctx context.Context, tx *sql.Tx, a *Alert, meta map[string]string) (*routing.Rule, error) {
+	rules, err := routing.FindAllByService(ctx, tx, uuid.MustParse(a.ServiceID))
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	rule, err := s.routeMatcher.Match(rules, routing.Env(a.Summary, a.Details, string(a.Source), meta))
+	if err != nil {
+		// a broken rule should not prevent the alert from being created
+		log.Log(ctx, errors.Wrap(err, "route alert"))
+		return nil, nil
+	}
+
+	return rule, nil
+}
@@ -38,6 +38,9 @@ type SearchOptions struct {
 	// Status, if specified, will restrict alerts to those with a matching status.
 	Status []Status `json:"t,omitempty"`
 
+	// Severity, if specified, will restrict alerts to those with a matching severity.
+	Severity []Severity `json:"sv,omitempty"`
+
 	// ServiceFilter, if specified, will restrict alerts to those with a matching ServiceID on IDs, if valid.
 	ServiceFilter IDFilter `json:"v,omitempty"`
 
@@ -99,7 +102,8 @@ var searchTemplate = template.Must(template.New("alert-search").Funcs(search.Hel
 		a.source,
 		a.status,
 		created_at,
-		a.dedup_key
+		a.dedup_key,
+		a.severity
 	FROM alerts a
 	WHERE true
 	{{ if .Omit }}
@@ -113,6 +117,9 @@ var searchTemplate = template.Must(template.New("alert-search").Funcs(search.Hel
 	{{ if .Status }}
 		AND a.status = any(:status::enum_alert_status[])
 	{{ end }}
+	{{ if .Severity }}
+		AND a.severity = any(:severity::enum_alert_severity[])
+	{{ end }}
 	{{ if .ServiceFilter.Valid }}
 		AND (a.service_id = any(:services)
 			{{ if .NotifiedUserID }}
@@ -173,6 +180,7 @@ func (opts renderData) Normalize() (*renderData, error) {
 		validate.Search("Search", opts.Search),
 		validate.Range("Limit", opts.Limit, 0, 1001),
 		validate.Range("Status", len(opts.Status), 0, 3),
+		validate.Range("Severity", len(opts.Severity), 0, 5),
 		validate.ManyUUID("Services", opts.ServiceFilter.IDs, 50),
 		validate.Range("Omit", len(opts.Omit), 0, 50),
 		validate.OneOf("Sort", opts.Sort, SortModeStatusID, SortModeDateID, SortModeDateIDReverse),
@@ -190,6 +198,12 @@ func (opts renderData) Normalize() (*renderData, error) {
 			return nil, err
 		}
 	}
+	for i, sev := range opts.Severity {
+		err = validate.OneOf("Severity["+strconv.Itoa(i)+"]", sev, SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &opts, err
 }
@@ -206,10 +220,16 @@ func (opts renderData) QueryArgs() []sql.NamedArg {
 		stat[i] = string(opts.Status[i])
 	}
 
+	sev := make(sqlutil.StringArray, len(opts.Severity))
+	for i := range opts.Severity {
+		sev[i] = string(opts.Severity[i])
+	}
+
 	return []sql.NamedArg{
 		sql.Named("search", opts.Search),
 		sql.Named("searchID", searchID),
 		sql.Named("status", stat),
+		sql.Named("severity", sev),
 		sql.Named("services", sqlutil.UUIDArray(opts.ServiceFilter.IDs)),
 		sql.Named("svcNameMatchIDs", sqlutil.UUIDArray(opts.serviceNameIDs)),
 		sql.Named("afterID", opts.After.ID),
@@ -0,0 +1,152 @@
+package alert
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/target/goalert/validation"
+)
+
+// Severity indicates the urgency of an Alert.
+type Severity string
+
+// Severity levels, from most to least urgent.
+const (
+	SeverityCritical Severity = "critical"
+	SeverityHigh     Severity = "high"
+	SeverityMedium   Severity = "medium"
+	SeverityLow      Severity = "low"
+	SeverityInfo     Severity = "info"
+)
+
+// DefaultSeverity is used for alerts that do not specify a severity.
+const DefaultSeverity = SeverityMedium
+
+// ParseSeverity parses a severity from an external source, accepting common aliases (e.g., "page",
+// "warning", "P1") case-insensitively. An empty string returns the DefaultSeverity.
+func ParseSeverity(s string) (Severity, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return DefaultSeverity, nil
+	case "critical", "crit", "page", "fatal", "emergency", "p1", "sev1":
+		return SeverityCritical, nil
+	case "high", "error", "err", "major", "p2", "sev2":
+		return SeverityHigh, nil
+	case "medium", "warning", "warn", "moderate", "p3", "sev3":
+		return SeverityMedium, nil
+	case "low", "minor", "p4", "sev4":
+		return SeverityLow, nil
+	case "info", "informational", "none", "ok", "p5", "sev5":
+		return SeverityInfo, nil
+	}
+
+	return "", validation.NewFieldError("Severity", "unknown severity "+s)
+}
+
+// SeverityFromEmail returns the severity of an email based on its X-Priority and Importance headers.
+func SeverityFromEmail(xPriority, importance string) Severity {
+	// X-Priority is a number from 1 (highest) to 5 (lowest), optionally followed by a description, e.g., "1 (Highest)"
+	if p := strings.TrimSpace(xPriority); p != "" {
+		switch p[0] {
+		case '1':
+			return SeverityCritical
+		case '2':
+			return SeverityHigh
+		case '4':
+			return SeverityLow
+		case '5':
+			return SeverityInfo
+		}
+	}
+
+	switch strings.ToLower(strings.TrimSpace(importance)) {
+	case "high":
+		return SeverityHigh
+	case "low":
+		return SeverityLow
+	}
+
+	return DefaultSeverity
+}
+
+// Valid returns nil if the Severity is one of the defined levels.
+func (s Severity) Valid() error {
+	switch s {
+	case SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityInfo:
+		return nil
+	}
+
+	return validation.NewFieldError("Severity", "unknown severity "+string(s))
+}
+
+func (s Severity) rank() int {
+	switch s {
+	case SeverityCritical:
+		return 5
+	case SeverityHigh:
+		return 4
+	case "", SeverityMedium:
+		return 3
+	case SeverityLow:
+		return 2
+	case SeverityInfo:
+		return 1
+	}
+
+	return 0
+}
+
+// AtLeast returns true if s is at least as urgent as sev. An empty Severity is treated as the DefaultSeverity.
+//
+// It must be kept in sync with the ordering of enum_alert_severity in the DB.
+func (s Severity) AtLeast(sev Severity) bool {
+	return s.rank() >= sev.rank()
+}
+
+// Scan handles reading a Severity from the DB format.
+func (s *Severity) Scan(value interface{}) error {
+	switch t := value.(type) {
+	case []byte:
+		*s = Severity(t)
+	case string:
+		*s = Severity(t)
+	case nil:
+		*s = DefaultSeverity
+	default:
+		return fmt.Errorf("could not process unknown type for Severity(%T)", t)
+	}
+
+	return nil
+}
+
+// Value converts the Severity to the DB representation.
+func (s Severity) Value() (driver.Value, error) {
+	if s == "" {
+		return string(DefaultSeverity), nil
+	}
+
+	return string(s), nil
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (s *Severity) UnmarshalGQL(v interface{}) error {
+	str, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return err
+	}
+
+	*s = Severity(str)
+	return s.Valid()
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (s Severity) MarshalGQL(w io.Writer) {
+	if s == "" {
+		s = DefaultSeverity
+	}
+
+	graphql.MarshalString(string(s)).MarshalGQL(w)
+}
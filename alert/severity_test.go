@@ -0,0 +1,44 @@
+package alert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSeverity(t *testing.T) {
+	check := func(in string, exp Severity) {
+		t.Helper()
+		sev, err := ParseSeverity(in)
+		assert.NoError(t, err, in)
+		assert.Equal(t, exp, sev, in)
+	}
+
+	check("", DefaultSeverity)
+	check("critical", SeverityCritical)
+	check(" CRITICAL ", SeverityCritical)
+	check("P1", SeverityCritical)
+	check("error", SeverityHigh)
+	check("warning", SeverityMedium)
+	check("minor", SeverityLow)
+	check("info", SeverityInfo)
+
+	_, err := ParseSeverity("urgent")
+	assert.Error(t, err)
+}
+
+func TestSeverityFromEmail(t *testing.T) {
+	assert.Equal(t, DefaultSeverity, SeverityFromEmail("", ""))
+	assert.Equal(t, SeverityCritical, SeverityFromEmail("1 (Highest)", ""))
+	assert.Equal(t, SeverityInfo, SeverityFromEmail("5", "high"))
+	assert.Equal(t, SeverityHigh, SeverityFromEmail("3 (Normal)", "High"))
+	assert.Equal(t, SeverityLow, SeverityFromEmail("", "low"))
+}
+
+func TestSeverity_AtLeast(t *testing.T) {
+	assert.True(t, SeverityCritical.AtLeast(SeverityHigh))
+	assert.True(t, SeverityHigh.AtLeast(SeverityHigh))
+	assert.False(t, SeverityLow.AtLeast(SeverityMedium))
+	assert.True(t, Severity("").AtLeast(SeverityMedium), "empty is the default severity")
+	assert.True(t, SeverityInfo.AtLeast(SeverityInfo))
+}
@@ -0,0 +1,84 @@
+package alert
+
+import (
+	"context"
+	"time"
+
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Limits for the duration of a snooze.
+const (
+	MinSnoozeDuration = time.Minute
+	MaxSnoozeDuration = 7 * 24 * time.Hour
+)
+
+// SnoozeManyAlerts will acknowledge the given alerts and return them to triggered (restarting escalation
+// from the first step) once the duration has passed. Snoozing an already-snoozed alert replaces the previous snooze.
+//
+// Closed alerts are ignored; the IDs of snoozed alerts are returned.
+func (s *Store) SnoozeManyAlerts(ctx context.Context, alertIDs []int, dur time.Duration) ([]int, error) {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(alertIDs) == 0 {
+		return nil, nil
+	}
+
+	mins := int(dur / time.Minute)
+	err = validate.Many(
+		validate.Range("AlertIDs", len(alertIDs), 1, maxBatch),
+		validate.Range("Duration", mins, int(MinSnoozeDuration/time.Minute), int(MaxSnoozeDuration/time.Minute)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(alertIDs))
+	for i, id := range alertIDs {
+		ids[i] = int64(id)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer sqlutil.Rollback(ctx, "alert: snooze", tx)
+
+	q := gadb.New(tx)
+	err = q.Alert_LockManyAlertServices(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := q.Alert_SnoozeManyAlerts(ctx, gadb.Alert_SnoozeManyAlertsParams{
+		AlertIds:        ids,
+		DurationMinutes: int32(mins),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snoozedIDs := make([]int, len(res))
+	for i, id := range res {
+		snoozedIDs[i] = int(id)
+	}
+
+	err = s.logDB.LogManyTx(ctx, tx, snoozedIDs, alertlog.TypeSnoozed, alertlog.SnoozeMetaData{DurationMinutes: mins})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return snoozedIDs, nil
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/target/goalert/alert/alertlog"
 	"github.com/target/goalert/gadb"
 	"github.com/target/goalert/permission"
+	"github.com/target/goalert/service/maintenance"
+	"github.com/target/goalert/service/routing"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
@@ -25,6 +28,9 @@ type Store struct {
 	db    *sql.DB
 	logDB *alertlog.Store
 
+	incidentExprs *incidentExprCache
+	routeMatcher  *routing.Matcher
+
 	insert       *sql.Stmt
 	update       *sql.Stmt
 	logs         *sql.Stmt
@@ -57,8 +63,11 @@ func NewStore(ctx context.Context, db *sql.DB, logDB *alertlog.Store) (*Store, e
 		db:    db,
 		logDB: logDB,
 
+		incidentExprs: &incidentExprCache{},
+		routeMatcher:  &routing.Matcher{},
+
 		insert: p(`
-			INSERT INTO alerts (summary, details, service_id, source, status, dedup_key) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at
+			INSERT INTO alerts (summary, details, service_id, source, status, dedup_key, severity) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at
 		`),
 		update: p("UPDATE alerts SET status = $2 WHERE id = $1"),
 		logs:   p("SELECT timestamp, event, message FROM alert_logs WHERE alert_id = $1"),
@@ -72,13 +81,14 @@ func NewStore(ctx context.Context, db *sql.DB, logDB *alertlog.Store) (*Store, e
 				a.source,
 				a.status,
 				created_at,
-				a.dedup_key
+				a.dedup_key,
+				a.severity
 			FROM alerts a
 			WHERE a.id = ANY ($1)
 		`),
 		createUpdNew: p(`
 			WITH existing as (
-				SELECT id, summary, details, status, source, created_at, false
+				SELECT id, summary, details, status, source, created_at, severity, false
 				FROM alerts
 				WHERE service_id = $3 AND dedup_key = $5
 			), to_insert as (
@@ -88,11 +98,11 @@ func NewStore(ctx context.Context, db *sql.DB, logDB *alertlog.Store) (*Store, e
 				FROM existing
 			), inserted as (
 				INSERT INTO alerts (
-					summary, details, service_id, source, dedup_key
+					summary, details, service_id, source, dedup_key, severity
 				)
-				SELECT $1, $2, $3, $4, $5
+				SELECT $1, $2, $3, $4, $5, $6
 				FROM to_insert
-				RETURNING id, summary, details, status, source, created_at, true
+				RETURNING id, summary, details, status, source, created_at, severity, true
 			)
 			SELECT * FROM existing
 			UNION
@@ -430,6 +440,12 @@ func (s *Store) UpdateManyAlertStatus(ctx context.Context, status Status, alertI
 		return nil, err
 	}
 
+	// An explicit status change replaces any snooze, even if the alert was already acknowledged by it.
+	_, err = gadb.New(tx).Alert_ClearSnoozes(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
 	rows, err := tx.StmtContext(ctx, s.updateByIDAndStatus).QueryContext(ctx, status, ids)
 	if err != nil {
 		return nil, err
@@ -451,6 +467,15 @@ func (s *Store) UpdateManyAlertStatus(ctx context.Context, status Status, alertI
 		return nil, err
 	}
 
+	updated64 := make([]int64, len(updatedIDs))
+	for i, id := range updatedIDs {
+		updated64[i] = int64(id)
+	}
+	err = s.cascadeIncidentTx(ctx, tx, updated64, status)
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -459,6 +484,8 @@ func (s *Store) UpdateManyAlertStatus(ctx context.Context, status Status, alertI
 	return updatedIDs, nil
 }
 
+// CreateTx creates a new alert manually. Maintenance windows and routing rules are not applied;
+// alerts from integrations are created with CreateOrUpdateTx.
 func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Alert, error) {
 	n, err := a.Normalize() // validation
 	if err != nil {
@@ -487,6 +514,10 @@ func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Alert, err
 	if err != nil {
 		return nil, err
 	}
+	meta.IncidentID, err = s.groupIncidentTx(ctx, tx, n)
+	if err != nil {
+		return nil, err
+	}
 
 	s.logDB.MustLogTx(ctx, tx, n.ID, alertlog.TypeCreated, meta)
 
@@ -500,7 +531,7 @@ func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Alert, err
 func (s *Store) _create(ctx context.Context, tx *sql.Tx, a Alert) (*Alert, *alertlog.CreatedMetaData, error) {
 	var meta alertlog.CreatedMetaData
 
-	row := tx.StmtContext(ctx, s.insert).QueryRowContext(ctx, a.Summary, a.Details, a.ServiceID, a.Source, a.Status, a.DedupKey())
+	row := tx.StmtContext(ctx, s.insert).QueryRowContext(ctx, a.Summary, a.Details, a.ServiceID, a.Source, a.Status, a.DedupKey(), a.Severity)
 	err := row.Scan(&a.ID, &a.CreatedAt)
 	if err != nil {
 		return nil, nil, err
@@ -517,6 +548,13 @@ func (s *Store) _create(ctx context.Context, tx *sql.Tx, a Alert) (*Alert, *aler
 // CreateOrUpdateTx returns `isNew` to indicate if the returned alert was a new one.
 // It is the caller's responsibility to log alert creation if the transaction is committed (and isNew is true).
 func (s *Store) CreateOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Alert, bool, error) {
+	return s.createOrUpdateTx(ctx, tx, a, nil)
+}
+
+// createOrUpdateTx behaves the same as CreateOrUpdateTx, but also sets metadata on the alert if it is new.
+//
+// The routing rules of the service are evaluated against new alerts (including the metadata) before they are created.
+func (s *Store) createOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert, alertMeta map[string]string) (*Alert, bool, error) {
 	err := permission.LimitCheckAny(ctx,
 		permission.System,
 		permission.Admin,
@@ -552,21 +590,91 @@ func (s *Store) CreateOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Al
 	var meta interface{}
 	switch n.Status {
 	case StatusTriggered:
+		// maintenance windows only apply to alerts from integrations and heartbeats
+		var maint *maintenance.Window
+		if permission.Service(ctx) {
+			maint, err = maintenance.ActiveWindow(ctx, tx, uuid.MustParse(n.ServiceID))
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		if maint != nil && maint.SuppressAlerts {
+			dedup, _ := n.DedupKey().Value()
+			err = maintenance.RecordSuppressed(ctx, tx, maint, n.Summary, dedup.(string))
+			if err != nil {
+				return nil, false, fmt.Errorf("record suppressed alert: %w", err)
+			}
+			log.Logf(log.WithFields(ctx, log.Fields{"ServiceID": n.ServiceID, "MaintenanceWindowID": maint.ID}), "Alert suppressed by maintenance window.")
+			return nil, false, nil
+		}
+
+		var rule *routing.Rule
+		rule, err = s.routeTx(ctx, tx, n, alertMeta)
+		if err != nil {
+			return nil, false, err
+		}
+		if rule != nil && rule.Drop {
+			log.Logf(log.WithFields(ctx, log.Fields{"ServiceID": n.ServiceID, "RoutingRule": rule.Name}), "Alert dropped by routing rule.")
+			return nil, false, nil
+		}
+
 		var m alertlog.CreatedMetaData
 		err = tx.Stmt(s.createUpdNew).
-			QueryRowContext(ctx, n.Summary, n.Details, n.ServiceID, n.Source, n.DedupKey()).
-			Scan(&n.ID, &n.Summary, &n.Details, &n.Status, &n.Source, &n.CreatedAt, &inserted)
+			QueryRowContext(ctx, n.Summary, n.Details, n.ServiceID, n.Source, n.DedupKey(), n.Severity).
+			Scan(&n.ID, &n.Summary, &n.Details, &n.Status, &n.Source, &n.CreatedAt, &n.Severity, &inserted)
 		if !inserted {
 			logType = alertlog.TypeDuplicateSupressed
 		} else {
 			logType = alertlog.TypeCreated
-			hasSteps, err := gadb.New(tx).Alert_ServiceEPHasSteps(ctx, uuid.MustParse(n.ServiceID))
+			var hasSteps bool
+			if rule != nil && rule.EscalationPolicyID != "" {
+				epID := uuid.MustParse(rule.EscalationPolicyID)
+				err = gadb.New(tx).Alert_SetEscalationPolicy(ctx, gadb.Alert_SetEscalationPolicyParams{
+					AlertID:            int64(n.ID),
+					EscalationPolicyID: epID,
+				})
+				if err != nil {
+					return nil, false, err
+				}
+				hasSteps, err = gadb.New(tx).Alert_EPHasSteps(ctx, epID)
+				m.RoutingRule = rule.Name
+			} else {
+				hasSteps, err = gadb.New(tx).Alert_ServiceEPHasSteps(ctx, uuid.MustParse(n.ServiceID))
+			}
 			if err != nil {
 				return nil, false, err
 			}
 			m.EPNoSteps = !hasSteps
+
+			if rule != nil && rule.Priority != "" {
+				alertMeta = maps.Clone(alertMeta)
+				if alertMeta == nil {
+					alertMeta = make(map[string]string, 1)
+				}
+				alertMeta[routing.MetadataKeyPriority] = rule.Priority
+			}
+
+			if maint != nil {
+				err = gadb.New(tx).Alert_DeleteEPState(ctx, int64(n.ID))
+				if err != nil {
+					return nil, false, err
+				}
+				m.MaintenanceWindowID = maint.ID
+			} else {
+				m.IncidentID, err = s.groupIncidentTx(ctx, tx, n)
+				if err != nil {
+					return nil, false, err
+				}
+			}
 		}
 		meta = &m
+
+		if inserted && alertMeta != nil {
+			err = s.SetMetadataTx(ctx, tx, n.ID, alertMeta)
+			if err != nil {
+				return nil, false, err
+			}
+		}
 	case StatusActive:
 		var oldStatus Status
 		err = tx.Stmt(s.createUpdAck).
@@ -591,6 +699,12 @@ func (s *Store) CreateOrUpdateTx(ctx context.Context, tx *sql.Tx, a *Alert) (*Al
 	if logType != "" {
 		s.logDB.MustLogTx(ctx, tx, n.ID, logType, meta)
 	}
+	if logType == alertlog.TypeAcknowledged || logType == alertlog.TypeClosed {
+		err = s.cascadeIncidentTx(ctx, tx, []int64{int64(n.ID)}, n.Status)
+		if err != nil {
+			return nil, false, err
+		}
+	}
 
 	return n, inserted, nil
 }
@@ -626,19 +740,11 @@ func (s *Store) createOrUpdate(ctx context.Context, a *Alert, meta map[string]st
 	}
 	defer sqlutil.Rollback(ctx, "alert: upsert", tx)
 
-	n, isNew, err := s.CreateOrUpdateTx(ctx, tx, a)
+	n, isNew, err := s.createOrUpdateTx(ctx, tx, a, meta)
 	if err != nil {
 		return nil, false, err
 	}
 
-	// Set metadata only if meta is not nil and isNew is true
-	if meta != nil && isNew {
-		err = s.SetMetadataTx(ctx, tx, n.ID, meta)
-		if err != nil {
-			return nil, false, err
-		}
-	}
-
 	err = tx.Commit()
 	if err != nil {
 		return nil, false, err
@@ -665,7 +771,20 @@ func (s *Store) UpdateStatusTx(ctx context.Context, tx *sql.Tx, id int, stat Sta
 	if _stat == gadb.EnumAlertStatusClosed {
 		return logError{isAlreadyClosed: true, alertID: id, _type: alertlog.TypeClosed, logDB: s.logDB}
 	}
+
+	// An explicit status change replaces any snooze, even if the alert was already acknowledged by it.
+	snoozes, err := gadb.New(tx).Alert_ClearSnoozes(ctx, []int64{int64(id)})
+	if err != nil {
+		return err
+	}
+
 	if _stat == gadb.EnumAlertStatusActive && stat == StatusActive {
+		if snoozes > 0 {
+			// The alert was only acknowledged by a snooze, which is now a regular acknowledgement.
+			s.logDB.MustLogTx(ctx, tx, id, alertlog.TypeAcknowledged, nil)
+			return nil
+		}
+
 		multiAck, err := gadb.New(tx).Alert_AlertMultiAck(ctx, int64(id))
 		if err != nil {
 			return err
@@ -694,7 +813,7 @@ func (s *Store) UpdateStatusTx(ctx context.Context, tx *sql.Tx, id int, stat Sta
 		log.Log(ctx, errors.Errorf("unknown/unhandled alert status update: %s", stat))
 	}
 
-	return nil
+	return s.cascadeIncidentTx(ctx, tx, []int64{int64(id)}, stat)
 }
 
 func (s *Store) UpdateStatus(ctx context.Context, id int, stat Status) error {
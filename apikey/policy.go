@@ -14,4 +14,7 @@ type GQLPolicy struct {
 	Version int
 	Query   string
 	Role    permission.Role
+
+	// SCIM indicates the key is only valid for the SCIM provisioning API, and not GraphQL.
+	SCIM bool `json:",omitempty"`
 }
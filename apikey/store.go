@@ -59,6 +59,7 @@ type APIKeyInfo struct {
 	UpdatedBy   *uuid.UUID
 	Query       string
 	Role        permission.Role
+	SCIM        bool
 }
 
 func (s *Store) FindAllAdminGraphQLKeys(ctx context.Context) ([]APIKeyInfo, error) {
@@ -112,6 +113,7 @@ func (s *Store) FindAllAdminGraphQLKeys(ctx context.Context) ([]APIKeyInfo, erro
 			UpdatedBy:   &k.UpdatedBy.UUID,
 			Query:       p.Query,
 			Role:        p.Role,
+			SCIM:        p.SCIM,
 		})
 	}
 
@@ -188,25 +190,26 @@ func (s *Store) DeleteAdminGraphQLKey(ctx context.Context, id uuid.UUID) error {
 	})
 }
 
-func (s *Store) AuthorizeGraphQL(ctx context.Context, tok, ua, ip string) (context.Context, error) {
+// authorize will validate the token and return the key ID and policy.
+func (s *Store) authorize(ctx context.Context, tok, ua, ip string) (uuid.UUID, *GQLPolicy, error) {
 	var claims Claims
 	_, err := s.key.VerifyJWT(tok, &claims, Issuer, Audience)
 	if err != nil {
-		return ctx, permission.Unauthorized()
+		return uuid.Nil, nil, permission.Unauthorized()
 	}
 	id, err := uuid.Parse(claims.Subject)
 	if err != nil {
 		log.Logf(ctx, "apikey: invalid subject: %v", err)
-		return ctx, permission.Unauthorized()
+		return uuid.Nil, nil, permission.Unauthorized()
 	}
 
 	info, valid, err := s.polCache.Get(ctx, id)
 	if err != nil {
-		return nil, err
+		return uuid.Nil, nil, err
 	}
 	if !valid {
 		// Successful negative cache lookup, we return Unauthorized because although the token was validated, the key was revoked/removed.
-		return ctx, permission.Unauthorized()
+		return uuid.Nil, nil, permission.Unauthorized()
 	}
 	if !bytes.Equal(info.Hash, claims.PolicyHash) {
 		// Successful cache lookup, but the policy has changed since the token was issued and so the token is no longer valid.
@@ -214,7 +217,7 @@ func (s *Store) AuthorizeGraphQL(ctx context.Context, tok, ua, ip string) (conte
 
 		// We want to log this as a warning, because it is a potential security issue.
 		log.Log(ctx, fmt.Errorf("apikey: policy hash mismatch for key %s", id))
-		return ctx, permission.Unauthorized()
+		return uuid.Nil, nil, permission.Unauthorized()
 	}
 
 	err = s.lastUsedCache.RecordUsage(ctx, id, ua, ip)
@@ -223,13 +226,45 @@ func (s *Store) AuthorizeGraphQL(ctx context.Context, tok, ua, ip string) (conte
 		log.Log(ctx, err)
 	}
 
+	return id, &info.Policy, nil
+}
+
+func (s *Store) AuthorizeGraphQL(ctx context.Context, tok, ua, ip string) (context.Context, error) {
+	id, pol, err := s.authorize(ctx, tok, ua, ip)
+	if err != nil {
+		return ctx, err
+	}
+	if pol.SCIM {
+		return ctx, permission.Unauthorized()
+	}
+
 	ctx = permission.SourceContext(ctx, &permission.SourceInfo{
 		ID:   id.String(),
 		Type: permission.SourceTypeGQLAPIKey,
 	})
-	ctx = permission.UserContext(ctx, "", info.Policy.Role)
+	ctx = permission.UserContext(ctx, "", pol.Role)
 
-	ctx = ContextWithPolicy(ctx, &info.Policy)
+	ctx = ContextWithPolicy(ctx, pol)
+	return ctx, nil
+}
+
+// AuthorizeSCIM will authorize a request to the SCIM provisioning API.
+func (s *Store) AuthorizeSCIM(ctx context.Context, tok, ua, ip string) (context.Context, error) {
+	id, pol, err := s.authorize(ctx, tok, ua, ip)
+	if err != nil {
+		return ctx, err
+	}
+	if !pol.SCIM {
+		return ctx, permission.Unauthorized()
+	}
+
+	ctx = permission.SourceContext(ctx, &permission.SourceInfo{
+		ID:   id.String(),
+		Type: permission.SourceTypeGQLAPIKey,
+	})
+	ctx = permission.UserContext(ctx, "", pol.Role)
+
+	ctx = ContextWithPolicy(ctx, pol)
 	return ctx, nil
 }
 
@@ -240,6 +275,9 @@ type NewAdminGQLKeyOpts struct {
 	Expires time.Time
 	Role    permission.Role
 	Query   string
+
+	// SCIM will create a key for the SCIM provisioning API instead of GraphQL. Query must be empty and Role must be admin.
+	SCIM bool
 }
 
 // CreateAdminGraphQLKey will create a new GraphQL API key returning the ID and token.
@@ -249,7 +287,17 @@ func (s *Store) CreateAdminGraphQLKey(ctx context.Context, opt NewAdminGQLKeyOpt
 		return uuid.Nil, "", err
 	}
 
-	_, qErr := graphql2.QueryFields(opt.Query)
+	var qErr error
+	if opt.SCIM {
+		if opt.Query != "" {
+			qErr = validation.NewFieldError("Query", "must be empty for SCIM keys")
+		}
+		if opt.Role != permission.RoleAdmin {
+			qErr = validate.Many(qErr, validation.NewFieldError("Role", "must be admin for SCIM keys"))
+		}
+	} else {
+		_, qErr = graphql2.QueryFields(opt.Query)
+	}
 	err = validate.Many(
 		qErr,
 		validate.IDName("Name", opt.Name),
@@ -267,6 +315,7 @@ func (s *Store) CreateAdminGraphQLKey(ctx context.Context, opt NewAdminGQLKeyOpt
 		Version: 1,
 		Query:   opt.Query,
 		Role:    opt.Role,
+		SCIM:    opt.SCIM,
 	})
 	if err != nil {
 		return uuid.Nil, "", err
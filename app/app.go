@@ -17,12 +17,15 @@ import (
 	"github.com/target/goalert/alert"
 	"github.com/target/goalert/alert/alertlog"
 	"github.com/target/goalert/alert/alertmetrics"
+	"github.com/target/goalert/alert/alertstream"
+	"github.com/target/goalert/alert/incident"
 	"github.com/target/goalert/apikey"
 	"github.com/target/goalert/app/lifecycle"
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/auth/authlink"
 	"github.com/target/goalert/auth/basic"
 	"github.com/target/goalert/auth/nonce"
+	"github.com/target/goalert/auth/saml"
 	"github.com/target/goalert/calsub"
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/engine"
@@ -38,7 +41,9 @@ import (
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/notification/nfydest"
 	"github.com/target/goalert/notification/slack"
+	"github.com/target/goalert/notification/ticket"
 	"github.com/target/goalert/notification/twilio"
+	"github.com/target/goalert/notification/webhook"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
 	"github.com/target/goalert/override"
@@ -46,8 +51,12 @@ import (
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/schedule/shiftswap"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/maintenance"
+	"github.com/target/goalert/service/routing"
 	"github.com/target/goalert/smtpsrv"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
@@ -56,6 +65,7 @@ import (
 	"github.com/target/goalert/util/calllimiter"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/workload"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"riverqueue.com/riverui"
@@ -91,6 +101,7 @@ type App struct {
 	Engine              *engine.Engine
 	graphql2            *graphqlapp.App
 	AuthHandler         *auth.Handler
+	samlProvider        *saml.Provider
 
 	twilioSMS    *twilio.SMS
 	twilioVoice  *twilio.Voice
@@ -98,11 +109,15 @@ type App struct {
 
 	slackChan *slack.ChannelSender
 
+	ticketSender *ticket.Sender
+
 	ConfigStore *config.Store
 
 	AlertStore        *alert.Store
+	IncidentStore     *incident.Store
 	AlertLogStore     *alertlog.Store
 	AlertMetricsStore *alertmetrics.Store
+	AlertStreamHub    *alertstream.Hub
 
 	AuthBasicStore        *basic.Store
 	UserStore             *user.Store
@@ -119,11 +134,17 @@ type App struct {
 	ScheduleStore       *schedule.Store
 	RotationStore       *rotation.Store
 	DestRegistry        *nfydest.Registry
+	WebhookSecretStore  *webhook.SecretStore
 
 	CalSubStore    *calsub.Store
 	OverrideStore  *override.Store
+	ShiftSwapStore *shiftswap.Store
+	WorkloadStore  *workload.Store
+	TeamStore      *team.Store
 	LimitStore     *limit.Store
 	HeartbeatStore *heartbeat.Store
+	MaintStore     *maintenance.Store
+	RoutingStore   *routing.Store
 
 	OAuthKeyring    keyring.Keyring
 	SessionKeyring  keyring.Keyring
@@ -250,3 +271,12 @@ func (a *App) SMTPAddr() string {
 
 	return a.smtpsrvL.Addr().String()
 }
+
+// SysAPIAddr returns the address of the system API listener, or an empty string if it is disabled.
+func (a *App) SysAPIAddr() string {
+	if a.sysAPIL == nil {
+		return ""
+	}
+
+	return a.sysAPIL.Addr().String()
+}
@@ -613,6 +613,38 @@ Migration: %s (#%d)
 		},
 	}
 
+	exportStateCmd = &cobra.Command{
+		Use:   "export-state",
+		Short: "Exports services, escalation policies, schedules, and rotations as YAML or JSON.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return exportState(cmd.Context(), viper.GetString("format"), viper.GetString("output"))
+		},
+	}
+
+	applyCmd = &cobra.Command{
+		Use:   "apply",
+		Short: "Updates services, escalation policies, schedules, and rotations to match a file from export-state.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file := viper.GetString("file")
+			if file == "" {
+				return validation.NewFieldError("file", "is required")
+			}
+
+			var data []byte
+			var err error
+			if file == "-" {
+				data, err = io.ReadAll(os.Stdin)
+			} else {
+				data, err = os.ReadFile(file)
+			}
+			if err != nil {
+				return errors.Wrap(err, "read state file")
+			}
+
+			return applyState(cmd.Context(), data, viper.GetBool("dry-run"))
+		},
+	}
+
 	addUserCmd = &cobra.Command{
 		Use:   "add-user",
 		Short: "Adds a user for basic authentication.",
@@ -960,11 +992,17 @@ func init() {
 	setConfigCmd.Flags().Bool("allow-empty-data-encryption-key", false, "Explicitly allow an empty data-encryption-key when setting config or re-encrypting data.")
 	reEncryptCmd.Flags().AddFlag(setConfigCmd.Flag("allow-empty-data-encryption-key"))
 
+	exportStateCmd.Flags().String("format", "yaml", "Output format, yaml or json.")
+	exportStateCmd.Flags().StringP("output", "o", "", "Write to a file instead of stdout.")
+
+	applyCmd.Flags().StringP("file", "f", "", "State file (YAML or JSON) to apply, or - for stdin (required).")
+	applyCmd.Flags().Bool("dry-run", false, "Print the changes that would be made without applying them.")
+
 	testCmd.Flags().Bool("offline", false, "Only perform offline checks.")
 
 	monitorCmd.Flags().StringP("config-file", "f", "", "Configuration file for monitoring (required).")
 	initCertCommands()
-	RootCmd.AddCommand(versionCmd, testCmd, migrateCmd, exportCmd, monitorCmd, addUserCmd, getConfigCmd, setConfigCmd, genCerts, reEncryptCmd)
+	RootCmd.AddCommand(versionCmd, testCmd, migrateCmd, exportCmd, monitorCmd, addUserCmd, getConfigCmd, setConfigCmd, exportStateCmd, applyCmd, genCerts, reEncryptCmd)
 
 	err := viper.BindPFlags(RootCmd.Flags())
 	if err != nil {
@@ -994,6 +1032,14 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = viper.BindPFlags(exportStateCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlags(applyCmd.Flags())
+	if err != nil {
+		panic(err)
+	}
 	err = viper.BindPFlags(RootCmd.PersistentFlags())
 	if err != nil {
 		panic(err)
@@ -7,7 +7,9 @@ import (
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/auth/basic"
 	"github.com/target/goalert/auth/github"
+	"github.com/target/goalert/auth/ldap"
 	"github.com/target/goalert/auth/oidc"
+	"github.com/target/goalert/auth/saml"
 )
 
 func (app *App) initAuth(ctx context.Context) error {
@@ -50,6 +52,25 @@ func (app *App) initAuth(ctx context.Context) error {
 		return err
 	}
 
+	app.samlProvider, err = saml.NewProvider(ctx, saml.Config{
+		Keyring:    app.OAuthKeyring,
+		NonceStore: app.NonceStore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "init SAML auth provider")
+	}
+	if err := app.AuthHandler.AddIdentityProvider("saml", app.samlProvider); err != nil {
+		return err
+	}
+
+	ldapProvider, err := ldap.NewProvider(ctx)
+	if err != nil {
+		return errors.Wrap(err, "init LDAP auth provider")
+	}
+	if err := app.AuthHandler.AddIdentityProvider("ldap", ldapProvider); err != nil {
+		return err
+	}
+
 	basicProvider, err := basic.NewProvider(ctx, app.AuthBasicStore)
 	if err != nil {
 		return errors.Wrap(err, "init basic auth provider")
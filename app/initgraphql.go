@@ -15,6 +15,7 @@ func (app *App) initGraphQL(ctx context.Context) error {
 		NRStore:             app.NotificationRuleStore,
 		NCStore:             app.NCStore,
 		AlertStore:          app.AlertStore,
+		IncidentStore:       app.IncidentStore,
 		AlertLogStore:       app.AlertLogStore,
 		AlertMetricsStore:   app.AlertMetricsStore,
 		ServiceStore:        app.ServiceStore,
@@ -29,11 +30,16 @@ func (app *App) initGraphQL(ctx context.Context) error {
 		LabelStore:          app.LabelStore,
 		RuleStore:           app.ScheduleRuleStore,
 		OverrideStore:       app.OverrideStore,
+		ShiftSwapStore:      app.ShiftSwapStore,
+		WorkloadStore:       app.WorkloadStore,
+		TeamStore:           app.TeamStore,
 		ConfigStore:         app.ConfigStore,
 		LimitStore:          app.LimitStore,
 		NotificationStore:   app.NotificationStore,
 		SlackStore:          app.slackChan,
 		HeartbeatStore:      app.HeartbeatStore,
+		MaintStore:          app.MaintStore,
+		RoutingStore:        app.RoutingStore,
 		NoticeStore:         app.NoticeStore,
 		Twilio:              app.twilioConfig,
 		AuthHandler:         app.AuthHandler,
@@ -42,6 +48,7 @@ func (app *App) initGraphQL(ctx context.Context) error {
 		SWO:                 app.cfg.SWO,
 		APIKeyStore:         app.APIKeyStore,
 		DestReg:             app.DestRegistry,
+		WebhookSecretStore:  app.WebhookSecretStore,
 		EncryptionKeys:      app.cfg.EncryptionKeys,
 	}
 
@@ -17,12 +17,16 @@ import (
 	"github.com/target/goalert/notification/twilio"
 	"github.com/target/goalert/permission"
 	prometheus "github.com/target/goalert/prometheusalertmanager"
+	"github.com/target/goalert/scim"
 	"github.com/target/goalert/site24x7"
 	"github.com/target/goalert/util/errutil"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/web"
 )
 
+// alertEventsPath is the path of the alert event stream, relative to the HTTP prefix.
+const alertEventsPath = "/api/v2/alerts/events"
+
 func (app *App) initHTTP(ctx context.Context) error {
 	middleware := []func(http.Handler) http.Handler{
 		func(next http.Handler) http.Handler {
@@ -122,6 +126,12 @@ func (app *App) initHTTP(ctx context.Context) error {
 		UserStore:           app.UserStore,
 	})
 
+	scimH := scim.NewHandler(scim.Config{
+		DB:            app.db,
+		UserStore:     app.UserStore,
+		RotationStore: app.RotationStore,
+	})
+
 	mux.Handle("POST /api/graphql", app.graphql2.Handler())
 
 	mux.HandleFunc("GET /api/v2/config", app.ConfigStore.ServeConfig)
@@ -141,6 +151,14 @@ func (app *App) initHTTP(ctx context.Context) error {
 	mux.HandleFunc("POST /api/v2/identity/providers/oidc", oidcAuth)
 	mux.HandleFunc("GET /api/v2/identity/providers/oidc/callback", oidcAuth)
 
+	ldapAuth := app.AuthHandler.IdentityProviderHandler("ldap")
+	mux.HandleFunc("POST /api/v2/identity/providers/ldap", ldapAuth)
+
+	samlAuth := app.AuthHandler.IdentityProviderHandler("saml")
+	mux.HandleFunc("POST /api/v2/identity/providers/saml", samlAuth)
+	mux.HandleFunc("POST /api/v2/identity/providers/saml/acs", samlAuth)
+	mux.HandleFunc("GET /api/v2/identity/providers/saml/metadata", app.samlProvider.ServeMetadata)
+
 	if expflag.ContextHas(ctx, expflag.UnivKeys) {
 		mux.HandleFunc("POST /api/v2/uik", app.UIKHandler.ServeHTTP)
 	}
@@ -153,6 +171,11 @@ func (app *App) initHTTP(ctx context.Context) error {
 	mux.HandleFunc("POST /api/v2/heartbeat/{heartbeatID}", generic.ServeHeartbeatCheck)
 	mux.HandleFunc("GET /api/v2/user-avatar/{userID}", generic.ServeUserAvatar)
 	mux.HandleFunc("GET /api/v2/calendar", app.CalSubStore.ServeICalData)
+	mux.HandleFunc("GET /api/v2/reports/on-call-workload", app.WorkloadStore.ServeCSV)
+	for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+		mux.Handle(method+" "+scim.BasePath+"/", scimH)
+	}
+	mux.Handle("GET "+alertEventsPath, app.AlertStreamHub)
 
 	mux.HandleFunc("POST /api/v2/twilio/message", app.twilioSMS.ServeMessage)
 	mux.HandleFunc("POST /api/v2/twilio/message/status", app.twilioSMS.ServeStatusCallback)
@@ -161,6 +184,8 @@ func (app *App) initHTTP(ctx context.Context) error {
 
 	mux.HandleFunc("POST /api/v2/slack/message-action", app.slackChan.ServeMessageAction)
 
+	mux.HandleFunc("POST /api/v2/ticketing/callback", app.ticketSender.ServeCallback)
+
 	middleware = append(middleware,
 		httpRewrite(app.cfg.HTTPPrefix, "/v1/graphql2", "/api/graphql"),
 		httpRedirect(app.cfg.HTTPPrefix, "/v1/graphql2/explore", "/api/graphql/explore"),
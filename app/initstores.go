@@ -7,6 +7,8 @@ import (
 	"github.com/target/goalert/alert"
 	"github.com/target/goalert/alert/alertlog"
 	"github.com/target/goalert/alert/alertmetrics"
+	"github.com/target/goalert/alert/alertstream"
+	"github.com/target/goalert/alert/incident"
 	"github.com/target/goalert/apikey"
 	"github.com/target/goalert/auth/authlink"
 	"github.com/target/goalert/auth/basic"
@@ -24,6 +26,8 @@ import (
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/notification/nfydest"
 	"github.com/target/goalert/notification/slack"
+	"github.com/target/goalert/notification/ticket"
+	"github.com/target/goalert/notification/webhook"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
 	"github.com/target/goalert/override"
@@ -31,12 +35,17 @@ import (
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/schedule/shiftswap"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/maintenance"
+	"github.com/target/goalert/service/routing"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/user/favorite"
 	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/workload"
 
 	"github.com/pkg/errors"
 )
@@ -154,6 +163,21 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init alert store")
 	}
 
+	if app.AlertStreamHub == nil {
+		app.AlertStreamHub = alertstream.NewHub(alertstream.Config{
+			DB:         app.db,
+			AlertStore: app.AlertStore,
+			LogStore:   app.AlertLogStore,
+		})
+	}
+
+	if app.IncidentStore == nil {
+		app.IncidentStore, err = incident.NewStore(ctx, app.db, app.AlertStore)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init incident store")
+	}
+
 	if app.ContactMethodStore == nil {
 		app.ContactMethodStore = contactmethod.NewStore(app.DestRegistry)
 	}
@@ -207,6 +231,14 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init notification channel store")
 	}
 
+	if app.WebhookSecretStore == nil {
+		app.WebhookSecretStore = webhook.NewSecretStore(ctx, app.db, app.cfg.EncryptionKeys)
+	}
+
+	if app.ticketSender == nil {
+		app.ticketSender = ticket.NewSender(ctx, app.httpClient, app.db, app.AlertStore)
+	}
+
 	if app.EscalationStore == nil {
 		app.EscalationStore, err = escalation.NewStore(ctx, app.db, escalation.Config{
 			LogStore: app.AlertLogStore,
@@ -253,6 +285,27 @@ func (app *App) initStores(ctx context.Context) error {
 		return errors.Wrap(err, "init override store")
 	}
 
+	if app.ShiftSwapStore == nil {
+		app.ShiftSwapStore, err = shiftswap.NewStore(ctx, app.db, app.OverrideStore)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init shift swap store")
+	}
+
+	if app.WorkloadStore == nil {
+		app.WorkloadStore, err = workload.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init workload store")
+	}
+
+	if app.TeamStore == nil {
+		app.TeamStore, err = team.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init team store")
+	}
+
 	if app.LimitStore == nil {
 		app.LimitStore, err = limit.NewStore(ctx, app.db)
 	}
@@ -265,6 +318,18 @@ func (app *App) initStores(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "init heartbeat store")
 	}
+	if app.MaintStore == nil {
+		app.MaintStore, err = maintenance.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init maintenance window store")
+	}
+	if app.RoutingStore == nil {
+		app.RoutingStore, err = routing.NewStore(ctx, app.db)
+	}
+	if err != nil {
+		return errors.Wrap(err, "init routing rule store")
+	}
 	if app.LabelStore == nil {
 		app.LabelStore, err = label.NewStore(ctx, app.db)
 	}
@@ -33,9 +33,26 @@ func (app *App) initSysAPI(ctx context.Context) error {
 		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
 	}
 
+	opts = append(opts,
+		// stores expect the config, logger, and experimental flags on the context
+		grpc.ChainUnaryInterceptor(sysapiserver.UnaryErrorInterceptor, sysapiserver.UnaryContextInterceptor(app.Context)),
+		grpc.ChainStreamInterceptor(sysapiserver.StreamErrorInterceptor, sysapiserver.StreamContextInterceptor(app.Context)),
+	)
+
 	srv := grpc.NewServer(opts...)
 	reflection.Register(srv)
-	sysapi.RegisterSysAPIServer(srv, &sysapiserver.Server{UserStore: app.UserStore})
+	sysapi.RegisterSysAPIServer(srv, &sysapiserver.Server{
+		DB:                 app.db,
+		UserStore:          app.UserStore,
+		ContactMethodStore: app.ContactMethodStore,
+		ServiceStore:       app.ServiceStore,
+		EscalationStore:    app.EscalationStore,
+		ScheduleStore:      app.ScheduleStore,
+		RotationStore:      app.RotationStore,
+		OverrideStore:      app.OverrideStore,
+		AlertStore:         app.AlertStore,
+		AlertLogStore:      app.AlertLogStore,
+	})
 	app.hSrv = health.NewServer()
 	grpc_health_v1.RegisterHealthServer(srv, app.hSrv)
 
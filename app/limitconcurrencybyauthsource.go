@@ -25,6 +25,12 @@ func LimitConcurrencyByAuthSource(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		ctx := req.Context()
 
+		if req.URL.Path == alertEventsPath {
+			// event streams are long-lived and would block all other requests from the same source
+			next.ServeHTTP(w, req)
+			return
+		}
+
 		src := permission.Source(ctx)
 		if src == nil {
 			// Any unknown source gets put into a single bucket.
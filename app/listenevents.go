@@ -16,4 +16,8 @@ func (app *App) setupListenEvents() {
 		})
 		return nil
 	})
+	app.events.Handle("/goalert/alert-log", func(ctx context.Context, payload string) error {
+		app.AlertStreamHub.Notify()
+		return nil
+	})
 }
@@ -38,6 +38,11 @@ func wrapGzip(next http.Handler) http.Handler {
 				output = w
 				return
 			}
+			if w.Header().Get("Content-Type") == "text/event-stream" {
+				// streamed responses must be written as-is so each event can be flushed
+				output = w
+				return
+			}
 
 			gz := gzPool.Get().(*gzip.Writer)
 			gz.Reset(w)
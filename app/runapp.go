@@ -35,6 +35,7 @@ func (app *App) _Run(ctx context.Context) error {
 	}
 
 	go app.events.Run(ctx)
+	go app.AlertStreamHub.Run(ctx)
 
 	if app.sysAPISrv != nil {
 		app.Logger.InfoContext(ctx, "System API server started.",
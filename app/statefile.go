@@ -0,0 +1,199 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/heartbeat"
+	"github.com/target/goalert/integrationkey"
+	"github.com/target/goalert/keyring"
+	"github.com/target/goalert/label"
+	"github.com/target/goalert/notification/msteams"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/notification/webhook"
+	"github.com/target/goalert/notificationchannel"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/statefile"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/sqlutil"
+)
+
+// withStateStores opens the DB, and calls fn with the stores needed to export or apply state, within a transaction.
+func withStateStores(ctx context.Context, fn func(ctx context.Context, s *statefile.Stores, tx *sql.Tx) error) error {
+	l := log.FromContext(ctx)
+	ctx = log.WithLogger(ctx, l)
+	if viper.GetBool("verbose") {
+		l.EnableDebug()
+	}
+
+	err := viper.ReadInConfig()
+	// ignore file not found error
+	if err != nil && !isCfgNotFound(err) {
+		return errors.Wrap(err, "read config")
+	}
+
+	c, err := getConfig(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open("pgx", c.DBURL)
+	if err != nil {
+		return errors.Wrap(err, "connect to postgres")
+	}
+	defer db.Close()
+	ctx = permission.SystemContext(ctx, "StateFile")
+
+	ctx, s, err := StateStores(ctx, db, c.EncryptionKeys)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "start transaction")
+	}
+	defer sqlutil.Rollback(ctx, "app: state file", tx)
+
+	return fn(ctx, s, tx)
+}
+
+// StateStores returns the stores needed to export or apply state, along with ctx carrying the current config from the DB.
+//
+// Only escalation policy actions that do not require external lookups (users, schedules, rotations, webhooks,
+// and Microsoft Teams) are supported.
+func StateStores(ctx context.Context, db *sql.DB, keys keyring.Keys) (context.Context, *statefile.Stores, error) {
+	cfgStore, err := config.NewStore(ctx, config.StoreConfig{DB: db, Keys: keys})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init config store")
+	}
+	cfg := cfgStore.Config()
+	err = cfgStore.Shutdown(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "shutdown config store")
+	}
+	ctx = cfg.Context(ctx)
+
+	s := &statefile.Stores{DB: db}
+	reg := nfydest.NewRegistry()
+	s.Services, err = service.NewStore(ctx, db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init service store")
+	}
+	usrStore, err := user.NewStore(ctx, db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init user store")
+	}
+	s.Schedules, err = schedule.NewStore(ctx, db, usrStore)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init schedule store")
+	}
+	s.Rotations, err = rotation.NewStore(ctx, db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init rotation store")
+	}
+	s.ScheduleRules, err = rule.NewStore(ctx, db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init schedule rule store")
+	}
+
+	// only destinations that do not require external lookups can be used as escalation policy actions
+	reg.RegisterProvider(ctx, usrStore)
+	reg.RegisterProvider(ctx, s.Schedules)
+	reg.RegisterProvider(ctx, s.Rotations)
+	reg.RegisterProvider(ctx, webhook.NewSender(ctx, http.DefaultClient, nil))
+	reg.RegisterProvider(ctx, msteams.NewSender(ctx, http.DefaultClient))
+	s.Registry = reg
+
+	logStore, err := alertlog.NewStore(ctx, db, reg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init alert log store")
+	}
+	ncStore, err := notificationchannel.NewStore(ctx, db, reg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init notification channel store")
+	}
+	s.Escalation, err = escalation.NewStore(ctx, db, escalation.Config{
+		LogStore: logStore,
+		NCStore:  ncStore,
+		Registry: reg,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init escalation policy store")
+	}
+	s.IntKeys = integrationkey.NewStore(ctx, db, nil, reg, ncStore)
+	s.Heartbeats, err = heartbeat.NewStore(ctx, db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init heartbeat store")
+	}
+	s.Labels, err = label.NewStore(ctx, db)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "init label store")
+	}
+
+	return ctx, s, nil
+}
+
+func exportState(ctx context.Context, format, output string) error {
+	return withStateStores(ctx, func(ctx context.Context, s *statefile.Stores, tx *sql.Tx) error {
+		st, err := s.Export(ctx, tx)
+		if err != nil {
+			return errors.Wrap(err, "export state")
+		}
+		data, err := st.Marshal(format)
+		if err != nil {
+			return err
+		}
+
+		if output == "" || output == "-" {
+			_, err = os.Stdout.Write(data)
+			return err
+		}
+
+		return os.WriteFile(output, data, 0o644)
+	})
+}
+
+func applyState(ctx context.Context, data []byte, dryRun bool) error {
+	desired, err := statefile.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	return withStateStores(ctx, func(ctx context.Context, s *statefile.Stores, tx *sql.Tx) error {
+		changes, err := s.Apply(ctx, tx, desired)
+		if err != nil {
+			return errors.Wrap(err, "apply state")
+		}
+		for _, c := range changes {
+			fmt.Println(c.String())
+		}
+		if len(changes) == 0 {
+			fmt.Println("No changes.")
+			return nil
+		}
+		if dryRun {
+			fmt.Printf("Dry run: %d change(s) not applied.\n", len(changes))
+			return nil
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return errors.Wrap(err, "commit changes")
+		}
+		log.Logf(ctx, "Applied %d change(s).", len(changes))
+		return nil
+	})
+}
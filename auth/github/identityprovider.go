@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util/log"
 	"golang.org/x/oauth2"
 )
@@ -168,64 +169,14 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 		}
 	}
 
+	m := &orgMatcher{g: g, login: login}
 	var inOrg bool
 	if !inUsers && len(cfg.GitHub.AllowedOrgs) > 0 {
-		for _, o := range cfg.GitHub.AllowedOrgs {
-			if strings.Contains(o, "/") {
-				// skip teams (process below)
-				continue
-			}
-			m, _, err := g.Organizations.IsMember(ctx, o, login)
-			if err != nil {
-				log.Log(ctx, errors.Wrap(err, "fetch GitHub org membership"))
-				return nil, auth.Error("Failed to read GitHub org membership")
-			}
-			if m {
-				inOrg = true
-				ctx = log.WithField(ctx, "github_org", o)
-				log.Debugf(ctx, "GitHub Auth matched org")
-				break
-			}
-		}
-
-		if !inOrg {
-
-			opt := &github.ListOptions{}
-			teams := make([]string, 0, 30)
-
-		CheckTeams:
-			for {
-				tm, resp, err := g.Teams.ListUserTeams(ctx, opt)
-				if err != nil {
-					log.Log(ctx, errors.Wrap(err, "fetch GitHub teams"))
-					return nil, auth.Error("Failed to read GitHub team membership")
-				}
-				for _, t := range tm {
-					teamName := strings.ToLower(t.Organization.GetLogin()) + "/" + strings.ToLower(t.GetSlug())
-					teams = append(teams, teamName)
-					if containsOrg(cfg.GitHub.AllowedOrgs, teamName) {
-						inOrg = true
-						ctx = log.WithField(ctx, "github_team", teamName)
-						log.Debugf(ctx, "GitHub Auth matched team")
-						break CheckTeams
-					}
-				}
-				if resp.NextPage == 0 {
-					break
-				}
-				opt.Page = resp.NextPage
-			}
-
-			// if still no match, log everything
-			if !inOrg {
-				log.Debugf(log.WithFields(ctx, log.Fields{
-					"AllowedOrgs":     cfg.GitHub.AllowedOrgs,
-					"TeamMemberships": teams,
-				}), "not in any matching team or org")
-			}
+		inOrg, err = m.matchAny(ctx, cfg.GitHub.AllowedOrgs)
+		if err != nil {
+			return nil, err
 		}
 	}
-
 	if !inUsers && !inOrg {
 		return nil, auth.Error("Not a member of an allowed org or whitelisted user.")
 	}
@@ -233,9 +184,84 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 		return nil, auth.Error("GitHub user has no display name set.")
 	}
 
+	var role permission.Role
+	if len(cfg.GitHub.AdminOrgs) > 0 {
+		isAdmin, err := m.matchAny(ctx, cfg.GitHub.AdminOrgs)
+		if err != nil {
+			return nil, err
+		}
+		role = permission.RoleUser
+		if isAdmin {
+			role = permission.RoleAdmin
+		}
+	}
+
 	return &auth.Identity{
 		Email:     u.GetEmail(),
 		Name:      u.GetName(),
 		SubjectID: strconv.FormatInt(u.GetID(), 10),
+		Role:      role,
 	}, nil
 }
+
+// orgMatcher checks org and team membership of a user, fetching the user's teams at most once.
+type orgMatcher struct {
+	g     *github.Client
+	login string
+
+	teams []string
+}
+
+// matchAny returns true if the user is a member of any of the listed orgs (or teams, using the format 'org/team').
+func (m *orgMatcher) matchAny(ctx context.Context, orgs []string) (bool, error) {
+	for _, o := range orgs {
+		if strings.Contains(o, "/") {
+			// skip teams (process below)
+			continue
+		}
+		isMember, _, err := m.g.Organizations.IsMember(ctx, o, m.login)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "fetch GitHub org membership"))
+			return false, auth.Error("Failed to read GitHub org membership")
+		}
+		if isMember {
+			log.Debugf(log.WithField(ctx, "github_org", o), "GitHub Auth matched org")
+			return true, nil
+		}
+	}
+
+	if m.teams == nil {
+		opt := &github.ListOptions{}
+		m.teams = make([]string, 0, 30)
+		for {
+			tm, resp, err := m.g.Teams.ListUserTeams(ctx, opt)
+			if err != nil {
+				m.teams = nil
+				log.Log(ctx, errors.Wrap(err, "fetch GitHub teams"))
+				return false, auth.Error("Failed to read GitHub team membership")
+			}
+			for _, t := range tm {
+				m.teams = append(m.teams, strings.ToLower(t.Organization.GetLogin())+"/"+strings.ToLower(t.GetSlug()))
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			opt.Page = resp.NextPage
+		}
+	}
+
+	for _, teamName := range m.teams {
+		if containsOrg(orgs, teamName) {
+			log.Debugf(log.WithField(ctx, "github_team", teamName), "GitHub Auth matched team")
+			return true, nil
+		}
+	}
+
+	// if still no match, log everything
+	log.Debugf(log.WithFields(ctx, log.Fields{
+		"Orgs":            orgs,
+		"TeamMemberships": m.teams,
+	}), "not in any matching team or org")
+
+	return false, nil
+}
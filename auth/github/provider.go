@@ -24,7 +24,7 @@ func authConfig(ctx context.Context) *oauth2.Config {
 		tokenURL = strings.TrimSuffix(cfg.GitHub.EnterpriseURL, "/") + "/login/oauth/access_token"
 	}
 	scopes := []string{"read:user"}
-	if len(cfg.GitHub.AllowedOrgs) > 0 {
+	if len(cfg.GitHub.AllowedOrgs) > 0 || len(cfg.GitHub.AdminOrgs) > 0 {
 		scopes = append(scopes, "read:org")
 	}
 	return &oauth2.Config{
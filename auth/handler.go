@@ -53,6 +53,7 @@ type Handler struct {
 	addSubject *sql.Stmt
 	updateUA   *sql.Stmt
 	updateUser *sql.Stmt
+	updateRole *sql.Stmt
 
 	startSession *sql.Stmt
 	fetchSession *sql.Stmt
@@ -84,12 +85,19 @@ func NewHandler(ctx context.Context, db *sql.DB, cfg HandlerConfig) (*Handler, e
 			where id = $1
 		`),
 
+		updateRole: p.P(`
+			update users
+			set role = $2
+			where id = $1 and role != $2
+		`),
+
 		userLookup: p.P(`
-			select user_id
-			from auth_subjects
+			select sub.user_id, usr.deactivated_at notnull
+			from auth_subjects sub
+			join users usr on usr.id = sub.user_id
 			where
-				provider_id = $1 and
-				subject_id = $2
+				sub.provider_id = $1 and
+				sub.subject_id = $2
 		`),
 		addSubject: p.P(`
 			insert into auth_subjects (provider_id, subject_id, user_id)
@@ -294,8 +302,17 @@ func (h *Handler) IdentityProviderHandler(id string) http.HandlerFunc {
 		ctx := req.Context()
 		cfg := config.FromContext(ctx)
 
+		var isCallback bool
+		if cp, ok := p.(PostCallbackProvider); ok && req.Method == "POST" {
+			isCallback = cp.IsPostCallback(req)
+		}
+
 		var refU *url.URL
-		if req.Method == "POST" {
+		if isCallback {
+			// Cross-site POST from an external identity provider, neither the referer
+			// nor the login_redir cookie are available.
+			refU, _ = url.Parse(cfg.CallbackURL(""))
+		} else if req.Method == "POST" {
 			if cfg.ShouldUsePublicURL() {
 				refU, _ = url.Parse(req.Header.Get("referer"))
 				if refU == nil || !cfg.ValidReferer("", req.Header.Get("referer")) {
@@ -340,7 +357,7 @@ func (h *Handler) IdentityProviderHandler(id string) http.HandlerFunc {
 			return
 		}
 
-		if req.Method == "POST" {
+		if req.Method == "POST" && !isCallback {
 			h.serveProviderPost(id, p, refU, w, req)
 			return
 		}
@@ -379,6 +396,10 @@ func (h *Handler) canCreateUser(ctx context.Context, providerID string) bool {
 		return cfg.OIDC.NewUsers
 	case "github":
 		return cfg.GitHub.NewUsers
+	case "ldap":
+		return cfg.LDAP.NewUsers
+	case "saml":
+		return cfg.SAML.NewUsers
 	}
 
 	return false
@@ -438,7 +459,8 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 	}
 
 	var userID string
-	err = h.userLookup.QueryRowContext(ctx, id, sub.SubjectID).Scan(&userID)
+	var deactivated bool
+	err = h.userLookup.QueryRowContext(ctx, id, sub.SubjectID).Scan(&userID, &deactivated)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
 	}
@@ -446,6 +468,10 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 		errRedirect(err)
 		return
 	}
+	if deactivated {
+		errRedirect(Error("This account has been deactivated."))
+		return
+	}
 
 	var newUser bool
 	if userID == "" {
@@ -465,8 +491,12 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 		}
 		defer sqlutil.Rollback(ctx, "auth: create user", tx)
 
+		role := permission.RoleUser
+		if sub.Role != "" {
+			role = sub.Role
+		}
 		u := &user.User{
-			Role:  permission.RoleUser,
+			Role:  role,
 			Name:  validate.SanitizeName(sub.Name),
 			Email: validate.SanitizeEmail(sub.Email),
 		}
@@ -494,6 +524,14 @@ func (h *Handler) handleProvider(id string, p IdentityProvider, refU *url.URL, w
 		if err != nil {
 			log.Log(ctx, errors.Wrap(err, "update user info"))
 		}
+		if sub.Role != "" {
+			// fail the login rather than leave a stale role, as the provider is the source of truth
+			_, err = h.updateRole.ExecContext(ctx, userID, sub.Role)
+			if err != nil {
+				errRedirect(errors.Wrap(err, "update user role"))
+				return
+			}
+		}
 	}
 
 	tok, err := h.CreateSession(ctx, req.UserAgent(), userID)
@@ -564,6 +602,15 @@ func (h *Handler) authWithToken(w http.ResponseWriter, req *http.Request, next h
 		next.ServeHTTP(w, req.WithContext(ctx))
 		return true
 	}
+	if strings.HasPrefix(req.URL.Path, "/api/v2/scim/") && strings.HasPrefix(tokStr, "ey") {
+		ctx, err = h.cfg.APIKeyStore.AuthorizeSCIM(ctx, tokStr, req.UserAgent(), req.RemoteAddr)
+		if errutil.HTTPError(req.Context(), w, err) {
+			return true
+		}
+
+		next.ServeHTTP(w, req.WithContext(ctx))
+		return true
+	}
 	if req.URL.Path == "/api/v2/uik" && strings.HasPrefix(tokStr, "ey") {
 		ctx, err = h.cfg.IntKeyStore.AuthorizeUIK(ctx, tokStr)
 		if errutil.HTTPError(req.Context(), w, err) {
@@ -3,6 +3,8 @@ package auth
 import (
 	"context"
 	"net/http"
+
+	"github.com/target/goalert/permission"
 )
 
 // An IdentityProvider provides an option for a user to login (identify themselves).
@@ -14,6 +16,17 @@ type IdentityProvider interface {
 	ExtractIdentity(*RouteInfo, http.ResponseWriter, *http.Request) (*Identity, error)
 }
 
+// A PostCallbackProvider is an IdentityProvider that receives POST requests directly from
+// an external identity provider (e.g., a SAML assertion consumer service).
+//
+// Callback requests skip referer validation, so the provider must authenticate them itself.
+type PostCallbackProvider interface {
+	IdentityProvider
+
+	// IsPostCallback returns true if the request is a callback from the external identity provider.
+	IsPostCallback(*http.Request) bool
+}
+
 // Identity represents a user's proven identity.
 type Identity struct {
 	// SubjectID should be a provider-specific identifier for an individual.
@@ -21,6 +34,9 @@ type Identity struct {
 	Email         string
 	EmailVerified bool
 	Name          string
+
+	// Role, if set, will be applied to the user on each login.
+	Role permission.Role
 }
 
 // ProviderInfo holds the details for using a provider.
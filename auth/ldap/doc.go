@@ -0,0 +1,2 @@
+// Package ldap implements an auth provider that identifies a user via a directory server.
+package ldap
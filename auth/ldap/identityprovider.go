@@ -0,0 +1,188 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+	"github.com/target/goalert/auth"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+)
+
+// Info implements the auth.Provider interface.
+func (Provider) Info(ctx context.Context) auth.ProviderInfo {
+	cfg := config.FromContext(ctx)
+	name := "LDAP"
+	if cfg.LDAP.OverrideName != "" {
+		name = cfg.LDAP.OverrideName
+	}
+	return auth.ProviderInfo{
+		Title: name,
+		Fields: []auth.Field{
+			{ID: "username", Label: "Username", Required: true},
+			{ID: "password", Label: "Password", Password: true, Required: true},
+		},
+		Enabled: cfg.LDAP.Enable,
+	}
+}
+
+func attrOrDefault(val, def string) string {
+	if val == "" {
+		return def
+	}
+	return val
+}
+
+// connect will dial the directory server, upgrading the connection with StartTLS if configured,
+// and bind as the configured search user.
+func (p *Provider) connect(cfg config.Config) (conn, error) {
+	u, err := url.Parse(cfg.LDAP.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse URL")
+	}
+	tlsCfg := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: cfg.LDAP.InsecureSkipVerify,
+	}
+
+	c, err := p.dial(cfg.LDAP.URL, tlsCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "dial")
+	}
+
+	if cfg.LDAP.StartTLS && u.Scheme == "ldap" {
+		err = c.StartTLS(tlsCfg)
+		if err != nil {
+			c.Close()
+			return nil, errors.Wrap(err, "start TLS")
+		}
+	}
+
+	if cfg.LDAP.BindDN != "" {
+		err = c.Bind(cfg.LDAP.BindDN, cfg.LDAP.BindPassword)
+		if err != nil {
+			c.Close()
+			return nil, errors.Wrap(err, "bind search user")
+		}
+	}
+
+	return c, nil
+}
+
+// hasGroup returns true if any of the user's groups are in the list, ignoring case.
+func hasGroup(userGroups, groups []string) bool {
+	for _, ug := range userGroups {
+		for _, g := range groups {
+			if strings.EqualFold(strings.TrimSpace(ug), strings.TrimSpace(g)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ExtractIdentity implements the auth.IdentityProvider interface, providing identity based
+// on the given username and password fields.
+func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter, req *http.Request) (*auth.Identity, error) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+	if !cfg.LDAP.Enable {
+		return nil, auth.Error("LDAP authentication is disabled.")
+	}
+
+	username, password := req.FormValue("username"), req.FormValue("password")
+	if username == "" {
+		return nil, auth.Error("invalid username")
+	}
+	if password == "" {
+		// an empty password results in an unauthenticated bind, which most servers allow
+		return nil, auth.Error("unknown username/password")
+	}
+	ctx = log.WithField(ctx, "username", username)
+
+	err := p.lim.Lock(ctx, username)
+	if errutil.HTTPError(ctx, w, err) {
+		return nil, err
+	}
+	defer p.lim.Unlock(username)
+
+	c, err := p.connect(cfg)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "connect to LDAP server"))
+		return nil, auth.Error("Failed to connect to LDAP server.")
+	}
+	defer c.Close()
+
+	subjectAttr := cfg.LDAP.SubjectAttribute
+	nameAttr := attrOrDefault(cfg.LDAP.NameAttribute, "cn")
+	emailAttr := attrOrDefault(cfg.LDAP.EmailAttribute, "mail")
+	groupAttr := attrOrDefault(cfg.LDAP.GroupAttribute, "memberOf")
+	attrs := []string{nameAttr, emailAttr, groupAttr}
+	if subjectAttr != "" {
+		attrs = append(attrs, subjectAttr)
+	}
+
+	filter := strings.ReplaceAll(attrOrDefault(cfg.LDAP.UserFilter, "(uid={username})"), "{username}", ldap.EscapeFilter(username))
+	res, err := c.Search(ldap.NewSearchRequest(
+		cfg.LDAP.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		2, int(timeout.Seconds()), false,
+		filter, attrs, nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		log.Log(ctx, errors.Wrap(err, "search for LDAP user"))
+		return nil, auth.Error("Failed to search LDAP directory.")
+	}
+	if res == nil || len(res.Entries) != 1 {
+		if res != nil && len(res.Entries) > 1 {
+			log.Log(ctx, fmt.Errorf("LDAP user filter matched multiple entries"))
+		}
+		auth.Delay(ctx)
+		return nil, auth.Error("unknown username/password")
+	}
+	entry := res.Entries[0]
+
+	err = c.Bind(entry.DN, password)
+	if err != nil {
+		log.Debug(ctx, errors.Wrap(err, "LDAP login"))
+		auth.Delay(ctx)
+		return nil, auth.Error("unknown username/password")
+	}
+
+	groups := entry.GetEqualFoldAttributeValues(groupAttr)
+	if len(cfg.LDAP.AllowedGroups) > 0 && !hasGroup(groups, cfg.LDAP.AllowedGroups) {
+		return nil, auth.Error("Not a member of an allowed group.")
+	}
+
+	var role permission.Role
+	if len(cfg.LDAP.AdminGroups) > 0 {
+		role = permission.RoleUser
+		if hasGroup(groups, cfg.LDAP.AdminGroups) {
+			role = permission.RoleAdmin
+		}
+	}
+
+	subjectID := strings.ToLower(entry.DN)
+	if subjectAttr != "" {
+		subjectID = entry.GetEqualFoldAttributeValue(subjectAttr)
+		if subjectID == "" {
+			log.Log(ctx, fmt.Errorf("LDAP user missing subject attribute '%s'", subjectAttr))
+			return nil, auth.Error("Invalid LDAP user entry.")
+		}
+	}
+
+	return &auth.Identity{
+		SubjectID: subjectID,
+		Name:      attrOrDefault(entry.GetEqualFoldAttributeValue(nameAttr), username),
+		Email:     entry.GetEqualFoldAttributeValue(emailAttr),
+		Role:      role,
+	}, nil
+}
@@ -0,0 +1,156 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/auth"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
+)
+
+// fakeDir is an in-process stand-in for a directory server.
+type fakeDir struct {
+	passwords map[string]string
+	entries   []*ldap.Entry
+
+	startTLS bool
+	boundAs  string
+}
+
+var uidFilter = regexp.MustCompile(`^\(uid=(.*)\)$`)
+
+func (d *fakeDir) Bind(username, password string) error {
+	if password == "" || d.passwords[username] != password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, nil)
+	}
+	d.boundAs = username
+	return nil
+}
+
+func (d *fakeDir) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	m := uidFilter.FindStringSubmatch(req.Filter)
+	if m == nil {
+		return nil, ldap.NewError(ldap.LDAPResultFilterError, nil)
+	}
+
+	res := &ldap.SearchResult{}
+	for _, e := range d.entries {
+		if !strings.HasSuffix(e.DN, req.BaseDN) || e.GetAttributeValue("uid") != m[1] {
+			continue
+		}
+		res.Entries = append(res.Entries, e)
+	}
+	return res, nil
+}
+
+func (d *fakeDir) StartTLS(*tls.Config) error { d.startTLS = true; return nil }
+func (d *fakeDir) Close() error               { return nil }
+
+func TestProvider_ExtractIdentity(t *testing.T) {
+	const (
+		bobDN   = "uid=bob,ou=people,dc=example,dc=com"
+		aliceDN = "uid=alice,ou=people,dc=example,dc=com"
+		admins  = "cn=admins,ou=groups,dc=example,dc=com"
+		oncall  = "cn=oncall,ou=groups,dc=example,dc=com"
+	)
+	dir := &fakeDir{
+		passwords: map[string]string{
+			"cn=search,dc=example,dc=com": "search-pass",
+			bobDN:                         "bob-pass",
+			aliceDN:                       "alice-pass",
+		},
+		entries: []*ldap.Entry{
+			ldap.NewEntry(bobDN, map[string][]string{
+				"uid":      {"bob"},
+				"cn":       {"Bob Smith"},
+				"mail":     {"bob@example.com"},
+				"memberOf": {oncall},
+			}),
+			ldap.NewEntry(aliceDN, map[string][]string{
+				"uid":      {"alice"},
+				"memberOf": {oncall, strings.ToUpper(admins)},
+			}),
+		},
+	}
+	p, err := NewProvider(context.Background())
+	require.NoError(t, err)
+	p.dial = func(u string, tlsCfg *tls.Config) (conn, error) {
+		assert.Equal(t, "ldap.example.com", tlsCfg.ServerName)
+		dir.startTLS = false
+		dir.boundAs = ""
+		return dir, nil
+	}
+
+	var cfg config.Config
+	cfg.LDAP.Enable = true
+	cfg.LDAP.URL = "ldap://ldap.example.com"
+	cfg.LDAP.StartTLS = true
+	cfg.LDAP.BindDN = "cn=search,dc=example,dc=com"
+	cfg.LDAP.BindPassword = "search-pass"
+	cfg.LDAP.BaseDN = "ou=people,dc=example,dc=com"
+
+	login := func(cfg config.Config, username, password string) (*auth.Identity, error) {
+		t.Helper()
+		form := url.Values{"username": {username}, "password": {password}}
+		req := httptest.NewRequest("POST", "/api/v2/identity/providers/ldap", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req = req.WithContext(cfg.Context(req.Context()))
+		return p.ExtractIdentity(&auth.RouteInfo{}, httptest.NewRecorder(), req)
+	}
+
+	id, err := login(cfg, "bob", "bob-pass")
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Identity{
+		SubjectID: bobDN,
+		Name:      "Bob Smith",
+		Email:     "bob@example.com",
+	}, id)
+	assert.True(t, dir.startTLS, "StartTLS")
+	assert.Equal(t, bobDN, dir.boundAs)
+
+	_, err = login(cfg, "bob", "wrong")
+	assert.ErrorAs(t, err, new(auth.Error), "bad password")
+	_, err = login(cfg, "bob", "")
+	assert.ErrorAs(t, err, new(auth.Error), "empty password")
+	_, err = login(cfg, "nobody", "bob-pass")
+	assert.ErrorAs(t, err, new(auth.Error), "unknown user")
+	_, err = login(cfg, "*", "bob-pass")
+	assert.Error(t, err, "filter is escaped")
+
+	t.Run("groups", func(t *testing.T) {
+		cfg := cfg
+		cfg.LDAP.AllowedGroups = []string{oncall}
+		cfg.LDAP.AdminGroups = []string{admins}
+		cfg.LDAP.SubjectAttribute = "uid"
+
+		id, err := login(cfg, "bob", "bob-pass")
+		require.NoError(t, err)
+		assert.Equal(t, "bob", id.SubjectID)
+		assert.Equal(t, permission.RoleUser, id.Role)
+
+		id, err = login(cfg, "alice", "alice-pass")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", id.Name, "name falls back to username")
+		assert.Equal(t, permission.RoleAdmin, id.Role, "group DN matching ignores case")
+
+		cfg.LDAP.AllowedGroups = []string{admins}
+		_, err = login(cfg, "bob", "bob-pass")
+		assert.ErrorAs(t, err, new(auth.Error), "not in allowed group")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg := cfg
+		cfg.LDAP.Enable = false
+		_, err := login(cfg, "bob", "bob-pass")
+		assert.ErrorAs(t, err, new(auth.Error))
+	})
+}
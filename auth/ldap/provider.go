@@ -0,0 +1,46 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/target/goalert/ctxlock"
+)
+
+// conn is the subset of an LDAP connection used by the Provider.
+type conn interface {
+	Bind(username, password string) error
+	Search(*ldap.SearchRequest) (*ldap.SearchResult, error)
+	StartTLS(*tls.Config) error
+	Close() error
+}
+
+// Provider implements the auth.IdentityProvider interface.
+type Provider struct {
+	dial func(url string, tlsCfg *tls.Config) (conn, error)
+
+	lim *ctxlock.IDLocker[string]
+}
+
+// NewProvider creates a new Provider.
+func NewProvider(ctx context.Context) (*Provider, error) {
+	return &Provider{
+		dial: dialURL,
+		lim:  ctxlock.NewIDLocker[string](ctxlock.Config{MaxHeld: 1}),
+	}, nil
+}
+
+const timeout = 10 * time.Second
+
+func dialURL(url string, tlsCfg *tls.Config) (conn, error) {
+	c, err := ldap.DialURL(url, ldap.DialWithTLSConfig(tlsCfg), ldap.DialWithDialer(&net.Dialer{Timeout: timeout}))
+	if err != nil {
+		return nil, err
+	}
+	c.SetTimeout(timeout)
+
+	return c, nil
+}
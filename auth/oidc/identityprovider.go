@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util/log"
 	"golang.org/x/oauth2"
 )
@@ -349,9 +351,54 @@ func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter,
 	infoFieldBool("EmailVerified", cfg.OIDC.UserInfoEmailVerifiedPath, &id.EmailVerified)
 	infoFieldStr("Name", cfg.OIDC.UserInfoNamePath, &id.Name)
 
+	if len(cfg.OIDC.AdminGroups) > 0 {
+		// missing or invalid groups are treated as no groups, so admin access is removed rather than kept
+		id.Role = permission.RoleUser
+		groups, ok := groupList(getInfo("Groups", cfg.OIDC.UserInfoGroupsPath))
+		if !ok {
+			log.Log(ctx, errors.New("expected Groups to be a string or list of strings in UserInfo"))
+		}
+		if hasGroup(groups, cfg.OIDC.AdminGroups) {
+			id.Role = permission.RoleAdmin
+		}
+	}
+
 	return &id, nil
 }
 
+// groupList converts a UserInfo search result to a list of group names. A single string is treated as
+// a list of one group.
+func groupList(v interface{}) ([]string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, true
+	case string:
+		return []string{v}, true
+	case []interface{}:
+		groups := make([]string, 0, len(v))
+		for _, g := range v {
+			s, ok := g.(string)
+			if !ok {
+				return nil, false
+			}
+			groups = append(groups, s)
+		}
+		return groups, true
+	}
+
+	return nil, false
+}
+
+// hasGroup returns true if any of the groups is in the list.
+func hasGroup(groups, list []string) bool {
+	for _, g := range groups {
+		if slices.Contains(list, g) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Provider) userInfoData(ctx context.Context, token oauth2.TokenSource) (interface{}, error) {
 	provider, err := p.provider(ctx)
 	if err != nil {
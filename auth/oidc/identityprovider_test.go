@@ -0,0 +1,33 @@
+package oidc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupList(t *testing.T) {
+	check := func(data string, exp []string, expOK bool) {
+		t.Helper()
+		var v interface{}
+		assert.NoError(t, json.Unmarshal([]byte(data), &v))
+		groups, ok := groupList(v)
+		assert.Equal(t, expOK, ok, data)
+		assert.Equal(t, exp, groups, data)
+	}
+
+	check(`null`, nil, true)
+	check(`"admins"`, []string{"admins"}, true)
+	check(`["admins", "users"]`, []string{"admins", "users"}, true)
+	check(`[]`, []string{}, true)
+	check(`["admins", 1]`, nil, false)
+	check(`{"name": "admins"}`, nil, false)
+}
+
+func TestHasGroup(t *testing.T) {
+	assert.True(t, hasGroup([]string{"users", "admins"}, []string{"admins"}))
+	assert.False(t, hasGroup([]string{"users"}, []string{"admins"}))
+	assert.False(t, hasGroup([]string{"Admins"}, []string{"admins"}), "group names are case sensitive")
+	assert.False(t, hasGroup(nil, []string{"admins"}))
+}
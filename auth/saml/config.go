@@ -0,0 +1,23 @@
+package saml
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/target/goalert/keyring"
+)
+
+// NonceStore records the use of one-time values.
+type NonceStore interface {
+	New() [16]byte
+	Consume(context.Context, [16]byte) (bool, error)
+}
+
+// Config provides necessary parameters for SAML authentication.
+type Config struct {
+	Keyring    keyring.Keyring
+	NonceStore NonceStore
+
+	// HTTPClient is used to fetch identity provider metadata. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+}
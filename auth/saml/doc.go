@@ -0,0 +1,2 @@
+// Package saml implements an auth provider that acts as a SAML 2.0 service provider.
+package saml
@@ -0,0 +1,278 @@
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/pkg/errors"
+	"github.com/target/goalert/auth"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/util/log"
+)
+
+var (
+	_ auth.IdentityProvider     = &Provider{}
+	_ auth.PostCallbackProvider = &Provider{}
+)
+
+var b64enc = base64.RawURLEncoding
+
+// requestIDPrefix ensures request IDs are valid xsd:ID values (which must not start with a digit).
+const requestIDPrefix = "id-"
+
+// Info returns the appropriate auth.ProviderInfo based on configuration.
+//
+// As SAML requires no user input, only the Title is provided.
+func (p *Provider) Info(ctx context.Context) auth.ProviderInfo {
+	cfg := config.FromContext(ctx)
+	title := "SAML"
+	if cfg.SAML.OverrideName != "" {
+		title = cfg.SAML.OverrideName
+	}
+	return auth.ProviderInfo{
+		Title:   title,
+		Enabled: cfg.SAML.Enable,
+	}
+}
+
+// IsPostCallback implements the auth.PostCallbackProvider interface, identifying requests
+// to the assertion consumer service.
+func (p *Provider) IsPostCallback(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "/acs")
+}
+
+// newRequestID will generate a signed AuthnRequest ID containing the nonce and current time.
+func (p *Provider) newRequestID(nonce [16]byte) (string, error) {
+	buf := bytes.NewBuffer(nil)
+	buf.Write(nonce[:])
+	if err := binary.Write(buf, binary.BigEndian, time.Now().Unix()); err != nil {
+		return "", err
+	}
+
+	sig, err := p.cfg.Keyring.Sign(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	buf.Write(sig)
+
+	return requestIDPrefix + b64enc.EncodeToString(buf.Bytes()), nil
+}
+
+// assertionNonce returns the nonce value used to record the use of an assertion, derived from its issuer and ID.
+func assertionNonce(a *saml.Assertion) [16]byte {
+	var nonce [16]byte
+	sum := sha256.Sum256([]byte(a.Issuer.Value + "\x00" + a.ID))
+	copy(nonce[:], sum[:])
+	return nonce
+}
+
+// parseRequestID will validate a request ID generated by newRequestID, returning the nonce
+// value. False is returned if the ID is invalid or expired.
+func (p *Provider) parseRequestID(id string) ([16]byte, bool) {
+	var nonce [16]byte
+	id, ok := strings.CutPrefix(id, requestIDPrefix)
+	if !ok {
+		return nonce, false
+	}
+	data, err := b64enc.DecodeString(id)
+	if err != nil || len(data) < 24 {
+		return nonce, false
+	}
+	valid, _ := p.cfg.Keyring.Verify(data[:24], data[24:])
+	if !valid {
+		return nonce, false
+	}
+
+	t := time.Unix(int64(binary.BigEndian.Uint64(data[16:24])), 0)
+	if time.Since(t) > time.Hour {
+		return nonce, false
+	}
+	if time.Until(t) > time.Minute*5 {
+		// too far in the future (clock drift)
+		return nonce, false
+	}
+
+	copy(nonce[:], data)
+	return nonce, true
+}
+
+// attrValue returns the first value of the named attribute, matching either the Name or FriendlyName.
+func attrValue(a *saml.Assertion, name string) string {
+	for _, st := range a.AttributeStatements {
+		for _, attr := range st.Attributes {
+			if attr.Name != name && attr.FriendlyName != name {
+				continue
+			}
+			for _, v := range attr.Values {
+				if v.Value != "" {
+					return strings.TrimSpace(v.Value)
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// identity maps a verified assertion to a user identity.
+func identity(cfg config.Config, a *saml.Assertion) (*auth.Identity, error) {
+	var nameID saml.NameID
+	if a.Subject != nil && a.Subject.NameID != nil {
+		nameID = *a.Subject.NameID
+	}
+
+	var id auth.Identity
+	if cfg.SAML.SubjectAttribute != "" {
+		id.SubjectID = attrValue(a, cfg.SAML.SubjectAttribute)
+	} else if nameID.Format != string(saml.TransientNameIDFormat) {
+		id.SubjectID = strings.TrimSpace(nameID.Value)
+	}
+	if id.SubjectID == "" {
+		return nil, errors.New("missing subject")
+	}
+
+	emailAttr := cfg.SAML.EmailAttribute
+	if emailAttr == "" {
+		emailAttr = "email"
+	}
+	id.Email = attrValue(a, emailAttr)
+	if id.Email == "" && nameID.Format == string(saml.EmailAddressNameIDFormat) {
+		id.Email = strings.TrimSpace(nameID.Value)
+	}
+
+	nameAttr := cfg.SAML.NameAttribute
+	if nameAttr == "" {
+		nameAttr = "displayName"
+	}
+	id.Name = attrValue(a, nameAttr)
+
+	return &id, nil
+}
+
+// ExtractIdentity will return a redirect error for new auth requests, and provide a users identity
+// for assertion consumer service requests.
+func (p *Provider) ExtractIdentity(route *auth.RouteInfo, w http.ResponseWriter, req *http.Request) (*auth.Identity, error) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	name := "SAML"
+	if cfg.SAML.OverrideName != "" {
+		name = cfg.SAML.OverrideName
+	}
+
+	switch route.RelativePath {
+	case "/":
+		sp, err := p.serviceProvider(ctx, true)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "init SAML service provider"))
+			return nil, auth.Error(fmt.Sprintf("Could not login due to wrong configuration for %s.", name))
+		}
+		ssoURL := sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+		if ssoURL == "" {
+			log.Log(ctx, errors.New("SAML identity provider does not support HTTP-Redirect binding"))
+			return nil, auth.Error(fmt.Sprintf("Could not login due to wrong configuration for %s.", name))
+		}
+
+		authReq, err := sp.MakeAuthenticationRequest(ssoURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "make SAML AuthnRequest"))
+			return nil, auth.Error("Failed to generate authentication request.")
+		}
+		authReq.ID, err = p.newRequestID(p.cfg.NonceStore.New())
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "generate SAML request ID"))
+			return nil, auth.Error("Failed to generate authentication request.")
+		}
+
+		// the redirect binding signs the query string, so the ID can be changed until now
+		u, err := authReq.Redirect("", sp)
+		if err != nil {
+			log.Log(ctx, errors.Wrap(err, "sign SAML AuthnRequest"))
+			return nil, auth.Error("Failed to generate authentication request.")
+		}
+
+		return nil, auth.RedirectURL(u.String())
+	case "/acs":
+		// handled below
+	default:
+		return nil, auth.Error(fmt.Sprintf("Could not login due to wrong configuration for %s.", name))
+	}
+
+	rawResp, err := base64.StdEncoding.DecodeString(req.FormValue("SAMLResponse"))
+	if err != nil {
+		return nil, auth.Error(fmt.Sprintf("Invalid response from %s server.", name))
+	}
+
+	// The response is not yet verified, only InResponseTo is used to determine which request
+	// it was for (if any). The ID is itself signed and must match the verified response below.
+	var unverified saml.Response
+	err = xml.Unmarshal(rawResp, &unverified)
+	if err != nil {
+		return nil, auth.Error(fmt.Sprintf("Invalid response from %s server.", name))
+	}
+
+	sp, err := p.serviceProvider(ctx, true)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "init SAML service provider"))
+		return nil, auth.Error(fmt.Sprintf("Could not login due to wrong configuration for %s.", name))
+	}
+
+	var requestIDs []string
+	nonce, ok := p.parseRequestID(unverified.InResponseTo)
+	switch {
+	case ok:
+		requestIDs = []string{unverified.InResponseTo}
+	case cfg.SAML.AllowIdPInitiated:
+		sp.AllowIDPInitiated = true
+	default:
+		return nil, auth.Error(fmt.Sprintf("Login request expired or must be started from GoAlert instead of %s. You can try again", name))
+	}
+
+	assertion, err := sp.ParseXMLResponse(rawResp, requestIDs, sp.AcsURL)
+	if err != nil {
+		var respErr *saml.InvalidResponseError
+		if errors.As(err, &respErr) {
+			err = respErr.PrivateErr
+		}
+		log.Log(ctx, errors.Wrap(err, "validate SAML response"))
+		return nil, auth.Error(fmt.Sprintf("Invalid response from %s server.", name))
+	}
+
+	if !ok {
+		// An IdP-initiated response has no request nonce, so the assertion ID is used up instead,
+		// preventing a captured response from being replayed. Assertions are only accepted shortly
+		// after they are issued (MaxIssueDelay), long before the nonce store forgets the ID.
+		if assertion.ID == "" {
+			log.Logf(ctx, "validate SAML response: assertion ID missing")
+			return nil, auth.Error(fmt.Sprintf("Invalid response from %s server.", name))
+		}
+		nonce = assertionNonce(assertion)
+	}
+
+	// only after verification, so a forged response can't use up the nonce
+	ok, err = p.cfg.NonceStore.Consume(ctx, nonce)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "consume nonce value"))
+		return nil, auth.Error("Could not login. You can try again")
+	}
+	if !ok {
+		return nil, auth.Error("Could not login. You can try again")
+	}
+
+	id, err := identity(cfg, assertion)
+	if err != nil {
+		log.Log(ctx, errors.Wrap(err, "map SAML assertion"))
+		return nil, auth.Error(fmt.Sprintf("Invalid response from %s server.", name))
+	}
+
+	return id, nil
+}
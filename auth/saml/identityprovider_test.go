@@ -0,0 +1,286 @@
+package saml
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/auth"
+	"github.com/target/goalert/config"
+)
+
+type testKeyring struct{ key []byte }
+
+func (k testKeyring) RotateKeys(context.Context) error { return nil }
+func (k testKeyring) Sign(p []byte) ([]byte, error) {
+	h := hmac.New(sha256.New, k.key)
+	h.Write(p)
+	return h.Sum(nil), nil
+}
+
+func (k testKeyring) Verify(p, sig []byte) (bool, bool) {
+	exp, _ := k.Sign(p)
+	return hmac.Equal(exp, sig), false
+}
+
+func (k testKeyring) SignJWT(jwt.Claims) (string, error) { return "", errors.New("not implemented") }
+func (k testKeyring) VerifyJWT(string, jwt.Claims, string, string) (bool, error) {
+	return false, errors.New("not implemented")
+}
+func (k testKeyring) Shutdown(context.Context) error { return nil }
+
+type testNonceStore map[[16]byte]bool
+
+func (s testNonceStore) New() [16]byte {
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func (s testNonceStore) Consume(_ context.Context, id [16]byte) (bool, error) {
+	if s[id] {
+		return false, nil
+	}
+	s[id] = true
+	return true, nil
+}
+
+type spProvider struct{ md *saml.EntityDescriptor }
+
+func (p spProvider) GetServiceProvider(_ *http.Request, id string) (*saml.EntityDescriptor, error) {
+	if p.md == nil || id != p.md.EntityID {
+		return nil, os.ErrNotExist
+	}
+	return p.md, nil
+}
+
+func newCert(t *testing.T, cn string) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return key, cert
+}
+
+func TestProvider_ExtractIdentity(t *testing.T) {
+	spKey, spCert := newCert(t, "goalert.example.com")
+	idpKey, idpCert := newCert(t, "idp.example.com")
+
+	sps := &spProvider{}
+	idp := &saml.IdentityProvider{
+		Key:                     idpKey,
+		Certificate:             idpCert,
+		MetadataURL:             url.URL{Scheme: "https", Host: "idp.example.com", Path: "/metadata"},
+		SSOURL:                  url.URL{Scheme: "https", Host: "idp.example.com", Path: "/sso"},
+		ServiceProviderProvider: sps,
+		AssertionMaker:          saml.DefaultAssertionMaker{},
+	}
+	idpMeta, err := xml.Marshal(idp.Metadata())
+	require.NoError(t, err)
+
+	var cfg config.Config
+	cfg.General.PublicURL = "https://goalert.example.com"
+	cfg.SAML.Enable = true
+	cfg.SAML.IdPMetadata = string(idpMeta)
+	cfg.SAML.Certificate = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: spCert.Raw}))
+	cfg.SAML.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(spKey)}))
+	cfg.SAML.EmailAttribute = "mail"
+	cfg.SAML.NameAttribute = "cn"
+	require.NoError(t, cfg.Validate())
+
+	nonces := make(testNonceStore)
+	p, err := NewProvider(context.Background(), Config{
+		Keyring:    testKeyring{key: []byte("secret")},
+		NonceStore: nonces,
+	})
+	require.NoError(t, err)
+
+	// SP metadata is what the IdP is configured with
+	rec := httptest.NewRecorder()
+	p.ServeMetadata(rec, httptest.NewRequest("GET", metadataPath, nil).WithContext(cfg.Context(context.Background())))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var spMeta saml.EntityDescriptor
+	require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &spMeta))
+	assert.Equal(t, "https://goalert.example.com/api/v2/identity/providers/saml/acs", spMeta.SPSSODescriptors[0].AssertionConsumerServices[0].Location)
+	sps.md = &spMeta
+
+	extract := func(cfg config.Config, req *http.Request) (*auth.Identity, error) {
+		t.Helper()
+		req = req.WithContext(cfg.Context(req.Context()))
+		var route auth.RouteInfo
+		route.RelativePath = strings.TrimPrefix(req.URL.Path, "/api/v2/identity/providers/saml")
+		if route.RelativePath == "" {
+			route.RelativePath = "/"
+		}
+		return p.ExtractIdentity(&route, httptest.NewRecorder(), req)
+	}
+
+	// respond will generate a signed SAML response from the IdP, as the browser would POST it to the ACS
+	respond := func(authnReq *saml.AuthnRequest, session *saml.Session) *http.Request {
+		t.Helper()
+		idpReq := &saml.IdpAuthnRequest{IDP: idp, HTTPRequest: httptest.NewRequest("GET", "/sso", nil), Now: time.Now()}
+		if authnReq != nil {
+			idpReq.Request = *authnReq
+		} else {
+			// IdP-initiated
+			idpReq.Request = saml.AuthnRequest{Issuer: &saml.Issuer{Value: spMeta.EntityID}}
+		}
+		idpReq.ServiceProviderMetadata = &spMeta
+		idpReq.SPSSODescriptor = &spMeta.SPSSODescriptors[0]
+		idpReq.ACSEndpoint = &spMeta.SPSSODescriptors[0].AssertionConsumerServices[0]
+		require.NoError(t, idp.AssertionMaker.MakeAssertion(idpReq, session))
+		form, err := idpReq.PostBinding()
+		require.NoError(t, err)
+
+		body := url.Values{"SAMLResponse": {form.SAMLResponse}}
+		req := httptest.NewRequest("POST", acsPath, strings.NewReader(body.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	login := func(cfg config.Config, session *saml.Session) *http.Request {
+		t.Helper()
+		_, err := extract(cfg, httptest.NewRequest("POST", "/api/v2/identity/providers/saml", nil))
+		var redir auth.RedirectURL
+		require.ErrorAs(t, err, &redir)
+		assert.True(t, strings.HasPrefix(string(redir), "https://idp.example.com/sso?SAMLRequest="))
+		u, err := url.Parse(string(redir))
+		require.NoError(t, err)
+		assert.NotEmpty(t, u.Query().Get("Signature"), "request is signed")
+
+		idpReq, err := saml.NewIdpAuthnRequest(idp, httptest.NewRequest("GET", u.String(), nil))
+		require.NoError(t, err)
+		require.NoError(t, idpReq.Validate())
+		return respond(&idpReq.Request, session)
+	}
+
+	session := &saml.Session{
+		ID:             "session1",
+		CreateTime:     time.Now(),
+		ExpireTime:     time.Now().Add(time.Hour),
+		NameID:         "bob-123",
+		NameIDFormat:   string(saml.PersistentNameIDFormat),
+		UserEmail:      "bob@example.com",
+		UserCommonName: "Bob Smith",
+	}
+
+	acsReq := login(cfg, session)
+	assert.True(t, p.IsPostCallback(acsReq))
+	id, err := extract(cfg, acsReq)
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Identity{SubjectID: "bob-123", Email: "bob@example.com", Name: "Bob Smith"}, id)
+
+	t.Run("replay", func(t *testing.T) {
+		acsReq := login(cfg, session)
+		body, err := url.ParseQuery(readBody(t, acsReq))
+		require.NoError(t, err)
+
+		post := func() (*auth.Identity, error) {
+			req := httptest.NewRequest("POST", acsPath, strings.NewReader(body.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			return extract(cfg, req)
+		}
+		_, err = post()
+		require.NoError(t, err)
+		_, err = post()
+		assert.ErrorAs(t, err, new(auth.Error), "response can only be used once")
+	})
+
+	t.Run("subject attribute", func(t *testing.T) {
+		cfg := cfg
+		cfg.SAML.SubjectAttribute = "mail"
+		acsReq := login(cfg, session)
+		id, err := extract(cfg, acsReq)
+		require.NoError(t, err)
+		assert.Equal(t, "bob@example.com", id.SubjectID)
+	})
+
+	t.Run("transient", func(t *testing.T) {
+		s := *session
+		s.NameIDFormat = string(saml.TransientNameIDFormat)
+		acsReq := login(cfg, &s)
+		_, err := extract(cfg, acsReq)
+		assert.ErrorAs(t, err, new(auth.Error), "transient NameID can't identify a user")
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		cfg := cfg
+		acsReq := login(cfg, session)
+		cfg.SAML.EntityID = "https://other.example.com"
+		_, err = extract(cfg, acsReq)
+		assert.ErrorAs(t, err, new(auth.Error))
+	})
+
+	t.Run("untrusted signature", func(t *testing.T) {
+		acsReq := login(cfg, session)
+
+		otherKey, otherCert := newCert(t, "idp.example.com")
+		other := *idp
+		other.Key = otherKey
+		other.Certificate = otherCert
+		otherMeta, err := xml.Marshal(other.Metadata())
+		require.NoError(t, err)
+		cfg := cfg
+		cfg.SAML.IdPMetadata = string(otherMeta)
+		_, err = extract(cfg, acsReq)
+		assert.ErrorAs(t, err, new(auth.Error))
+	})
+
+	t.Run("idp initiated", func(t *testing.T) {
+		_, err := extract(cfg, respond(nil, session))
+		assert.ErrorAs(t, err, new(auth.Error), "disabled by default")
+
+		cfg := cfg
+		cfg.SAML.AllowIdPInitiated = true
+		body := readBody(t, respond(nil, session))
+		post := func() (*auth.Identity, error) {
+			req := httptest.NewRequest("POST", acsPath, strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			return extract(cfg, req)
+		}
+		id, err := post()
+		require.NoError(t, err)
+		assert.Equal(t, "bob-123", id.SubjectID)
+
+		_, err = post()
+		assert.ErrorAs(t, err, new(auth.Error), "response can only be used once")
+
+		id, err = extract(cfg, respond(nil, session))
+		require.NoError(t, err, "a new response is accepted")
+		assert.Equal(t, "bob-123", id.SubjectID)
+	})
+}
+
+func readBody(t *testing.T, req *http.Request) string {
+	t.Helper()
+	require.NoError(t, req.ParseForm())
+	return req.PostForm.Encode()
+}
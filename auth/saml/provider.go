@@ -0,0 +1,230 @@
+package saml
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/pkg/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation"
+)
+
+const (
+	metadataPath = "/api/v2/identity/providers/saml/metadata"
+	acsPath      = "/api/v2/identity/providers/saml/acs"
+
+	// metadataCacheTime is how long identity provider metadata fetched by URL is reused.
+	metadataCacheTime = time.Hour
+
+	// maxMetadataSize is the largest identity provider metadata document that will be fetched.
+	maxMetadataSize = 10 << 20
+)
+
+// Provider implements the auth.IdentityProvider interface by acting as a SAML 2.0
+// service provider.
+type Provider struct {
+	cfg Config
+
+	mx       sync.Mutex
+	metadata map[string]cachedMetadata
+}
+
+type cachedMetadata struct {
+	desc    *saml.EntityDescriptor
+	fetched time.Time
+}
+
+// NewProvider prepares a new Provider with the given config.
+func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	if cfg.Keyring == nil {
+		return nil, errors.New("Keyring missing")
+	}
+	if cfg.NonceStore == nil {
+		return nil, errors.New("NonceStore missing")
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	return &Provider{
+		cfg:      cfg,
+		metadata: make(map[string]cachedMetadata),
+	}, nil
+}
+
+// parseMetadata will parse identity provider metadata, which may be a single
+// EntityDescriptor or an EntitiesDescriptor containing one with an IDPSSODescriptor.
+func parseMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	var desc saml.EntityDescriptor
+	err := xml.Unmarshal(data, &desc)
+	if err == nil {
+		return &desc, nil
+	}
+
+	var list saml.EntitiesDescriptor
+	if listErr := xml.Unmarshal(data, &list); listErr != nil {
+		return nil, err
+	}
+	var find func(list saml.EntitiesDescriptor) *saml.EntityDescriptor
+	find = func(list saml.EntitiesDescriptor) *saml.EntityDescriptor {
+		for i, d := range list.EntityDescriptors {
+			if len(d.IDPSSODescriptors) > 0 {
+				return &list.EntityDescriptors[i]
+			}
+		}
+		for _, l := range list.EntitiesDescriptors {
+			if d := find(l); d != nil {
+				return d
+			}
+		}
+		return nil
+	}
+	if d := find(list); d != nil {
+		return d, nil
+	}
+
+	return nil, errors.New("no identity provider found in metadata")
+}
+
+// idpMetadata returns the configured identity provider metadata, fetching it if necessary.
+func (p *Provider) idpMetadata(ctx context.Context) (*saml.EntityDescriptor, error) {
+	cfg := config.FromContext(ctx)
+	if cfg.SAML.IdPMetadata != "" {
+		return parseMetadata([]byte(cfg.SAML.IdPMetadata))
+	}
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	cached, ok := p.metadata[cfg.SAML.IdPMetadataURL]
+	if ok && time.Since(cached.fetched) < metadataCacheTime {
+		return cached.desc, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", cfg.SAML.IdPMetadataURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch metadata")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch metadata: unexpected status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "read metadata")
+	}
+	desc, err := parseMetadata(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse metadata")
+	}
+
+	p.metadata[cfg.SAML.IdPMetadataURL] = cachedMetadata{desc: desc, fetched: time.Now()}
+	return desc, nil
+}
+
+// serviceProvider returns the service provider for the current config. If withIdP is set,
+// the identity provider metadata will also be loaded.
+func (p *Provider) serviceProvider(ctx context.Context, withIdP bool) (*saml.ServiceProvider, error) {
+	cfg := config.FromContext(ctx)
+	if cfg.SAML.Certificate == "" || cfg.SAML.PrivateKey == "" {
+		return nil, errors.New("certificate and private key are required")
+	}
+
+	pair, err := tls.X509KeyPair([]byte(cfg.SAML.Certificate), []byte(cfg.SAML.PrivateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse certificate/key")
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "parse certificate")
+	}
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", pair.PrivateKey)
+	}
+
+	var sigMethod string
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		sigMethod = dsig.RSASHA256SignatureMethod
+	case *ecdsa.PrivateKey:
+		sigMethod = dsig.ECDSASHA256SignatureMethod
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	metaURL, err := url.Parse(cfg.CallbackURL(metadataPath))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse metadata URL")
+	}
+	acsURL, err := url.Parse(cfg.CallbackURL(acsPath))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse ACS URL")
+	}
+
+	// The NameID is only a stable identifier if it is persistent.
+	nameIDFormat := saml.PersistentNameIDFormat
+	if cfg.SAML.SubjectAttribute != "" {
+		nameIDFormat = saml.UnspecifiedNameIDFormat
+	}
+
+	sp := &saml.ServiceProvider{
+		EntityID:          cfg.SAML.EntityID,
+		Key:               key,
+		Certificate:       cert,
+		HTTPClient:        p.cfg.HTTPClient,
+		MetadataURL:       *metaURL,
+		AcsURL:            *acsURL,
+		AuthnNameIDFormat: nameIDFormat,
+		SignatureMethod:   sigMethod,
+	}
+	if !withIdP {
+		return sp, nil
+	}
+
+	sp.IDPMetadata, err = p.idpMetadata(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "load identity provider metadata")
+	}
+
+	return sp, nil
+}
+
+// ServeMetadata will serve the service provider metadata XML, for configuring the identity provider.
+func (p *Provider) ServeMetadata(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	sp, err := p.serviceProvider(ctx, false)
+	if err != nil {
+		log.Debug(ctx, errors.Wrap(err, "SAML metadata"))
+		errutil.HTTPError(ctx, w, validation.NewGenericError("SAML.Certificate and SAML.PrivateKey must be configured"))
+		return
+	}
+
+	data, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = w.Write(data)
+}
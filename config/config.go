@@ -1,11 +1,13 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
 
+	"github.com/expr-lang/expr"
 	"github.com/pkg/errors"
 	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
@@ -38,6 +40,11 @@ type Config struct {
 		RequiredLabels []string `public:"true" info:"List of label names to require new services to define."`
 	}
 
+	Schedules struct {
+		CoverageCheckHours     int    `public:"true" info:"Schedules are checked this many hours ahead for gaps where nobody is on-call (0 means disable the check)."`
+		CoverageAlertServiceID string `public:"true" info:"If set, an alert is created on this service for each schedule with a gap in on-call coverage, and closed once the gap is resolved."`
+	}
+
 	Maintenance struct {
 		AlertCleanupDays     int  `public:"true" info:"Closed alerts will be deleted after this many days (0 means disable cleanup)."`
 		AlertAutoCloseDays   int  `public:"true" info:"Unacknowledged alerts will automatically be closed after this many days of inactivity. (0 means disable auto-close)."`
@@ -46,6 +53,14 @@ type Config struct {
 		ScheduleCleanupDays  int  `public:"true" info:"Schedule on-call history will be deleted after this many days (0 means disable cleanup)."`
 	}
 
+	Incidents struct {
+		Enable        bool     `public:"true" info:"Group related new alerts into incidents. Only the first alert of an incident is escalated; acknowledging or closing it (or the incident) applies to every alert in the incident."`
+		WindowMinutes int      `public:"true" info:"New alerts are added to a matching open incident if it was created within this many minutes (default 30)."`
+		LabelKeys     []string `public:"true" info:"Group alerts from services that have the same value for any of these label keys."`
+		DedupPrefixes []string `public:"true" info:"Group alerts whose dedup key starts with any of these prefixes (one incident per prefix)."`
+		Expressions   []string `info:"List of 'name=expression' pairs. Alerts matching the same expression are grouped into an incident with that name. Expressions have access to 'alert' and 'service'."`
+	}
+
 	Auth struct {
 		RefererURLs  []string `info:"Allowed referer URLs for auth and redirects." deprecated:"Use --public-url flag instead, which takes precedence."`
 		DisableBasic bool     `public:"true" info:"Disallow username/password login."`
@@ -61,6 +76,7 @@ type Config struct {
 
 		AllowedUsers []string `info:"Allow any of the listed GitHub usernames to authenticate. Use '*' to allow any user."`
 		AllowedOrgs  []string `info:"Allow any member of any listed GitHub org (or team, using the format 'org/team') to authenticate."`
+		AdminOrgs    []string `info:"Members of any listed GitHub org (or team, using the format 'org/team') are given the admin role on login, other users are given the user role. If empty, roles are not changed."`
 
 		EnterpriseURL string `info:"GitHub URL (without /api) when used with GitHub Enterprise."`
 	}
@@ -79,6 +95,61 @@ type Config struct {
 		UserInfoEmailPath         string `info:"JMESPath expression to find email address in UserInfo. If set, the email claim will be ignored in favor of this. (suggestion: email)."`
 		UserInfoEmailVerifiedPath string `info:"JMESPath expression to find email verification state in UserInfo. If set, the email_verified claim will be ignored in favor of this. (suggestion: email_verified)."`
 		UserInfoNamePath          string `info:"JMESPath expression to find full name in UserInfo. If set, the name claim will be ignored in favor of this. (suggestion: name || cn || join(' ', [firstname, lastname]))"`
+		UserInfoGroupsPath        string `info:"JMESPath expression to find the list of groups in UserInfo. Required to use AdminGroups. (suggestion: groups)"`
+
+		AdminGroups []string `info:"Users in any listed group are given the admin role on login, other users are given the user role. If empty, roles are not changed."`
+	}
+
+	LDAP struct {
+		Enable bool `public:"true" info:"Enable LDAP authentication."`
+
+		NewUsers     bool   `info:"Allow new user creation via LDAP authentication."`
+		OverrideName string `info:"Set the name/label on the login page to something other than LDAP."`
+
+		URL                string `info:"URL of the directory server (e.g., ldaps://ldap.example.com or ldap://ldap.example.com:389)."`
+		StartTLS           bool   `info:"Upgrade ldap:// connections using StartTLS."`
+		InsecureSkipVerify bool   `info:"Skip TLS certificate verification of the directory server. Not recommended."`
+
+		BindDN       string `info:"DN used to search for users. If blank, searches are made anonymously."`
+		BindPassword string `password:"true"`
+
+		BaseDN     string `info:"DN to search for users under."`
+		UserFilter string `info:"Filter used to find a user, where {username} is replaced with the login username. If left blank, (uid={username}) will be used."`
+
+		SubjectAttribute string `info:"Attribute that uniquely identifies a user. If left blank, the user's DN will be used."`
+		NameAttribute    string `info:"Attribute containing the user's full name. If left blank, cn will be used."`
+		EmailAttribute   string `info:"Attribute containing the user's email address. If left blank, mail will be used."`
+		GroupAttribute   string `info:"Attribute listing the DNs of groups the user is a member of. If left blank, memberOf will be used."`
+
+		AllowedGroups []string `info:"Only allow members of any listed group DN to authenticate. If empty, any user found is allowed."`
+		AdminGroups   []string `info:"Members of any listed group DN are given the admin role on login, other users are given the user role. If empty, roles are not changed."`
+	}
+
+	SAML struct {
+		Enable bool `public:"true" info:"Enable SAML 2.0 authentication."`
+
+		NewUsers     bool   `info:"Allow new user creation via SAML authentication."`
+		OverrideName string `info:"Set the name/label on the login page to something other than SAML."`
+
+		IdPMetadataURL string `info:"URL of the identity provider's SAML metadata."`
+		IdPMetadata    string `info:"SAML metadata XML of the identity provider. If set, IdPMetadataURL is ignored."`
+
+		EntityID    string `info:"Entity ID of GoAlert as a service provider. If left blank, the metadata URL will be used."`
+		Certificate string `info:"PEM-encoded certificate published in the service provider metadata."`
+		PrivateKey  string `password:"true" info:"PEM-encoded RSA or ECDSA private key for Certificate, used to sign authentication requests."`
+
+		AllowIdPInitiated bool `info:"Allow logins started from the identity provider, without a prior request from GoAlert."`
+
+		SubjectAttribute string `info:"Attribute that uniquely identifies a user. If left blank, the persistent NameID will be used."`
+		NameAttribute    string `info:"Attribute containing the user's full name. If left blank, displayName will be used."`
+		EmailAttribute   string `info:"Attribute containing the user's email address. If left blank, email will be used."`
+	}
+
+	SCIM struct {
+		Enable bool `info:"Enable the SCIM 2.0 provisioning API. Requests must use an admin API key created for SCIM."`
+
+		AuthProvider  string `info:"Login provider (oidc, saml, github, or ldap) that provisioned users will be linked to, so they can login. If left blank, provisioned users are not linked to a login provider."`
+		AuthSubjectID string `info:"SCIM attribute (userName or externalId) matching the subject ID of AuthProvider. If left blank, userName will be used."`
 	}
 
 	Mailgun struct {
@@ -103,6 +174,10 @@ type Config struct {
 		DisableBroadcastThreadReplies bool `info:"Disable broadcasting alert status updates in threads to the main channel." public:"true"`
 	}
 
+	MSTeams struct {
+		Enable bool `public:"true" info:"Enables Microsoft Teams (or compatible) incoming webhooks as a destination for escalation steps and on-call notifications. Webhook.AllowedURLs also applies to these URLs."`
+	}
+
 	Twilio struct {
 		Enable bool `public:"true" info:"Enables sending and processing of Voice and SMS messages through the Twilio notification provider."`
 
@@ -142,6 +217,28 @@ type Config struct {
 		AllowedURLs []string `public:"true" info:"If set, allows webhooks for these domains only."`
 	}
 
+	Ticketing struct {
+		Enable bool `public:"true" info:"Enables opening tickets in an external ticketing system from escalation steps."`
+
+		APIURL string `info:"Base URL of the ticketing system REST API. Tickets are created with POST {APIURL}/tickets and updated with PATCH {APIURL}/tickets/{id}."`
+		APIKey string `password:"true" info:"Bearer token used to authenticate requests to the ticketing system."`
+
+		FieldMapping []string `info:"List of 'field=expression' pairs that set or override fields of new tickets. Expressions have access to 'alert' and 'queue'."`
+
+		OpenStatus         string `info:"Ticket status for unacknowledged alerts (default 'open')."`
+		AcknowledgedStatus string `info:"Ticket status for acknowledged alerts (default 'acknowledged')."`
+		ClosedStatus       string `info:"Ticket status for closed alerts (default 'closed')."`
+
+		CallbackSecret string `password:"true" info:"Secret used to verify signed requests to the ticketing callback endpoint (/api/v2/ticketing/callback)."`
+	}
+
+	EventSink struct {
+		Enable bool `public:"true" info:"Publishes alert status changes, escalations, and on-call shift changes as CloudEvents to an HTTP endpoint."`
+
+		URL                 string `info:"URL that events are sent to with POST, one event per request, in structured JSON mode."`
+		AuthorizationHeader string `password:"true" info:"If set, the value of the Authorization header for each request (e.g., 'Bearer <token>')."`
+	}
+
 	Feedback struct {
 		Enable      bool   `public:"true" info:"Enables Feedback link in nav bar."`
 		OverrideURL string `public:"true" info:"Use a custom URL for Feedback link in nav bar."`
@@ -408,6 +505,21 @@ func (cfg Config) PublicURL() string {
 	return strings.TrimSuffix(cfg.fallbackURL, "/")
 }
 
+func validateLDAPURL(fname, val string) error {
+	u, err := url.Parse(val)
+	if err != nil {
+		return validation.NewFieldError(fname, "invalid URL: "+err.Error())
+	}
+	if u.Scheme != "ldap" && u.Scheme != "ldaps" {
+		return validation.NewFieldError(fname, "scheme must be ldap or ldaps")
+	}
+	if u.Host == "" {
+		return validation.NewFieldError(fname, "host is required")
+	}
+
+	return nil
+}
+
 func validateEnable(prefix string, isEnabled bool, vals ...string) error {
 	if !isEnabled {
 		return nil
@@ -481,11 +593,14 @@ func (cfg Config) Validate() error {
 		validate.Range("Maintenance.AlertAutoCloseDays", cfg.Maintenance.AlertAutoCloseDays, 0, 9000),
 		validate.Range("Maintenance.APIKeyExpireDays", cfg.Maintenance.APIKeyExpireDays, 0, 9000),
 		validate.Range("Maintenance.ScheduleCleanupDays", cfg.Maintenance.ScheduleCleanupDays, 0, 9000),
+		validate.Range("Schedules.CoverageCheckHours", cfg.Schedules.CoverageCheckHours, 0, 30*24),
 		validateScopes("OIDC.Scopes", cfg.OIDC.Scopes),
 		validatePath("OIDC.UserInfoEmailPath", cfg.OIDC.UserInfoEmailPath),
 		validatePath("OIDC.UserInfoEmailVerifiedPath", cfg.OIDC.UserInfoEmailVerifiedPath),
 		validatePath("OIDC.UserInfoNamePath", cfg.OIDC.UserInfoNamePath),
+		validatePath("OIDC.UserInfoGroupsPath", cfg.OIDC.UserInfoGroupsPath),
 		validateKey("Slack.SigningSecret", cfg.Slack.SigningSecret),
+		validateKey("Ticketing.CallbackSecret", cfg.Ticketing.CallbackSecret),
 	)
 
 	if cfg.General.GoogleAnalyticsID != "" {
@@ -502,6 +617,33 @@ func (cfg Config) Validate() error {
 	if cfg.OIDC.Scopes != "" {
 		err = validate.Many(err, validateScopes("OIDC.Scopes", cfg.OIDC.Scopes))
 	}
+	if len(cfg.OIDC.AdminGroups) > 0 && cfg.OIDC.UserInfoGroupsPath == "" {
+		err = validate.Many(err, validation.NewFieldError("OIDC.UserInfoGroupsPath", "required when OIDC.AdminGroups is set"))
+	}
+	if cfg.LDAP.URL != "" {
+		err = validate.Many(err, validateLDAPURL("LDAP.URL", cfg.LDAP.URL))
+	}
+	if cfg.LDAP.UserFilter != "" && !strings.Contains(cfg.LDAP.UserFilter, "{username}") {
+		err = validate.Many(err, validation.NewFieldError("LDAP.UserFilter", "must contain {username}"))
+	}
+	if cfg.SAML.IdPMetadataURL != "" {
+		err = validate.Many(err, validate.AbsoluteURL("SAML.IdPMetadataURL", cfg.SAML.IdPMetadataURL))
+	}
+	if cfg.SAML.Enable && cfg.SAML.IdPMetadataURL == "" && cfg.SAML.IdPMetadata == "" {
+		err = validate.Many(err, validation.NewFieldError("SAML.IdPMetadataURL", "required when SAML.IdPMetadata is not set"))
+	}
+	if cfg.SAML.Certificate != "" && cfg.SAML.PrivateKey != "" {
+		_, keyErr := tls.X509KeyPair([]byte(cfg.SAML.Certificate), []byte(cfg.SAML.PrivateKey))
+		if keyErr != nil {
+			err = validate.Many(err, validation.NewFieldError("SAML.PrivateKey", "invalid certificate/key pair: "+keyErr.Error()))
+		}
+	}
+	if cfg.SCIM.AuthProvider != "" {
+		err = validate.Many(err, validate.OneOf("SCIM.AuthProvider", cfg.SCIM.AuthProvider, "oidc", "saml", "github", "ldap"))
+	}
+	if cfg.SCIM.AuthSubjectID != "" {
+		err = validate.Many(err, validate.OneOf("SCIM.AuthSubjectID", cfg.SCIM.AuthSubjectID, "userName", "externalId"))
+	}
 	if cfg.GitHub.EnterpriseURL != "" {
 		err = validate.Many(err, validate.AbsoluteURL("GitHub.EnterpriseURL", cfg.GitHub.EnterpriseURL))
 	}
@@ -557,10 +699,21 @@ func (cfg Config) Validate() error {
 			"ClientID", cfg.OIDC.ClientID,
 			"ClientSecret", cfg.OIDC.ClientSecret,
 		),
+		validateEnable("LDAP", cfg.LDAP.Enable,
+			"URL", cfg.LDAP.URL,
+			"BaseDN", cfg.LDAP.BaseDN,
+		),
+		validateEnable("SAML", cfg.SAML.Enable,
+			"Certificate", cfg.SAML.Certificate,
+			"PrivateKey", cfg.SAML.PrivateKey,
+		),
 		validateEnable("SMTP", cfg.SMTP.Enable,
 			"From", cfg.SMTP.From,
 			"Address", cfg.SMTP.Address,
 		),
+		validateEnable("Ticketing", cfg.Ticketing.Enable,
+			"APIURL", cfg.Ticketing.APIURL,
+		),
 	)
 
 	if cfg.Feedback.OverrideURL != "" {
@@ -590,6 +743,68 @@ func (cfg Config) Validate() error {
 		err = validate.Many(err, validate.AbsoluteURL(field, urlStr))
 	}
 
+	if cfg.Ticketing.APIURL != "" {
+		err = validate.Many(err, validate.AbsoluteURL("Ticketing.APIURL", cfg.Ticketing.APIURL))
+	}
+	if cfg.EventSink.URL != "" {
+		err = validate.Many(err, validate.AbsoluteURL("EventSink.URL", cfg.EventSink.URL))
+	}
+	if cfg.EventSink.Enable && cfg.EventSink.URL == "" {
+		err = validate.Many(err, validation.NewFieldError("EventSink.URL", "is required when EventSink is enabled"))
+	}
+	fields := make(map[string]bool)
+	for i, str := range cfg.Ticketing.FieldMapping {
+		parts := strings.SplitN(str, "=", 2)
+		fname := fmt.Sprintf("Ticketing.FieldMapping[%d]", i)
+		if len(parts) != 2 {
+			err = validate.Many(err, validation.NewFieldError(
+				fname,
+				"must be in the format 'field=expression'",
+			))
+			continue
+		}
+		err = validate.Many(err, validate.ASCII(fname+".Field", parts[0], 1, 255))
+		if _, cErr := expr.Compile(parts[1], expr.AllowUndefinedVariables()); cErr != nil {
+			err = validate.Many(err, validation.NewFieldError(fname+".Expression", cErr.Error()))
+		}
+		if fields[parts[0]] {
+			err = validate.Many(err, validation.NewFieldError(fname, fmt.Sprintf("field '%s' already set", parts[0])))
+		}
+		fields[parts[0]] = true
+	}
+
+	if cfg.Schedules.CoverageAlertServiceID != "" {
+		err = validate.Many(err, validate.UUID("Schedules.CoverageAlertServiceID", cfg.Schedules.CoverageAlertServiceID))
+	}
+
+	err = validate.Many(err, validate.Range("Incidents.WindowMinutes", cfg.Incidents.WindowMinutes, 0, 24*60))
+	for i, key := range cfg.Incidents.LabelKeys {
+		err = validate.Many(err, validate.LabelKey(fmt.Sprintf("Incidents.LabelKeys[%d]", i), key))
+	}
+	for i, prefix := range cfg.Incidents.DedupPrefixes {
+		err = validate.Many(err, validate.Text(fmt.Sprintf("Incidents.DedupPrefixes[%d]", i), prefix, 1, 255))
+	}
+	names := make(map[string]bool)
+	for i, str := range cfg.Incidents.Expressions {
+		parts := strings.SplitN(str, "=", 2)
+		fname := fmt.Sprintf("Incidents.Expressions[%d]", i)
+		if len(parts) != 2 {
+			err = validate.Many(err, validation.NewFieldError(
+				fname,
+				"must be in the format 'name=expression'",
+			))
+			continue
+		}
+		err = validate.Many(err, validate.Text(fname+".Name", parts[0], 1, 255))
+		if _, cErr := expr.Compile(parts[1], expr.AllowUndefinedVariables(), expr.AsBool()); cErr != nil {
+			err = validate.Many(err, validation.NewFieldError(fname+".Expression", cErr.Error()))
+		}
+		if names[parts[0]] {
+			err = validate.Many(err, validation.NewFieldError(fname, fmt.Sprintf("name '%s' already used", parts[0])))
+		}
+		names[parts[0]] = true
+	}
+
 	m := make(map[string]bool)
 	for i, str := range cfg.Twilio.SMSFromNumberOverride {
 		parts := strings.SplitN(str, "=", 2)
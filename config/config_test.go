@@ -119,4 +119,98 @@ func TestConfig_Validate(t *testing.T) {
 		cfg.Twilio.VoiceLanguage = "\x00" // non-ASCII value
 		assert.Error(t, cfg.Validate(), "language must be a valid string")
 	})
+	t.Run("Ticketing.FieldMapping", func(t *testing.T) {
+		var cfg Config
+		cfg.Ticketing.FieldMapping = []string{`title=sprintf("[%s] %s", alert.serviceName, alert.summary)`, "priority=1"}
+		assert.NoError(t, cfg.Validate())
+
+		cfg.Ticketing.FieldMapping = []string{"title"}
+		assert.ErrorContains(t, cfg.Validate(), "Ticketing.FieldMapping[0]", "must be field=expression")
+
+		cfg.Ticketing.FieldMapping = []string{"title=alert.summary +"}
+		assert.ErrorContains(t, cfg.Validate(), "Ticketing.FieldMapping[0].Expression", "expression must compile")
+
+		cfg.Ticketing.FieldMapping = []string{"title=1", "title=2"}
+		assert.ErrorContains(t, cfg.Validate(), "Ticketing.FieldMapping[1]", "duplicate field")
+	})
+	t.Run("EventSink", func(t *testing.T) {
+		var cfg Config
+		cfg.EventSink.Enable = true
+		assert.ErrorContains(t, cfg.Validate(), "EventSink.URL", "URL is required when enabled")
+
+		cfg.EventSink.URL = "https://example.com/events"
+		assert.NoError(t, cfg.Validate())
+
+		cfg.EventSink.URL = "example.com"
+		assert.ErrorContains(t, cfg.Validate(), "EventSink.URL", "URL must be absolute")
+	})
+	t.Run("OIDC", func(t *testing.T) {
+		var cfg Config
+		cfg.OIDC.AdminGroups = []string{"goalert-admins"}
+		assert.ErrorContains(t, cfg.Validate(), "OIDC.UserInfoGroupsPath", "groups path is required for admin groups")
+
+		cfg.OIDC.UserInfoGroupsPath = "groups"
+		assert.NoError(t, cfg.Validate())
+	})
+	t.Run("LDAP", func(t *testing.T) {
+		var cfg Config
+		cfg.LDAP.Enable = true
+		assert.ErrorContains(t, cfg.Validate(), "LDAP.URL", "URL is required when enabled")
+
+		cfg.LDAP.URL = "ldaps://ldap.example.com"
+		cfg.LDAP.BaseDN = "dc=example,dc=com"
+		assert.NoError(t, cfg.Validate())
+
+		cfg.LDAP.URL = "https://ldap.example.com"
+		assert.ErrorContains(t, cfg.Validate(), "LDAP.URL", "scheme must be ldap or ldaps")
+
+		cfg.LDAP.URL = "ldap://ldap.example.com:389"
+		cfg.LDAP.UserFilter = "(uid=bob)"
+		assert.ErrorContains(t, cfg.Validate(), "LDAP.UserFilter", "filter must include the username")
+	})
+	t.Run("SAML", func(t *testing.T) {
+		var cfg Config
+		cfg.SAML.Enable = true
+		err := cfg.Validate()
+		assert.ErrorContains(t, err, "SAML.IdPMetadataURL", "metadata is required when enabled")
+		assert.ErrorContains(t, err, "SAML.Certificate", "certificate is required when enabled")
+
+		cfg.SAML.IdPMetadataURL = "idp.example.com/metadata"
+		assert.ErrorContains(t, cfg.Validate(), "SAML.IdPMetadataURL", "URL must be absolute")
+
+		cfg = Config{}
+		cfg.SAML.Certificate = "foo"
+		cfg.SAML.PrivateKey = "bar"
+		assert.ErrorContains(t, cfg.Validate(), "SAML.PrivateKey", "certificate and key must be valid")
+	})
+	t.Run("Schedules", func(t *testing.T) {
+		var cfg Config
+		cfg.Schedules.CoverageCheckHours = 24
+		cfg.Schedules.CoverageAlertServiceID = "a81facc0-4764-012d-7bfb-002500d5d678"
+		assert.NoError(t, cfg.Validate())
+
+		cfg.Schedules.CoverageAlertServiceID = "foo"
+		assert.ErrorContains(t, cfg.Validate(), "Schedules.CoverageAlertServiceID", "service ID must be a UUID")
+
+		cfg = Config{}
+		cfg.Schedules.CoverageCheckHours = -1
+		assert.ErrorContains(t, cfg.Validate(), "Schedules.CoverageCheckHours")
+	})
+	t.Run("Incidents.Expressions", func(t *testing.T) {
+		var cfg Config
+		cfg.Incidents.Expressions = []string{`database=alert.summary contains "postgres" || service.labels["team"] == "dba"`}
+		assert.NoError(t, cfg.Validate())
+
+		cfg.Incidents.Expressions = []string{"database"}
+		assert.ErrorContains(t, cfg.Validate(), "Incidents.Expressions[0]", "must be name=expression")
+
+		cfg.Incidents.Expressions = []string{`database=alert.summary`}
+		assert.NoError(t, cfg.Validate(), "undefined variables are allowed")
+
+		cfg.Incidents.Expressions = []string{`database="postgres"`}
+		assert.ErrorContains(t, cfg.Validate(), "Incidents.Expressions[0].Expression", "expression must be a boolean")
+
+		cfg.Incidents.Expressions = []string{"db=true", "db=false"}
+		assert.ErrorContains(t, cfg.Validate(), "Incidents.Expressions[1]", "duplicate name")
+	})
 }
@@ -8,6 +8,13 @@ type Hints struct {
 	OIDC struct {
 		RedirectURL string
 	}
+	SAML struct {
+		MetadataURL string
+		ACSURL      string
+	}
+	SCIM struct {
+		BaseURL string
+	}
 	Mailgun struct {
 		ForwardURL string
 	}
@@ -26,6 +33,9 @@ func (cfg Config) Hints() Hints {
 
 	h.GitHub.AuthCallbackURL = cfg.CallbackURL("/api/v2/identity/providers/github/callback")
 	h.OIDC.RedirectURL = cfg.CallbackURL("/api/v2/identity/providers/oidc/callback")
+	h.SAML.MetadataURL = cfg.CallbackURL("/api/v2/identity/providers/saml/metadata")
+	h.SAML.ACSURL = cfg.CallbackURL("/api/v2/identity/providers/saml/acs")
+	h.SCIM.BaseURL = cfg.CallbackURL("/api/v2/scim")
 	h.Mailgun.ForwardURL = cfg.CallbackURL("/api/v2/mailgun/incoming")
 	h.Twilio.MessageWebhookURL = cfg.CallbackURL("/api/v2/twilio/message")
 	h.Twilio.VoiceWebhookURL = cfg.CallbackURL("/api/v2/twilio/call")
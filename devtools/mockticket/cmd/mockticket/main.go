@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/target/goalert/devtools/mockticket"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8086", "Address to listen on.")
+	prefix := flag.String("prefix", "", "API URL prefix.")
+	apiKey := flag.String("api-key", "", "If set, require this Bearer token on API requests.")
+	callbackURL := flag.String("callback-url", "http://localhost:3030/api/v2/ticketing/callback", "GoAlert ticketing callback URL.")
+	callbackSecret := flag.String("callback-secret", "", "Secret used to sign callbacks.")
+	flag.Parse()
+
+	log.SetFlags(log.Lshortfile)
+
+	srv := mockticket.NewServer(mockticket.Config{
+		APIKey:         *apiKey,
+		CallbackURL:    *callbackURL,
+		CallbackSecret: *callbackSecret,
+	})
+
+	h := http.Handler(srv)
+	if *prefix != "" {
+		h = http.StripPrefix(*prefix, h)
+	}
+
+	log.Println("Listening:", *addr)
+	err := http.ListenAndServe(*addr, h)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,251 @@
+package mockticket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/target/goalert/notification/webhook"
+)
+
+// Config configures a mock ticketing Server.
+type Config struct {
+	// APIKey, if set, is required as a Bearer token on all API requests.
+	APIKey string
+
+	// CallbackURL is the URL signed callbacks are sent to (e.g., http://localhost:3030/api/v2/ticketing/callback).
+	CallbackURL string
+
+	// CallbackSecret is used to sign callbacks.
+	CallbackSecret string
+
+	// Client is used to send callbacks, http.DefaultClient is used if nil.
+	Client *http.Client
+}
+
+// Ticket is a ticket stored by the mock server.
+type Ticket struct {
+	ID      string
+	Fields  map[string]any
+	Status  string
+	History []string
+}
+
+// Server implements a minimal REST ticketing API.
+//
+// Tickets are created with `POST /tickets`, updated with `PATCH /tickets/{id}`, and can be listed with
+// `GET /tickets`. `POST /tickets/{id}/{action}` sends a signed callback to GoAlert for the ticket's alert.
+type Server struct {
+	cfg Config
+
+	mx      sync.Mutex
+	nextID  int
+	tickets map[string]*Ticket
+
+	mux *http.ServeMux
+}
+
+// NewServer creates a new Server with no tickets.
+func NewServer(cfg Config) *Server {
+	srv := &Server{
+		cfg:     cfg,
+		nextID:  1,
+		tickets: make(map[string]*Ticket),
+		mux:     http.NewServeMux(),
+	}
+
+	srv.mux.HandleFunc("POST /tickets", srv.serveCreate)
+	srv.mux.HandleFunc("GET /tickets", srv.serveList)
+	srv.mux.HandleFunc("GET /tickets/{id}", srv.serveGet)
+	srv.mux.HandleFunc("PATCH /tickets/{id}", srv.serveUpdate)
+	srv.mux.HandleFunc("POST /tickets/{id}/{action}", srv.serveAction)
+
+	return srv
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if s.cfg.APIKey != "" && req.Header.Get("Authorization") != "Bearer "+s.cfg.APIKey {
+		http.Error(w, "invalid API key", http.StatusUnauthorized)
+		return
+	}
+
+	s.mux.ServeHTTP(w, req)
+}
+
+// Tickets returns a copy of all tickets, ordered by ID.
+func (s *Server) Tickets() []Ticket {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	result := make([]Ticket, 0, len(s.tickets))
+	for _, t := range s.tickets {
+		result = append(result, s.copyTicket(t))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, _ := strconv.Atoi(result[i].ID)
+		b, _ := strconv.Atoi(result[j].ID)
+		return a < b
+	})
+
+	return result
+}
+
+// Ticket returns a copy of the ticket with the given ID.
+func (s *Server) Ticket(id string) (Ticket, bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	t, ok := s.tickets[id]
+	if !ok {
+		return Ticket{}, false
+	}
+
+	return s.copyTicket(t), true
+}
+
+func (s *Server) copyTicket(t *Ticket) Ticket {
+	cpy := *t
+	cpy.Fields = make(map[string]any, len(t.Fields))
+	for k, v := range t.Fields {
+		cpy.Fields[k] = v
+	}
+	cpy.History = append([]string(nil), t.History...)
+	return cpy
+}
+
+// SendCallback sends a signed callback for the ticket to GoAlert, requesting the action
+// (e.g., "acknowledge" or "close") be applied to the alert the ticket was opened for.
+func (s *Server) SendCallback(ctx context.Context, ticketID, action string) error {
+	t, ok := s.Ticket(ticketID)
+	if !ok {
+		return fmt.Errorf("unknown ticket '%s'", ticketID)
+	}
+	if s.cfg.CallbackURL == "" {
+		return fmt.Errorf("callback URL not configured")
+	}
+
+	alertID, err := strconv.Atoi(fmt.Sprint(t.Fields["alert_id"]))
+	if err != nil {
+		return fmt.Errorf("ticket '%s' has invalid alert_id: %w", ticketID, err)
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"alert_id":  alertID,
+		"ticket_id": t.ID,
+		"action":    action,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.cfg.CallbackURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Signature(s.cfg.CallbackSecret, now, data))
+
+	client := s.cfg.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("callback failed: %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (s *Server) serveCreate(w http.ResponseWriter, req *http.Request) {
+	var fields map[string]any
+	dec := json.NewDecoder(req.Body)
+	dec.UseNumber()
+	err := dec.Decode(&fields)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mx.Lock()
+	t := &Ticket{
+		ID:      strconv.Itoa(s.nextID),
+		Fields:  fields,
+		Status:  fmt.Sprint(fields["status"]),
+		History: []string{"created"},
+	}
+	s.nextID++
+	s.tickets[t.ID] = t
+	s.mx.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]string{"id": t.ID})
+}
+
+func (s *Server) serveList(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s.Tickets())
+}
+
+func (s *Server) serveGet(w http.ResponseWriter, req *http.Request) {
+	t, ok := s.Ticket(req.PathValue("id"))
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(t)
+}
+
+func (s *Server) serveUpdate(w http.ResponseWriter, req *http.Request) {
+	var update struct {
+		Status  string `json:"status"`
+		Comment string `json:"comment"`
+	}
+	err := json.NewDecoder(req.Body).Decode(&update)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	t, ok := s.tickets[req.PathValue("id")]
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	if update.Status != "" {
+		t.Status = update.Status
+	}
+	t.History = append(t.History, strings.TrimSpace(update.Status+" "+update.Comment))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) serveAction(w http.ResponseWriter, req *http.Request) {
+	err := s.SendCallback(req.Context(), req.PathValue("id"), req.PathValue("action"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -57,6 +57,17 @@ func (db *DB) CleanupAlerts(ctx context.Context, j *river.Job[AlertArgs]) error
 		if err != nil {
 			return err
 		}
+
+		err = db.whileWork(ctx, func(ctx context.Context, tx *sql.Tx) (done bool, err error) {
+			count, err := gadb.New(tx).CleanupMgrDeleteOldSuppressedAlerts(ctx, int64(cfg.Maintenance.AlertCleanupDays))
+			if err != nil {
+				return false, fmt.Errorf("delete old suppressed alerts: %w", err)
+			}
+			return count < 100, nil
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -224,3 +224,17 @@ WHERE
         FOR UPDATE
             SKIP LOCKED);
 
+
+-- name: CleanupMgrDeleteOldSuppressedAlerts :execrows
+-- CleanupMgrDeleteOldSuppressedAlerts will delete records of alerts suppressed by a maintenance window that are older than the given number of days before now.
+DELETE FROM service_maintenance_suppressed_alerts
+WHERE id = ANY (
+        SELECT
+            id
+        FROM
+            service_maintenance_suppressed_alerts
+        WHERE
+            suppressed_at < now() -(sqlc.arg(stale_threshold_days)::bigint * '1 day'::interval)
+        LIMIT 100
+        FOR UPDATE
+            SKIP LOCKED);
@@ -15,6 +15,7 @@ import (
 	"github.com/target/goalert/engine/cleanupmanager"
 	"github.com/target/goalert/engine/compatmanager"
 	"github.com/target/goalert/engine/escalationmanager"
+	"github.com/target/goalert/engine/eventsinkmanager"
 	"github.com/target/goalert/engine/heartbeatmanager"
 	"github.com/target/goalert/engine/message"
 	"github.com/target/goalert/engine/metricsmanager"
@@ -99,7 +100,7 @@ func NewEngine(ctx context.Context, db *sql.DB, c *Config) (*Engine, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "rotation management backend")
 	}
-	schedMgr, err := schedulemanager.NewDB(ctx, db)
+	schedMgr, err := schedulemanager.NewDB(ctx, db, c.OnCallStore, c.AlertStore)
 	if err != nil {
 		return nil, errors.Wrap(err, "schedule management backend")
 	}
@@ -135,6 +136,10 @@ func NewEngine(ctx context.Context, db *sql.DB, c *Config) (*Engine, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "compatibility backend")
 	}
+	eventSinkMgr, err := eventsinkmanager.NewDB(ctx, db, c.ConfigSource)
+	if err != nil {
+		return nil, errors.Wrap(err, "event sink backend")
+	}
 
 	p.modules = []processinglock.Module{
 		compatMgr,
@@ -147,6 +152,7 @@ func NewEngine(ctx context.Context, db *sql.DB, c *Config) (*Engine, error) {
 		hbMgr,
 		cleanMgr,
 		metricsMgr,
+		eventSinkMgr,
 	}
 
 	if expflag.ContextHas(ctx, expflag.UnivKeys) {
@@ -15,6 +15,7 @@ type DB struct {
 
 	clearMaintExpiredSvc *sql.Stmt
 	cleanupNoSteps       *sql.Stmt
+	retriggerSnoozed     *sql.Stmt
 
 	lockStmt     *sql.Stmt
 	updateOnCall *sql.Stmt
@@ -32,7 +33,7 @@ func (db *DB) Name() string { return "Engine.EscalationManager" }
 // NewDB creates a new DB.
 func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
-		Version: 4,
+		Version: 6,
 		Type:    processinglock.TypeEscalation,
 	})
 	if err != nil {
@@ -98,9 +99,50 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				pol.step_count = 0
 		`),
 
+		retriggerSnoozed: p.P(`
+			with expired as (
+				delete from alert_snoozes
+				where alert_id in (
+					select alert_id
+					from alert_snoozes
+					where snoozed_until <= now()
+					for update skip locked
+					limit 1000
+				)
+				returning alert_id
+			), retriggered as (
+				update alerts a
+				set status = 'triggered'
+				from expired
+				where
+					a.id = expired.alert_id and
+					a.status = 'active'
+				returning a.id
+			), _reset as (
+				update escalation_policy_state state
+				set
+					last_escalation = null,
+					next_escalation = null,
+					escalation_policy_step_id = null,
+					escalation_policy_step_number = 0,
+					loop_count = 0,
+					force_escalation = false
+				from retriggered
+				where state.alert_id = retriggered.id
+			)
+			select id from retriggered
+		`),
+
 		newPolicies: p.P(`
 			with to_escalate as (
-				select alert_id, step.id ep_step_id, step.delay, step.escalation_policy_id, a.service_id, step.multi_ack
+				select
+					alert_id,
+					step.id ep_step_id,
+					step.delay,
+					step.escalation_policy_id,
+					a.service_id,
+					step.multi_ack,
+					not fn_ep_step_conditions_met(step.id, state.alert_id) skipped
 				from escalation_policy_state state
 				join escalation_policy_steps step on
 					step.escalation_policy_id = state.escalation_policy_id and
@@ -116,6 +158,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				join ep_step_on_call_users on_call on
 					on_call.end_time isnull and
 					on_call.ep_step_id = esc.ep_step_id
+				where not esc.skipped
 			), _cycles as (
 				insert into notification_policy_cycles (alert_id, user_id, multi_ack)
 				select alert_id, user_id, multi_ack from _step_cycles
@@ -131,6 +174,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				join escalation_policy_actions act on
 					act.channel_id notnull and
 					act.escalation_policy_step_id = esc.ep_step_id
+				where not esc.skipped
 			), _channels as (
 				insert into outgoing_messages (message_type, alert_id, service_id, escalation_policy_id, channel_id)
 				select
@@ -144,7 +188,10 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				update escalation_policy_state state
 				set
 					last_escalation = now(),
-					next_escalation = now() + (cast(esc.delay as text)||' minutes')::interval,
+					next_escalation = CASE
+						WHEN esc.skipped THEN now()
+						ELSE now() + (cast(esc.delay as text)||' minutes')::interval
+						END,
 					escalation_policy_step_id = esc.ep_step_id,
 					force_escalation = false
 				from
@@ -152,7 +199,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				where
 					state.alert_id = esc.alert_id
 			)
-			select distinct esc.alert_id, step isnull and chan isnull
+			select distinct esc.alert_id, esc.skipped, not esc.skipped and step isnull and chan isnull
 			from to_escalate esc
 			left join _step_cycles step on step.alert_id = esc.alert_id
 			left join _step_channels chan on chan.alert_id = esc.alert_id
@@ -168,7 +215,8 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 					state.escalation_policy_step_number >= ep.step_count repeated,
 					a.service_id,
 					step.escalation_policy_id,
-					step.multi_ack
+					step.multi_ack,
+					not fn_ep_step_conditions_met(step.id, state.alert_id) skipped
 				from escalation_policy_state state
 				join alerts a on a.id = state.alert_id and (a.status = 'triggered' or state.force_escalation)
 				join escalation_policies ep on ep.id = state.escalation_policy_id
@@ -190,6 +238,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				join ep_step_on_call_users on_call on
 					on_call.end_time isnull and
 					on_call.ep_step_id = esc.ep_step_id
+				where not esc.skipped
 			), _cycles as (
 				insert into notification_policy_cycles (alert_id, user_id, multi_ack)
 				select alert_id, user_id, multi_ack
@@ -206,6 +255,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				join escalation_policy_actions act on
 					act.channel_id notnull and
 					act.escalation_policy_step_id = esc.ep_step_id
+				where not esc.skipped
 			), _channels as (
 				insert into outgoing_messages (message_type, alert_id, service_id, escalation_policy_id, channel_id)
 				select
@@ -219,7 +269,10 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				update escalation_policy_state state
 				set
 					last_escalation = now(),
-					next_escalation = now() + (cast(esc.delay as text)||' minutes')::interval,
+					next_escalation = CASE
+						WHEN esc.skipped THEN now()
+						ELSE now() + (cast(esc.delay as text)||' minutes')::interval
+						END,
 					escalation_policy_step_number = esc.step_number,
 					escalation_policy_step_id = esc.ep_step_id,
 					force_escalation = false
@@ -228,7 +281,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				where
 					state.alert_id = esc.alert_id
 			)
-			select distinct esc.alert_id, esc.repeated, esc.step_number, step isnull and chan isnull
+			select distinct esc.alert_id, esc.repeated, esc.step_number, esc.skipped, not esc.skipped and step isnull and chan isnull
 			from to_escalate esc
 			left join _step_cycles step on step.alert_id = esc.alert_id
 			left join _step_channels chan on chan.alert_id = esc.alert_id
@@ -241,11 +294,15 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 					nextStep.delay,
 					nextStep.step_number,
 					force_escalation forced,
-					oldStep.delay old_delay,
+					CASE
+						WHEN state.next_escalation <= state.last_escalation THEN 0
+						ELSE oldStep.delay
+						END old_delay,
 					oldStep.step_number + 1 >= ep.step_count repeated,
 					nextStep.escalation_policy_id,
 					a.service_id,
-					nextStep.multi_ack
+					nextStep.multi_ack,
+					not fn_ep_step_conditions_met(nextStep.id, state.alert_id) skipped
 				from escalation_policy_state state
 				join alerts a on a.id = state.alert_id and (a.status = 'triggered' or state.force_escalation)
 				join escalation_policies ep on ep.id = state.escalation_policy_id
@@ -273,6 +330,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				join ep_step_on_call_users on_call on
 					on_call.end_time isnull and
 					on_call.ep_step_id = esc.ep_step_id
+				where not esc.skipped
 			), _cycles as (
 				insert into notification_policy_cycles (alert_id, user_id, multi_ack)
 				select alert_id, user_id, multi_ack
@@ -289,6 +347,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				join escalation_policy_actions act on
 					act.channel_id notnull and
 					act.escalation_policy_step_id = esc.ep_step_id
+				where not esc.skipped
 			), _channels as (
 				insert into outgoing_messages (message_type, alert_id, service_id, escalation_policy_id, channel_id)
 				select
@@ -302,7 +361,10 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				update escalation_policy_state state
 				set
 					last_escalation = now(),
-					next_escalation = now() + (cast(esc.delay as text)||' minutes')::interval,
+					next_escalation = CASE
+						WHEN esc.skipped THEN now()
+						ELSE now() + (cast(esc.delay as text)||' minutes')::interval
+						END,
 					escalation_policy_step_number = esc.step_number,
 					escalation_policy_step_id = esc.ep_step_id,
 					loop_count = CASE WHEN esc.repeated THEN loop_count + 1 ELSE loop_count END,
@@ -312,7 +374,7 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 				where
 					state.alert_id = esc.alert_id
 			)
-			select distinct esc.alert_id, esc.repeated, esc.step_number, esc.old_delay, esc.forced, step isnull and chan isnull
+			select distinct esc.alert_id, esc.repeated, esc.step_number, esc.old_delay, esc.forced, esc.skipped, not esc.skipped and step isnull and chan isnull
 			from to_escalate esc
 			left join _step_cycles step on step.alert_id = esc.alert_id
 			left join _step_channels chan on chan.alert_id = esc.alert_id
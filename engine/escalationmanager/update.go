@@ -54,10 +54,15 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 		return errors.Wrap(err, "end policies with no steps")
 	}
 
+	err = db.processSnoozes(ctx)
+	if err != nil {
+		return errors.Wrap(err, "re-trigger snoozed alerts")
+	}
+
 	err = db.processEscalations(ctx, db.newPolicies, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
 		var id int
 		var meta alertlog.EscalationMetaData
-		err := rows.Scan(&id, &meta.NoOneOnCall)
+		err := rows.Scan(&id, &meta.Skipped, &meta.NoOneOnCall)
 		return id, &meta, err
 	})
 	if err != nil {
@@ -67,7 +72,7 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 	err = db.processEscalations(ctx, db.deletedSteps, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
 		var id int
 		var meta alertlog.EscalationMetaData
-		err := rows.Scan(&id, &meta.Repeat, &meta.NewStepIndex, &meta.NoOneOnCall)
+		err := rows.Scan(&id, &meta.Repeat, &meta.NewStepIndex, &meta.Skipped, &meta.NoOneOnCall)
 		return id, &meta, err
 	})
 	if err != nil {
@@ -77,7 +82,7 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 	err = db.processEscalations(ctx, db.normalEscalation, func(rows *sql.Rows) (int, *alertlog.EscalationMetaData, error) {
 		var id int
 		var meta alertlog.EscalationMetaData
-		err := rows.Scan(&id, &meta.Repeat, &meta.NewStepIndex, &meta.OldDelayMinutes, &meta.Forced, &meta.NoOneOnCall)
+		err := rows.Scan(&id, &meta.Repeat, &meta.NewStepIndex, &meta.OldDelayMinutes, &meta.Forced, &meta.Skipped, &meta.NoOneOnCall)
 		return id, &meta, err
 	})
 	if err != nil {
@@ -87,6 +92,44 @@ func (db *DB) update(ctx context.Context, all bool, alertID *int) error {
 	return nil
 }
 
+// processSnoozes returns alerts with an expired snooze to triggered. Their escalation
+// policy state is reset so they are picked up by newPolicies and escalated from the first step.
+func (db *DB) processSnoozes(ctx context.Context) error {
+	tx, err := db.lock.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "escalation manager: process snoozes", tx)
+
+	rows, err := tx.StmtContext(ctx, db.retriggerSnoozed).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if len(ids) > 0 {
+		err = db.log.LogManyTx(ctx, tx, ids, alertlog.TypeSnoozeExpired, nil)
+		if err != nil {
+			return errors.Wrap(err, "log snooze expired")
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (db *DB) processEscalations(ctx context.Context, stmt *sql.Stmt, scan func(*sql.Rows) (int, *alertlog.EscalationMetaData, error)) error {
 	tx, err := db.lock.BeginTx(ctx, nil)
 	if err != nil {
@@ -0,0 +1,42 @@
+// Package eventsinkmanager delivers events from the event outbox to the configured event sink.
+//
+// Events are added to the outbox by database triggers, in the same transaction as the change
+// they describe, and are delivered at-least-once, in order for each subject, as CloudEvents.
+package eventsinkmanager
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/engine/processinglock"
+)
+
+// DB delivers outgoing events.
+type DB struct {
+	lock *processinglock.Lock
+
+	cfgSrc config.Source
+	client *http.Client
+}
+
+// Name returns the name of the module.
+func (db *DB) Name() string { return "Engine.EventSinkManager" }
+
+// NewDB creates a new DB.
+func NewDB(ctx context.Context, db *sql.DB, cfg config.Source) (*DB, error) {
+	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
+		Type:    processinglock.TypeEventSink,
+		Version: 2,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{
+		lock:   lock,
+		cfgSrc: cfg,
+		client: http.DefaultClient,
+	}, nil
+}
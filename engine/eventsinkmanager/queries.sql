@@ -0,0 +1,71 @@
+-- name: EventSinkClaim :many
+-- EventSinkClaim claims the oldest pending event of each subject that is due to be sent, so events of a subject are sent in order.
+WITH next AS (
+    SELECT DISTINCT ON (subject)
+        id,
+        next_attempt_at,
+        claimed_until
+    FROM
+        event_outbox
+    WHERE
+        dead_at IS NULL
+    ORDER BY
+        subject,
+        id
+),
+due AS (
+    SELECT
+        id
+    FROM
+        next
+    WHERE
+        next_attempt_at <= now()
+        AND (claimed_until IS NULL
+            OR claimed_until < now())
+    ORDER BY
+        id
+    LIMIT $1)
+UPDATE
+    event_outbox o
+SET
+    claimed_until = now() + sqlc.arg(claim_seconds)::int * '1 second'::interval
+FROM
+    due
+WHERE
+    o.id = due.id
+RETURNING
+    o.id,
+    o.event_type,
+    o.subject,
+    o.data,
+    o.created_at,
+    o.attempts;
+
+-- name: EventSinkDelete :exec
+DELETE FROM event_outbox
+WHERE id = ANY (@ids::bigint[]);
+
+-- name: EventSinkFailed :one
+-- EventSinkFailed records a failed attempt, and schedules a retry with an exponential backoff, or marks the event dead after max_attempts.
+UPDATE
+    event_outbox
+SET
+    attempts = attempts + 1,
+    last_error = $2,
+    claimed_until = NULL,
+    next_attempt_at = now() + least(power(2, attempts) * '1 second'::interval, '1 hour'::interval),
+    dead_at = CASE WHEN attempts + 1 >= sqlc.arg(max_attempts)::int THEN
+        now()
+    END
+WHERE
+    id = $1
+RETURNING
+    (dead_at IS NOT NULL)::bool AS dead;
+
+-- name: EventSinkDeleteDead :exec
+-- EventSinkDeleteDead deletes dead events older than the given number of days.
+DELETE FROM event_outbox
+WHERE dead_at < now() - sqlc.arg(days)::int * '1 day'::interval;
+
+-- name: EventSinkDeleteAll :exec
+DELETE FROM event_outbox;
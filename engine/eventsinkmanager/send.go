@@ -0,0 +1,216 @@
+package eventsinkmanager
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/engine/processinglock"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/util/log"
+)
+
+const (
+	batchSize = 100
+
+	// maxConcurrent is the number of events (of different subjects) sent at once.
+	maxConcurrent = 10
+
+	// claimSeconds is how long claimed events are reserved for sending. It must be longer than it
+	// takes to send a batch, so the same event is not sent twice at once.
+	claimSeconds = 120
+
+	// maxAttempts is the number of failed attempts after which an event is dropped as a dead letter.
+	maxAttempts = 20
+
+	// deadRetentionDays is how long dead letters are kept, for troubleshooting, before they are deleted.
+	deadRetentionDays = 7
+
+	// maxRunTime limits how long a single job sends events before it is re-scheduled.
+	maxRunTime = 30 * time.Second
+
+	// TypePrefix is prepended to the outbox event type to form the CloudEvents type (e.g., com.goalert.alert.closed).
+	TypePrefix = "com.goalert."
+)
+
+// CloudEvent is an event in the CloudEvents v1.0 structured JSON format.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+func newCloudEvent(cfg config.Config, row gadb.EventSinkClaimRow) CloudEvent {
+	source := cfg.General.PublicURL
+	if source == "" {
+		source = "/goalert"
+	}
+
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              strconv.FormatInt(row.ID, 10),
+		Source:          source,
+		Type:            TypePrefix + row.EventType,
+		Subject:         row.Subject,
+		Time:            row.CreatedAt,
+		DataContentType: "application/json",
+		Data:            row.Data,
+	}
+}
+
+// send delivers pending events, in order for each subject. A failed event is retried, with a backoff,
+// before any later events of the same subject are sent; other subjects are not affected.
+//
+// Events are claimed in one transaction, sent without holding it open, and then deleted (or their failure
+// recorded) in another.
+func (db *DB) send(ctx context.Context, j *river.Job[SendArgs]) error {
+	cfg := db.cfgSrc.Config()
+
+	start := time.Now()
+	for {
+		var rows []gadb.EventSinkClaimRow
+		err := db.lock.WithTx(ctx, func(ctx context.Context, tx *sql.Tx) (err error) {
+			q := gadb.New(tx)
+			if !cfg.EventSink.Enable {
+				// events are not kept while the sink is disabled
+				return q.EventSinkDeleteAll(ctx)
+			}
+
+			err = q.EventSinkDeleteDead(ctx, deadRetentionDays)
+			if err != nil {
+				return fmt.Errorf("delete dead events: %w", err)
+			}
+
+			rows, err = q.EventSinkClaim(ctx, gadb.EventSinkClaimParams{
+				Limit:        batchSize,
+				ClaimSeconds: claimSeconds,
+			})
+			if err != nil {
+				return fmt.Errorf("claim pending events: %w", err)
+			}
+
+			return nil
+		})
+		if errors.Is(err, processinglock.ErrNoLock) {
+			// another instance is sending events
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		errs := db.postAll(ctx, cfg, rows)
+
+		err = db.lock.WithTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			q := gadb.New(tx)
+			var sent []int64
+			for i, row := range rows {
+				if errs[i] == nil {
+					sent = append(sent, row.ID)
+					continue
+				}
+
+				ctx := log.WithField(ctx, "EventID", row.ID)
+				log.Log(ctx, fmt.Errorf("send event: %w", errs[i]))
+				dead, err := q.EventSinkFailed(ctx, gadb.EventSinkFailedParams{
+					ID:          row.ID,
+					LastError:   sql.NullString{String: errs[i].Error(), Valid: true},
+					MaxAttempts: maxAttempts,
+				})
+				if err != nil {
+					return fmt.Errorf("record failed event: %w", err)
+				}
+				if dead {
+					log.Log(ctx, fmt.Errorf("dropping event after %d failed attempts", maxAttempts))
+				}
+			}
+
+			err := q.EventSinkDelete(ctx, sent)
+			if err != nil {
+				return fmt.Errorf("delete sent events: %w", err)
+			}
+
+			return nil
+		})
+		if errors.Is(err, processinglock.ErrNoLock) {
+			// claims expire, so the events will be sent again
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if time.Since(start) > maxRunTime {
+			return river.JobSnooze(time.Second)
+		}
+	}
+}
+
+// postAll sends the events of rows, returning the error (or nil) for each.
+func (db *DB) postAll(ctx context.Context, cfg config.Config, rows []gadb.EventSinkClaimRow) []error {
+	errs := make([]error, len(rows))
+	sem := make(chan struct{}, maxConcurrent)
+	var wg sync.WaitGroup
+	for i, row := range rows {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = db.post(ctx, cfg, newCloudEvent(cfg, row))
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func (db *DB) post(ctx context.Context, cfg config.Config, e CloudEvent) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.EventSink.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json; charset=utf-8")
+	if cfg.EventSink.AuthorizationHeader != "" {
+		req.Header.Set("Authorization", cfg.EventSink.AuthorizationHeader)
+	}
+
+	resp, err := db.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("HTTP %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	return nil
+}
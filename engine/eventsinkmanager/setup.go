@@ -0,0 +1,51 @@
+package eventsinkmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/target/goalert/engine/processinglock"
+)
+
+const (
+	QueueName    = "event-sink"
+	PrioritySend = 2
+)
+
+var _ processinglock.Setupable = &DB{}
+
+// SendArgs are the arguments for delivering pending events.
+type SendArgs struct{}
+
+func (SendArgs) Kind() string { return "event-sink-send" }
+
+// Setup implements processinglock.Setupable.
+func (db *DB) Setup(ctx context.Context, args processinglock.SetupArgs) error {
+	river.AddWorker(args.Workers, river.WorkFunc(db.send))
+
+	// events are sent concurrently within a job, so only one worker is needed
+	err := args.River.Queues().Add(QueueName, river.QueueConfig{MaxWorkers: 1})
+	if err != nil {
+		return fmt.Errorf("add queue: %w", err)
+	}
+
+	args.River.PeriodicJobs().AddMany([]*river.PeriodicJob{
+		river.NewPeriodicJob(
+			river.PeriodicInterval(5*time.Second),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return SendArgs{}, &river.InsertOpts{
+					Queue:    QueueName,
+					Priority: PrioritySend,
+
+					// skip if a send is already pending or running
+					UniqueOpts: river.UniqueOpts{ByArgs: true},
+				}
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		),
+	})
+
+	return nil
+}
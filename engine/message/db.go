@@ -84,7 +84,13 @@ func NewDB(ctx context.Context, db *sql.DB, a *alertlog.Store, pausable lifecycl
 			last_status_at = now(),
 			status_details = $3,
 			provider_msg_id = coalesce($2, provider_msg_id),
-			next_retry_at = CASE WHEN retry_count < 3 THEN now() + '15 seconds'::interval ELSE null END
+			-- webhooks back off exponentially between attempts (15s, 30s, 1m), everything else retries every 15s
+			next_retry_at = CASE WHEN retry_count < 3 THEN now() + '15 seconds'::interval * (
+				CASE WHEN
+					(select dest->>'Type' from user_contact_methods cm where cm.id = outgoing_messages.contact_method_id) = 'builtin-webhook' or
+					(select dest->>'Type' from notification_channels nc where nc.id = outgoing_messages.channel_id) = 'builtin-webhook'
+				THEN 2 ^ retry_count ELSE 1 END
+			) ELSE null END
 		where id = $1 or provider_msg_id = $2
 	`)
 	permFail := p.P(`
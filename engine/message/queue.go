@@ -15,6 +15,7 @@ var typePriority = map[gadb.EnumOutgoingMessagesType]int{
 	notification.MessageTypeTest:         2,
 
 	notification.MessageTypeScheduleOnCallUsers: 3,
+	notification.MessageTypeShiftSwapRequest:    3,
 
 	// First alert will jump the list with priority 0, so this only
 	// represents additional alerts to the service after the first.
@@ -26,7 +26,7 @@ func (db *DB) Name() string { return "Engine.NotificationCycleManager" }
 func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
 		Type:    processinglock.TypeNPCycle,
-		Version: 2,
+		Version: 5,
 	})
 	if err != nil {
 		return nil, err
@@ -112,17 +112,39 @@ func NewDB(ctx context.Context, db *sql.DB, log *alertlog.Store) (*DB, error) {
 					cycle.id,
 					rule.user_id,
 					a.service_id,
-					svc.escalation_policy_id
+					coalesce(state.escalation_policy_id, svc.escalation_policy_id)
 				from process_cycles cycle
 				join alerts a on a.id = cycle.alert_id
+				-- deactivated users are never notified
+				join users u on u.id = cycle.user_id and u.deactivated_at isnull
 				join services svc on svc.id = a.service_id
+				-- routed alerts are escalated by a policy other than the one of their service
+				left join escalation_policy_state state on state.alert_id = a.id
 				join user_notification_rules rule on
 					rule.user_id = cycle.user_id and
 					(
 						cycle.last_tick isnull or
 						concat(rule.delay_minutes,' minutes')::interval > (cycle.last_tick - cycle.started_at)
 					) and
-					concat(rule.delay_minutes,' minutes')::interval <= (now() - cycle.started_at)
+					concat(rule.delay_minutes,' minutes')::interval <= (now() - cycle.started_at) and
+					(rule.min_severity isnull or a.severity >= rule.min_severity)
+				-- rules only apply during their time window, in the user's time zone
+				-- (must match notificationrule.NotificationRule.ActiveAt)
+				cross join lateral (
+					select
+						extract(dow from now() at time zone rule.time_zone)::int + 1 today,
+						extract(dow from now() at time zone rule.time_zone - interval '1 day')::int + 1 yesterday,
+						(now() at time zone rule.time_zone)::time clock
+				) loc
+				where case
+					when rule.start_time = rule.end_time then
+						rule.weekday_filter[loc.today]
+					when rule.start_time < rule.end_time then
+						rule.weekday_filter[loc.today] and loc.clock >= rule.start_time and loc.clock < rule.end_time
+					else
+						(rule.weekday_filter[loc.today] and loc.clock >= rule.start_time) or
+						(rule.weekday_filter[loc.yesterday] and loc.clock < rule.end_time)
+				end
 				returning cycle_id
 			), no_first_notif_sent as (
 				select user_id, alert_id
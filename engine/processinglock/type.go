@@ -17,4 +17,5 @@ const (
 	TypeMetrics      Type = "metrics"
 	TypeCompat       Type = "compat"
 	TypeSignals      Type = "signals"
+	TypeEventSink    Type = "event_sink"
 )
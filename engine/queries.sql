@@ -24,3 +24,24 @@ SELECT
         WHERE
             nc.dest = $1);
 
+-- name: EngineGetShiftSwapRequest :one
+-- Get the shift swap request, schedule, and requesting user details for a message.
+SELECT
+    req.id,
+    req.status,
+    req.start_time,
+    req.end_time,
+    req.trade_start_time,
+    req.trade_end_time,
+    req.note,
+    sched.id AS schedule_id,
+    sched.name AS schedule_name,
+    sched.time_zone,
+    usr.name AS from_user_name
+FROM
+    outgoing_messages msg
+    JOIN shift_swap_requests req ON req.id = msg.shift_swap_request_id
+    JOIN schedules sched ON sched.id = req.schedule_id
+    JOIN users usr ON usr.id = req.from_user_id
+WHERE
+    msg.id = $1;
@@ -0,0 +1,24 @@
+package rotationmanager
+
+import (
+	"slices"
+	"time"
+
+	"github.com/target/goalert/schedule/rotation"
+)
+
+// calcFollowTheSun will calculate the position for a follow-the-sun rotation at t, if it differs from the current state.
+// If no change is required (or the active region has no participants), nil is returned.
+func calcFollowTheSun(t time.Time, rot *rotation.Rotation, state rotState, userIDs []string) *advance {
+	userID := rot.WithParticipants(userIDs).RegionUserID(t)
+	if userID == "" {
+		return nil
+	}
+
+	pos := slices.Index(userIDs, userID)
+	if pos == -1 || (pos == state.Position && state.Version != 1) {
+		return nil
+	}
+
+	return &advance{newPosition: pos}
+}
@@ -14,7 +14,16 @@ SELECT
         WHERE
             p.rotation_id = rot.id
         ORDER BY
-            position)::uuid[] AS participants
+            position)::uuid[] AS participants,
+    ARRAY (
+        SELECT
+            p.user_id
+        FROM
+            rotation_participants p
+        WHERE
+            p.rotation_id = rot.id
+        ORDER BY
+            position)::uuid[] AS participant_user_ids
     FROM
         rotations rot
     LEFT JOIN rotation_state state ON rot.id = state.rotation_id
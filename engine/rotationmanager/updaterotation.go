@@ -3,6 +3,7 @@ package rotationmanager
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -57,6 +58,12 @@ func (db *DB) updateRotation(ctx context.Context, j *river.Job[UpdateArgs]) erro
 			Start:       row.Rotation.StartTime.In(loc),
 			ShiftLength: int(row.Rotation.ShiftLength),
 		}
+		if r.Type == rotation.TypeFollowTheSun {
+			err = json.Unmarshal(row.Rotation.Regions, &r.Regions)
+			if err != nil {
+				return fmt.Errorf("parse regions: %w", err)
+			}
+		}
 
 		// schedule next run
 		_, err = db.riverDBSQL.InsertTx(ctx, tx, UpdateArgs{RotationID: j.Args.RotationID}, &river.InsertOpts{
@@ -78,7 +85,12 @@ func (db *DB) updateRotation(ctx context.Context, j *river.Job[UpdateArgs]) erro
 			if err != nil {
 				return fmt.Errorf("start rotation: %w", err)
 			}
-			return nil
+			if r.Type != rotation.TypeFollowTheSun {
+				return nil
+			}
+
+			// follow-the-sun rotations start with whoever's region is active
+			row.StateVersion = 2
 		}
 
 		s := rotState{
@@ -86,9 +98,18 @@ func (db *DB) updateRotation(ctx context.Context, j *river.Job[UpdateArgs]) erro
 			Position:   int(row.StatePosition),
 			Version:    int(row.StateVersion),
 		}
-		adv, err := calcAdvance(ctx, row.Now, &r, s, len(row.Participants))
-		if err != nil {
-			return fmt.Errorf("calc advance: %w", err)
+		var adv *advance
+		if r.Type == rotation.TypeFollowTheSun {
+			userIDs := make([]string, len(row.ParticipantUserIds))
+			for i, id := range row.ParticipantUserIds {
+				userIDs[i] = id.String()
+			}
+			adv = calcFollowTheSun(row.Now, &r, s, userIDs)
+		} else {
+			adv, err = calcAdvance(ctx, row.Now, &r, s, len(row.Participants))
+			if err != nil {
+				return fmt.Errorf("calc advance: %w", err)
+			}
 		}
 		if adv == nil {
 			// no advancement needed
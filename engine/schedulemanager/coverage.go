@@ -0,0 +1,94 @@
+package schedulemanager
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/validation/validate"
+)
+
+// maxGapDetails is the maximum number of gaps listed in the details of a coverage alert.
+const maxGapDetails = 10
+
+// CoverageArgs are the arguments for checking schedules for gaps in on-call coverage.
+type CoverageArgs struct{}
+
+func (CoverageArgs) Kind() string { return "schedule-manager-coverage" }
+
+// CheckCoverage will create an alert on the configured service for each schedule with a gap
+// in on-call coverage within the configured window, and close it once the gap is resolved.
+func (db *DB) CheckCoverage(ctx context.Context, j *river.Job[CoverageArgs]) error {
+	cfg := config.FromContext(ctx)
+	if cfg.Schedules.CoverageCheckHours == 0 || cfg.Schedules.CoverageAlertServiceID == "" {
+		return nil
+	}
+	ctx = permission.SystemContext(ctx, "ScheduleManager")
+
+	scheds, err := gadb.New(db.db).SchedMgrCoverageSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("get schedules: %w", err)
+	}
+
+	start := time.Now()
+	end := start.Add(time.Duration(cfg.Schedules.CoverageCheckHours) * time.Hour)
+	for _, s := range scheds {
+		gaps, err := db.onCallStore.CoverageGapsBySchedule(ctx, s.ID.String(), start, end)
+		if err != nil {
+			return fmt.Errorf("get coverage gaps for schedule %s: %w", s.ID, err)
+		}
+
+		a := &alert.Alert{
+			Status:    alert.StatusClosed,
+			ServiceID: cfg.Schedules.CoverageAlertServiceID,
+			Dedup: &alert.DedupID{
+				Type:    alert.DedupTypeScheduleGap,
+				Version: 1,
+				Payload: s.ID.String(),
+			},
+		}
+		if len(gaps) > 0 {
+			loc, err := util.LoadLocation(s.TimeZone)
+			if err != nil {
+				return fmt.Errorf("load time zone '%s' for schedule %s: %w", s.TimeZone, s.ID, err)
+			}
+			a.Status = alert.StatusTriggered
+			a.Summary = validate.SanitizeText(fmt.Sprintf("Schedule '%s' has gaps in on-call coverage.", s.Name), alert.MaxSummaryLength)
+			a.Details = gapDetails(loc, gaps)
+		}
+
+		err = db.lock.WithTxShared(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			_, _, err := db.alertStore.CreateOrUpdateTx(ctx, tx, a)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("update coverage alert for schedule %s: %w", s.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// gapDetails returns alert details listing the gaps, in the schedule's time zone.
+func gapDetails(loc *time.Location, gaps []oncall.Gap) string {
+	var b strings.Builder
+	b.WriteString("Nobody is on-call:\n")
+	for i, g := range gaps {
+		if i == maxGapDetails {
+			fmt.Fprintf(&b, "\n...and %d more", len(gaps)-i)
+			break
+		}
+		fmt.Fprintf(&b, "\n- %s to %s", g.Start.In(loc).Format(time.UnixDate), g.End.In(loc).Format(time.UnixDate))
+	}
+
+	return b.String()
+}
@@ -5,13 +5,19 @@ import (
 	"database/sql"
 
 	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
 	"github.com/target/goalert/engine/processinglock"
+	"github.com/target/goalert/oncall"
 )
 
 // DB will manage schedules and schedule rules in Postgres.
 type DB struct {
+	db   *sql.DB
 	lock *processinglock.Lock
 
+	onCallStore *oncall.Store
+	alertStore  *alert.Store
+
 	migrateSchedIDs []uuid.UUID
 	migrateMap      map[uuid.UUID]uuid.UUID
 }
@@ -20,7 +26,7 @@ type DB struct {
 func (db *DB) Name() string { return "Engine.ScheduleManager" }
 
 // NewDB will create a new DB instance, preparing all statements.
-func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
+func NewDB(ctx context.Context, db *sql.DB, onCallStore *oncall.Store, alertStore *alert.Store) (*DB, error) {
 	lock, err := processinglock.NewLock(ctx, db, processinglock.Config{
 		Type:    processinglock.TypeSchedule,
 		Version: 3,
@@ -29,5 +35,11 @@ func NewDB(ctx context.Context, db *sql.DB) (*DB, error) {
 		return nil, err
 	}
 
-	return &DB{lock: lock}, nil
+	return &DB{
+		db:   db,
+		lock: lock,
+
+		onCallStore: onCallStore,
+		alertStore:  alertStore,
+	}, nil
 }
@@ -112,3 +112,12 @@ WHERE
 INSERT INTO outgoing_messages(id, message_type, channel_id, schedule_id)
     VALUES ($1, 'schedule_on_call_notification', $2, $3);
 
+
+-- name: SchedMgrCoverageSchedules :many
+-- Returns all schedules, to check for gaps in on-call coverage.
+SELECT
+    id,
+    name,
+    time_zone
+FROM
+    schedules;
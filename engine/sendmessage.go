@@ -12,6 +12,7 @@ import (
 	"github.com/target/goalert/gadb"
 	"github.com/target/goalert/notification"
 	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/log"
 )
 
@@ -115,9 +116,9 @@ func (p *Engine) sendMessage(ctx context.Context, msg *message.Message) (*notifi
 
 		var status notification.AlertState
 		switch e.Type() {
-		case alertlog.TypeAcknowledged:
+		case alertlog.TypeAcknowledged, alertlog.TypeSnoozed:
 			status = notification.AlertStateAcknowledged
-		case alertlog.TypeEscalated:
+		case alertlog.TypeEscalated, alertlog.TypeSnoozeExpired:
 			status = notification.AlertStateUnacknowledged
 		case alertlog.TypeClosed:
 			status = notification.AlertStateClosed
@@ -194,6 +195,45 @@ func (p *Engine) sendMessage(ctx context.Context, msg *message.Message) (*notifi
 			Base:   msg.Base(),
 			Params: params,
 		}
+	case notification.MessageTypeShiftSwapRequest:
+		id, err := uuid.Parse(msg.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse shift swap message id")
+		}
+		req, err := gadb.New(p.b.db).EngineGetShiftSwapRequest(ctx, id)
+		if err != nil {
+			return nil, errors.Wrap(err, "get shift swap request")
+		}
+		if req.Status != gadb.EnumShiftSwapStatusPending {
+			return &notification.SendResult{
+				ID: msg.ID,
+				Status: notification.Status{
+					Details: "shift swap request " + string(req.Status) + " before message sent",
+					State:   notification.StateFailedPerm,
+				},
+			}, nil
+		}
+		loc, err := util.LoadLocation(req.TimeZone)
+		if err != nil {
+			return nil, errors.Wrap(err, "load schedule time zone")
+		}
+
+		m := notification.ShiftSwapRequest{
+			Base:         msg.Base(),
+			RequestID:    req.ID.String(),
+			ScheduleID:   req.ScheduleID.String(),
+			ScheduleName: req.ScheduleName,
+			ScheduleURL:  p.cfg.ConfigSource.Config().CallbackURL("/schedules/" + req.ScheduleID.String()),
+			FromUserName: req.FromUserName,
+			Start:        req.StartTime.In(loc),
+			End:          req.EndTime.In(loc),
+			Note:         req.Note,
+		}
+		if req.TradeStartTime.Valid {
+			m.TradeStart = req.TradeStartTime.Time.In(loc)
+			m.TradeEnd = req.TradeEndTime.Time.In(loc)
+		}
+		notifMsg = m
 	default:
 		log.Log(ctx, errors.New("SEND NOT IMPLEMENTED FOR MESSAGE TYPE "+string(msg.Type)))
 		return &notification.SendResult{ID: msg.ID, Status: notification.Status{State: notification.StateFailedPerm}}, nil
@@ -18,7 +18,7 @@ FROM
     alert_logs
 WHERE
     alert_id = @alert_id::bigint
-    AND event = @event_type::enum_alert_log_event
+    AND event = ANY (@event_types::enum_alert_log_event[])
 ORDER BY
     id DESC
 LIMIT 1;
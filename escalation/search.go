@@ -30,6 +30,9 @@ type SearchOptions struct {
 	// Omit specifies a list of policy IDs to exclude from the results.
 	Omit []string `json:"o,omitempty"`
 
+	// TeamIDs, if set, will limit results to escalation policies owned by any of the teams.
+	TeamIDs []string `json:"t,omitempty"`
+
 	Limit int `json:"-"`
 }
 
@@ -54,6 +57,9 @@ var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()
 	{{if .Omit}}
 		AND NOT pol.id = any(:omit)
 	{{end}}
+	{{if .TeamIDs}}
+		AND pol.team_id = any(:teamIDs)
+	{{end}}
 	{{if .Search}}
 		AND ({{orderedPrefixSearch "search" "pol.name"}} OR {{contains "search" "pol.description"}} OR {{contains "search" "pol.name"}})
 	{{end}}
@@ -89,6 +95,7 @@ func (opts renderData) Normalize() (*renderData, error) {
 		validate.Search("Search", opts.Search),
 		validate.Range("Limit", opts.Limit, 0, search.MaxResults),
 		validate.ManyUUID("Omit", opts.Omit, 50),
+		validate.ManyUUID("TeamIDs", opts.TeamIDs, 50),
 	)
 	if opts.After.Name != "" {
 		err = validate.Many(err, validate.IDName("After.Name", opts.After.Name))
@@ -108,6 +115,7 @@ func (opts renderData) QueryArgs() []sql.NamedArg {
 		sql.Named("search", opts.Search),
 		sql.Named("afterName", opts.After.Name),
 		sql.Named("omit", sqlutil.UUIDArray(opts.Omit)),
+		sql.Named("teamIDs", sqlutil.UUIDArray(opts.TeamIDs)),
 		sql.Named("favUserID", opts.FavoritesUserID),
 	}
 }
@@ -0,0 +1,205 @@
+// Package simulation calculates the notifications an escalation policy would send for a new alert,
+// without creating one.
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/user/notificationrule"
+)
+
+// Source provides the data needed to simulate an escalation policy.
+type Source interface {
+	// StepActions returns the destinations of an escalation policy step.
+	StepActions(ctx context.Context, stepID uuid.UUID) ([]gadb.DestV1, error)
+
+	// OnCallUserIDs returns the IDs of users on-call for a schedule or rotation destination at time t.
+	OnCallUserIDs(ctx context.Context, dest gadb.DestV1, t time.Time) ([]string, error)
+
+	// NotificationRules returns the notification rules of a user, excluding those for disabled contact methods.
+	NotificationRules(ctx context.Context, userID string) ([]notificationrule.NotificationRule, error)
+}
+
+// Alert describes the hypothetical alert to simulate.
+type Alert struct {
+	// Start is the time the alert is created.
+	Start time.Time
+
+	Severity alert.Severity
+	Metadata map[string]string
+
+	// Labels are the labels of the alert's service.
+	Labels map[string]string
+}
+
+// Notification is a notification that would be sent for the alert.
+type Notification struct {
+	Time time.Time
+
+	// Minute is the number of minutes after the alert was created.
+	Minute int
+
+	StepNumber int
+
+	// Repeat is the number of times the policy had repeated when the step was reached.
+	Repeat int
+
+	// UserID and ContactMethodID are set for notifications to users.
+	UserID          string
+	ContactMethodID uuid.UUID
+
+	// Channel is set for notifications sent directly to a destination (e.g., a Slack channel or webhook).
+	Channel *gadb.DestV1
+}
+
+// Simulate returns the notifications that would be sent, in order, for an alert escalating through
+// the policy's steps until end. The alert is assumed to never be acknowledged or closed.
+//
+// It must be kept in sync with the behavior of the engine when escalating alerts and sending notifications.
+func Simulate(ctx context.Context, src Source, pol escalation.Policy, steps []escalation.Step, a Alert, end time.Time) ([]Notification, error) {
+	if len(steps) == 0 {
+		return nil, nil
+	}
+	sim := &simulation{
+		src:   src,
+		a:     a,
+		end:   end,
+		rules: make(map[string][]notificationrule.NotificationRule),
+	}
+
+	t := a.Start
+	passStart := t
+	var idx, repeat int
+	for t.Before(end) {
+		step := steps[idx]
+		if step.ConditionsMet(t, a.Metadata, a.Labels) {
+			err := sim.escalate(ctx, step, repeat, t)
+			if err != nil {
+				return nil, fmt.Errorf("step %d: %w", step.StepNumber, err)
+			}
+			t = t.Add(step.Delay())
+		}
+		// skipped steps escalate immediately
+
+		idx++
+		if idx < len(steps) {
+			continue
+		}
+		if repeat >= pol.Repeat || t.Equal(passStart) {
+			// done repeating, or every step was skipped
+			break
+		}
+		idx = 0
+		repeat++
+		passStart = t
+	}
+
+	sort.SliceStable(sim.result, func(i, j int) bool { return sim.result[i].Time.Before(sim.result[j].Time) })
+	return sim.result, nil
+}
+
+type simulation struct {
+	src   Source
+	a     Alert
+	end   time.Time
+	rules map[string][]notificationrule.NotificationRule
+
+	result []Notification
+}
+
+func (sim *simulation) add(n Notification) {
+	n.Minute = int(n.Time.Sub(sim.a.Start) / time.Minute)
+	sim.result = append(sim.result, n)
+}
+
+// escalate adds the notifications for a step reached at time t.
+func (sim *simulation) escalate(ctx context.Context, step escalation.Step, repeat int, t time.Time) error {
+	actions, err := sim.src.StepActions(ctx, step.ID)
+	if err != nil {
+		return err
+	}
+
+	var userIDs []string
+	seen := make(map[string]bool)
+	addUser := func(id string) {
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+		userIDs = append(userIDs, id)
+	}
+	for _, act := range actions {
+		switch act.Type {
+		case user.DestTypeUser:
+			addUser(act.Arg(user.FieldUserID))
+		case schedule.DestTypeSchedule, rotation.DestTypeRotation:
+			ids, err := sim.src.OnCallUserIDs(ctx, act, t)
+			if err != nil {
+				return err
+			}
+			for _, id := range ids {
+				addUser(id)
+			}
+		default:
+			sim.add(Notification{
+				Time:       t,
+				StepNumber: step.StepNumber,
+				Repeat:     repeat,
+				Channel:    &act,
+			})
+		}
+	}
+
+	for _, id := range userIDs {
+		err = sim.notifyUser(ctx, step, repeat, t, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notifyUser adds the notifications for a user's notification rules, starting at time t.
+func (sim *simulation) notifyUser(ctx context.Context, step escalation.Step, repeat int, t time.Time, userID string) error {
+	rules, ok := sim.rules[userID]
+	if !ok {
+		var err error
+		rules, err = sim.src.NotificationRules(ctx, userID)
+		if err != nil {
+			return err
+		}
+		sim.rules[userID] = rules
+	}
+
+	for _, r := range rules {
+		if r.MinSeverity != "" && !sim.a.Severity.AtLeast(r.MinSeverity) {
+			continue
+		}
+
+		sendAt := t.Add(time.Duration(r.DelayMinutes) * time.Minute)
+		if !sendAt.Before(sim.end) || !r.ActiveAt(sendAt) {
+			continue
+		}
+
+		sim.add(Notification{
+			Time:            sendAt,
+			StepNumber:      step.StepNumber,
+			Repeat:          repeat,
+			UserID:          userID,
+			ContactMethodID: r.ContactMethodID,
+		})
+	}
+
+	return nil
+}
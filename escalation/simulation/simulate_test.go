@@ -0,0 +1,101 @@
+package simulation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/user/notificationrule"
+)
+
+type testSource struct {
+	actions map[uuid.UUID][]gadb.DestV1
+	onCall  func(t time.Time) []string
+	rules   map[string][]notificationrule.NotificationRule
+}
+
+func (src *testSource) StepActions(ctx context.Context, stepID uuid.UUID) ([]gadb.DestV1, error) {
+	return src.actions[stepID], nil
+}
+
+func (src *testSource) OnCallUserIDs(ctx context.Context, dest gadb.DestV1, t time.Time) ([]string, error) {
+	return src.onCall(t), nil
+}
+
+func (src *testSource) NotificationRules(ctx context.Context, userID string) ([]notificationrule.NotificationRule, error) {
+	return src.rules[userID], nil
+}
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	step1 := escalation.Step{ID: uuid.New(), StepNumber: 0, DelayMinutes: 10}
+	step2 := escalation.Step{ID: uuid.New(), StepNumber: 1, DelayMinutes: 5}
+	cm1, cm2, cm3 := uuid.New(), uuid.New(), uuid.New()
+	chan1 := gadb.NewDestV1("builtin-slack-channel", "slack_channel_id", "C123")
+	src := &testSource{
+		actions: map[uuid.UUID][]gadb.DestV1{
+			step1.ID: {
+				gadb.NewDestV1(user.DestTypeUser, user.FieldUserID, "bob"),
+				chan1,
+			},
+			step2.ID: {
+				gadb.NewDestV1(schedule.DestTypeSchedule, schedule.FieldScheduleID, "sched"),
+				gadb.NewDestV1(user.DestTypeUser, user.FieldUserID, "bob"),
+			},
+		},
+		onCall: func(t time.Time) []string {
+			if t.Before(start.Add(15 * time.Minute)) {
+				return []string{"joe", "bob"}
+			}
+			return []string{"ann"}
+		},
+		rules: map[string][]notificationrule.NotificationRule{
+			"bob": {
+				{DelayMinutes: 0, ContactMethodID: cm1},
+				{DelayMinutes: 30, ContactMethodID: cm1},
+			},
+			"joe": {
+				{DelayMinutes: 1, ContactMethodID: cm2},
+				{DelayMinutes: 0, ContactMethodID: cm3, MinSeverity: alert.SeverityHigh},
+			},
+		},
+	}
+
+	res, err := Simulate(ctx, src, escalation.Policy{}, []escalation.Step{step1, step2}, Alert{Start: start}, start.Add(20*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []Notification{
+		{Time: start, StepNumber: 0, Channel: &chan1},
+		{Time: start, StepNumber: 0, UserID: "bob", ContactMethodID: cm1},
+		{Time: start.Add(10 * time.Minute), Minute: 10, StepNumber: 1, UserID: "bob", ContactMethodID: cm1},
+		{Time: start.Add(11 * time.Minute), Minute: 11, StepNumber: 1, UserID: "joe", ContactMethodID: cm2},
+	}, res)
+
+	// repeat, with the first step skipped
+	step1.Matches = []escalation.StepMatch{{Type: escalation.StepMatchMetadata, Key: "env", Value: "prod"}}
+	res, err = Simulate(ctx, src, escalation.Policy{Repeat: 1}, []escalation.Step{step1, step2}, Alert{Start: start, Severity: alert.SeverityCritical}, start.Add(20*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []Notification{
+		{Time: start, StepNumber: 1, UserID: "joe", ContactMethodID: cm3},
+		{Time: start, StepNumber: 1, UserID: "bob", ContactMethodID: cm1},
+		{Time: start.Add(time.Minute), Minute: 1, StepNumber: 1, UserID: "joe", ContactMethodID: cm2},
+		{Time: start.Add(5 * time.Minute), Minute: 5, StepNumber: 1, Repeat: 1, UserID: "joe", ContactMethodID: cm3},
+		{Time: start.Add(5 * time.Minute), Minute: 5, StepNumber: 1, Repeat: 1, UserID: "bob", ContactMethodID: cm1},
+		{Time: start.Add(6 * time.Minute), Minute: 6, StepNumber: 1, Repeat: 1, UserID: "joe", ContactMethodID: cm2},
+	}, res)
+
+	// every step skipped
+	step2.Matches = step1.Matches
+	res, err = Simulate(ctx, src, escalation.Policy{Repeat: 5}, []escalation.Step{step1, step2}, Alert{Start: start}, start.Add(20*time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, res)
+}
@@ -1,12 +1,20 @@
 package escalation
 
 import (
+	"fmt"
+	"io"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/google/uuid"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 )
 
+// MaxStepMatches is the maximum number of matches for a single step.
+const MaxStepMatches = 10
+
 type ActiveStep struct {
 	StepID          string
 	PolicyID        string
@@ -27,6 +35,56 @@ type Step struct {
 	// after the alert is acknowledged, so that everyone on the step is notified
 	// and can acknowledge.
 	MultiAck bool `json:"multi_ack"`
+
+	// WeekdayFilter, Start, and End restrict the step to a window of time on the enabled days,
+	// in TimeZone. If Start and End are equal, the step applies all day. If End is before Start,
+	// the window ends the following day.
+	WeekdayFilter timeutil.WeekdayFilter `json:"weekday_filter"`
+	Start         timeutil.Clock         `json:"start"`
+	End           timeutil.Clock         `json:"end"`
+	TimeZone      *time.Location         `json:"-"`
+
+	// Matches must all be met by the alert for the step to apply.
+	Matches []StepMatch `json:"matches,omitempty"`
+}
+
+// StepMatchType is the source of the value checked by a StepMatch.
+type StepMatchType string
+
+const (
+	// StepMatchLabel checks a label of the alert's service.
+	StepMatchLabel StepMatchType = "label"
+
+	// StepMatchMetadata checks an alert metadata value.
+	StepMatchMetadata StepMatchType = "metadata"
+)
+
+// StepMatch requires the value for Key to equal Value.
+type StepMatch struct {
+	Type  StepMatchType `json:"type"`
+	Key   string        `json:"key"`
+	Value string        `json:"value"`
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (t *StepMatchType) UnmarshalGQL(v interface{}) error {
+	str, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return err
+	}
+
+	*t = StepMatchType(str)
+	switch *t {
+	case StepMatchLabel, StepMatchMetadata:
+		return nil
+	}
+
+	return validation.NewFieldError("Type", "unknown match type "+str)
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (t StepMatchType) MarshalGQL(w io.Writer) {
+	graphql.MarshalString(string(t)).MarshalGQL(w)
 }
 
 func (s Step) Delay() time.Duration {
@@ -37,10 +95,74 @@ func (s Step) Normalize() (*Step, error) {
 	err := validate.Many(
 		validate.UUID("PolicyID", s.PolicyID),
 		validate.Range("DelayMinutes", s.DelayMinutes, 1, 9000),
+		validate.Range("Matches", len(s.Matches), 0, MaxStepMatches),
 	)
+	for i, m := range s.Matches {
+		prefix := fmt.Sprintf("Matches[%d].", i)
+		err = validate.Many(err,
+			validate.OneOf(prefix+"Type", m.Type, StepMatchLabel, StepMatchMetadata),
+			validate.RequiredText(prefix+"Key", m.Key, 1, 255),
+			validate.Text(prefix+"Value", m.Value, 0, 255),
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if s.WeekdayFilter.IsNever() {
+		// a step that never applies is not useful, so no days means every day
+		s.WeekdayFilter = timeutil.EveryDay()
+	}
+	if s.TimeZone == nil {
+		s.TimeZone = time.UTC
+	}
+	s.Start = timeutil.Clock(time.Duration(s.Start).Truncate(time.Minute))
+	s.End = timeutil.Clock(time.Duration(s.End).Truncate(time.Minute))
+
 	return &s, nil
 }
+
+// matches returns Matches, or an empty slice if there are none.
+func (s Step) matches() []StepMatch {
+	if s.Matches == nil {
+		return []StepMatch{}
+	}
+
+	return s.Matches
+}
+
+// hasWindow returns true if the step is restricted to a window of time.
+func (s Step) hasWindow() bool {
+	return !(s.WeekdayFilter.IsAlways() || s.WeekdayFilter.IsNever()) || s.Start != s.End
+}
+
+// HasConditions returns true if the step is restricted to a window of time or to matching alerts.
+func (s Step) HasConditions() bool {
+	return len(s.Matches) > 0 || s.hasWindow()
+}
+
+// ConditionsMet returns true if the step applies to an alert with the given metadata, for a
+// service with the given labels, at time t. Steps are skipped when their conditions are not met.
+//
+// It must be kept in sync with the conditions used by the engine when escalating alerts.
+func (s Step) ConditionsMet(t time.Time, metadata, labels map[string]string) bool {
+	for _, m := range s.Matches {
+		values := metadata
+		if m.Type == StepMatchLabel {
+			values = labels
+		}
+		v, ok := values[m.Key]
+		if !ok || v != m.Value {
+			return false
+		}
+	}
+
+	if !s.hasWindow() {
+		return true
+	}
+	if s.TimeZone != nil {
+		t = t.In(s.TimeZone)
+	}
+
+	return s.WeekdayFilter.InWindow(s.Start, s.End, t)
+}
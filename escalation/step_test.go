@@ -2,6 +2,10 @@ package escalation
 
 import (
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/util/timeutil"
 )
 
 func TestStep_Normalize(t *testing.T) {
@@ -23,10 +27,14 @@ func TestStep_Normalize(t *testing.T) {
 
 	valid := []Step{
 		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1, Matches: []StepMatch{{Type: StepMatchMetadata, Key: "env", Value: "prod"}}},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1, Matches: []StepMatch{{Type: StepMatchLabel, Key: "team", Value: ""}}},
 	}
 
 	invalid := []Step{
 		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 9001},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1, Matches: []StepMatch{{Type: "foo", Key: "env", Value: "prod"}}},
+		{PolicyID: "a81facc0-4764-012d-7bfb-002500d5d678", DelayMinutes: 1, Matches: []StepMatch{{Type: StepMatchMetadata, Value: "prod"}}},
 	}
 	for _, s := range valid {
 		test(true, s)
@@ -35,3 +43,35 @@ func TestStep_Normalize(t *testing.T) {
 		test(false, s)
 	}
 }
+
+func TestStep_ConditionsMet(t *testing.T) {
+	// Wednesday
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	var s Step
+	assert.True(t, s.ConditionsMet(now, nil, nil), "no conditions")
+
+	s.Matches = []StepMatch{{Type: StepMatchMetadata, Key: "env", Value: "prod"}}
+	assert.True(t, s.ConditionsMet(now, map[string]string{"env": "prod"}, nil))
+	assert.False(t, s.ConditionsMet(now, map[string]string{"env": "dev"}, nil))
+	assert.False(t, s.ConditionsMet(now, nil, map[string]string{"env": "prod"}), "label should not match metadata condition")
+
+	s.Matches = append(s.Matches, StepMatch{Type: StepMatchLabel, Key: "team", Value: "ops"})
+	assert.True(t, s.ConditionsMet(now, map[string]string{"env": "prod"}, map[string]string{"team": "ops"}))
+	assert.False(t, s.ConditionsMet(now, map[string]string{"env": "prod"}, nil), "all conditions must match")
+
+	s = Step{
+		WeekdayFilter: timeutil.EveryDay(),
+		Start:         timeutil.NewClock(9, 0),
+		End:           timeutil.NewClock(17, 0),
+	}
+	assert.True(t, s.ConditionsMet(now, nil, nil))
+	assert.False(t, s.ConditionsMet(now.Add(6*time.Hour), nil, nil))
+
+	s.TimeZone, _ = time.LoadLocation("America/Chicago")
+	assert.False(t, s.ConditionsMet(now, nil, nil), "7:00 in Chicago")
+
+	s = Step{WeekdayFilter: timeutil.WeekdayFilter{0, 1, 0, 0, 0, 0, 0}}
+	assert.False(t, s.ConditionsMet(now, nil, nil), "only Monday")
+	assert.True(t, s.ConditionsMet(now.AddDate(0, 0, -2), nil, nil))
+}
@@ -3,6 +3,7 @@ package escalation
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/target/goalert/alert/alertlog"
 	"github.com/target/goalert/notification/nfydest"
@@ -18,6 +19,35 @@ import (
 	"github.com/pkg/errors"
 )
 
+const stepColumns = `step.id, step.escalation_policy_id, step.delay, step.step_number, step.multi_ack,
+	step.weekday_filter, step.start_time, step.end_time, step.time_zone, step.match_conditions`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanStep reads a step selected with stepColumns.
+func scanStep(row scanner) (*Step, error) {
+	var st Step
+	var tz string
+	var matches []byte
+	err := row.Scan(&st.ID, &st.PolicyID, &st.DelayMinutes, &st.StepNumber, &st.MultiAck,
+		&st.WeekdayFilter, &st.Start, &st.End, &tz, &matches)
+	if err != nil {
+		return nil, err
+	}
+	st.TimeZone, err = util.LoadLocation(tz)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(matches, &st.Matches)
+	if err != nil {
+		return nil, err
+	}
+
+	return &st, nil
+}
+
 type Config struct {
 	NCStore         *notificationchannel.Store
 	Registry        *nfydest.Registry
@@ -48,6 +78,7 @@ type Store struct {
 	createStep           *sql.Stmt
 	updateStepDelay      *sql.Stmt
 	updateStepMultiAck   *sql.Stmt
+	updateStepConditions *sql.Stmt
 	updateStepNumber     *sql.Stmt
 	deleteStep           *sql.Stmt
 }
@@ -108,10 +139,10 @@ func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
 		updatePolicy: p.P(`UPDATE escalation_policies SET name = $2, description = $3, repeat = $4 WHERE id = $1`),
 		deletePolicy: p.P(`DELETE FROM escalation_policies WHERE id = any($1)`),
 
-		findOneStepForUpdate: p.P(`SELECT id, escalation_policy_id, delay, step_number, multi_ack FROM escalation_policy_steps WHERE id = $1 FOR UPDATE`),
-		findAllSteps:         p.P(`SELECT id, escalation_policy_id, delay, step_number, multi_ack FROM escalation_policy_steps WHERE escalation_policy_id = $1 ORDER BY step_number`),
+		findOneStepForUpdate: p.P(`SELECT ` + stepColumns + ` FROM escalation_policy_steps step WHERE id = $1 FOR UPDATE`),
+		findAllSteps:         p.P(`SELECT ` + stepColumns + ` FROM escalation_policy_steps step WHERE escalation_policy_id = $1 ORDER BY step_number`),
 		findAllOnCallSteps: p.P(`
-			SELECT step.id, step.escalation_policy_id, step.delay, step.step_number, step.multi_ack
+			SELECT ` + stepColumns + `
 			FROM ep_step_on_call_users oc
 			JOIN escalation_policy_steps step ON step.id = oc.ep_step_id
 			WHERE oc.user_id = $1 AND oc.end_time isnull
@@ -120,12 +151,17 @@ func NewStore(ctx context.Context, db *sql.DB, cfg Config) (*Store, error) {
 
 		createStep: p.P(`
 			INSERT INTO escalation_policy_steps
-				(id, escalation_policy_id, delay, step_number, multi_ack)
-			VALUES ($1, $2, $3, DEFAULT, $4)
+				(id, escalation_policy_id, delay, step_number, multi_ack, weekday_filter, start_time, end_time, time_zone, match_conditions)
+			VALUES ($1, $2, $3, DEFAULT, $4, $5, $6, $7, $8, $9)
 			RETURNING step_number
 		`),
 		updateStepDelay:    p.P(`UPDATE escalation_policy_steps SET delay = $2 WHERE id = $1`),
 		updateStepMultiAck: p.P(`UPDATE escalation_policy_steps SET multi_ack = $2 WHERE id = $1`),
+		updateStepConditions: p.P(`
+			UPDATE escalation_policy_steps
+			SET weekday_filter = $2, start_time = $3, end_time = $4, time_zone = $5, match_conditions = $6
+			WHERE id = $1
+		`),
 		updateStepNumber:   p.P(`UPDATE escalation_policy_steps SET step_number = $2 WHERE id = $1`),
 		deleteStep:         p.P(`DELETE FROM escalation_policy_steps WHERE id = $1 RETURNING escalation_policy_id`),
 	}, p.Err
@@ -340,14 +376,7 @@ func (s *Store) FindOneStepForUpdateTx(ctx context.Context, tx *sql.Tx, id strin
 		stmt = tx.StmtContext(ctx, stmt)
 	}
 
-	row := stmt.QueryRowContext(ctx, id)
-	var st Step
-	err = row.Scan(&st.ID, &st.PolicyID, &st.DelayMinutes, &st.StepNumber, &st.MultiAck)
-	if err != nil {
-		return nil, err
-	}
-
-	return &st, nil
+	return scanStep(stmt.QueryRowContext(ctx, id))
 }
 
 func (s *Store) FindAllSteps(ctx context.Context, policyID string) ([]Step, error) {
@@ -378,12 +407,11 @@ func (s *Store) FindAllOnCallStepsForUserTx(ctx context.Context, tx *sql.Tx, use
 
 	var result []Step
 	for rows.Next() {
-		var s Step
-		err = rows.Scan(&s.ID, &s.PolicyID, &s.DelayMinutes, &s.StepNumber, &s.MultiAck)
+		s, err := scanStep(rows)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, s)
+		result = append(result, *s)
 	}
 	return result, nil
 }
@@ -413,12 +441,11 @@ func (s *Store) FindAllStepsTx(ctx context.Context, tx *sql.Tx, policyID string)
 
 	var result []Step
 	for rows.Next() {
-		var s Step
-		err = rows.Scan(&s.ID, &s.PolicyID, &s.DelayMinutes, &s.StepNumber, &s.MultiAck)
+		s, err := scanStep(rows)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, s)
+		result = append(result, *s)
 	}
 	return result, nil
 }
@@ -441,8 +468,12 @@ func (s *Store) CreateStepTx(ctx context.Context, tx *sql.Tx, st *Step) (*Step,
 	}
 
 	n.ID = uuid.New()
+	matches, err := json.Marshal(n.matches())
+	if err != nil {
+		return nil, err
+	}
 
-	err = stmt.QueryRowContext(ctx, n.ID, n.PolicyID, n.DelayMinutes, n.MultiAck).Scan(&n.StepNumber)
+	err = stmt.QueryRowContext(ctx, n.ID, n.PolicyID, n.DelayMinutes, n.MultiAck, n.WeekdayFilter, n.Start, n.End, n.TimeZone.String(), matches).Scan(&n.StepNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -516,6 +547,35 @@ func (s *Store) UpdateStepMultiAckTx(ctx context.Context, tx *sql.Tx, stepID uui
 	return nil
 }
 
+// UpdateStepConditionsTx updates the time window and matches of a step.
+func (s *Store) UpdateStepConditionsTx(ctx context.Context, tx *sql.Tx, st *Step) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+
+	n, err := st.Normalize()
+	if err != nil {
+		return err
+	}
+	matches, err := json.Marshal(n.matches())
+	if err != nil {
+		return err
+	}
+
+	stmt := s.updateStepConditions
+	if tx != nil {
+		stmt = tx.StmtContext(ctx, stmt)
+	}
+
+	_, err = stmt.ExecContext(ctx, n.ID, n.WeekdayFilter, n.Start, n.End, n.TimeZone.String(), matches)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteStepTx deletes a step from an escalation policy.
 func (s *Store) DeleteStepTx(ctx context.Context, tx *sql.Tx, id uuid.UUID) (string, error) {
 	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
@@ -23,6 +23,7 @@ const (
 	EngineProcessingTypeCleanup      EngineProcessingType = "cleanup"
 	EngineProcessingTypeCompat       EngineProcessingType = "compat"
 	EngineProcessingTypeEscalation   EngineProcessingType = "escalation"
+	EngineProcessingTypeEventSink    EngineProcessingType = "event_sink"
 	EngineProcessingTypeHeartbeat    EngineProcessingType = "heartbeat"
 	EngineProcessingTypeMessage      EngineProcessingType = "message"
 	EngineProcessingTypeMetrics      EngineProcessingType = "metrics"
@@ -84,6 +85,8 @@ const (
 	EnumAlertLogEventPolicyUpdated       EnumAlertLogEvent = "policy_updated"
 	EnumAlertLogEventReopened            EnumAlertLogEvent = "reopened"
 	EnumAlertLogEventResponseReceived    EnumAlertLogEvent = "response_received"
+	EnumAlertLogEventSnoozeExpired       EnumAlertLogEvent = "snooze_expired"
+	EnumAlertLogEventSnoozed             EnumAlertLogEvent = "snoozed"
 	EnumAlertLogEventStatusChanged       EnumAlertLogEvent = "status_changed"
 )
 
@@ -166,6 +169,51 @@ func (ns NullEnumAlertLogSubjectType) Value() (driver.Value, error) {
 	return string(ns.EnumAlertLogSubjectType), nil
 }
 
+type EnumAlertSeverity string
+
+const (
+	EnumAlertSeverityCritical EnumAlertSeverity = "critical"
+	EnumAlertSeverityHigh     EnumAlertSeverity = "high"
+	EnumAlertSeverityInfo     EnumAlertSeverity = "info"
+	EnumAlertSeverityLow      EnumAlertSeverity = "low"
+	EnumAlertSeverityMedium   EnumAlertSeverity = "medium"
+)
+
+func (e *EnumAlertSeverity) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EnumAlertSeverity(s)
+	case string:
+		*e = EnumAlertSeverity(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EnumAlertSeverity: %T", src)
+	}
+	return nil
+}
+
+type NullEnumAlertSeverity struct {
+	EnumAlertSeverity EnumAlertSeverity
+	Valid             bool // Valid is true if EnumAlertSeverity is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEnumAlertSeverity) Scan(value interface{}) error {
+	if value == nil {
+		ns.EnumAlertSeverity, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EnumAlertSeverity.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEnumAlertSeverity) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EnumAlertSeverity), nil
+}
+
 type EnumAlertSource string
 
 const (
@@ -499,6 +547,7 @@ const (
 	EnumOutgoingMessagesTypeAlertStatusUpdate          EnumOutgoingMessagesType = "alert_status_update"
 	EnumOutgoingMessagesTypeAlertStatusUpdateBundle    EnumOutgoingMessagesType = "alert_status_update_bundle"
 	EnumOutgoingMessagesTypeScheduleOnCallNotification EnumOutgoingMessagesType = "schedule_on_call_notification"
+	EnumOutgoingMessagesTypeShiftSwapRequest           EnumOutgoingMessagesType = "shift_swap_request"
 	EnumOutgoingMessagesTypeSignalMessage              EnumOutgoingMessagesType = "signal_message"
 	EnumOutgoingMessagesTypeTestNotification           EnumOutgoingMessagesType = "test_notification"
 	EnumOutgoingMessagesTypeVerificationMessage        EnumOutgoingMessagesType = "verification_message"
@@ -542,10 +591,11 @@ func (ns NullEnumOutgoingMessagesType) Value() (driver.Value, error) {
 type EnumRotationType string
 
 const (
-	EnumRotationTypeDaily   EnumRotationType = "daily"
-	EnumRotationTypeHourly  EnumRotationType = "hourly"
-	EnumRotationTypeMonthly EnumRotationType = "monthly"
-	EnumRotationTypeWeekly  EnumRotationType = "weekly"
+	EnumRotationTypeDaily        EnumRotationType = "daily"
+	EnumRotationTypeFollowTheSun EnumRotationType = "follow_the_sun"
+	EnumRotationTypeHourly       EnumRotationType = "hourly"
+	EnumRotationTypeMonthly      EnumRotationType = "monthly"
+	EnumRotationTypeWeekly       EnumRotationType = "weekly"
 )
 
 func (e *EnumRotationType) Scan(src interface{}) error {
@@ -583,6 +633,50 @@ func (ns NullEnumRotationType) Value() (driver.Value, error) {
 	return string(ns.EnumRotationType), nil
 }
 
+type EnumShiftSwapStatus string
+
+const (
+	EnumShiftSwapStatusAccepted EnumShiftSwapStatus = "accepted"
+	EnumShiftSwapStatusCanceled EnumShiftSwapStatus = "canceled"
+	EnumShiftSwapStatusDeclined EnumShiftSwapStatus = "declined"
+	EnumShiftSwapStatusPending  EnumShiftSwapStatus = "pending"
+)
+
+func (e *EnumShiftSwapStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EnumShiftSwapStatus(s)
+	case string:
+		*e = EnumShiftSwapStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EnumShiftSwapStatus: %T", src)
+	}
+	return nil
+}
+
+type NullEnumShiftSwapStatus struct {
+	EnumShiftSwapStatus EnumShiftSwapStatus
+	Valid               bool // Valid is true if EnumShiftSwapStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEnumShiftSwapStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.EnumShiftSwapStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EnumShiftSwapStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEnumShiftSwapStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EnumShiftSwapStatus), nil
+}
+
 type EnumSwitchoverState string
 
 const (
@@ -626,6 +720,48 @@ func (ns NullEnumSwitchoverState) Value() (driver.Value, error) {
 	return string(ns.EnumSwitchoverState), nil
 }
 
+type EnumTeamRole string
+
+const (
+	EnumTeamRoleMember EnumTeamRole = "member"
+	EnumTeamRoleOwner  EnumTeamRole = "owner"
+)
+
+func (e *EnumTeamRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EnumTeamRole(s)
+	case string:
+		*e = EnumTeamRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EnumTeamRole: %T", src)
+	}
+	return nil
+}
+
+type NullEnumTeamRole struct {
+	EnumTeamRole EnumTeamRole
+	Valid        bool // Valid is true if EnumTeamRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEnumTeamRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.EnumTeamRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EnumTeamRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEnumTeamRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EnumTeamRole), nil
+}
+
 type EnumThrottleType string
 
 const (
@@ -815,6 +951,7 @@ type Alert struct {
 	LastEscalation  sql.NullTime
 	LastProcessed   sql.NullTime
 	ServiceID       uuid.NullUUID
+	Severity        EnumAlertSeverity
 	Source          EnumAlertSource
 	Status          EnumAlertStatus
 	Summary         string
@@ -857,6 +994,12 @@ type AlertMetric struct {
 	TimeToClose sql.NullInt64
 }
 
+type AlertSnooze struct {
+	AlertID      int64
+	CreatedAt    time.Time
+	SnoozedUntil time.Time
+}
+
 type AlertStatusSubscription struct {
 	AlertID         int64
 	ChannelID       uuid.NullUUID
@@ -948,6 +1091,7 @@ type EscalationPolicy struct {
 	Name        string
 	Repeat      int32
 	StepCount   int32
+	TeamID      uuid.NullUUID
 }
 
 type EscalationPolicyAction struct {
@@ -974,10 +1118,28 @@ type EscalationPolicyState struct {
 
 type EscalationPolicyStep struct {
 	Delay              int32
+	EndTime            timeutil.Clock
 	EscalationPolicyID uuid.UUID
 	ID                 uuid.UUID
+	MatchConditions    json.RawMessage
 	MultiAck           bool
+	StartTime          timeutil.Clock
 	StepNumber         int32
+	TimeZone           string
+	WeekdayFilter      timeutil.WeekdayFilter
+}
+
+type EventOutbox struct {
+	Attempts      int32
+	ClaimedUntil  sql.NullTime
+	CreatedAt     time.Time
+	Data          json.RawMessage
+	DeadAt        sql.NullTime
+	EventType     string
+	ID            int64
+	LastError     sql.NullString
+	NextAttemptAt time.Time
+	Subject       string
 }
 
 type GorpMigration struct {
@@ -1018,6 +1180,19 @@ type HeartbeatMonitor struct {
 	ServiceID         uuid.UUID
 }
 
+type Incident struct {
+	CreatedAt      time.Time
+	GroupKey       string
+	ID             int64
+	PrimaryAlertID int64
+	Title          string
+}
+
+type IncidentAlert struct {
+	AlertID    int64
+	IncidentID int64
+}
+
 type IntegrationKey struct {
 	ExternalSystemName sql.NullString
 	ID                 uuid.UUID
@@ -1102,6 +1277,7 @@ type OutgoingMessage struct {
 	SendingDeadline        sql.NullTime
 	SentAt                 sql.NullTime
 	ServiceID              uuid.NullUUID
+	ShiftSwapRequestID     uuid.NullUUID
 	SrcValue               sql.NullString
 	StatusAlertIds         []int64
 	StatusDetails          string
@@ -1193,8 +1369,10 @@ type Rotation struct {
 	LastProcessed    sql.NullTime
 	Name             string
 	ParticipantCount int32
+	Regions          json.RawMessage
 	ShiftLength      int64
 	StartTime        time.Time
+	TeamID           uuid.NullUUID
 	TimeZone         string
 	Type             EnumRotationType
 }
@@ -1220,6 +1398,7 @@ type Schedule struct {
 	ID            uuid.UUID
 	LastProcessed sql.NullTime
 	Name          string
+	TeamID        uuid.NullUUID
 	TimeZone      string
 }
 
@@ -1256,12 +1435,71 @@ type ScheduleRule struct {
 	Wednesday     bool
 }
 
+type ScimGroup struct {
+	ExternalID sql.NullString
+	RotationID uuid.UUID
+}
+
+type ScimUser struct {
+	ExternalID sql.NullString
+	UserID     uuid.UUID
+	UserName   string
+}
+
 type Service struct {
 	Description          string
 	EscalationPolicyID   uuid.UUID
 	ID                   uuid.UUID
 	MaintenanceExpiresAt sql.NullTime
 	Name                 string
+	TeamID               uuid.NullUUID
+}
+
+type ServiceMaintenanceSuppressedAlert struct {
+	DedupKey     string
+	ID           uuid.UUID
+	ServiceID    uuid.UUID
+	Summary      string
+	SuppressedAt time.Time
+	WindowID     uuid.NullUUID
+}
+
+type ServiceMaintenanceWindow struct {
+	CreatedAt      time.Time
+	Description    string
+	EndTime        time.Time
+	ID             uuid.UUID
+	ServiceID      uuid.UUID
+	StartTime      time.Time
+	SuppressAlerts bool
+	TimeZone       string
+	WeekdayFilter  timeutil.WeekdayFilter
+}
+
+type ServiceRoutingRule struct {
+	ConditionExpr      string
+	DropAlert          bool
+	EscalationPolicyID uuid.NullUUID
+	ID                 uuid.UUID
+	Name               string
+	Position           int32
+	Priority           string
+	ServiceID          uuid.UUID
+}
+
+type ShiftSwapRequest struct {
+	CreatedAt      time.Time
+	EndTime        time.Time
+	FromUserID     uuid.UUID
+	ID             uuid.UUID
+	Note           string
+	ResolvedAt     sql.NullTime
+	ScheduleID     uuid.UUID
+	StartTime      time.Time
+	Status         EnumShiftSwapStatus
+	ToUserID       uuid.UUID
+	TradeEndTime   sql.NullTime
+	TradeStartTime sql.NullTime
 }
 
 type SwitchoverLog struct {
@@ -1276,6 +1514,19 @@ type SwitchoverState struct {
 	Ok           bool
 }
 
+type Team struct {
+	CreatedAt   time.Time
+	Description string
+	ID          uuid.UUID
+	Name        string
+}
+
+type TeamMember struct {
+	Role   EnumTeamRole
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
 type TwilioSmsCallback struct {
 	AlertID     sql.NullInt64
 	CallbackID  uuid.UUID
@@ -1315,6 +1566,7 @@ type User struct {
 	AlertStatusLogContactMethodID uuid.NullUUID
 	AvatarUrl                     string
 	Bio                           string
+	DeactivatedAt                 sql.NullTime
 	Email                         string
 	ID                            uuid.UUID
 	Name                          string
@@ -1353,6 +1605,7 @@ type UserFavorite struct {
 	TgtRotationID         uuid.NullUUID
 	TgtScheduleID         uuid.NullUUID
 	TgtServiceID          uuid.NullUUID
+	TgtTeamID             uuid.NullUUID
 	TgtUserID             uuid.NullUUID
 	UserID                uuid.UUID
 }
@@ -1361,8 +1614,13 @@ type UserNotificationRule struct {
 	ContactMethodID uuid.UUID
 	CreatedAt       sql.NullTime
 	DelayMinutes    int32
+	EndTime         timeutil.Clock
 	ID              uuid.UUID
+	MinSeverity     NullEnumAlertSeverity
+	StartTime       timeutil.Clock
+	TimeZone        string
 	UserID          uuid.UUID
+	WeekdayFilter   timeutil.WeekdayFilter
 }
 
 type UserOverride struct {
@@ -1386,3 +1644,9 @@ type UserVerificationCode struct {
 	ID              uuid.UUID
 	Sent            bool
 }
+
+type WebhookSigningSecret struct {
+	CreatedAt  time.Time
+	Secret     []byte
+	WebhookUrl string
+}
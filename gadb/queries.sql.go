@@ -312,8 +312,8 @@ SELECT
     $10
 FROM
     alerts a
-    JOIN services svc ON svc.id = a.service_id
-        AND svc.escalation_policy_id = $1
+    JOIN escalation_policy_state state ON state.alert_id = a.id
+        AND state.escalation_policy_id = $1
 WHERE
     a.status != 'closed'
 `
@@ -331,7 +331,7 @@ type AlertLog_InsertEPParams struct {
 	Message             string
 }
 
-// Inserts a new alert log for all alerts in the escalation policy that are not closed.
+// Inserts a new alert log for all alerts escalated by the escalation policy that are not closed.
 func (q *Queries) AlertLog_InsertEP(ctx context.Context, arg AlertLog_InsertEPParams) error {
 	_, err := q.db.ExecContext(ctx, alertLog_InsertEP,
 		arg.EscalationPolicyID,
@@ -500,6 +500,94 @@ func (q *Queries) AlertLog_LookupNCDest(ctx context.Context, id uuid.UUID) (Null
 	return dest, err
 }
 
+const alertStream_FavoriteServiceIDs = `-- name: AlertStream_FavoriteServiceIDs :many
+SELECT
+    tgt_service_id::uuid
+FROM
+    user_favorites
+WHERE
+    user_id = $1
+    AND tgt_service_id NOTNULL
+`
+
+func (q *Queries) AlertStream_FavoriteServiceIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, alertStream_FavoriteServiceIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var tgt_service_id uuid.UUID
+		if err := rows.Scan(&tgt_service_id); err != nil {
+			return nil, err
+		}
+		items = append(items, tgt_service_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const alertStream_LabelServiceIDs = `-- name: AlertStream_LabelServiceIDs :many
+SELECT
+    tgt_service_id::uuid
+FROM
+    labels
+WHERE
+    key = $1
+    AND value = $2
+    AND tgt_service_id NOTNULL
+`
+
+type AlertStream_LabelServiceIDsParams struct {
+	Key   string
+	Value string
+}
+
+func (q *Queries) AlertStream_LabelServiceIDs(ctx context.Context, arg AlertStream_LabelServiceIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, alertStream_LabelServiceIDs, arg.Key, arg.Value)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var tgt_service_id uuid.UUID
+		if err := rows.Scan(&tgt_service_id); err != nil {
+			return nil, err
+		}
+		items = append(items, tgt_service_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const alert_AddIncidentAlert = `-- name: Alert_AddIncidentAlert :exec
+INSERT INTO incident_alerts(alert_id, incident_id)
+    VALUES ($1, $2)
+`
+
+type Alert_AddIncidentAlertParams struct {
+	AlertID    int64
+	IncidentID int64
+}
+
+// Adds an alert to an existing incident.
+func (q *Queries) Alert_AddIncidentAlert(ctx context.Context, arg Alert_AddIncidentAlertParams) error {
+	_, err := q.db.ExecContext(ctx, alert_AddIncidentAlert, arg.AlertID, arg.IncidentID)
+	return err
+}
+
 const alert_AlertHasEPState = `-- name: Alert_AlertHasEPState :one
 SELECT
     EXISTS (
@@ -540,6 +628,108 @@ func (q *Queries) Alert_AlertMultiAck(ctx context.Context, alertID int64) (bool,
 	return multi_ack, err
 }
 
+const alert_ClearSnoozes = `-- name: Alert_ClearSnoozes :execrows
+DELETE FROM alert_snoozes
+WHERE alert_id = ANY ($1::bigint[])
+`
+
+// Removes any pending snooze for the alerts, so they will not be re-triggered.
+func (q *Queries) Alert_ClearSnoozes(ctx context.Context, alertIds []int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, alert_ClearSnoozes, pq.Array(alertIds))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const alert_CreateIncident = `-- name: Alert_CreateIncident :one
+WITH inc AS (
+INSERT INTO incidents(title, group_key, primary_alert_id)
+        VALUES ($1, $2, $3)
+    RETURNING
+        id, primary_alert_id)
+    INSERT INTO incident_alerts(alert_id, incident_id)
+    SELECT
+        primary_alert_id,
+        id
+    FROM
+        inc
+    RETURNING
+        incident_id
+`
+
+type Alert_CreateIncidentParams struct {
+	Title          string
+	GroupKey       string
+	PrimaryAlertID int64
+}
+
+// Creates a new incident with the provided alert as the primary (escalated) alert.
+func (q *Queries) Alert_CreateIncident(ctx context.Context, arg Alert_CreateIncidentParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, alert_CreateIncident, arg.Title, arg.GroupKey, arg.PrimaryAlertID)
+	var incident_id int64
+	err := row.Scan(&incident_id)
+	return incident_id, err
+}
+
+const alert_DeleteEPState = `-- name: Alert_DeleteEPState :exec
+DELETE FROM escalation_policy_state
+WHERE alert_id = $1
+`
+
+// Removes the escalation policy state of the alert, so it will not be escalated.
+func (q *Queries) Alert_DeleteEPState(ctx context.Context, alertID int64) error {
+	_, err := q.db.ExecContext(ctx, alert_DeleteEPState, alertID)
+	return err
+}
+
+const alert_EPHasSteps = `-- name: Alert_EPHasSteps :one
+SELECT
+    EXISTS (
+        SELECT
+            1
+        FROM
+            escalation_policy_steps
+        WHERE
+            escalation_policy_id = $1)
+`
+
+// Returns true if the Escalation Policy has at least one step.
+func (q *Queries) Alert_EPHasSteps(ctx context.Context, escalationPolicyID uuid.UUID) (bool, error) {
+	row := q.db.QueryRowContext(ctx, alert_EPHasSteps, escalationPolicyID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const alert_FindOpenIncident = `-- name: Alert_FindOpenIncident :one
+SELECT
+    inc.id
+FROM
+    incidents inc
+    JOIN alerts a ON a.id = inc.primary_alert_id
+WHERE
+    inc.group_key = $1
+    AND inc.created_at > now() - make_interval(mins => $2::int)
+    AND a.status != 'closed'
+ORDER BY
+    inc.created_at DESC
+LIMIT 1
+`
+
+type Alert_FindOpenIncidentParams struct {
+	GroupKey      string
+	WindowMinutes int32
+}
+
+// Returns the ID of the most recent incident for the group key that was created within the window and is still open.
+func (q *Queries) Alert_FindOpenIncident(ctx context.Context, arg Alert_FindOpenIncidentParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, alert_FindOpenIncident, arg.GroupKey, arg.WindowMinutes)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const alert_GetAlertFeedback = `-- name: Alert_GetAlertFeedback :many
 SELECT
     alert_id,
@@ -637,20 +827,21 @@ func (q *Queries) Alert_GetAlertMetadata(ctx context.Context, alertID int64) (pq
 
 const alert_GetEscalationPolicyID = `-- name: Alert_GetEscalationPolicyID :one
 SELECT
-    escalation_policy_id
+    coalesce(state.escalation_policy_id, svc.escalation_policy_id)::uuid
 FROM
     alerts a
     JOIN services svc ON svc.id = a.service_id
+    LEFT JOIN escalation_policy_state state ON state.alert_id = a.id
 WHERE
     a.id = $1::bigint
 `
 
-// Returns the escalation policy ID associated with the alert.
+// Returns the escalation policy ID associated with the alert, which may differ from the service's if the alert was routed.
 func (q *Queries) Alert_GetEscalationPolicyID(ctx context.Context, id int64) (uuid.UUID, error) {
 	row := q.db.QueryRowContext(ctx, alert_GetEscalationPolicyID, id)
-	var escalation_policy_id uuid.UUID
-	err := row.Scan(&escalation_policy_id)
-	return escalation_policy_id, err
+	var column_1 uuid.UUID
+	err := row.Scan(&column_1)
+	return column_1, err
 }
 
 const alert_GetStatusAndLockService = `-- name: Alert_GetStatusAndLockService :one
@@ -672,6 +863,57 @@ func (q *Queries) Alert_GetStatusAndLockService(ctx context.Context, id int64) (
 	return status, err
 }
 
+const alert_IncidentChildAlerts = `-- name: Alert_IncidentChildAlerts :many
+SELECT
+    ia.alert_id
+FROM
+    incidents inc
+    JOIN incident_alerts ia ON ia.incident_id = inc.id
+        AND ia.alert_id != inc.primary_alert_id
+WHERE
+    inc.primary_alert_id = ANY ($1::bigint[])
+`
+
+// Returns the IDs of the non-primary alerts of incidents where the primary alert is one of the provided alerts.
+func (q *Queries) Alert_IncidentChildAlerts(ctx context.Context, alertIds []int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, alert_IncidentChildAlerts, pq.Array(alertIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var alert_id int64
+		if err := rows.Scan(&alert_id); err != nil {
+			return nil, err
+		}
+		items = append(items, alert_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const alert_LockIncidentGroup = `-- name: Alert_LockIncidentGroup :exec
+SELECT
+    pg_advisory_xact_lock($1::int, hashtext($2::text))
+`
+
+type Alert_LockIncidentGroupParams struct {
+	LockID   int32
+	GroupKey string
+}
+
+// Locks the incident group key for the current transaction, so concurrent alerts are grouped into the same incident.
+func (q *Queries) Alert_LockIncidentGroup(ctx context.Context, arg Alert_LockIncidentGroupParams) error {
+	_, err := q.db.ExecContext(ctx, alert_LockIncidentGroup, arg.LockID, arg.GroupKey)
+	return err
+}
+
 const alert_LockManyAlertServices = `-- name: Alert_LockManyAlertServices :exec
 SELECT
     1
@@ -776,6 +1018,50 @@ func (q *Queries) Alert_ServiceEPHasSteps(ctx context.Context, serviceID uuid.UU
 	return exists, err
 }
 
+const alert_ServiceGroupingInfo = `-- name: Alert_ServiceGroupingInfo :many
+SELECT
+    svc.name,
+    coalesce(l.key, '')::text AS key,
+    coalesce(l.value, '')::text AS value
+FROM
+    services svc
+    LEFT JOIN labels l ON l.tgt_service_id = svc.id
+WHERE
+    svc.id = $1
+ORDER BY
+    l.key
+`
+
+type Alert_ServiceGroupingInfoRow struct {
+	Name  string
+	Key   string
+	Value string
+}
+
+// Returns the name and labels of a service, for incident grouping. A row with empty key and value is returned for services without labels.
+func (q *Queries) Alert_ServiceGroupingInfo(ctx context.Context, serviceID uuid.UUID) ([]Alert_ServiceGroupingInfoRow, error) {
+	rows, err := q.db.QueryContext(ctx, alert_ServiceGroupingInfo, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Alert_ServiceGroupingInfoRow
+	for rows.Next() {
+		var i Alert_ServiceGroupingInfoRow
+		if err := rows.Scan(&i.Name, &i.Key, &i.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const alert_SetAlertFeedback = `-- name: Alert_SetAlertFeedback :exec
 INSERT INTO alert_feedback(alert_id, noise_reason)
     VALUES ($1, $2)
@@ -831,6 +1117,25 @@ func (q *Queries) Alert_SetAlertMetadata(ctx context.Context, arg Alert_SetAlert
 	return result.RowsAffected()
 }
 
+const alert_SetEscalationPolicy = `-- name: Alert_SetEscalationPolicy :exec
+INSERT INTO escalation_policy_state(alert_id, escalation_policy_id)
+    VALUES ($1, $2)
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        escalation_policy_id = excluded.escalation_policy_id, escalation_policy_step_id = NULL, escalation_policy_step_number = 0, loop_count = 0, last_escalation = NULL, next_escalation = NULL, force_escalation = FALSE
+`
+
+type Alert_SetEscalationPolicyParams struct {
+	AlertID            int64
+	EscalationPolicyID uuid.UUID
+}
+
+// Sets the escalation policy of a new alert, overriding the one of its service.
+func (q *Queries) Alert_SetEscalationPolicy(ctx context.Context, arg Alert_SetEscalationPolicyParams) error {
+	_, err := q.db.ExecContext(ctx, alert_SetEscalationPolicy, arg.AlertID, arg.EscalationPolicyID)
+	return err
+}
+
 const alert_SetManyAlertFeedback = `-- name: Alert_SetManyAlertFeedback :many
 INSERT INTO alert_feedback(alert_id, noise_reason)
     VALUES (unnest($1::bigint[]), $2)
@@ -872,6 +1177,60 @@ func (q *Queries) Alert_SetManyAlertFeedback(ctx context.Context, arg Alert_SetM
 	return items, nil
 }
 
+const alert_SnoozeManyAlerts = `-- name: Alert_SnoozeManyAlerts :many
+WITH acked AS (
+    UPDATE
+        alerts
+    SET
+        status = 'active'
+    WHERE
+        id = ANY ($2::bigint[])
+        AND status != 'closed'
+    RETURNING
+        id)
+INSERT INTO alert_snoozes(alert_id, snoozed_until)
+SELECT
+    id,
+    now() + make_interval(mins => $1::int)
+FROM
+    acked
+ON CONFLICT (alert_id)
+    DO UPDATE SET
+        snoozed_until = excluded.snoozed_until,
+        created_at = now()
+    RETURNING
+        alert_id
+`
+
+type Alert_SnoozeManyAlertsParams struct {
+	DurationMinutes int32
+	AlertIds        []int64
+}
+
+// Acknowledges the (non-closed) alerts and sets the time they will be re-triggered.
+func (q *Queries) Alert_SnoozeManyAlerts(ctx context.Context, arg Alert_SnoozeManyAlertsParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, alert_SnoozeManyAlerts, arg.DurationMinutes, pq.Array(arg.AlertIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var alert_id int64
+		if err := rows.Scan(&alert_id); err != nil {
+			return nil, err
+		}
+		items = append(items, alert_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const allPendingMsgDests = `-- name: AllPendingMsgDests :many
 SELECT DISTINCT
   usr.name AS user_name,
@@ -1317,6 +1676,29 @@ func (q *Queries) CleanupMgrDeleteOldStepShifts(ctx context.Context, historyThre
 	return result.RowsAffected()
 }
 
+const cleanupMgrDeleteOldSuppressedAlerts = `-- name: CleanupMgrDeleteOldSuppressedAlerts :execrows
+DELETE FROM service_maintenance_suppressed_alerts
+WHERE id = ANY (
+        SELECT
+            id
+        FROM
+            service_maintenance_suppressed_alerts
+        WHERE
+            suppressed_at < now() -($1::bigint * '1 day'::interval)
+        LIMIT 100
+        FOR UPDATE
+            SKIP LOCKED)
+`
+
+// CleanupMgrDeleteOldSuppressedAlerts will delete records of alerts suppressed by a maintenance window that are older than the given number of days before now.
+func (q *Queries) CleanupMgrDeleteOldSuppressedAlerts(ctx context.Context, staleThresholdDays int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cleanupMgrDeleteOldSuppressedAlerts, staleThresholdDays)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const cleanupMgrDisableOldCalSub = `-- name: CleanupMgrDisableOldCalSub :execrows
 UPDATE
     user_calendar_subscriptions
@@ -2223,26 +2605,82 @@ func (q *Queries) EnableChangeLogTriggers(ctx context.Context) error {
 	return err
 }
 
-const engineGetSignalParams = `-- name: EngineGetSignalParams :one
+const engineGetShiftSwapRequest = `-- name: EngineGetShiftSwapRequest :one
 SELECT
-    params
+    req.id,
+    req.status,
+    req.start_time,
+    req.end_time,
+    req.trade_start_time,
+    req.trade_end_time,
+    req.note,
+    sched.id AS schedule_id,
+    sched.name AS schedule_name,
+    sched.time_zone,
+    usr.name AS from_user_name
 FROM
-    pending_signals
+    outgoing_messages msg
+    JOIN shift_swap_requests req ON req.id = msg.shift_swap_request_id
+    JOIN schedules sched ON sched.id = req.schedule_id
+    JOIN users usr ON usr.id = req.from_user_id
 WHERE
-    message_id = $1
+    msg.id = $1
 `
 
-// Get a pending signal's rendered params.
-func (q *Queries) EngineGetSignalParams(ctx context.Context, messageID uuid.NullUUID) (json.RawMessage, error) {
-	row := q.db.QueryRowContext(ctx, engineGetSignalParams, messageID)
-	var params json.RawMessage
-	err := row.Scan(&params)
-	return params, err
+type EngineGetShiftSwapRequestRow struct {
+	ID             uuid.UUID
+	Status         EnumShiftSwapStatus
+	StartTime      time.Time
+	EndTime        time.Time
+	TradeStartTime sql.NullTime
+	TradeEndTime   sql.NullTime
+	Note           string
+	ScheduleID     uuid.UUID
+	ScheduleName   string
+	TimeZone       string
+	FromUserName   string
 }
 
-const engineIsKnownDest = `-- name: EngineIsKnownDest :one
-SELECT
-    EXISTS (
+// Get the shift swap request, schedule, and requesting user details for a message.
+func (q *Queries) EngineGetShiftSwapRequest(ctx context.Context, id uuid.UUID) (EngineGetShiftSwapRequestRow, error) {
+	row := q.db.QueryRowContext(ctx, engineGetShiftSwapRequest, id)
+	var i EngineGetShiftSwapRequestRow
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.StartTime,
+		&i.EndTime,
+		&i.TradeStartTime,
+		&i.TradeEndTime,
+		&i.Note,
+		&i.ScheduleID,
+		&i.ScheduleName,
+		&i.TimeZone,
+		&i.FromUserName,
+	)
+	return i, err
+}
+
+const engineGetSignalParams = `-- name: EngineGetSignalParams :one
+SELECT
+    params
+FROM
+    pending_signals
+WHERE
+    message_id = $1
+`
+
+// Get a pending signal's rendered params.
+func (q *Queries) EngineGetSignalParams(ctx context.Context, messageID uuid.NullUUID) (json.RawMessage, error) {
+	row := q.db.QueryRowContext(ctx, engineGetSignalParams, messageID)
+	var params json.RawMessage
+	err := row.Scan(&params)
+	return params, err
+}
+
+const engineIsKnownDest = `-- name: EngineIsKnownDest :one
+SELECT
+    EXISTS (
         SELECT
         FROM
             user_contact_methods uc
@@ -2265,6 +2703,155 @@ func (q *Queries) EngineIsKnownDest(ctx context.Context, dest NullDestV1) (sql.N
 	return column_1, err
 }
 
+const eventSinkClaim = `-- name: EventSinkClaim :many
+WITH next AS (
+    SELECT DISTINCT ON (subject)
+        id,
+        next_attempt_at,
+        claimed_until
+    FROM
+        event_outbox
+    WHERE
+        dead_at IS NULL
+    ORDER BY
+        subject,
+        id
+),
+due AS (
+    SELECT
+        id
+    FROM
+        next
+    WHERE
+        next_attempt_at <= now()
+        AND (claimed_until IS NULL
+            OR claimed_until < now())
+    ORDER BY
+        id
+    LIMIT $1)
+UPDATE
+    event_outbox o
+SET
+    claimed_until = now() + $2::int * '1 second'::interval
+FROM
+    due
+WHERE
+    o.id = due.id
+RETURNING
+    o.id,
+    o.event_type,
+    o.subject,
+    o.data,
+    o.created_at,
+    o.attempts
+`
+
+type EventSinkClaimParams struct {
+	Limit        int32
+	ClaimSeconds int32
+}
+
+type EventSinkClaimRow struct {
+	ID        int64
+	EventType string
+	Subject   string
+	Data      json.RawMessage
+	CreatedAt time.Time
+	Attempts  int32
+}
+
+// EventSinkClaim claims the oldest pending event of each subject that is due to be sent, so events of a subject are sent in order.
+func (q *Queries) EventSinkClaim(ctx context.Context, arg EventSinkClaimParams) ([]EventSinkClaimRow, error) {
+	rows, err := q.db.QueryContext(ctx, eventSinkClaim, arg.Limit, arg.ClaimSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventSinkClaimRow
+	for rows.Next() {
+		var i EventSinkClaimRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.Subject,
+			&i.Data,
+			&i.CreatedAt,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const eventSinkDelete = `-- name: EventSinkDelete :exec
+DELETE FROM event_outbox
+WHERE id = ANY ($1::bigint[])
+`
+
+func (q *Queries) EventSinkDelete(ctx context.Context, ids []int64) error {
+	_, err := q.db.ExecContext(ctx, eventSinkDelete, pq.Array(ids))
+	return err
+}
+
+const eventSinkDeleteAll = `-- name: EventSinkDeleteAll :exec
+DELETE FROM event_outbox
+`
+
+func (q *Queries) EventSinkDeleteAll(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, eventSinkDeleteAll)
+	return err
+}
+
+const eventSinkDeleteDead = `-- name: EventSinkDeleteDead :exec
+DELETE FROM event_outbox
+WHERE dead_at < now() - $1::int * '1 day'::interval
+`
+
+// EventSinkDeleteDead deletes dead events older than the given number of days.
+func (q *Queries) EventSinkDeleteDead(ctx context.Context, days int32) error {
+	_, err := q.db.ExecContext(ctx, eventSinkDeleteDead, days)
+	return err
+}
+
+const eventSinkFailed = `-- name: EventSinkFailed :one
+UPDATE
+    event_outbox
+SET
+    attempts = attempts + 1,
+    last_error = $2,
+    claimed_until = NULL,
+    next_attempt_at = now() + least(power(2, attempts) * '1 second'::interval, '1 hour'::interval),
+    dead_at = CASE WHEN attempts + 1 >= $3::int THEN
+        now()
+    END
+WHERE
+    id = $1
+RETURNING
+    (dead_at IS NOT NULL)::bool AS dead
+`
+
+type EventSinkFailedParams struct {
+	ID          int64
+	LastError   sql.NullString
+	MaxAttempts int32
+}
+
+// EventSinkFailed records a failed attempt, and schedules a retry with an exponential backoff, or marks the event dead after max_attempts.
+func (q *Queries) EventSinkFailed(ctx context.Context, arg EventSinkFailedParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, eventSinkFailed, arg.ID, arg.LastError, arg.MaxAttempts)
+	var dead bool
+	err := row.Scan(&dead)
+	return dead, err
+}
+
 const findManyCalSubByUser = `-- name: FindManyCalSubByUser :many
 SELECT
     id,
@@ -2732,6 +3319,99 @@ func (q *Queries) HBUpdate(ctx context.Context, arg HBUpdateParams) error {
 	return err
 }
 
+const incident_AlertIDs = `-- name: Incident_AlertIDs :many
+SELECT
+    ia.alert_id
+FROM
+    incident_alerts ia
+    JOIN incidents inc ON inc.id = ia.incident_id
+WHERE
+    ia.incident_id = $1
+ORDER BY
+    ia.alert_id != inc.primary_alert_id,
+    ia.alert_id
+`
+
+// Returns the IDs of all alerts of the incident, primary alert first.
+func (q *Queries) Incident_AlertIDs(ctx context.Context, incidentID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, incident_AlertIDs, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var alert_id int64
+		if err := rows.Scan(&alert_id); err != nil {
+			return nil, err
+		}
+		items = append(items, alert_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incident_FindByAlert = `-- name: Incident_FindByAlert :one
+SELECT
+    incident_id
+FROM
+    incident_alerts
+WHERE
+    alert_id = $1
+`
+
+// Returns the ID of the incident the alert belongs to.
+func (q *Queries) Incident_FindByAlert(ctx context.Context, alertID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, incident_FindByAlert, alertID)
+	var incident_id int64
+	err := row.Scan(&incident_id)
+	return incident_id, err
+}
+
+const incident_FindOne = `-- name: Incident_FindOne :one
+SELECT
+    inc.id,
+    inc.title,
+    inc.primary_alert_id,
+    inc.created_at,
+    min(a.status)::enum_alert_status AS status
+FROM
+    incidents inc
+    JOIN incident_alerts ia ON ia.incident_id = inc.id
+    JOIN alerts a ON a.id = ia.alert_id
+WHERE
+    inc.id = $1
+GROUP BY
+    inc.id
+`
+
+type Incident_FindOneRow struct {
+	ID             int64
+	Title          string
+	PrimaryAlertID int64
+	CreatedAt      time.Time
+	Status         EnumAlertStatus
+}
+
+// Returns the incident, with the least-resolved status of its alerts.
+func (q *Queries) Incident_FindOne(ctx context.Context, id int64) (Incident_FindOneRow, error) {
+	row := q.db.QueryRowContext(ctx, incident_FindOne, id)
+	var i Incident_FindOneRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.PrimaryAlertID,
+		&i.CreatedAt,
+		&i.Status,
+	)
+	return i, err
+}
+
 const intKeyCreate = `-- name: IntKeyCreate :exec
 INSERT INTO integration_keys(id, name, type, service_id, external_system_name)
     VALUES ($1, $2, $3, $4, $5)
@@ -3159,6 +3839,42 @@ func (q *Queries) Keyring_GetKeyringSecrets(ctx context.Context) ([]Keyring_GetK
 	return items, nil
 }
 
+const keyring_GetWebhookSecrets = `-- name: Keyring_GetWebhookSecrets :many
+SELECT
+    webhook_url,
+    secret
+FROM
+    webhook_signing_secrets
+`
+
+type Keyring_GetWebhookSecretsRow struct {
+	WebhookUrl string
+	Secret     []byte
+}
+
+func (q *Queries) Keyring_GetWebhookSecrets(ctx context.Context) ([]Keyring_GetWebhookSecretsRow, error) {
+	rows, err := q.db.QueryContext(ctx, keyring_GetWebhookSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Keyring_GetWebhookSecretsRow
+	for rows.Next() {
+		var i Keyring_GetWebhookSecretsRow
+		if err := rows.Scan(&i.WebhookUrl, &i.Secret); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const keyring_LockConfig = `-- name: Keyring_LockConfig :exec
 LOCK TABLE config IN ACCESS EXCLUSIVE MODE
 `
@@ -3179,6 +3895,16 @@ func (q *Queries) Keyring_LockKeyrings(ctx context.Context) error {
 	return err
 }
 
+const keyring_LockWebhookSecrets = `-- name: Keyring_LockWebhookSecrets :exec
+LOCK TABLE webhook_signing_secrets IN ACCESS EXCLUSIVE MODE
+`
+
+// Locks the webhook_signing_secrets table so no new secrets can be created.
+func (q *Queries) Keyring_LockWebhookSecrets(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, keyring_LockWebhookSecrets)
+	return err
+}
+
 const keyring_UpdateConfigPayload = `-- name: Keyring_UpdateConfigPayload :exec
 UPDATE
     config
@@ -3219,6 +3945,25 @@ func (q *Queries) Keyring_UpdateKeyringSecrets(ctx context.Context, arg Keyring_
 	return err
 }
 
+const keyring_UpdateWebhookSecret = `-- name: Keyring_UpdateWebhookSecret :exec
+UPDATE
+    webhook_signing_secrets
+SET
+    secret = $1
+WHERE
+    webhook_url = $2
+`
+
+type Keyring_UpdateWebhookSecretParams struct {
+	Secret     []byte
+	WebhookUrl string
+}
+
+func (q *Queries) Keyring_UpdateWebhookSecret(ctx context.Context, arg Keyring_UpdateWebhookSecretParams) error {
+	_, err := q.db.ExecContext(ctx, keyring_UpdateWebhookSecret, arg.Secret, arg.WebhookUrl)
+	return err
+}
+
 const labelDeleteKeyByTarget = `-- name: LabelDeleteKeyByTarget :exec
 DELETE FROM labels
 WHERE key = $1
@@ -3926,47 +4671,344 @@ func (q *Queries) LogEvents(ctx context.Context, id int64) ([]LogEventsRow, erro
 	return items, nil
 }
 
-const messageMgrGetPending = `-- name: MessageMgrGetPending :many
+const maintWindow_Create = `-- name: MaintWindow_Create :exec
+INSERT INTO service_maintenance_windows(id, service_id, description, start_time, end_time, weekday_filter, time_zone, suppress_alerts)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type MaintWindow_CreateParams struct {
+	ID             uuid.UUID
+	ServiceID      uuid.UUID
+	Description    string
+	StartTime      time.Time
+	EndTime        time.Time
+	WeekdayFilter  timeutil.WeekdayFilter
+	TimeZone       string
+	SuppressAlerts bool
+}
+
+func (q *Queries) MaintWindow_Create(ctx context.Context, arg MaintWindow_CreateParams) error {
+	_, err := q.db.ExecContext(ctx, maintWindow_Create,
+		arg.ID,
+		arg.ServiceID,
+		arg.Description,
+		arg.StartTime,
+		arg.EndTime,
+		arg.WeekdayFilter,
+		arg.TimeZone,
+		arg.SuppressAlerts,
+	)
+	return err
+}
+
+const maintWindow_Delete = `-- name: MaintWindow_Delete :exec
+DELETE FROM service_maintenance_windows
+WHERE id = ANY ($1::uuid[])
+`
+
+func (q *Queries) MaintWindow_Delete(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, maintWindow_Delete, pq.Array(ids))
+	return err
+}
+
+const maintWindow_FindAllByService = `-- name: MaintWindow_FindAllByService :many
 SELECT
-    msg.id,
-    msg.message_type,
-    cm.id AS cm_id,
-    chan.id AS chan_id,
-    coalesce(cm.dest, chan.dest) AS dest,
-    msg.alert_id,
-    msg.alert_log_id,
-    msg.user_verification_code_id,
-    cm.user_id,
-    msg.service_id,
-    msg.created_at,
-    msg.sent_at,
-    msg.status_alert_ids,
-    msg.schedule_id
+    created_at, description, end_time, id, service_id, start_time, suppress_alerts, time_zone, weekday_filter
 FROM
-    outgoing_messages msg
-    LEFT JOIN user_contact_methods cm ON cm.id = msg.contact_method_id
-    LEFT JOIN notification_channels chan ON chan.id = msg.channel_id
+    service_maintenance_windows
 WHERE
-    sent_at >= $1
-    OR last_status = 'pending'
-    AND (msg.contact_method_id ISNULL
-        OR msg.message_type = 'verification_message'
-        OR NOT cm.disabled)
+    service_id = $1
+ORDER BY
+    start_time,
+    id
 `
 
-type MessageMgrGetPendingRow struct {
-	ID                     uuid.UUID
-	MessageType            EnumOutgoingMessagesType
-	CmID                   uuid.NullUUID
-	ChanID                 uuid.NullUUID
-	Dest                   NullDestV1
-	AlertID                sql.NullInt64
-	AlertLogID             sql.NullInt64
-	UserVerificationCodeID uuid.NullUUID
-	UserID                 uuid.NullUUID
-	ServiceID              uuid.NullUUID
-	CreatedAt              time.Time
-	SentAt                 sql.NullTime
+func (q *Queries) MaintWindow_FindAllByService(ctx context.Context, serviceID uuid.UUID) ([]ServiceMaintenanceWindow, error) {
+	rows, err := q.db.QueryContext(ctx, maintWindow_FindAllByService, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceMaintenanceWindow
+	for rows.Next() {
+		var i ServiceMaintenanceWindow
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.Description,
+			&i.EndTime,
+			&i.ID,
+			&i.ServiceID,
+			&i.StartTime,
+			&i.SuppressAlerts,
+			&i.TimeZone,
+			&i.WeekdayFilter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const maintWindow_FindOne = `-- name: MaintWindow_FindOne :one
+SELECT
+    created_at, description, end_time, id, service_id, start_time, suppress_alerts, time_zone, weekday_filter
+FROM
+    service_maintenance_windows
+WHERE
+    id = $1
+`
+
+func (q *Queries) MaintWindow_FindOne(ctx context.Context, id uuid.UUID) (ServiceMaintenanceWindow, error) {
+	row := q.db.QueryRowContext(ctx, maintWindow_FindOne, id)
+	var i ServiceMaintenanceWindow
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.Description,
+		&i.EndTime,
+		&i.ID,
+		&i.ServiceID,
+		&i.StartTime,
+		&i.SuppressAlerts,
+		&i.TimeZone,
+		&i.WeekdayFilter,
+	)
+	return i, err
+}
+
+const maintWindow_FindOneForUpdate = `-- name: MaintWindow_FindOneForUpdate :one
+SELECT
+    created_at, description, end_time, id, service_id, start_time, suppress_alerts, time_zone, weekday_filter
+FROM
+    service_maintenance_windows
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+func (q *Queries) MaintWindow_FindOneForUpdate(ctx context.Context, id uuid.UUID) (ServiceMaintenanceWindow, error) {
+	row := q.db.QueryRowContext(ctx, maintWindow_FindOneForUpdate, id)
+	var i ServiceMaintenanceWindow
+	err := row.Scan(
+		&i.CreatedAt,
+		&i.Description,
+		&i.EndTime,
+		&i.ID,
+		&i.ServiceID,
+		&i.StartTime,
+		&i.SuppressAlerts,
+		&i.TimeZone,
+		&i.WeekdayFilter,
+	)
+	return i, err
+}
+
+const maintWindow_FindStarted = `-- name: MaintWindow_FindStarted :many
+SELECT
+    w.created_at, w.description, w.end_time, w.id, w.service_id, w.start_time, w.suppress_alerts, w.time_zone, w.weekday_filter,
+    now()::timestamptz AS now
+FROM
+    service_maintenance_windows w
+WHERE
+    w.service_id = $1
+    AND w.start_time <= now()
+    AND (w.end_time > now()
+        OR w.weekday_filter != '{f,f,f,f,f,f,f}')
+`
+
+type MaintWindow_FindStartedRow struct {
+	ServiceMaintenanceWindow ServiceMaintenanceWindow
+	Now                      time.Time
+}
+
+// Returns windows for the service that have started and may still be active, along with the current DB time.
+func (q *Queries) MaintWindow_FindStarted(ctx context.Context, serviceID uuid.UUID) ([]MaintWindow_FindStartedRow, error) {
+	rows, err := q.db.QueryContext(ctx, maintWindow_FindStarted, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MaintWindow_FindStartedRow
+	for rows.Next() {
+		var i MaintWindow_FindStartedRow
+		if err := rows.Scan(
+			&i.ServiceMaintenanceWindow.CreatedAt,
+			&i.ServiceMaintenanceWindow.Description,
+			&i.ServiceMaintenanceWindow.EndTime,
+			&i.ServiceMaintenanceWindow.ID,
+			&i.ServiceMaintenanceWindow.ServiceID,
+			&i.ServiceMaintenanceWindow.StartTime,
+			&i.ServiceMaintenanceWindow.SuppressAlerts,
+			&i.ServiceMaintenanceWindow.TimeZone,
+			&i.ServiceMaintenanceWindow.WeekdayFilter,
+			&i.Now,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const maintWindow_FindSuppressedByService = `-- name: MaintWindow_FindSuppressedByService :many
+SELECT
+    dedup_key, id, service_id, summary, suppressed_at, window_id
+FROM
+    service_maintenance_suppressed_alerts
+WHERE
+    service_id = $1
+ORDER BY
+    suppressed_at DESC
+LIMIT $2
+`
+
+type MaintWindow_FindSuppressedByServiceParams struct {
+	ServiceID uuid.UUID
+	Limit     int32
+}
+
+func (q *Queries) MaintWindow_FindSuppressedByService(ctx context.Context, arg MaintWindow_FindSuppressedByServiceParams) ([]ServiceMaintenanceSuppressedAlert, error) {
+	rows, err := q.db.QueryContext(ctx, maintWindow_FindSuppressedByService, arg.ServiceID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceMaintenanceSuppressedAlert
+	for rows.Next() {
+		var i ServiceMaintenanceSuppressedAlert
+		if err := rows.Scan(
+			&i.DedupKey,
+			&i.ID,
+			&i.ServiceID,
+			&i.Summary,
+			&i.SuppressedAt,
+			&i.WindowID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const maintWindow_RecordSuppressed = `-- name: MaintWindow_RecordSuppressed :exec
+INSERT INTO service_maintenance_suppressed_alerts(service_id, window_id, summary, dedup_key)
+    VALUES ($1, $2, $3, $4)
+`
+
+type MaintWindow_RecordSuppressedParams struct {
+	ServiceID uuid.UUID
+	WindowID  uuid.NullUUID
+	Summary   string
+	DedupKey  string
+}
+
+func (q *Queries) MaintWindow_RecordSuppressed(ctx context.Context, arg MaintWindow_RecordSuppressedParams) error {
+	_, err := q.db.ExecContext(ctx, maintWindow_RecordSuppressed,
+		arg.ServiceID,
+		arg.WindowID,
+		arg.Summary,
+		arg.DedupKey,
+	)
+	return err
+}
+
+const maintWindow_Update = `-- name: MaintWindow_Update :exec
+UPDATE
+    service_maintenance_windows
+SET
+    description = $2,
+    start_time = $3,
+    end_time = $4,
+    weekday_filter = $5,
+    time_zone = $6,
+    suppress_alerts = $7
+WHERE
+    id = $1
+`
+
+type MaintWindow_UpdateParams struct {
+	ID             uuid.UUID
+	Description    string
+	StartTime      time.Time
+	EndTime        time.Time
+	WeekdayFilter  timeutil.WeekdayFilter
+	TimeZone       string
+	SuppressAlerts bool
+}
+
+func (q *Queries) MaintWindow_Update(ctx context.Context, arg MaintWindow_UpdateParams) error {
+	_, err := q.db.ExecContext(ctx, maintWindow_Update,
+		arg.ID,
+		arg.Description,
+		arg.StartTime,
+		arg.EndTime,
+		arg.WeekdayFilter,
+		arg.TimeZone,
+		arg.SuppressAlerts,
+	)
+	return err
+}
+
+const messageMgrGetPending = `-- name: MessageMgrGetPending :many
+SELECT
+    msg.id,
+    msg.message_type,
+    cm.id AS cm_id,
+    chan.id AS chan_id,
+    coalesce(cm.dest, chan.dest) AS dest,
+    msg.alert_id,
+    msg.alert_log_id,
+    msg.user_verification_code_id,
+    cm.user_id,
+    msg.service_id,
+    msg.created_at,
+    msg.sent_at,
+    msg.status_alert_ids,
+    msg.schedule_id
+FROM
+    outgoing_messages msg
+    LEFT JOIN user_contact_methods cm ON cm.id = msg.contact_method_id
+    LEFT JOIN notification_channels chan ON chan.id = msg.channel_id
+WHERE
+    sent_at >= $1
+    OR last_status = 'pending'
+    AND (msg.contact_method_id ISNULL
+        OR msg.message_type = 'verification_message'
+        OR NOT cm.disabled)
+`
+
+type MessageMgrGetPendingRow struct {
+	ID                     uuid.UUID
+	MessageType            EnumOutgoingMessagesType
+	CmID                   uuid.NullUUID
+	ChanID                 uuid.NullUUID
+	Dest                   NullDestV1
+	AlertID                sql.NullInt64
+	AlertLogID             sql.NullInt64
+	UserVerificationCodeID uuid.NullUUID
+	UserID                 uuid.NullUUID
+	ServiceID              uuid.NullUUID
+	CreatedAt              time.Time
+	SentAt                 sql.NullTime
 	StatusAlertIds         []int64
 	ScheduleID             uuid.NullUUID
 }
@@ -4011,7 +5053,7 @@ func (q *Queries) MessageMgrGetPending(ctx context.Context, sentAt sql.NullTime)
 
 const nfyLastMessageStatus = `-- name: NfyLastMessageStatus :one
 SELECT
-    om.alert_id, om.alert_log_id, om.channel_id, om.contact_method_id, om.created_at, om.cycle_id, om.escalation_policy_id, om.fired_at, om.id, om.last_status, om.last_status_at, om.message_type, om.next_retry_at, om.provider_msg_id, om.provider_seq, om.retry_count, om.schedule_id, om.sending_deadline, om.sent_at, om.service_id, om.src_value, om.status_alert_ids, om.status_details, om.user_id, om.user_verification_code_id,
+    om.alert_id, om.alert_log_id, om.channel_id, om.contact_method_id, om.created_at, om.cycle_id, om.escalation_policy_id, om.fired_at, om.id, om.last_status, om.last_status_at, om.message_type, om.next_retry_at, om.provider_msg_id, om.provider_seq, om.retry_count, om.schedule_id, om.sending_deadline, om.sent_at, om.service_id, om.shift_swap_request_id, om.src_value, om.status_alert_ids, om.status_details, om.user_id, om.user_verification_code_id,
     cm.dest AS cm_dest,
     ch.dest AS ch_dest
 FROM
@@ -4060,6 +5102,7 @@ func (q *Queries) NfyLastMessageStatus(ctx context.Context, arg NfyLastMessageSt
 		&i.OutgoingMessage.SendingDeadline,
 		&i.OutgoingMessage.SentAt,
 		&i.OutgoingMessage.ServiceID,
+		&i.OutgoingMessage.ShiftSwapRequestID,
 		&i.OutgoingMessage.SrcValue,
 		pq.Array(&i.OutgoingMessage.StatusAlertIds),
 		&i.OutgoingMessage.StatusDetails,
@@ -4073,7 +5116,7 @@ func (q *Queries) NfyLastMessageStatus(ctx context.Context, arg NfyLastMessageSt
 
 const nfyManyMessageStatus = `-- name: NfyManyMessageStatus :many
 SELECT
-    om.alert_id, om.alert_log_id, om.channel_id, om.contact_method_id, om.created_at, om.cycle_id, om.escalation_policy_id, om.fired_at, om.id, om.last_status, om.last_status_at, om.message_type, om.next_retry_at, om.provider_msg_id, om.provider_seq, om.retry_count, om.schedule_id, om.sending_deadline, om.sent_at, om.service_id, om.src_value, om.status_alert_ids, om.status_details, om.user_id, om.user_verification_code_id,
+    om.alert_id, om.alert_log_id, om.channel_id, om.contact_method_id, om.created_at, om.cycle_id, om.escalation_policy_id, om.fired_at, om.id, om.last_status, om.last_status_at, om.message_type, om.next_retry_at, om.provider_msg_id, om.provider_seq, om.retry_count, om.schedule_id, om.sending_deadline, om.sent_at, om.service_id, om.shift_swap_request_id, om.src_value, om.status_alert_ids, om.status_details, om.user_id, om.user_verification_code_id,
     cm.dest AS cm_dest,
     ch.dest AS ch_dest
 FROM
@@ -4120,6 +5163,7 @@ func (q *Queries) NfyManyMessageStatus(ctx context.Context, dollar_1 []uuid.UUID
 			&i.OutgoingMessage.SendingDeadline,
 			&i.OutgoingMessage.SentAt,
 			&i.OutgoingMessage.ServiceID,
+			&i.OutgoingMessage.ShiftSwapRequestID,
 			&i.OutgoingMessage.SrcValue,
 			pq.Array(&i.OutgoingMessage.StatusAlertIds),
 			&i.OutgoingMessage.StatusDetails,
@@ -4143,7 +5187,7 @@ func (q *Queries) NfyManyMessageStatus(ctx context.Context, dollar_1 []uuid.UUID
 
 const nfyOriginalMessageStatus = `-- name: NfyOriginalMessageStatus :one
 SELECT
-    om.alert_id, om.alert_log_id, om.channel_id, om.contact_method_id, om.created_at, om.cycle_id, om.escalation_policy_id, om.fired_at, om.id, om.last_status, om.last_status_at, om.message_type, om.next_retry_at, om.provider_msg_id, om.provider_seq, om.retry_count, om.schedule_id, om.sending_deadline, om.sent_at, om.service_id, om.src_value, om.status_alert_ids, om.status_details, om.user_id, om.user_verification_code_id,
+    om.alert_id, om.alert_log_id, om.channel_id, om.contact_method_id, om.created_at, om.cycle_id, om.escalation_policy_id, om.fired_at, om.id, om.last_status, om.last_status_at, om.message_type, om.next_retry_at, om.provider_msg_id, om.provider_seq, om.retry_count, om.schedule_id, om.sending_deadline, om.sent_at, om.service_id, om.shift_swap_request_id, om.src_value, om.status_alert_ids, om.status_details, om.user_id, om.user_verification_code_id,
     cm.dest AS cm_dest,
     ch.dest AS ch_dest
 FROM
@@ -4196,6 +5240,7 @@ func (q *Queries) NfyOriginalMessageStatus(ctx context.Context, arg NfyOriginalM
 		&i.OutgoingMessage.SendingDeadline,
 		&i.OutgoingMessage.SentAt,
 		&i.OutgoingMessage.ServiceID,
+		&i.OutgoingMessage.ShiftSwapRequestID,
 		&i.OutgoingMessage.SrcValue,
 		pq.Array(&i.OutgoingMessage.StatusAlertIds),
 		&i.OutgoingMessage.StatusDetails,
@@ -4609,7 +5654,7 @@ func (q *Queries) RotMgrEnd(ctx context.Context, rotationID uuid.UUID) error {
 const rotMgrRotationData = `-- name: RotMgrRotationData :one
 SELECT
     now()::timestamptz AS now,
-    rot.description, rot.id, rot.last_processed, rot.name, rot.participant_count, rot.shift_length, rot.start_time, rot.time_zone, rot.type,
+    rot.description, rot.id, rot.last_processed, rot.name, rot.participant_count, rot.regions, rot.shift_length, rot.start_time, rot.team_id, rot.time_zone, rot.type,
     coalesce(state.version, 0) AS state_version,
     coalesce(state.position, 0) AS state_position,
     state.shift_start AS state_shift_start,
@@ -4621,7 +5666,16 @@ SELECT
         WHERE
             p.rotation_id = rot.id
         ORDER BY
-            position)::uuid[] AS participants
+            position)::uuid[] AS participants,
+    ARRAY (
+        SELECT
+            p.user_id
+        FROM
+            rotation_participants p
+        WHERE
+            p.rotation_id = rot.id
+        ORDER BY
+            position)::uuid[] AS participant_user_ids
     FROM
         rotations rot
     LEFT JOIN rotation_state state ON rot.id = state.rotation_id
@@ -4630,12 +5684,13 @@ WHERE
 `
 
 type RotMgrRotationDataRow struct {
-	Now             time.Time
-	Rotation        Rotation
-	StateVersion    int32
-	StatePosition   int32
-	StateShiftStart sql.NullTime
-	Participants    []uuid.UUID
+	Now                time.Time
+	Rotation           Rotation
+	StateVersion       int32
+	StatePosition      int32
+	StateShiftStart    sql.NullTime
+	Participants       []uuid.UUID
+	ParticipantUserIds []uuid.UUID
 }
 
 // Get rotation data for a given rotation ID
@@ -4649,14 +5704,17 @@ func (q *Queries) RotMgrRotationData(ctx context.Context, rotationID uuid.UUID)
 		&i.Rotation.LastProcessed,
 		&i.Rotation.Name,
 		&i.Rotation.ParticipantCount,
+		&i.Rotation.Regions,
 		&i.Rotation.ShiftLength,
 		&i.Rotation.StartTime,
+		&i.Rotation.TeamID,
 		&i.Rotation.TimeZone,
 		&i.Rotation.Type,
 		&i.StateVersion,
 		&i.StatePosition,
 		&i.StateShiftStart,
 		pq.Array(&i.Participants),
+		pq.Array(&i.ParticipantUserIds),
 	)
 	return i, err
 }
@@ -4709,93 +5767,207 @@ func (q *Queries) RotMgrUpdate(ctx context.Context, arg RotMgrUpdateParams) erro
 	return err
 }
 
-const sWOConnLock = `-- name: SWOConnLock :one
-WITH LOCK AS (
-    SELECT
-        pg_advisory_lock_shared(4369))
-SELECT
-    current_state = 'use_next_db'
-FROM
-    LOCK,
-    switchover_state
+const routingRule_DeleteAllByService = `-- name: RoutingRule_DeleteAllByService :exec
+DELETE FROM service_routing_rules
+WHERE service_id = $1
 `
 
-func (q *Queries) SWOConnLock(ctx context.Context) (bool, error) {
-	row := q.db.QueryRowContext(ctx, sWOConnLock)
-	var column_1 bool
-	err := row.Scan(&column_1)
-	return column_1, err
+func (q *Queries) RoutingRule_DeleteAllByService(ctx context.Context, serviceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, routingRule_DeleteAllByService, serviceID)
+	return err
 }
 
-const sWOConnUnlockAll = `-- name: SWOConnUnlockAll :exec
+const routingRule_FindAllByService = `-- name: RoutingRule_FindAllByService :many
 SELECT
-    pg_advisory_unlock_all()
+    condition_expr, drop_alert, escalation_policy_id, id, name, position, priority, service_id
+FROM
+    service_routing_rules
+WHERE
+    service_id = $1
+ORDER BY
+    position
 `
 
-func (q *Queries) SWOConnUnlockAll(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, sWOConnUnlockAll)
-	return err
+func (q *Queries) RoutingRule_FindAllByService(ctx context.Context, serviceID uuid.UUID) ([]ServiceRoutingRule, error) {
+	rows, err := q.db.QueryContext(ctx, routingRule_FindAllByService, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceRoutingRule
+	for rows.Next() {
+		var i ServiceRoutingRule
+		if err := rows.Scan(
+			&i.ConditionExpr,
+			&i.DropAlert,
+			&i.EscalationPolicyID,
+			&i.ID,
+			&i.Name,
+			&i.Position,
+			&i.Priority,
+			&i.ServiceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const schedCreate = `-- name: SchedCreate :one
-INSERT INTO schedules (id, name, description, time_zone)
-VALUES (DEFAULT, $1, $2, $3)
-RETURNING id
+const routingRule_Insert = `-- name: RoutingRule_Insert :exec
+INSERT INTO service_routing_rules(id, service_id, position, name, condition_expr, escalation_policy_id, priority, drop_alert)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
-type SchedCreateParams struct {
-	Name        string
-	Description string
-	TimeZone    string
+type RoutingRule_InsertParams struct {
+	ID                 uuid.UUID
+	ServiceID          uuid.UUID
+	Position           int32
+	Name               string
+	ConditionExpr      string
+	EscalationPolicyID uuid.NullUUID
+	Priority           string
+	DropAlert          bool
 }
 
-// Creates a new schedule and returns its ID.
-func (q *Queries) SchedCreate(ctx context.Context, arg SchedCreateParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, schedCreate, arg.Name, arg.Description, arg.TimeZone)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) RoutingRule_Insert(ctx context.Context, arg RoutingRule_InsertParams) error {
+	_, err := q.db.ExecContext(ctx, routingRule_Insert,
+		arg.ID,
+		arg.ServiceID,
+		arg.Position,
+		arg.Name,
+		arg.ConditionExpr,
+		arg.EscalationPolicyID,
+		arg.Priority,
+		arg.DropAlert,
+	)
+	return err
 }
 
-const schedDeleteMany = `-- name: SchedDeleteMany :exec
-DELETE FROM schedules
-WHERE id = ANY($1::uuid[])
+const sCIMAuthSubjectDelete = `-- name: SCIMAuthSubjectDelete :exec
+DELETE FROM auth_subjects
+WHERE provider_id = $1
+    AND subject_id = $2
+    AND user_id = $3
 `
 
-// Deletes multiple schedules by their IDs.
-func (q *Queries) SchedDeleteMany(ctx context.Context, dollar_1 []uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, schedDeleteMany, pq.Array(dollar_1))
+type SCIMAuthSubjectDeleteParams struct {
+	ProviderID string
+	SubjectID  string
+	UserID     uuid.UUID
+}
+
+func (q *Queries) SCIMAuthSubjectDelete(ctx context.Context, arg SCIMAuthSubjectDeleteParams) error {
+	_, err := q.db.ExecContext(ctx, sCIMAuthSubjectDelete, arg.ProviderID, arg.SubjectID, arg.UserID)
 	return err
 }
 
-const schedFindAll = `-- name: SchedFindAll :many
-SELECT id, name, description, time_zone
-FROM schedules
+const sCIMAuthSubjectInsert = `-- name: SCIMAuthSubjectInsert :exec
+INSERT INTO auth_subjects(provider_id, subject_id, user_id)
+    VALUES ($1, $2, $3)
+ON CONFLICT (provider_id, subject_id)
+    DO NOTHING
 `
 
-type SchedFindAllRow struct {
-	ID          uuid.UUID
-	Name        string
-	Description string
-	TimeZone    string
+type SCIMAuthSubjectInsertParams struct {
+	ProviderID string
+	SubjectID  string
+	UserID     uuid.UUID
 }
 
-// Returns all schedules.
-func (q *Queries) SchedFindAll(ctx context.Context) ([]SchedFindAllRow, error) {
-	rows, err := q.db.QueryContext(ctx, schedFindAll)
+func (q *Queries) SCIMAuthSubjectInsert(ctx context.Context, arg SCIMAuthSubjectInsertParams) error {
+	_, err := q.db.ExecContext(ctx, sCIMAuthSubjectInsert, arg.ProviderID, arg.SubjectID, arg.UserID)
+	return err
+}
+
+const sCIMGroupCount = `-- name: SCIMGroupCount :one
+SELECT
+    count(*)
+FROM
+    scim_groups g
+    JOIN rotations r ON r.id = g.rotation_id
+WHERE ($1::text IS NULL
+    OR lower(r.name) = lower($1))
+AND ($2::text IS NULL
+    OR g.external_id = $2)
+`
+
+type SCIMGroupCountParams struct {
+	DisplayName sql.NullString
+	ExternalID  sql.NullString
+}
+
+// Count rotations linked to SCIM groups, with the same filters as SCIMGroupFindMany.
+func (q *Queries) SCIMGroupCount(ctx context.Context, arg SCIMGroupCountParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sCIMGroupCount, arg.DisplayName, arg.ExternalID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const sCIMGroupDelete = `-- name: SCIMGroupDelete :exec
+DELETE FROM scim_groups
+WHERE rotation_id = $1
+`
+
+func (q *Queries) SCIMGroupDelete(ctx context.Context, rotationID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, sCIMGroupDelete, rotationID)
+	return err
+}
+
+const sCIMGroupFindMany = `-- name: SCIMGroupFindMany :many
+SELECT
+    r.id,
+    r.name,
+    g.external_id
+FROM
+    scim_groups g
+    JOIN rotations r ON r.id = g.rotation_id
+WHERE ($1::text IS NULL
+    OR lower(r.name) = lower($1))
+AND ($2::text IS NULL
+    OR g.external_id = $2)
+ORDER BY
+    lower(r.name)
+OFFSET $3
+LIMIT $4
+`
+
+type SCIMGroupFindManyParams struct {
+	DisplayName sql.NullString
+	ExternalID  sql.NullString
+	Skip        int32
+	Max         int32
+}
+
+type SCIMGroupFindManyRow struct {
+	ID         uuid.UUID
+	Name       string
+	ExternalID sql.NullString
+}
+
+// Get rotations linked to SCIM groups, optionally filtered by displayName or externalId.
+func (q *Queries) SCIMGroupFindMany(ctx context.Context, arg SCIMGroupFindManyParams) ([]SCIMGroupFindManyRow, error) {
+	rows, err := q.db.QueryContext(ctx, sCIMGroupFindMany,
+		arg.DisplayName,
+		arg.ExternalID,
+		arg.Skip,
+		arg.Max,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SchedFindAllRow
+	var items []SCIMGroupFindManyRow
 	for rows.Next() {
-		var i SchedFindAllRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Description,
-			&i.TimeZone,
-		); err != nil {
+		var i SCIMGroupFindManyRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.ExternalID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -4809,83 +5981,261 @@ func (q *Queries) SchedFindAll(ctx context.Context) ([]SchedFindAllRow, error) {
 	return items, nil
 }
 
-const schedFindData = `-- name: SchedFindData :one
+const sCIMGroupFindOne = `-- name: SCIMGroupFindOne :one
 SELECT
-    data
+    r.id,
+    r.name,
+    g.external_id
 FROM
-    schedule_data
+    scim_groups g
+    JOIN rotations r ON r.id = g.rotation_id
 WHERE
-    schedule_id = $1
+    g.rotation_id = $1
 `
 
-// Returns the schedule data for a given schedule ID.
-func (q *Queries) SchedFindData(ctx context.Context, scheduleID uuid.UUID) (json.RawMessage, error) {
-	row := q.db.QueryRowContext(ctx, schedFindData, scheduleID)
-	var data json.RawMessage
-	err := row.Scan(&data)
-	return data, err
+type SCIMGroupFindOneRow struct {
+	ID         uuid.UUID
+	Name       string
+	ExternalID sql.NullString
 }
 
-const schedFindDataForUpdate = `-- name: SchedFindDataForUpdate :one
+func (q *Queries) SCIMGroupFindOne(ctx context.Context, rotationID uuid.UUID) (SCIMGroupFindOneRow, error) {
+	row := q.db.QueryRowContext(ctx, sCIMGroupFindOne, rotationID)
+	var i SCIMGroupFindOneRow
+	err := row.Scan(&i.ID, &i.Name, &i.ExternalID)
+	return i, err
+}
+
+const sCIMGroupInsert = `-- name: SCIMGroupInsert :exec
+INSERT INTO scim_groups(rotation_id, external_id)
+    VALUES ($1, $2)
+`
+
+type SCIMGroupInsertParams struct {
+	RotationID uuid.UUID
+	ExternalID sql.NullString
+}
+
+func (q *Queries) SCIMGroupInsert(ctx context.Context, arg SCIMGroupInsertParams) error {
+	_, err := q.db.ExecContext(ctx, sCIMGroupInsert, arg.RotationID, arg.ExternalID)
+	return err
+}
+
+const sCIMGroupMembers = `-- name: SCIMGroupMembers :many
 SELECT
-    data
+    p.rotation_id,
+    p.user_id,
+    u.name
+FROM (
+    SELECT DISTINCT ON (rp.rotation_id, rp.user_id)
+        rp.rotation_id,
+        rp.user_id,
+        rp.position
+    FROM
+        rotation_participants rp
+    WHERE
+        rp.rotation_id = ANY ($1::uuid[])
+    ORDER BY
+        rp.rotation_id,
+        rp.user_id,
+        rp.position) p
+    JOIN users u ON u.id = p.user_id
+ORDER BY
+    p.rotation_id,
+    p.position
+`
+
+type SCIMGroupMembersRow struct {
+	RotationID uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+}
+
+// Get the distinct users of each rotation, in participant order.
+func (q *Queries) SCIMGroupMembers(ctx context.Context, rotationIds []uuid.UUID) ([]SCIMGroupMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, sCIMGroupMembers, pq.Array(rotationIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SCIMGroupMembersRow
+	for rows.Next() {
+		var i SCIMGroupMembersRow
+		if err := rows.Scan(&i.RotationID, &i.UserID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sCIMGroupUpdate = `-- name: SCIMGroupUpdate :exec
+UPDATE
+    scim_groups
+SET
+    external_id = $2
+WHERE
+    rotation_id = $1
+`
+
+type SCIMGroupUpdateParams struct {
+	RotationID uuid.UUID
+	ExternalID sql.NullString
+}
+
+func (q *Queries) SCIMGroupUpdate(ctx context.Context, arg SCIMGroupUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, sCIMGroupUpdate, arg.RotationID, arg.ExternalID)
+	return err
+}
+
+const sCIMRotationFindByName = `-- name: SCIMRotationFindByName :one
+SELECT
+    id
 FROM
-    schedule_data
+    rotations
 WHERE
-    schedule_id = $1
-FOR UPDATE
+    lower(name) = lower($1)
 `
 
-// Returns the schedule data for a given schedule ID with FOR UPDATE lock.
-func (q *Queries) SchedFindDataForUpdate(ctx context.Context, scheduleID uuid.UUID) (json.RawMessage, error) {
-	row := q.db.QueryRowContext(ctx, schedFindDataForUpdate, scheduleID)
-	var data json.RawMessage
-	err := row.Scan(&data)
-	return data, err
+func (q *Queries) SCIMRotationFindByName(ctx context.Context, name string) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, sCIMRotationFindByName, name)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
 }
 
-const schedFindMany = `-- name: SchedFindMany :many
+const sCIMUnlinkedUserBySubject = `-- name: SCIMUnlinkedUserBySubject :one
 SELECT
-    s.id,
-    s.name,
-    s.description,
-    s.time_zone,
-    fav IS DISTINCT FROM NULL as is_favorite
-FROM schedules s
-LEFT JOIN user_favorites fav ON
-    fav.tgt_schedule_id = s.id AND fav.user_id = $2
-WHERE s.id = ANY($1::uuid[])
+    a.user_id
+FROM
+    auth_subjects a
+WHERE
+    a.provider_id = $1
+    AND a.subject_id = $2
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            scim_users s
+        WHERE
+            s.user_id = a.user_id)
 `
 
-type SchedFindManyParams struct {
-	Column1 []uuid.UUID
-	UserID  uuid.UUID
+type SCIMUnlinkedUserBySubjectParams struct {
+	ProviderID string
+	SubjectID  string
 }
 
-type SchedFindManyRow struct {
-	ID          uuid.UUID
-	Name        string
-	Description string
-	TimeZone    string
-	IsFavorite  bool
+// Find an existing user, not yet provisioned by SCIM, by their login subject.
+func (q *Queries) SCIMUnlinkedUserBySubject(ctx context.Context, arg SCIMUnlinkedUserBySubjectParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, sCIMUnlinkedUserBySubject, arg.ProviderID, arg.SubjectID)
+	var user_id uuid.UUID
+	err := row.Scan(&user_id)
+	return user_id, err
 }
 
-// Returns multiple schedules with user favorite status.
-func (q *Queries) SchedFindMany(ctx context.Context, arg SchedFindManyParams) ([]SchedFindManyRow, error) {
-	rows, err := q.db.QueryContext(ctx, schedFindMany, pq.Array(arg.Column1), arg.UserID)
+const sCIMUserCount = `-- name: SCIMUserCount :one
+SELECT
+    count(*)
+FROM
+    scim_users s
+WHERE ($1::text IS NULL
+    OR lower(s.user_name) = lower($1))
+AND ($2::text IS NULL
+    OR s.external_id = $2)
+`
+
+type SCIMUserCountParams struct {
+	UserName   sql.NullString
+	ExternalID sql.NullString
+}
+
+// Count SCIM provisioned users, with the same filters as SCIMUserFindMany.
+func (q *Queries) SCIMUserCount(ctx context.Context, arg SCIMUserCountParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sCIMUserCount, arg.UserName, arg.ExternalID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const sCIMUserDelete = `-- name: SCIMUserDelete :exec
+DELETE FROM scim_users
+WHERE user_id = $1
+`
+
+func (q *Queries) SCIMUserDelete(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, sCIMUserDelete, userID)
+	return err
+}
+
+const sCIMUserFindMany = `-- name: SCIMUserFindMany :many
+SELECT
+    u.id,
+    u.name,
+    u.email,
+    u.role,
+    u.deactivated_at,
+    s.user_name,
+    s.external_id
+FROM
+    scim_users s
+    JOIN users u ON u.id = s.user_id
+WHERE ($1::text IS NULL
+    OR lower(s.user_name) = lower($1))
+AND ($2::text IS NULL
+    OR s.external_id = $2)
+ORDER BY
+    lower(s.user_name)
+OFFSET $3
+LIMIT $4
+`
+
+type SCIMUserFindManyParams struct {
+	UserName   sql.NullString
+	ExternalID sql.NullString
+	Skip       int32
+	Max        int32
+}
+
+type SCIMUserFindManyRow struct {
+	ID            uuid.UUID
+	Name          string
+	Email         string
+	Role          EnumUserRole
+	DeactivatedAt sql.NullTime
+	UserName      string
+	ExternalID    sql.NullString
+}
+
+// Get SCIM provisioned users, optionally filtered by userName or externalId.
+func (q *Queries) SCIMUserFindMany(ctx context.Context, arg SCIMUserFindManyParams) ([]SCIMUserFindManyRow, error) {
+	rows, err := q.db.QueryContext(ctx, sCIMUserFindMany,
+		arg.UserName,
+		arg.ExternalID,
+		arg.Skip,
+		arg.Max,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SchedFindManyRow
+	var items []SCIMUserFindManyRow
 	for rows.Next() {
-		var i SchedFindManyRow
+		var i SCIMUserFindManyRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
-			&i.Description,
-			&i.TimeZone,
-			&i.IsFavorite,
+			&i.Email,
+			&i.Role,
+			&i.DeactivatedAt,
+			&i.UserName,
+			&i.ExternalID,
 		); err != nil {
 			return nil, err
 		}
@@ -4900,110 +6250,1383 @@ func (q *Queries) SchedFindMany(ctx context.Context, arg SchedFindManyParams) ([
 	return items, nil
 }
 
-const schedFindOne = `-- name: SchedFindOne :one
+const sCIMUserFindOne = `-- name: SCIMUserFindOne :one
 SELECT
-    s.id,
-    s.name,
-    s.description,
-    s.time_zone,
-    fav IS DISTINCT FROM NULL as is_favorite
-FROM schedules s
-LEFT JOIN user_favorites fav ON
-    fav.tgt_schedule_id = s.id AND fav.user_id = $2
-WHERE s.id = $1
+    u.id,
+    u.name,
+    u.email,
+    u.role,
+    u.deactivated_at,
+    s.user_name,
+    s.external_id
+FROM
+    scim_users s
+    JOIN users u ON u.id = s.user_id
+WHERE
+    s.user_id = $1
 `
 
-type SchedFindOneParams struct {
-	ID     uuid.UUID
-	UserID uuid.UUID
+type SCIMUserFindOneRow struct {
+	ID            uuid.UUID
+	Name          string
+	Email         string
+	Role          EnumUserRole
+	DeactivatedAt sql.NullTime
+	UserName      string
+	ExternalID    sql.NullString
 }
 
-type SchedFindOneRow struct {
-	ID          uuid.UUID
-	Name        string
-	Description string
-	TimeZone    string
-	IsFavorite  bool
+func (q *Queries) SCIMUserFindOne(ctx context.Context, userID uuid.UUID) (SCIMUserFindOneRow, error) {
+	row := q.db.QueryRowContext(ctx, sCIMUserFindOne, userID)
+	var i SCIMUserFindOneRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Role,
+		&i.DeactivatedAt,
+		&i.UserName,
+		&i.ExternalID,
+	)
+	return i, err
 }
 
-// Returns a single schedule with user favorite status.
-func (q *Queries) SchedFindOne(ctx context.Context, arg SchedFindOneParams) (SchedFindOneRow, error) {
-	row := q.db.QueryRowContext(ctx, schedFindOne, arg.ID, arg.UserID)
-	var i SchedFindOneRow
+const sCIMUserFindOneForUpdate = `-- name: SCIMUserFindOneForUpdate :one
+SELECT
+    u.id,
+    u.name,
+    u.email,
+    u.role,
+    u.deactivated_at,
+    s.user_name,
+    s.external_id
+FROM
+    scim_users s
+    JOIN users u ON u.id = s.user_id
+WHERE
+    s.user_id = $1
+FOR UPDATE
+`
+
+type SCIMUserFindOneForUpdateRow struct {
+	ID            uuid.UUID
+	Name          string
+	Email         string
+	Role          EnumUserRole
+	DeactivatedAt sql.NullTime
+	UserName      string
+	ExternalID    sql.NullString
+}
+
+func (q *Queries) SCIMUserFindOneForUpdate(ctx context.Context, userID uuid.UUID) (SCIMUserFindOneForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, sCIMUserFindOneForUpdate, userID)
+	var i SCIMUserFindOneForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Role,
+		&i.DeactivatedAt,
+		&i.UserName,
+		&i.ExternalID,
+	)
+	return i, err
+}
+
+const sCIMUserInsert = `-- name: SCIMUserInsert :exec
+INSERT INTO scim_users(user_id, user_name, external_id)
+    VALUES ($1, $2, $3)
+`
+
+type SCIMUserInsertParams struct {
+	UserID     uuid.UUID
+	UserName   string
+	ExternalID sql.NullString
+}
+
+func (q *Queries) SCIMUserInsert(ctx context.Context, arg SCIMUserInsertParams) error {
+	_, err := q.db.ExecContext(ctx, sCIMUserInsert, arg.UserID, arg.UserName, arg.ExternalID)
+	return err
+}
+
+const sCIMUserUpdate = `-- name: SCIMUserUpdate :exec
+UPDATE
+    scim_users
+SET
+    user_name = $2,
+    external_id = $3
+WHERE
+    user_id = $1
+`
+
+type SCIMUserUpdateParams struct {
+	UserID     uuid.UUID
+	UserName   string
+	ExternalID sql.NullString
+}
+
+func (q *Queries) SCIMUserUpdate(ctx context.Context, arg SCIMUserUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, sCIMUserUpdate, arg.UserID, arg.UserName, arg.ExternalID)
+	return err
+}
+
+const sCIMUsersExist = `-- name: SCIMUsersExist :many
+SELECT
+    id
+FROM
+    users
+WHERE
+    id = ANY ($1::uuid[])
+    AND deactivated_at IS NULL
+`
+
+// Get the IDs of the given users that exist and are not deactivated.
+func (q *Queries) SCIMUsersExist(ctx context.Context, userIds []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, sCIMUsersExist, pq.Array(userIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sWOConnLock = `-- name: SWOConnLock :one
+WITH LOCK AS (
+    SELECT
+        pg_advisory_lock_shared(4369))
+SELECT
+    current_state = 'use_next_db'
+FROM
+    LOCK,
+    switchover_state
+`
+
+func (q *Queries) SWOConnLock(ctx context.Context) (bool, error) {
+	row := q.db.QueryRowContext(ctx, sWOConnLock)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const sWOConnUnlockAll = `-- name: SWOConnUnlockAll :exec
+SELECT
+    pg_advisory_unlock_all()
+`
+
+func (q *Queries) SWOConnUnlockAll(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, sWOConnUnlockAll)
+	return err
+}
+
+const schedCreate = `-- name: SchedCreate :one
+INSERT INTO schedules (id, name, description, time_zone)
+VALUES (DEFAULT, $1, $2, $3)
+RETURNING id
+`
+
+type SchedCreateParams struct {
+	Name        string
+	Description string
+	TimeZone    string
+}
+
+// Creates a new schedule and returns its ID.
+func (q *Queries) SchedCreate(ctx context.Context, arg SchedCreateParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, schedCreate, arg.Name, arg.Description, arg.TimeZone)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const schedDeleteMany = `-- name: SchedDeleteMany :exec
+DELETE FROM schedules
+WHERE id = ANY($1::uuid[])
+`
+
+// Deletes multiple schedules by their IDs.
+func (q *Queries) SchedDeleteMany(ctx context.Context, dollar_1 []uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, schedDeleteMany, pq.Array(dollar_1))
+	return err
+}
+
+const schedFindAll = `-- name: SchedFindAll :many
+SELECT id, name, description, time_zone
+FROM schedules
+`
+
+type SchedFindAllRow struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	TimeZone    string
+}
+
+// Returns all schedules.
+func (q *Queries) SchedFindAll(ctx context.Context) ([]SchedFindAllRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedFindAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedFindAllRow
+	for rows.Next() {
+		var i SchedFindAllRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedFindData = `-- name: SchedFindData :one
+SELECT
+    data
+FROM
+    schedule_data
+WHERE
+    schedule_id = $1
+`
+
+// Returns the schedule data for a given schedule ID.
+func (q *Queries) SchedFindData(ctx context.Context, scheduleID uuid.UUID) (json.RawMessage, error) {
+	row := q.db.QueryRowContext(ctx, schedFindData, scheduleID)
+	var data json.RawMessage
+	err := row.Scan(&data)
+	return data, err
+}
+
+const schedFindDataForUpdate = `-- name: SchedFindDataForUpdate :one
+SELECT
+    data
+FROM
+    schedule_data
+WHERE
+    schedule_id = $1
+FOR UPDATE
+`
+
+// Returns the schedule data for a given schedule ID with FOR UPDATE lock.
+func (q *Queries) SchedFindDataForUpdate(ctx context.Context, scheduleID uuid.UUID) (json.RawMessage, error) {
+	row := q.db.QueryRowContext(ctx, schedFindDataForUpdate, scheduleID)
+	var data json.RawMessage
+	err := row.Scan(&data)
+	return data, err
+}
+
+const schedFindMany = `-- name: SchedFindMany :many
+SELECT
+    s.id,
+    s.name,
+    s.description,
+    s.time_zone,
+    fav IS DISTINCT FROM NULL as is_favorite
+FROM schedules s
+LEFT JOIN user_favorites fav ON
+    fav.tgt_schedule_id = s.id AND fav.user_id = $2
+WHERE s.id = ANY($1::uuid[])
+`
+
+type SchedFindManyParams struct {
+	Column1 []uuid.UUID
+	UserID  uuid.UUID
+}
+
+type SchedFindManyRow struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	TimeZone    string
+	IsFavorite  bool
+}
+
+// Returns multiple schedules with user favorite status.
+func (q *Queries) SchedFindMany(ctx context.Context, arg SchedFindManyParams) ([]SchedFindManyRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedFindMany, pq.Array(arg.Column1), arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedFindManyRow
+	for rows.Next() {
+		var i SchedFindManyRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.TimeZone,
+			&i.IsFavorite,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedFindOne = `-- name: SchedFindOne :one
+SELECT
+    s.id,
+    s.name,
+    s.description,
+    s.time_zone,
+    fav IS DISTINCT FROM NULL as is_favorite
+FROM schedules s
+LEFT JOIN user_favorites fav ON
+    fav.tgt_schedule_id = s.id AND fav.user_id = $2
+WHERE s.id = $1
+`
+
+type SchedFindOneParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+type SchedFindOneRow struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	TimeZone    string
+	IsFavorite  bool
+}
+
+// Returns a single schedule with user favorite status.
+func (q *Queries) SchedFindOne(ctx context.Context, arg SchedFindOneParams) (SchedFindOneRow, error) {
+	row := q.db.QueryRowContext(ctx, schedFindOne, arg.ID, arg.UserID)
+	var i SchedFindOneRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.TimeZone,
+		&i.IsFavorite,
+	)
+	return i, err
+}
+
+const schedFindOneForUpdate = `-- name: SchedFindOneForUpdate :one
+SELECT id, name, description, time_zone
+FROM schedules
+WHERE id = $1
+FOR UPDATE
+`
+
+type SchedFindOneForUpdateRow struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	TimeZone    string
+}
+
+// Returns a single schedule with FOR UPDATE lock.
+func (q *Queries) SchedFindOneForUpdate(ctx context.Context, id uuid.UUID) (SchedFindOneForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, schedFindOneForUpdate, id)
+	var i SchedFindOneForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.TimeZone,
+	)
+	return i, err
+}
+
+const schedInsertData = `-- name: SchedInsertData :exec
+INSERT INTO schedule_data (schedule_id, data)
+VALUES ($1, '{}')
+`
+
+// Inserts empty schedule data for a given schedule ID.
+func (q *Queries) SchedInsertData(ctx context.Context, scheduleID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, schedInsertData, scheduleID)
+	return err
+}
+
+const schedMgrCoverageSchedules = `-- name: SchedMgrCoverageSchedules :many
+SELECT
+    id,
+    name,
+    time_zone
+FROM
+    schedules
+`
+
+type SchedMgrCoverageSchedulesRow struct {
+	ID       uuid.UUID
+	Name     string
+	TimeZone string
+}
+
+// Returns all schedules, to check for gaps in on-call coverage.
+func (q *Queries) SchedMgrCoverageSchedules(ctx context.Context) ([]SchedMgrCoverageSchedulesRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedMgrCoverageSchedules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedMgrCoverageSchedulesRow
+	for rows.Next() {
+		var i SchedMgrCoverageSchedulesRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.TimeZone); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedMgrDataForUpdate = `-- name: SchedMgrDataForUpdate :many
+SELECT
+    schedule_id,
+    data
+FROM
+    schedule_data
+WHERE
+    data NOTNULL
+FOR UPDATE
+`
+
+type SchedMgrDataForUpdateRow struct {
+	ScheduleID uuid.UUID
+	Data       json.RawMessage
+}
+
+func (q *Queries) SchedMgrDataForUpdate(ctx context.Context) ([]SchedMgrDataForUpdateRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedMgrDataForUpdate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedMgrDataForUpdateRow
+	for rows.Next() {
+		var i SchedMgrDataForUpdateRow
+		if err := rows.Scan(&i.ScheduleID, &i.Data); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedMgrDataIDs = `-- name: SchedMgrDataIDs :many
+SELECT
+    schedule_id
+FROM
+    schedule_data
+`
+
+// Returns all schedule IDs that have an entry in the schedule_data table.
+func (q *Queries) SchedMgrDataIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, schedMgrDataIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var schedule_id uuid.UUID
+		if err := rows.Scan(&schedule_id); err != nil {
+			return nil, err
+		}
+		items = append(items, schedule_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedMgrEndOnCall = `-- name: SchedMgrEndOnCall :exec
+UPDATE
+    schedule_on_call_users
+SET
+    end_time = now()
+WHERE
+    schedule_id = $1
+    AND user_id = $2
+    AND end_time ISNULL
+`
+
+type SchedMgrEndOnCallParams struct {
+	ScheduleID uuid.UUID
+	UserID     uuid.UUID
+}
+
+func (q *Queries) SchedMgrEndOnCall(ctx context.Context, arg SchedMgrEndOnCallParams) error {
+	_, err := q.db.ExecContext(ctx, schedMgrEndOnCall, arg.ScheduleID, arg.UserID)
+	return err
+}
+
+const schedMgrGetData = `-- name: SchedMgrGetData :one
+SELECT
+    data
+FROM
+    schedule_data
+WHERE
+    schedule_id = $1
+`
+
+// Returns the data for a single schedule.
+func (q *Queries) SchedMgrGetData(ctx context.Context, scheduleID uuid.UUID) (json.RawMessage, error) {
+	row := q.db.QueryRowContext(ctx, schedMgrGetData, scheduleID)
+	var data json.RawMessage
+	err := row.Scan(&data)
+	return data, err
+}
+
+const schedMgrInsertMessage = `-- name: SchedMgrInsertMessage :exec
+INSERT INTO outgoing_messages(id, message_type, channel_id, schedule_id)
+    VALUES ($1, 'schedule_on_call_notification', $2, $3)
+`
+
+type SchedMgrInsertMessageParams struct {
+	ID         uuid.UUID
+	ChannelID  uuid.NullUUID
+	ScheduleID uuid.NullUUID
+}
+
+func (q *Queries) SchedMgrInsertMessage(ctx context.Context, arg SchedMgrInsertMessageParams) error {
+	_, err := q.db.ExecContext(ctx, schedMgrInsertMessage, arg.ID, arg.ChannelID, arg.ScheduleID)
+	return err
+}
+
+const schedMgrNCDedupMapping = `-- name: SchedMgrNCDedupMapping :many
+SELECT
+    old_id,
+    new_id
+FROM
+    notification_channel_duplicates
+`
+
+type SchedMgrNCDedupMappingRow struct {
+	OldID uuid.UUID
+	NewID uuid.UUID
+}
+
+// Returns the mapping of old notification channel IDs to new notification channel IDs.
+func (q *Queries) SchedMgrNCDedupMapping(ctx context.Context) ([]SchedMgrNCDedupMappingRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedMgrNCDedupMapping)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedMgrNCDedupMappingRow
+	for rows.Next() {
+		var i SchedMgrNCDedupMappingRow
+		if err := rows.Scan(&i.OldID, &i.NewID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedMgrOnCall = `-- name: SchedMgrOnCall :many
+SELECT
+    schedule_id,
+    user_id
+FROM
+    schedule_on_call_users
+WHERE
+    end_time ISNULL
+`
+
+type SchedMgrOnCallRow struct {
+	ScheduleID uuid.UUID
+	UserID     uuid.UUID
+}
+
+func (q *Queries) SchedMgrOnCall(ctx context.Context) ([]SchedMgrOnCallRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedMgrOnCall)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedMgrOnCallRow
+	for rows.Next() {
+		var i SchedMgrOnCallRow
+		if err := rows.Scan(&i.ScheduleID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedMgrOverrides = `-- name: SchedMgrOverrides :many
+SELECT
+    add_user_id,
+    remove_user_id,
+    tgt_schedule_id
+FROM
+    user_overrides
+WHERE
+    now() BETWEEN start_time AND end_time
+`
+
+type SchedMgrOverridesRow struct {
+	AddUserID     uuid.NullUUID
+	RemoveUserID  uuid.NullUUID
+	TgtScheduleID uuid.UUID
+}
+
+func (q *Queries) SchedMgrOverrides(ctx context.Context) ([]SchedMgrOverridesRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedMgrOverrides)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedMgrOverridesRow
+	for rows.Next() {
+		var i SchedMgrOverridesRow
+		if err := rows.Scan(&i.AddUserID, &i.RemoveUserID, &i.TgtScheduleID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedMgrRules = `-- name: SchedMgrRules :many
+SELECT
+    rule.created_at, rule.end_time, rule.friday, rule.id, rule.is_active, rule.monday, rule.saturday, rule.schedule_id, rule.start_time, rule.sunday, rule.tgt_rotation_id, rule.tgt_user_id, rule.thursday, rule.tuesday, rule.wednesday,
+    coalesce(rule.tgt_user_id, part.user_id) AS resolved_user_id
+FROM
+    schedule_rules rule
+    LEFT JOIN rotation_state rState ON rState.rotation_id = rule.tgt_rotation_id
+    LEFT JOIN rotation_participants part ON part.id = rState.rotation_participant_id
+WHERE
+    coalesce(rule.tgt_user_id, part.user_id)
+    NOTNULL
+`
+
+type SchedMgrRulesRow struct {
+	CreatedAt      time.Time
+	EndTime        timeutil.Clock
+	Friday         bool
+	ID             uuid.UUID
+	IsActive       bool
+	Monday         bool
+	Saturday       bool
+	ScheduleID     uuid.UUID
+	StartTime      timeutil.Clock
+	Sunday         bool
+	TgtRotationID  uuid.NullUUID
+	TgtUserID      uuid.NullUUID
+	Thursday       bool
+	Tuesday        bool
+	Wednesday      bool
+	ResolvedUserID uuid.UUID
+}
+
+func (q *Queries) SchedMgrRules(ctx context.Context) ([]SchedMgrRulesRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedMgrRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedMgrRulesRow
+	for rows.Next() {
+		var i SchedMgrRulesRow
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.EndTime,
+			&i.Friday,
+			&i.ID,
+			&i.IsActive,
+			&i.Monday,
+			&i.Saturday,
+			&i.ScheduleID,
+			&i.StartTime,
+			&i.Sunday,
+			&i.TgtRotationID,
+			&i.TgtUserID,
+			&i.Thursday,
+			&i.Tuesday,
+			&i.Wednesday,
+			&i.ResolvedUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedMgrSetData = `-- name: SchedMgrSetData :exec
+UPDATE
+    schedule_data
+SET
+    data = $2
+WHERE
+    schedule_id = $1
+`
+
+type SchedMgrSetDataParams struct {
+	ScheduleID uuid.UUID
+	Data       json.RawMessage
+}
+
+func (q *Queries) SchedMgrSetData(ctx context.Context, arg SchedMgrSetDataParams) error {
+	_, err := q.db.ExecContext(ctx, schedMgrSetData, arg.ScheduleID, arg.Data)
+	return err
+}
+
+const schedMgrSetDataV1Rules = `-- name: SchedMgrSetDataV1Rules :exec
+UPDATE
+    schedule_data
+SET
+    data = jsonb_set(data, '{V1,OnCallNotificationRules}', $2)
+WHERE
+    schedule_id = $1
+`
+
+type SchedMgrSetDataV1RulesParams struct {
+	ScheduleID  uuid.UUID
+	Replacement json.RawMessage
+}
+
+// Sets the .V1.OnCallNotificationRules for a schedule.
+func (q *Queries) SchedMgrSetDataV1Rules(ctx context.Context, arg SchedMgrSetDataV1RulesParams) error {
+	_, err := q.db.ExecContext(ctx, schedMgrSetDataV1Rules, arg.ScheduleID, arg.Replacement)
+	return err
+}
+
+const schedMgrStartOnCall = `-- name: SchedMgrStartOnCall :exec
+INSERT INTO schedule_on_call_users(schedule_id, start_time, user_id)
+SELECT
+    $1,
+    now(),
+    $2
+FROM
+    users
+WHERE
+    id = $2
+`
+
+type SchedMgrStartOnCallParams struct {
+	ScheduleID uuid.UUID
+	UserID     uuid.UUID
+}
+
+func (q *Queries) SchedMgrStartOnCall(ctx context.Context, arg SchedMgrStartOnCallParams) error {
+	_, err := q.db.ExecContext(ctx, schedMgrStartOnCall, arg.ScheduleID, arg.UserID)
+	return err
+}
+
+const schedMgrTimezones = `-- name: SchedMgrTimezones :many
+SELECT
+    id,
+    time_zone
+FROM
+    schedules
+`
+
+type SchedMgrTimezonesRow struct {
+	ID       uuid.UUID
+	TimeZone string
+}
+
+func (q *Queries) SchedMgrTimezones(ctx context.Context) ([]SchedMgrTimezonesRow, error) {
+	rows, err := q.db.QueryContext(ctx, schedMgrTimezones)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SchedMgrTimezonesRow
+	for rows.Next() {
+		var i SchedMgrTimezonesRow
+		if err := rows.Scan(&i.ID, &i.TimeZone); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const schedUpdate = `-- name: SchedUpdate :exec
+UPDATE schedules
+SET name = $2, description = $3, time_zone = $4
+WHERE id = $1
+`
+
+type SchedUpdateParams struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	TimeZone    string
+}
+
+// Updates an existing schedule.
+func (q *Queries) SchedUpdate(ctx context.Context, arg SchedUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, schedUpdate,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.TimeZone,
+	)
+	return err
+}
+
+const schedUpdateData = `-- name: SchedUpdateData :exec
+UPDATE schedule_data
+SET data = $2
+WHERE schedule_id = $1
+`
+
+type SchedUpdateDataParams struct {
+	ScheduleID uuid.UUID
+	Data       json.RawMessage
+}
+
+// Updates the schedule data for a given schedule ID.
+func (q *Queries) SchedUpdateData(ctx context.Context, arg SchedUpdateDataParams) error {
+	_, err := q.db.ExecContext(ctx, schedUpdateData, arg.ScheduleID, arg.Data)
+	return err
+}
+
+const scheduleFindManyByUser = `-- name: ScheduleFindManyByUser :many
+SELECT
+    description, id, last_processed, name, team_id, time_zone
+FROM
+    schedules
+WHERE
+    id = ANY (
+        SELECT
+            schedule_id
+        FROM
+            schedule_rules
+        WHERE
+            tgt_user_id = $1
+            OR tgt_rotation_id = ANY (
+                SELECT
+                    rotation_id
+                FROM
+                    rotation_participants
+                WHERE
+                    user_id = $1))
+`
+
+func (q *Queries) ScheduleFindManyByUser(ctx context.Context, tgtUserID uuid.NullUUID) ([]Schedule, error) {
+	rows, err := q.db.QueryContext(ctx, scheduleFindManyByUser, tgtUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Schedule
+	for rows.Next() {
+		var i Schedule
+		if err := rows.Scan(
+			&i.Description,
+			&i.ID,
+			&i.LastProcessed,
+			&i.Name,
+			&i.TeamID,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sequenceNames = `-- name: SequenceNames :many
+SELECT sequence_name::text
+FROM information_schema.sequences
+WHERE sequence_catalog = current_database()
+    AND sequence_schema = 'public'
+    AND sequence_name != 'change_log_id_seq'
+`
+
+func (q *Queries) SequenceNames(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, sequenceNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var sequence_name string
+		if err := rows.Scan(&sequence_name); err != nil {
+			return nil, err
+		}
+		items = append(items, sequence_name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const serviceAlertCounts = `-- name: ServiceAlertCounts :many
+SELECT
+  COUNT(*),
+  status,
+  service_id
+FROM
+  alerts
+WHERE
+  service_id = ANY ($1::uuid[])
+GROUP BY
+  service_id,
+  status
+`
+
+type ServiceAlertCountsRow struct {
+	Count     int64
+	Status    EnumAlertStatus
+	ServiceID uuid.NullUUID
+}
+
+func (q *Queries) ServiceAlertCounts(ctx context.Context, serviceIds []uuid.UUID) ([]ServiceAlertCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, serviceAlertCounts, pq.Array(serviceIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceAlertCountsRow
+	for rows.Next() {
+		var i ServiceAlertCountsRow
+		if err := rows.Scan(&i.Count, &i.Status, &i.ServiceID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const serviceAlertStats = `-- name: ServiceAlertStats :many
+SELECT
+  date_bin($1::interval, closed_at, $2::timestamptz)::timestamptz AS bucket,
+  coalesce(EXTRACT(EPOCH FROM AVG(time_to_ack)), 0)::double precision AS avg_time_to_ack_seconds,
+  coalesce(EXTRACT(EPOCH FROM AVG(time_to_close)), 0)::double precision AS avg_time_to_close_seconds,
+  coalesce(COUNT(*), 0)::bigint AS alert_count,
+  coalesce(SUM(
+      CASE WHEN escalated THEN
+        1
+      ELSE
+        0
+      END), 0)::bigint AS escalated_count,
+  service_id
+FROM
+  alert_metrics
+WHERE
+  service_id = ANY ($3::uuid[])
+  AND (closed_at BETWEEN $4
+    AND $5)
+GROUP BY
+  service_id,
+  bucket
+ORDER BY
+  bucket
+`
+
+type ServiceAlertStatsParams struct {
+	Stride     sqlutil.Interval
+	Origin     time.Time
+	ServiceIds []uuid.UUID
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+type ServiceAlertStatsRow struct {
+	Bucket                time.Time
+	AvgTimeToAckSeconds   float64
+	AvgTimeToCloseSeconds float64
+	AlertCount            int64
+	EscalatedCount        int64
+	ServiceID             uuid.UUID
+}
+
+// ServiceAlertStats returns statistics about alerts for a service.
+func (q *Queries) ServiceAlertStats(ctx context.Context, arg ServiceAlertStatsParams) ([]ServiceAlertStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, serviceAlertStats,
+		arg.Stride,
+		arg.Origin,
+		pq.Array(arg.ServiceIds),
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceAlertStatsRow
+	for rows.Next() {
+		var i ServiceAlertStatsRow
+		if err := rows.Scan(
+			&i.Bucket,
+			&i.AvgTimeToAckSeconds,
+			&i.AvgTimeToCloseSeconds,
+			&i.AlertCount,
+			&i.EscalatedCount,
+			&i.ServiceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const shiftSwapClearMessages = `-- name: ShiftSwapClearMessages :exec
+DELETE FROM outgoing_messages
+WHERE shift_swap_request_id = $1::uuid
+    AND last_status = 'pending'
+`
+
+// Remove unsent request messages once a request is resolved.
+func (q *Queries) ShiftSwapClearMessages(ctx context.Context, requestID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, shiftSwapClearMessages, requestID)
+	return err
+}
+
+const shiftSwapCreate = `-- name: ShiftSwapCreate :one
+INSERT INTO shift_swap_requests(schedule_id, from_user_id, to_user_id, start_time, end_time, trade_start_time, trade_end_time, note)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+    id, created_at
+`
+
+type ShiftSwapCreateParams struct {
+	ScheduleID     uuid.UUID
+	FromUserID     uuid.UUID
+	ToUserID       uuid.UUID
+	StartTime      time.Time
+	EndTime        time.Time
+	TradeStartTime sql.NullTime
+	TradeEndTime   sql.NullTime
+	Note           string
+}
+
+type ShiftSwapCreateRow struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+}
+
+func (q *Queries) ShiftSwapCreate(ctx context.Context, arg ShiftSwapCreateParams) (ShiftSwapCreateRow, error) {
+	row := q.db.QueryRowContext(ctx, shiftSwapCreate,
+		arg.ScheduleID,
+		arg.FromUserID,
+		arg.ToUserID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.TradeStartTime,
+		arg.TradeEndTime,
+		arg.Note,
+	)
+	var i ShiftSwapCreateRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
+const shiftSwapFindManyBySchedule = `-- name: ShiftSwapFindManyBySchedule :many
+SELECT
+    created_at, end_time, from_user_id, id, note, resolved_at, schedule_id, start_time, status, to_user_id, trade_end_time, trade_start_time
+FROM
+    shift_swap_requests
+WHERE
+    schedule_id = $1
+    AND ($2::enum_shift_swap_status[] ISNULL
+        OR status = ANY ($2::enum_shift_swap_status[]))
+ORDER BY
+    created_at DESC,
+    id
+`
+
+type ShiftSwapFindManyByScheduleParams struct {
+	ScheduleID uuid.UUID
+	Statuses   []EnumShiftSwapStatus
+}
+
+func (q *Queries) ShiftSwapFindManyBySchedule(ctx context.Context, arg ShiftSwapFindManyByScheduleParams) ([]ShiftSwapRequest, error) {
+	rows, err := q.db.QueryContext(ctx, shiftSwapFindManyBySchedule, arg.ScheduleID, pq.Array(arg.Statuses))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ShiftSwapRequest
+	for rows.Next() {
+		var i ShiftSwapRequest
+		if err := rows.Scan(
+			&i.CreatedAt,
+			&i.EndTime,
+			&i.FromUserID,
+			&i.ID,
+			&i.Note,
+			&i.ResolvedAt,
+			&i.ScheduleID,
+			&i.StartTime,
+			&i.Status,
+			&i.ToUserID,
+			&i.TradeEndTime,
+			&i.TradeStartTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const shiftSwapFindOne = `-- name: ShiftSwapFindOne :one
+SELECT
+    created_at, end_time, from_user_id, id, note, resolved_at, schedule_id, start_time, status, to_user_id, trade_end_time, trade_start_time
+FROM
+    shift_swap_requests
+WHERE
+    id = $1
+`
+
+func (q *Queries) ShiftSwapFindOne(ctx context.Context, id uuid.UUID) (ShiftSwapRequest, error) {
+	row := q.db.QueryRowContext(ctx, shiftSwapFindOne, id)
+	var i ShiftSwapRequest
 	err := row.Scan(
+		&i.CreatedAt,
+		&i.EndTime,
+		&i.FromUserID,
 		&i.ID,
-		&i.Name,
-		&i.Description,
-		&i.TimeZone,
-		&i.IsFavorite,
+		&i.Note,
+		&i.ResolvedAt,
+		&i.ScheduleID,
+		&i.StartTime,
+		&i.Status,
+		&i.ToUserID,
+		&i.TradeEndTime,
+		&i.TradeStartTime,
 	)
 	return i, err
 }
 
-const schedFindOneForUpdate = `-- name: SchedFindOneForUpdate :one
-SELECT id, name, description, time_zone
-FROM schedules
-WHERE id = $1
+const shiftSwapFindOneForUpdate = `-- name: ShiftSwapFindOneForUpdate :one
+SELECT
+    created_at, end_time, from_user_id, id, note, resolved_at, schedule_id, start_time, status, to_user_id, trade_end_time, trade_start_time
+FROM
+    shift_swap_requests
+WHERE
+    id = $1
 FOR UPDATE
 `
 
-type SchedFindOneForUpdateRow struct {
-	ID          uuid.UUID
-	Name        string
-	Description string
-	TimeZone    string
-}
-
-// Returns a single schedule with FOR UPDATE lock.
-func (q *Queries) SchedFindOneForUpdate(ctx context.Context, id uuid.UUID) (SchedFindOneForUpdateRow, error) {
-	row := q.db.QueryRowContext(ctx, schedFindOneForUpdate, id)
-	var i SchedFindOneForUpdateRow
+func (q *Queries) ShiftSwapFindOneForUpdate(ctx context.Context, id uuid.UUID) (ShiftSwapRequest, error) {
+	row := q.db.QueryRowContext(ctx, shiftSwapFindOneForUpdate, id)
+	var i ShiftSwapRequest
 	err := row.Scan(
+		&i.CreatedAt,
+		&i.EndTime,
+		&i.FromUserID,
 		&i.ID,
-		&i.Name,
-		&i.Description,
-		&i.TimeZone,
+		&i.Note,
+		&i.ResolvedAt,
+		&i.ScheduleID,
+		&i.StartTime,
+		&i.Status,
+		&i.ToUserID,
+		&i.TradeEndTime,
+		&i.TradeStartTime,
 	)
 	return i, err
 }
 
-const schedInsertData = `-- name: SchedInsertData :exec
-INSERT INTO schedule_data (schedule_id, data)
-VALUES ($1, '{}')
+const shiftSwapNotify = `-- name: ShiftSwapNotify :exec
+INSERT INTO outgoing_messages(id, message_type, contact_method_id, user_id, shift_swap_request_id)
+SELECT
+    gen_random_uuid(),
+    'shift_swap_request',
+    cm.id,
+    cm.user_id,
+    $1::uuid
+FROM
+    user_contact_methods cm
+WHERE
+    cm.user_id = $2
+    AND NOT cm.disabled
 `
 
-// Inserts empty schedule data for a given schedule ID.
-func (q *Queries) SchedInsertData(ctx context.Context, scheduleID uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, schedInsertData, scheduleID)
+type ShiftSwapNotifyParams struct {
+	RequestID uuid.UUID
+	UserID    uuid.UUID
+}
+
+// Queue a request message to each enabled contact method of the requested user.
+func (q *Queries) ShiftSwapNotify(ctx context.Context, arg ShiftSwapNotifyParams) error {
+	_, err := q.db.ExecContext(ctx, shiftSwapNotify, arg.RequestID, arg.UserID)
 	return err
 }
 
-const schedMgrDataForUpdate = `-- name: SchedMgrDataForUpdate :many
+const shiftSwapSetStatus = `-- name: ShiftSwapSetStatus :one
+UPDATE
+    shift_swap_requests
+SET
+    status = $2,
+    resolved_at = now()
+WHERE
+    id = $1
+RETURNING
+    resolved_at
+`
+
+type ShiftSwapSetStatusParams struct {
+	ID     uuid.UUID
+	Status EnumShiftSwapStatus
+}
+
+func (q *Queries) ShiftSwapSetStatus(ctx context.Context, arg ShiftSwapSetStatusParams) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, shiftSwapSetStatus, arg.ID, arg.Status)
+	var resolved_at sql.NullTime
+	err := row.Scan(&resolved_at)
+	return resolved_at, err
+}
+
+const signalMgrDeleteStale = `-- name: SignalMgrDeleteStale :exec
+DELETE FROM pending_signals
+WHERE message_id IS NULL
+    AND created_at < NOW() - INTERVAL '1 hour'
+`
+
+// Delete stale pending signals.
+func (q *Queries) SignalMgrDeleteStale(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, signalMgrDeleteStale)
+	return err
+}
+
+const signalMgrGetPending = `-- name: SignalMgrGetPending :many
 SELECT
-    schedule_id,
-    data
+    id,
+    dest_id,
+    service_id
 FROM
-    schedule_data
+    pending_signals
 WHERE
-    data NOTNULL
+    message_id IS NULL
+    AND ($1::uuid IS NULL
+        OR service_id = $1)
 FOR UPDATE
+    SKIP LOCKED
+LIMIT 100
 `
 
-type SchedMgrDataForUpdateRow struct {
-	ScheduleID uuid.UUID
-	Data       json.RawMessage
+type SignalMgrGetPendingRow struct {
+	ID        int32
+	DestID    uuid.UUID
+	ServiceID uuid.UUID
 }
 
-func (q *Queries) SchedMgrDataForUpdate(ctx context.Context) ([]SchedMgrDataForUpdateRow, error) {
-	rows, err := q.db.QueryContext(ctx, schedMgrDataForUpdate)
+// Get a batch of pending signals to process.
+func (q *Queries) SignalMgrGetPending(ctx context.Context, serviceID uuid.NullUUID) ([]SignalMgrGetPendingRow, error) {
+	rows, err := q.db.QueryContext(ctx, signalMgrGetPending, serviceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SchedMgrDataForUpdateRow
+	var items []SignalMgrGetPendingRow
 	for rows.Next() {
-		var i SchedMgrDataForUpdateRow
-		if err := rows.Scan(&i.ScheduleID, &i.Data); err != nil {
+		var i SignalMgrGetPendingRow
+		if err := rows.Scan(&i.ID, &i.DestID, &i.ServiceID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -5017,27 +7640,42 @@ func (q *Queries) SchedMgrDataForUpdate(ctx context.Context) ([]SchedMgrDataForU
 	return items, nil
 }
 
-const schedMgrDataIDs = `-- name: SchedMgrDataIDs :many
+const signalMgrGetScheduled = `-- name: SignalMgrGetScheduled :many
 SELECT
-    schedule_id
+    count(*),
+    service_id,
+    channel_id
 FROM
-    schedule_data
+    outgoing_messages
+WHERE
+    message_type = 'signal_message'
+    AND last_status = 'pending'
+    AND ($1::uuid IS NULL
+        OR service_id = $1)
+GROUP BY
+    service_id,
+    channel_id
 `
 
-// Returns all schedule IDs that have an entry in the schedule_data table.
-func (q *Queries) SchedMgrDataIDs(ctx context.Context) ([]uuid.UUID, error) {
-	rows, err := q.db.QueryContext(ctx, schedMgrDataIDs)
+type SignalMgrGetScheduledRow struct {
+	Count     int64
+	ServiceID uuid.NullUUID
+	ChannelID uuid.NullUUID
+}
+
+func (q *Queries) SignalMgrGetScheduled(ctx context.Context, serviceID uuid.NullUUID) ([]SignalMgrGetScheduledRow, error) {
+	rows, err := q.db.QueryContext(ctx, signalMgrGetScheduled, serviceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []uuid.UUID
+	var items []SignalMgrGetScheduledRow
 	for rows.Next() {
-		var schedule_id uuid.UUID
-		if err := rows.Scan(&schedule_id); err != nil {
+		var i SignalMgrGetScheduledRow
+		if err := rows.Scan(&i.Count, &i.ServiceID, &i.ChannelID); err != nil {
 			return nil, err
 		}
-		items = append(items, schedule_id)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5048,125 +7686,65 @@ func (q *Queries) SchedMgrDataIDs(ctx context.Context) ([]uuid.UUID, error) {
 	return items, nil
 }
 
-const schedMgrEndOnCall = `-- name: SchedMgrEndOnCall :exec
-UPDATE
-    schedule_on_call_users
-SET
-    end_time = now()
-WHERE
-    schedule_id = $1
-    AND user_id = $2
-    AND end_time ISNULL
+const signalMgrInsertMessage = `-- name: SignalMgrInsertMessage :exec
+INSERT INTO outgoing_messages(id, message_type, service_id, channel_id)
+    VALUES ($1, 'signal_message', $2, $3)
 `
 
-type SchedMgrEndOnCallParams struct {
-	ScheduleID uuid.UUID
-	UserID     uuid.UUID
+type SignalMgrInsertMessageParams struct {
+	ID        uuid.UUID
+	ServiceID uuid.NullUUID
+	ChannelID uuid.NullUUID
 }
 
-func (q *Queries) SchedMgrEndOnCall(ctx context.Context, arg SchedMgrEndOnCallParams) error {
-	_, err := q.db.ExecContext(ctx, schedMgrEndOnCall, arg.ScheduleID, arg.UserID)
+// Insert a new message into the outgoing_messages table.
+func (q *Queries) SignalMgrInsertMessage(ctx context.Context, arg SignalMgrInsertMessageParams) error {
+	_, err := q.db.ExecContext(ctx, signalMgrInsertMessage, arg.ID, arg.ServiceID, arg.ChannelID)
 	return err
 }
 
-const schedMgrGetData = `-- name: SchedMgrGetData :one
-SELECT
-    data
-FROM
-    schedule_data
+const signalMgrUpdateSignal = `-- name: SignalMgrUpdateSignal :exec
+UPDATE
+    pending_signals
+SET
+    message_id = $2
 WHERE
-    schedule_id = $1
-`
-
-// Returns the data for a single schedule.
-func (q *Queries) SchedMgrGetData(ctx context.Context, scheduleID uuid.UUID) (json.RawMessage, error) {
-	row := q.db.QueryRowContext(ctx, schedMgrGetData, scheduleID)
-	var data json.RawMessage
-	err := row.Scan(&data)
-	return data, err
-}
-
-const schedMgrInsertMessage = `-- name: SchedMgrInsertMessage :exec
-INSERT INTO outgoing_messages(id, message_type, channel_id, schedule_id)
-    VALUES ($1, 'schedule_on_call_notification', $2, $3)
+    id = $1
 `
 
-type SchedMgrInsertMessageParams struct {
-	ID         uuid.UUID
-	ChannelID  uuid.NullUUID
-	ScheduleID uuid.NullUUID
+type SignalMgrUpdateSignalParams struct {
+	ID        int32
+	MessageID uuid.NullUUID
 }
 
-func (q *Queries) SchedMgrInsertMessage(ctx context.Context, arg SchedMgrInsertMessageParams) error {
-	_, err := q.db.ExecContext(ctx, schedMgrInsertMessage, arg.ID, arg.ChannelID, arg.ScheduleID)
+// Update a pending signal with the message_id.
+func (q *Queries) SignalMgrUpdateSignal(ctx context.Context, arg SignalMgrUpdateSignalParams) error {
+	_, err := q.db.ExecContext(ctx, signalMgrUpdateSignal, arg.ID, arg.MessageID)
 	return err
 }
 
-const schedMgrNCDedupMapping = `-- name: SchedMgrNCDedupMapping :many
-SELECT
-    old_id,
-    new_id
-FROM
-    notification_channel_duplicates
-`
-
-type SchedMgrNCDedupMappingRow struct {
-	OldID uuid.UUID
-	NewID uuid.UUID
-}
-
-// Returns the mapping of old notification channel IDs to new notification channel IDs.
-func (q *Queries) SchedMgrNCDedupMapping(ctx context.Context) ([]SchedMgrNCDedupMappingRow, error) {
-	rows, err := q.db.QueryContext(ctx, schedMgrNCDedupMapping)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []SchedMgrNCDedupMappingRow
-	for rows.Next() {
-		var i SchedMgrNCDedupMappingRow
-		if err := rows.Scan(&i.OldID, &i.NewID); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const schedMgrOnCall = `-- name: SchedMgrOnCall :many
+const stateFile_EscalationPolicyIDs = `-- name: StateFile_EscalationPolicyIDs :many
 SELECT
-    schedule_id,
-    user_id
+    id
 FROM
-    schedule_on_call_users
-WHERE
-    end_time ISNULL
+    escalation_policies
+ORDER BY
+    lower(name)
 `
 
-type SchedMgrOnCallRow struct {
-	ScheduleID uuid.UUID
-	UserID     uuid.UUID
-}
-
-func (q *Queries) SchedMgrOnCall(ctx context.Context) ([]SchedMgrOnCallRow, error) {
-	rows, err := q.db.QueryContext(ctx, schedMgrOnCall)
+func (q *Queries) StateFile_EscalationPolicyIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, stateFile_EscalationPolicyIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SchedMgrOnCallRow
+	var items []uuid.UUID
 	for rows.Next() {
-		var i SchedMgrOnCallRow
-		if err := rows.Scan(&i.ScheduleID, &i.UserID); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5177,36 +7755,28 @@ func (q *Queries) SchedMgrOnCall(ctx context.Context) ([]SchedMgrOnCallRow, erro
 	return items, nil
 }
 
-const schedMgrOverrides = `-- name: SchedMgrOverrides :many
+const stateFile_RotationIDs = `-- name: StateFile_RotationIDs :many
 SELECT
-    add_user_id,
-    remove_user_id,
-    tgt_schedule_id
+    id
 FROM
-    user_overrides
-WHERE
-    now() BETWEEN start_time AND end_time
+    rotations
+ORDER BY
+    lower(name)
 `
 
-type SchedMgrOverridesRow struct {
-	AddUserID     uuid.NullUUID
-	RemoveUserID  uuid.NullUUID
-	TgtScheduleID uuid.UUID
-}
-
-func (q *Queries) SchedMgrOverrides(ctx context.Context) ([]SchedMgrOverridesRow, error) {
-	rows, err := q.db.QueryContext(ctx, schedMgrOverrides)
+func (q *Queries) StateFile_RotationIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, stateFile_RotationIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SchedMgrOverridesRow
+	var items []uuid.UUID
 	for rows.Next() {
-		var i SchedMgrOverridesRow
-		if err := rows.Scan(&i.AddUserID, &i.RemoveUserID, &i.TgtScheduleID); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5217,68 +7787,28 @@ func (q *Queries) SchedMgrOverrides(ctx context.Context) ([]SchedMgrOverridesRow
 	return items, nil
 }
 
-const schedMgrRules = `-- name: SchedMgrRules :many
+const stateFile_ServiceIDs = `-- name: StateFile_ServiceIDs :many
 SELECT
-    rule.created_at, rule.end_time, rule.friday, rule.id, rule.is_active, rule.monday, rule.saturday, rule.schedule_id, rule.start_time, rule.sunday, rule.tgt_rotation_id, rule.tgt_user_id, rule.thursday, rule.tuesday, rule.wednesday,
-    coalesce(rule.tgt_user_id, part.user_id) AS resolved_user_id
+    id
 FROM
-    schedule_rules rule
-    LEFT JOIN rotation_state rState ON rState.rotation_id = rule.tgt_rotation_id
-    LEFT JOIN rotation_participants part ON part.id = rState.rotation_participant_id
-WHERE
-    coalesce(rule.tgt_user_id, part.user_id)
-    NOTNULL
+    services
+ORDER BY
+    lower(name)
 `
 
-type SchedMgrRulesRow struct {
-	CreatedAt      time.Time
-	EndTime        timeutil.Clock
-	Friday         bool
-	ID             uuid.UUID
-	IsActive       bool
-	Monday         bool
-	Saturday       bool
-	ScheduleID     uuid.UUID
-	StartTime      timeutil.Clock
-	Sunday         bool
-	TgtRotationID  uuid.NullUUID
-	TgtUserID      uuid.NullUUID
-	Thursday       bool
-	Tuesday        bool
-	Wednesday      bool
-	ResolvedUserID uuid.UUID
-}
-
-func (q *Queries) SchedMgrRules(ctx context.Context) ([]SchedMgrRulesRow, error) {
-	rows, err := q.db.QueryContext(ctx, schedMgrRules)
+func (q *Queries) StateFile_ServiceIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, stateFile_ServiceIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SchedMgrRulesRow
+	var items []uuid.UUID
 	for rows.Next() {
-		var i SchedMgrRulesRow
-		if err := rows.Scan(
-			&i.CreatedAt,
-			&i.EndTime,
-			&i.Friday,
-			&i.ID,
-			&i.IsActive,
-			&i.Monday,
-			&i.Saturday,
-			&i.ScheduleID,
-			&i.StartTime,
-			&i.Sunday,
-			&i.TgtRotationID,
-			&i.TgtUserID,
-			&i.Thursday,
-			&i.Tuesday,
-			&i.Wednesday,
-			&i.ResolvedUserID,
-		); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5289,93 +7819,121 @@ func (q *Queries) SchedMgrRules(ctx context.Context) ([]SchedMgrRulesRow, error)
 	return items, nil
 }
 
-const schedMgrSetData = `-- name: SchedMgrSetData :exec
-UPDATE
-    schedule_data
-SET
-    data = $2
-WHERE
-    schedule_id = $1
+const statusMgrCleanupStaleSubs = `-- name: StatusMgrCleanupStaleSubs :exec
+DELETE FROM alert_status_subscriptions sub
+WHERE sub.updated_at < now() - '7 days'::interval
 `
 
-type SchedMgrSetDataParams struct {
-	ScheduleID uuid.UUID
-	Data       json.RawMessage
+func (q *Queries) StatusMgrCleanupStaleSubs(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, statusMgrCleanupStaleSubs)
+	return err
 }
 
-func (q *Queries) SchedMgrSetData(ctx context.Context, arg SchedMgrSetDataParams) error {
-	_, err := q.db.ExecContext(ctx, schedMgrSetData, arg.ScheduleID, arg.Data)
+const statusMgrDeleteSub = `-- name: StatusMgrDeleteSub :exec
+DELETE FROM alert_status_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) StatusMgrDeleteSub(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, statusMgrDeleteSub, id)
 	return err
 }
 
-const schedMgrSetDataV1Rules = `-- name: SchedMgrSetDataV1Rules :exec
-UPDATE
-    schedule_data
-SET
-    data = jsonb_set(data, '{V1,OnCallNotificationRules}', $2)
+const statusMgrFindOne = `-- name: StatusMgrFindOne :one
+SELECT
+    sub.alert_id, sub.channel_id, sub.contact_method_id, sub.id, sub.last_alert_status, sub.updated_at,
+    a.status
+FROM
+    alert_status_subscriptions sub
+    JOIN alerts a ON a.id = sub.alert_id
 WHERE
-    schedule_id = $1
+    sub.id = $1
+FOR UPDATE
+    SKIP LOCKED
 `
 
-type SchedMgrSetDataV1RulesParams struct {
-	ScheduleID  uuid.UUID
-	Replacement json.RawMessage
+type StatusMgrFindOneRow struct {
+	AlertID         int64
+	ChannelID       uuid.NullUUID
+	ContactMethodID uuid.NullUUID
+	ID              int64
+	LastAlertStatus EnumAlertStatus
+	UpdatedAt       time.Time
+	Status          EnumAlertStatus
 }
 
-// Sets the .V1.OnCallNotificationRules for a schedule.
-func (q *Queries) SchedMgrSetDataV1Rules(ctx context.Context, arg SchedMgrSetDataV1RulesParams) error {
-	_, err := q.db.ExecContext(ctx, schedMgrSetDataV1Rules, arg.ScheduleID, arg.Replacement)
-	return err
+func (q *Queries) StatusMgrFindOne(ctx context.Context, id int64) (StatusMgrFindOneRow, error) {
+	row := q.db.QueryRowContext(ctx, statusMgrFindOne, id)
+	var i StatusMgrFindOneRow
+	err := row.Scan(
+		&i.AlertID,
+		&i.ChannelID,
+		&i.ContactMethodID,
+		&i.ID,
+		&i.LastAlertStatus,
+		&i.UpdatedAt,
+		&i.Status,
+	)
+	return i, err
 }
 
-const schedMgrStartOnCall = `-- name: SchedMgrStartOnCall :exec
-INSERT INTO schedule_on_call_users(schedule_id, start_time, user_id)
+const statusMgrLogEntry = `-- name: StatusMgrLogEntry :one
 SELECT
-    $1,
-    now(),
-    $2
+    id,
+    sub_user_id AS user_id
 FROM
-    users
+    alert_logs
 WHERE
-    id = $2
+    alert_id = $1::bigint
+    AND event = ANY ($2::enum_alert_log_event[])
+ORDER BY
+    id DESC
+LIMIT 1
 `
 
-type SchedMgrStartOnCallParams struct {
-	ScheduleID uuid.UUID
-	UserID     uuid.UUID
+type StatusMgrLogEntryParams struct {
+	AlertID    int64
+	EventTypes []EnumAlertLogEvent
 }
 
-func (q *Queries) SchedMgrStartOnCall(ctx context.Context, arg SchedMgrStartOnCallParams) error {
-	_, err := q.db.ExecContext(ctx, schedMgrStartOnCall, arg.ScheduleID, arg.UserID)
-	return err
+type StatusMgrLogEntryRow struct {
+	ID     int64
+	UserID uuid.NullUUID
 }
 
-const schedMgrTimezones = `-- name: SchedMgrTimezones :many
+func (q *Queries) StatusMgrLogEntry(ctx context.Context, arg StatusMgrLogEntryParams) (StatusMgrLogEntryRow, error) {
+	row := q.db.QueryRowContext(ctx, statusMgrLogEntry, arg.AlertID, pq.Array(arg.EventTypes))
+	var i StatusMgrLogEntryRow
+	err := row.Scan(&i.ID, &i.UserID)
+	return i, err
+}
+
+const statusMgrOutdated = `-- name: StatusMgrOutdated :many
 SELECT
-    id,
-    time_zone
+    sub.id
 FROM
-    schedules
+    alert_status_subscriptions sub
+    JOIN alerts a ON a.id = sub.alert_id
+WHERE
+    sub.last_alert_status != a.status
+    AND sub.updated_at > now() - '7 days'::interval
+    AND ($1::bigint IS NULL
+        OR sub.alert_id = $1::bigint)
 `
 
-type SchedMgrTimezonesRow struct {
-	ID       uuid.UUID
-	TimeZone string
-}
-
-func (q *Queries) SchedMgrTimezones(ctx context.Context) ([]SchedMgrTimezonesRow, error) {
-	rows, err := q.db.QueryContext(ctx, schedMgrTimezones)
+func (q *Queries) StatusMgrOutdated(ctx context.Context, alertID sql.NullInt64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, statusMgrOutdated, alertID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SchedMgrTimezonesRow
+	var items []int64
 	for rows.Next() {
-		var i SchedMgrTimezonesRow
-		if err := rows.Scan(&i.ID, &i.TimeZone); err != nil {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5386,88 +7944,94 @@ func (q *Queries) SchedMgrTimezones(ctx context.Context) ([]SchedMgrTimezonesRow
 	return items, nil
 }
 
-const schedUpdate = `-- name: SchedUpdate :exec
-UPDATE schedules
-SET name = $2, description = $3, time_zone = $4
-WHERE id = $1
+const statusMgrSendChannelMsg = `-- name: StatusMgrSendChannelMsg :exec
+INSERT INTO outgoing_messages(id, message_type, channel_id, alert_id, alert_log_id)
+    VALUES ($1::uuid, 'alert_status_update', $2::uuid, $3::bigint, $4)
 `
 
-type SchedUpdateParams struct {
-	ID          uuid.UUID
-	Name        string
-	Description string
-	TimeZone    string
+type StatusMgrSendChannelMsgParams struct {
+	ID        uuid.UUID
+	ChannelID uuid.UUID
+	AlertID   int64
+	LogID     sql.NullInt64
+}
+
+func (q *Queries) StatusMgrSendChannelMsg(ctx context.Context, arg StatusMgrSendChannelMsgParams) error {
+	_, err := q.db.ExecContext(ctx, statusMgrSendChannelMsg,
+		arg.ID,
+		arg.ChannelID,
+		arg.AlertID,
+		arg.LogID,
+	)
+	return err
+}
+
+const statusMgrSendUserMsg = `-- name: StatusMgrSendUserMsg :exec
+INSERT INTO outgoing_messages(id, message_type, contact_method_id, user_id, alert_id, alert_log_id)
+    VALUES ($1::uuid, 'alert_status_update', $2::uuid, $3::uuid, $4::bigint, $5)
+`
+
+type StatusMgrSendUserMsgParams struct {
+	ID      uuid.UUID
+	CmID    uuid.UUID
+	UserID  uuid.UUID
+	AlertID int64
+	LogID   sql.NullInt64
 }
 
-// Updates an existing schedule.
-func (q *Queries) SchedUpdate(ctx context.Context, arg SchedUpdateParams) error {
-	_, err := q.db.ExecContext(ctx, schedUpdate,
+func (q *Queries) StatusMgrSendUserMsg(ctx context.Context, arg StatusMgrSendUserMsgParams) error {
+	_, err := q.db.ExecContext(ctx, statusMgrSendUserMsg,
 		arg.ID,
-		arg.Name,
-		arg.Description,
-		arg.TimeZone,
+		arg.CmID,
+		arg.UserID,
+		arg.AlertID,
+		arg.LogID,
 	)
 	return err
 }
 
-const schedUpdateData = `-- name: SchedUpdateData :exec
-UPDATE schedule_data
-SET data = $2
-WHERE schedule_id = $1
+const statusMgrUpdateSub = `-- name: StatusMgrUpdateSub :exec
+UPDATE
+    alert_status_subscriptions
+SET
+    last_alert_status = $2,
+    updated_at = now()
+WHERE
+    id = $1
 `
 
-type SchedUpdateDataParams struct {
-	ScheduleID uuid.UUID
-	Data       json.RawMessage
+type StatusMgrUpdateSubParams struct {
+	ID              int64
+	LastAlertStatus EnumAlertStatus
 }
 
-// Updates the schedule data for a given schedule ID.
-func (q *Queries) SchedUpdateData(ctx context.Context, arg SchedUpdateDataParams) error {
-	_, err := q.db.ExecContext(ctx, schedUpdateData, arg.ScheduleID, arg.Data)
+func (q *Queries) StatusMgrUpdateSub(ctx context.Context, arg StatusMgrUpdateSubParams) error {
+	_, err := q.db.ExecContext(ctx, statusMgrUpdateSub, arg.ID, arg.LastAlertStatus)
 	return err
 }
 
-const scheduleFindManyByUser = `-- name: ScheduleFindManyByUser :many
+const sysAPI_EscalationPolicyIDs = `-- name: SysAPI_EscalationPolicyIDs :many
 SELECT
-    description, id, last_processed, name, time_zone
+    id
 FROM
-    schedules
-WHERE
-    id = ANY (
-        SELECT
-            schedule_id
-        FROM
-            schedule_rules
-        WHERE
-            tgt_user_id = $1
-            OR tgt_rotation_id = ANY (
-                SELECT
-                    rotation_id
-                FROM
-                    rotation_participants
-                WHERE
-                    user_id = $1))
+    escalation_policies
+ORDER BY
+    lower(name)
 `
 
-func (q *Queries) ScheduleFindManyByUser(ctx context.Context, tgtUserID uuid.NullUUID) ([]Schedule, error) {
-	rows, err := q.db.QueryContext(ctx, scheduleFindManyByUser, tgtUserID)
+func (q *Queries) SysAPI_EscalationPolicyIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, sysAPI_EscalationPolicyIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Schedule
+	var items []uuid.UUID
 	for rows.Next() {
-		var i Schedule
-		if err := rows.Scan(
-			&i.Description,
-			&i.ID,
-			&i.LastProcessed,
-			&i.Name,
-			&i.TimeZone,
-		); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5478,27 +8042,28 @@ func (q *Queries) ScheduleFindManyByUser(ctx context.Context, tgtUserID uuid.Nul
 	return items, nil
 }
 
-const sequenceNames = `-- name: SequenceNames :many
-SELECT sequence_name::text
-FROM information_schema.sequences
-WHERE sequence_catalog = current_database()
-    AND sequence_schema = 'public'
-    AND sequence_name != 'change_log_id_seq'
+const sysAPI_RotationIDs = `-- name: SysAPI_RotationIDs :many
+SELECT
+    id
+FROM
+    rotations
+ORDER BY
+    lower(name)
 `
 
-func (q *Queries) SequenceNames(ctx context.Context) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, sequenceNames)
+func (q *Queries) SysAPI_RotationIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, sysAPI_RotationIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []uuid.UUID
 	for rows.Next() {
-		var sequence_name string
-		if err := rows.Scan(&sequence_name); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, sequence_name)
+		items = append(items, id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5509,39 +8074,28 @@ func (q *Queries) SequenceNames(ctx context.Context) ([]string, error) {
 	return items, nil
 }
 
-const serviceAlertCounts = `-- name: ServiceAlertCounts :many
+const sysAPI_ScheduleIDs = `-- name: SysAPI_ScheduleIDs :many
 SELECT
-  COUNT(*),
-  status,
-  service_id
+    id
 FROM
-  alerts
-WHERE
-  service_id = ANY ($1::uuid[])
-GROUP BY
-  service_id,
-  status
+    schedules
+ORDER BY
+    lower(name)
 `
 
-type ServiceAlertCountsRow struct {
-	Count     int64
-	Status    EnumAlertStatus
-	ServiceID uuid.NullUUID
-}
-
-func (q *Queries) ServiceAlertCounts(ctx context.Context, serviceIds []uuid.UUID) ([]ServiceAlertCountsRow, error) {
-	rows, err := q.db.QueryContext(ctx, serviceAlertCounts, pq.Array(serviceIds))
+func (q *Queries) SysAPI_ScheduleIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, sysAPI_ScheduleIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ServiceAlertCountsRow
+	var items []uuid.UUID
 	for rows.Next() {
-		var i ServiceAlertCountsRow
-		if err := rows.Scan(&i.Count, &i.Status, &i.ServiceID); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5552,76 +8106,28 @@ func (q *Queries) ServiceAlertCounts(ctx context.Context, serviceIds []uuid.UUID
 	return items, nil
 }
 
-const serviceAlertStats = `-- name: ServiceAlertStats :many
+const sysAPI_ServiceIDs = `-- name: SysAPI_ServiceIDs :many
 SELECT
-  date_bin($1::interval, closed_at, $2::timestamptz)::timestamptz AS bucket,
-  coalesce(EXTRACT(EPOCH FROM AVG(time_to_ack)), 0)::double precision AS avg_time_to_ack_seconds,
-  coalesce(EXTRACT(EPOCH FROM AVG(time_to_close)), 0)::double precision AS avg_time_to_close_seconds,
-  coalesce(COUNT(*), 0)::bigint AS alert_count,
-  coalesce(SUM(
-      CASE WHEN escalated THEN
-        1
-      ELSE
-        0
-      END), 0)::bigint AS escalated_count,
-  service_id
+    id
 FROM
-  alert_metrics
-WHERE
-  service_id = ANY ($3::uuid[])
-  AND (closed_at BETWEEN $4
-    AND $5)
-GROUP BY
-  service_id,
-  bucket
+    services
 ORDER BY
-  bucket
+    lower(name)
 `
 
-type ServiceAlertStatsParams struct {
-	Stride     sqlutil.Interval
-	Origin     time.Time
-	ServiceIds []uuid.UUID
-	StartTime  time.Time
-	EndTime    time.Time
-}
-
-type ServiceAlertStatsRow struct {
-	Bucket                time.Time
-	AvgTimeToAckSeconds   float64
-	AvgTimeToCloseSeconds float64
-	AlertCount            int64
-	EscalatedCount        int64
-	ServiceID             uuid.UUID
-}
-
-// ServiceAlertStats returns statistics about alerts for a service.
-func (q *Queries) ServiceAlertStats(ctx context.Context, arg ServiceAlertStatsParams) ([]ServiceAlertStatsRow, error) {
-	rows, err := q.db.QueryContext(ctx, serviceAlertStats,
-		arg.Stride,
-		arg.Origin,
-		pq.Array(arg.ServiceIds),
-		arg.StartTime,
-		arg.EndTime,
-	)
+func (q *Queries) SysAPI_ServiceIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, sysAPI_ServiceIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ServiceAlertStatsRow
+	var items []uuid.UUID
 	for rows.Next() {
-		var i ServiceAlertStatsRow
-		if err := rows.Scan(
-			&i.Bucket,
-			&i.AvgTimeToAckSeconds,
-			&i.AvgTimeToCloseSeconds,
-			&i.AlertCount,
-			&i.EscalatedCount,
-			&i.ServiceID,
-		); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5632,51 +8138,75 @@ func (q *Queries) ServiceAlertStats(ctx context.Context, arg ServiceAlertStatsPa
 	return items, nil
 }
 
-const signalMgrDeleteStale = `-- name: SignalMgrDeleteStale :exec
-DELETE FROM pending_signals
-WHERE message_id IS NULL
-    AND created_at < NOW() - INTERVAL '1 hour'
+const sysAPI_UserIDs = `-- name: SysAPI_UserIDs :many
+SELECT
+    id
+FROM
+    users
+ORDER BY
+    lower(name),
+    id
 `
 
-// Delete stale pending signals.
-func (q *Queries) SignalMgrDeleteStale(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, signalMgrDeleteStale)
-	return err
+func (q *Queries) SysAPI_UserIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, sysAPI_UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const signalMgrGetPending = `-- name: SignalMgrGetPending :many
-SELECT
-    id,
-    dest_id,
-    service_id
-FROM
-    pending_signals
-WHERE
-    message_id IS NULL
-    AND ($1::uuid IS NULL
-        OR service_id = $1)
-FOR UPDATE
-    SKIP LOCKED
-LIMIT 100
+const tableColumns = `-- name: TableColumns :many
+SELECT col.table_name::text,
+    col.column_name::text,
+    col.data_type::text,
+    col.ordinal_position::INT
+FROM information_schema.columns col
+    JOIN information_schema.tables t ON t.table_catalog = col.table_catalog
+    AND t.table_schema = col.table_schema
+    AND t.table_name = col.table_name
+    AND t.table_type = 'BASE TABLE'
+WHERE col.table_catalog = current_database()
+    AND col.table_schema = 'public'
 `
 
-type SignalMgrGetPendingRow struct {
-	ID        int32
-	DestID    uuid.UUID
-	ServiceID uuid.UUID
+type TableColumnsRow struct {
+	ColTableName       string
+	ColColumnName      string
+	ColDataType        string
+	ColOrdinalPosition int32
 }
 
-// Get a batch of pending signals to process.
-func (q *Queries) SignalMgrGetPending(ctx context.Context, serviceID uuid.NullUUID) ([]SignalMgrGetPendingRow, error) {
-	rows, err := q.db.QueryContext(ctx, signalMgrGetPending, serviceID)
+func (q *Queries) TableColumns(ctx context.Context) ([]TableColumnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, tableColumns)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SignalMgrGetPendingRow
+	var items []TableColumnsRow
 	for rows.Next() {
-		var i SignalMgrGetPendingRow
-		if err := rows.Scan(&i.ID, &i.DestID, &i.ServiceID); err != nil {
+		var i TableColumnsRow
+		if err := rows.Scan(
+			&i.ColTableName,
+			&i.ColColumnName,
+			&i.ColDataType,
+			&i.ColOrdinalPosition,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -5690,39 +8220,77 @@ func (q *Queries) SignalMgrGetPending(ctx context.Context, serviceID uuid.NullUU
 	return items, nil
 }
 
-const signalMgrGetScheduled = `-- name: SignalMgrGetScheduled :many
+const teamCreate = `-- name: TeamCreate :one
+INSERT INTO teams(name, description)
+    VALUES ($1, $2)
+RETURNING
+    id
+`
+
+type TeamCreateParams struct {
+	Name        string
+	Description string
+}
+
+func (q *Queries) TeamCreate(ctx context.Context, arg TeamCreateParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, teamCreate, arg.Name, arg.Description)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const teamDeleteMany = `-- name: TeamDeleteMany :exec
+DELETE FROM teams
+WHERE id = ANY ($1::uuid[])
+`
+
+func (q *Queries) TeamDeleteMany(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, teamDeleteMany, pq.Array(ids))
+	return err
+}
+
+const teamFindMany = `-- name: TeamFindMany :many
 SELECT
-    count(*),
-    service_id,
-    channel_id
+    t.id,
+    t.name,
+    t.description,
+    (fav.id IS NOT NULL)::bool AS is_favorite
 FROM
-    outgoing_messages
+    teams t
+    LEFT JOIN user_favorites fav ON fav.tgt_team_id = t.id
+        AND fav.user_id = $1
 WHERE
-    message_type = 'signal_message'
-    AND last_status = 'pending'
-    AND ($1::uuid IS NULL
-        OR service_id = $1)
-GROUP BY
-    service_id,
-    channel_id
+    t.id = ANY ($2::uuid[])
 `
 
-type SignalMgrGetScheduledRow struct {
-	Count     int64
-	ServiceID uuid.NullUUID
-	ChannelID uuid.NullUUID
+type TeamFindManyParams struct {
+	UserID uuid.NullUUID
+	Ids    []uuid.UUID
 }
 
-func (q *Queries) SignalMgrGetScheduled(ctx context.Context, serviceID uuid.NullUUID) ([]SignalMgrGetScheduledRow, error) {
-	rows, err := q.db.QueryContext(ctx, signalMgrGetScheduled, serviceID)
+type TeamFindManyRow struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	IsFavorite  bool
+}
+
+// Get teams by ID, and whether each is a favorite of the given user.
+func (q *Queries) TeamFindMany(ctx context.Context, arg TeamFindManyParams) ([]TeamFindManyRow, error) {
+	rows, err := q.db.QueryContext(ctx, teamFindMany, arg.UserID, pq.Array(arg.Ids))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SignalMgrGetScheduledRow
+	var items []TeamFindManyRow
 	for rows.Next() {
-		var i SignalMgrGetScheduledRow
-		if err := rows.Scan(&i.Count, &i.ServiceID, &i.ChannelID); err != nil {
+		var i TeamFindManyRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.IsFavorite,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -5736,158 +8304,152 @@ func (q *Queries) SignalMgrGetScheduled(ctx context.Context, serviceID uuid.Null
 	return items, nil
 }
 
-const signalMgrInsertMessage = `-- name: SignalMgrInsertMessage :exec
-INSERT INTO outgoing_messages(id, message_type, service_id, channel_id)
-    VALUES ($1, 'signal_message', $2, $3)
-`
-
-type SignalMgrInsertMessageParams struct {
-	ID        uuid.UUID
-	ServiceID uuid.NullUUID
-	ChannelID uuid.NullUUID
-}
-
-// Insert a new message into the outgoing_messages table.
-func (q *Queries) SignalMgrInsertMessage(ctx context.Context, arg SignalMgrInsertMessageParams) error {
-	_, err := q.db.ExecContext(ctx, signalMgrInsertMessage, arg.ID, arg.ServiceID, arg.ChannelID)
-	return err
-}
-
-const signalMgrUpdateSignal = `-- name: SignalMgrUpdateSignal :exec
-UPDATE
-    pending_signals
-SET
-    message_id = $2
+const teamFindOneForUpdate = `-- name: TeamFindOneForUpdate :one
+SELECT
+    id
+FROM
+    teams
 WHERE
     id = $1
+FOR UPDATE
 `
 
-type SignalMgrUpdateSignalParams struct {
-	ID        int32
-	MessageID uuid.NullUUID
-}
-
-// Update a pending signal with the message_id.
-func (q *Queries) SignalMgrUpdateSignal(ctx context.Context, arg SignalMgrUpdateSignalParams) error {
-	_, err := q.db.ExecContext(ctx, signalMgrUpdateSignal, arg.ID, arg.MessageID)
-	return err
+func (q *Queries) TeamFindOneForUpdate(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, teamFindOneForUpdate, id)
+	var id_2 uuid.UUID
+	err := row.Scan(&id_2)
+	return id_2, err
 }
 
-const statusMgrCleanupStaleSubs = `-- name: StatusMgrCleanupStaleSubs :exec
-DELETE FROM alert_status_subscriptions sub
-WHERE sub.updated_at < now() - '7 days'::interval
+const teamMemberDelete = `-- name: TeamMemberDelete :exec
+DELETE FROM team_members
+WHERE team_id = $1
+    AND user_id = $2
 `
 
-func (q *Queries) StatusMgrCleanupStaleSubs(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, statusMgrCleanupStaleSubs)
-	return err
+type TeamMemberDeleteParams struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
 }
 
-const statusMgrDeleteSub = `-- name: StatusMgrDeleteSub :exec
-DELETE FROM alert_status_subscriptions
-WHERE id = $1
-`
-
-func (q *Queries) StatusMgrDeleteSub(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, statusMgrDeleteSub, id)
+func (q *Queries) TeamMemberDelete(ctx context.Context, arg TeamMemberDeleteParams) error {
+	_, err := q.db.ExecContext(ctx, teamMemberDelete, arg.TeamID, arg.UserID)
 	return err
 }
 
-const statusMgrFindOne = `-- name: StatusMgrFindOne :one
+const teamMemberOf = `-- name: TeamMemberOf :many
 SELECT
-    sub.alert_id, sub.channel_id, sub.contact_method_id, sub.id, sub.last_alert_status, sub.updated_at,
-    a.status
+    team_id
 FROM
-    alert_status_subscriptions sub
-    JOIN alerts a ON a.id = sub.alert_id
+    team_members
 WHERE
-    sub.id = $1
-FOR UPDATE
-    SKIP LOCKED
+    user_id = $1
+    AND team_id = ANY ($2::uuid[])
 `
 
-type StatusMgrFindOneRow struct {
-	AlertID         int64
-	ChannelID       uuid.NullUUID
-	ContactMethodID uuid.NullUUID
-	ID              int64
-	LastAlertStatus EnumAlertStatus
-	UpdatedAt       time.Time
-	Status          EnumAlertStatus
+type TeamMemberOfParams struct {
+	UserID  uuid.UUID
+	TeamIds []uuid.UUID
 }
 
-func (q *Queries) StatusMgrFindOne(ctx context.Context, id int64) (StatusMgrFindOneRow, error) {
-	row := q.db.QueryRowContext(ctx, statusMgrFindOne, id)
-	var i StatusMgrFindOneRow
-	err := row.Scan(
-		&i.AlertID,
-		&i.ChannelID,
-		&i.ContactMethodID,
-		&i.ID,
-		&i.LastAlertStatus,
-		&i.UpdatedAt,
-		&i.Status,
-	)
-	return i, err
+// Get the IDs of the given teams the user is a member of.
+func (q *Queries) TeamMemberOf(ctx context.Context, arg TeamMemberOfParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, teamMemberOf, arg.UserID, pq.Array(arg.TeamIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var team_id uuid.UUID
+		if err := rows.Scan(&team_id); err != nil {
+			return nil, err
+		}
+		items = append(items, team_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const statusMgrLogEntry = `-- name: StatusMgrLogEntry :one
+const teamMemberRole = `-- name: TeamMemberRole :one
 SELECT
-    id,
-    sub_user_id AS user_id
+    role
 FROM
-    alert_logs
+    team_members
 WHERE
-    alert_id = $1::bigint
-    AND event = $2::enum_alert_log_event
-ORDER BY
-    id DESC
-LIMIT 1
+    team_id = $1
+    AND user_id = $2
 `
 
-type StatusMgrLogEntryParams struct {
-	AlertID   int64
-	EventType EnumAlertLogEvent
+type TeamMemberRoleParams struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
 }
 
-type StatusMgrLogEntryRow struct {
-	ID     int64
-	UserID uuid.NullUUID
+func (q *Queries) TeamMemberRole(ctx context.Context, arg TeamMemberRoleParams) (EnumTeamRole, error) {
+	row := q.db.QueryRowContext(ctx, teamMemberRole, arg.TeamID, arg.UserID)
+	var role EnumTeamRole
+	err := row.Scan(&role)
+	return role, err
 }
 
-func (q *Queries) StatusMgrLogEntry(ctx context.Context, arg StatusMgrLogEntryParams) (StatusMgrLogEntryRow, error) {
-	row := q.db.QueryRowContext(ctx, statusMgrLogEntry, arg.AlertID, arg.EventType)
-	var i StatusMgrLogEntryRow
-	err := row.Scan(&i.ID, &i.UserID)
-	return i, err
+const teamMemberSet = `-- name: TeamMemberSet :exec
+INSERT INTO team_members(team_id, user_id, role)
+    VALUES ($1, $2, $3)
+ON CONFLICT (team_id, user_id)
+    DO UPDATE SET
+        role = $3
+`
+
+type TeamMemberSetParams struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+	Role   EnumTeamRole
 }
 
-const statusMgrOutdated = `-- name: StatusMgrOutdated :many
+func (q *Queries) TeamMemberSet(ctx context.Context, arg TeamMemberSetParams) error {
+	_, err := q.db.ExecContext(ctx, teamMemberSet, arg.TeamID, arg.UserID, arg.Role)
+	return err
+}
+
+const teamMembers = `-- name: TeamMembers :many
 SELECT
-    sub.id
+    m.user_id,
+    m.role
 FROM
-    alert_status_subscriptions sub
-    JOIN alerts a ON a.id = sub.alert_id
+    team_members m
+    JOIN users u ON u.id = m.user_id
 WHERE
-    sub.last_alert_status != a.status
-    AND sub.updated_at > now() - '7 days'::interval
-    AND ($1::bigint IS NULL
-        OR sub.alert_id = $1::bigint)
+    m.team_id = $1
+ORDER BY
+    m.role DESC,
+    lower(u.name)
 `
 
-func (q *Queries) StatusMgrOutdated(ctx context.Context, alertID sql.NullInt64) ([]int64, error) {
-	rows, err := q.db.QueryContext(ctx, statusMgrOutdated, alertID)
+type TeamMembersRow struct {
+	UserID uuid.UUID
+	Role   EnumTeamRole
+}
+
+// Get the members of a team, owners first.
+func (q *Queries) TeamMembers(ctx context.Context, teamID uuid.UUID) ([]TeamMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, teamMembers, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int64
+	var items []TeamMembersRow
 	for rows.Next() {
-		var id int64
-		if err := rows.Scan(&id); err != nil {
+		var i TeamMembersRow
+		if err := rows.Scan(&i.UserID, &i.Role); err != nil {
 			return nil, err
 		}
-		items = append(items, id)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -5898,108 +8460,190 @@ func (q *Queries) StatusMgrOutdated(ctx context.Context, alertID sql.NullInt64)
 	return items, nil
 }
 
-const statusMgrSendChannelMsg = `-- name: StatusMgrSendChannelMsg :exec
-INSERT INTO outgoing_messages(id, message_type, channel_id, alert_id, alert_log_id)
-    VALUES ($1::uuid, 'alert_status_update', $2::uuid, $3::bigint, $4)
+const teamOwnerCount = `-- name: TeamOwnerCount :one
+SELECT
+    count(*)
+FROM
+    team_members
+WHERE
+    team_id = $1
+    AND role = 'owner'
 `
 
-type StatusMgrSendChannelMsgParams struct {
-	ID        uuid.UUID
-	ChannelID uuid.UUID
-	AlertID   int64
-	LogID     sql.NullInt64
+func (q *Queries) TeamOwnerCount(ctx context.Context, teamID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, teamOwnerCount, teamID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-func (q *Queries) StatusMgrSendChannelMsg(ctx context.Context, arg StatusMgrSendChannelMsgParams) error {
-	_, err := q.db.ExecContext(ctx, statusMgrSendChannelMsg,
-		arg.ID,
-		arg.ChannelID,
-		arg.AlertID,
-		arg.LogID,
-	)
+const teamSetEscalationPolicyOwner = `-- name: TeamSetEscalationPolicyOwner :exec
+UPDATE
+    escalation_policies
+SET
+    team_id = $2
+WHERE
+    id = $1
+`
+
+type TeamSetEscalationPolicyOwnerParams struct {
+	ID     uuid.UUID
+	TeamID uuid.NullUUID
+}
+
+func (q *Queries) TeamSetEscalationPolicyOwner(ctx context.Context, arg TeamSetEscalationPolicyOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, teamSetEscalationPolicyOwner, arg.ID, arg.TeamID)
 	return err
 }
 
-const statusMgrSendUserMsg = `-- name: StatusMgrSendUserMsg :exec
-INSERT INTO outgoing_messages(id, message_type, contact_method_id, user_id, alert_id, alert_log_id)
-    VALUES ($1::uuid, 'alert_status_update', $2::uuid, $3::uuid, $4::bigint, $5)
+const teamSetRotationOwner = `-- name: TeamSetRotationOwner :exec
+UPDATE
+    rotations
+SET
+    team_id = $2
+WHERE
+    id = $1
+`
+
+type TeamSetRotationOwnerParams struct {
+	ID     uuid.UUID
+	TeamID uuid.NullUUID
+}
+
+func (q *Queries) TeamSetRotationOwner(ctx context.Context, arg TeamSetRotationOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, teamSetRotationOwner, arg.ID, arg.TeamID)
+	return err
+}
+
+const teamSetScheduleOwner = `-- name: TeamSetScheduleOwner :exec
+UPDATE
+    schedules
+SET
+    team_id = $2
+WHERE
+    id = $1
 `
 
-type StatusMgrSendUserMsgParams struct {
-	ID      uuid.UUID
-	CmID    uuid.UUID
-	UserID  uuid.UUID
-	AlertID int64
-	LogID   sql.NullInt64
+type TeamSetScheduleOwnerParams struct {
+	ID     uuid.UUID
+	TeamID uuid.NullUUID
 }
 
-func (q *Queries) StatusMgrSendUserMsg(ctx context.Context, arg StatusMgrSendUserMsgParams) error {
-	_, err := q.db.ExecContext(ctx, statusMgrSendUserMsg,
-		arg.ID,
-		arg.CmID,
-		arg.UserID,
-		arg.AlertID,
-		arg.LogID,
-	)
+func (q *Queries) TeamSetScheduleOwner(ctx context.Context, arg TeamSetScheduleOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, teamSetScheduleOwner, arg.ID, arg.TeamID)
 	return err
 }
 
-const statusMgrUpdateSub = `-- name: StatusMgrUpdateSub :exec
+const teamSetServiceOwner = `-- name: TeamSetServiceOwner :exec
 UPDATE
-    alert_status_subscriptions
+    services
 SET
-    last_alert_status = $2,
-    updated_at = now()
+    team_id = $2
 WHERE
     id = $1
 `
 
-type StatusMgrUpdateSubParams struct {
-	ID              int64
-	LastAlertStatus EnumAlertStatus
+type TeamSetServiceOwnerParams struct {
+	ID     uuid.UUID
+	TeamID uuid.NullUUID
 }
 
-func (q *Queries) StatusMgrUpdateSub(ctx context.Context, arg StatusMgrUpdateSubParams) error {
-	_, err := q.db.ExecContext(ctx, statusMgrUpdateSub, arg.ID, arg.LastAlertStatus)
+func (q *Queries) TeamSetServiceOwner(ctx context.Context, arg TeamSetServiceOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, teamSetServiceOwner, arg.ID, arg.TeamID)
 	return err
 }
 
-const tableColumns = `-- name: TableColumns :many
-SELECT col.table_name::text,
-    col.column_name::text,
-    col.data_type::text,
-    col.ordinal_position::INT
-FROM information_schema.columns col
-    JOIN information_schema.tables t ON t.table_catalog = col.table_catalog
-    AND t.table_schema = col.table_schema
-    AND t.table_name = col.table_name
-    AND t.table_type = 'BASE TABLE'
-WHERE col.table_catalog = current_database()
-    AND col.table_schema = 'public'
+const teamTargetOwners = `-- name: TeamTargetOwners :many
+SELECT
+    id,
+    team_id::uuid
+FROM
+    services
+WHERE
+    id = ANY ($1::uuid[])
+    AND team_id IS NOT NULL
+UNION ALL
+SELECT
+    id,
+    team_id::uuid
+FROM
+    escalation_policies
+WHERE
+    id = ANY ($2::uuid[])
+    AND team_id IS NOT NULL
+UNION ALL
+SELECT
+    id,
+    team_id::uuid
+FROM
+    schedules
+WHERE
+    id = ANY ($3::uuid[])
+    AND team_id IS NOT NULL
+UNION ALL
+SELECT
+    id,
+    team_id::uuid
+FROM
+    rotations
+WHERE
+    id = ANY ($4::uuid[])
+    AND team_id IS NOT NULL
+UNION ALL
+SELECT
+    k.id,
+    svc.team_id::uuid
+FROM
+    integration_keys k
+    JOIN services svc ON svc.id = k.service_id
+WHERE
+    k.id = ANY ($5::uuid[])
+    AND svc.team_id IS NOT NULL
+UNION ALL
+SELECT
+    hb.id,
+    svc.team_id::uuid
+FROM
+    heartbeat_monitors hb
+    JOIN services svc ON svc.id = hb.service_id
+WHERE
+    hb.id = ANY ($6::uuid[])
+    AND svc.team_id IS NOT NULL
 `
 
-type TableColumnsRow struct {
-	ColTableName       string
-	ColColumnName      string
-	ColDataType        string
-	ColOrdinalPosition int32
+type TeamTargetOwnersParams struct {
+	ServiceIds          []uuid.UUID
+	EscalationPolicyIds []uuid.UUID
+	ScheduleIds         []uuid.UUID
+	RotationIds         []uuid.UUID
+	IntegrationKeyIds   []uuid.UUID
+	HeartbeatMonitorIds []uuid.UUID
 }
 
-func (q *Queries) TableColumns(ctx context.Context) ([]TableColumnsRow, error) {
-	rows, err := q.db.QueryContext(ctx, tableColumns)
+type TeamTargetOwnersRow struct {
+	ID     uuid.UUID
+	TeamID uuid.UUID
+}
+
+// Get the owning team of each target that belongs to a team. Integration keys and heartbeat
+// monitors belong to the team that owns their service.
+func (q *Queries) TeamTargetOwners(ctx context.Context, arg TeamTargetOwnersParams) ([]TeamTargetOwnersRow, error) {
+	rows, err := q.db.QueryContext(ctx, teamTargetOwners,
+		pq.Array(arg.ServiceIds),
+		pq.Array(arg.EscalationPolicyIds),
+		pq.Array(arg.ScheduleIds),
+		pq.Array(arg.RotationIds),
+		pq.Array(arg.IntegrationKeyIds),
+		pq.Array(arg.HeartbeatMonitorIds),
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []TableColumnsRow
+	var items []TeamTargetOwnersRow
 	for rows.Next() {
-		var i TableColumnsRow
-		if err := rows.Scan(
-			&i.ColTableName,
-			&i.ColColumnName,
-			&i.ColDataType,
-			&i.ColOrdinalPosition,
-		); err != nil {
+		var i TeamTargetOwnersRow
+		if err := rows.Scan(&i.ID, &i.TeamID); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -6013,6 +8657,27 @@ func (q *Queries) TableColumns(ctx context.Context) ([]TableColumnsRow, error) {
 	return items, nil
 }
 
+const teamUpdate = `-- name: TeamUpdate :exec
+UPDATE
+    teams
+SET
+    name = $2,
+    description = $3
+WHERE
+    id = $1
+`
+
+type TeamUpdateParams struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+}
+
+func (q *Queries) TeamUpdate(ctx context.Context, arg TeamUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, teamUpdate, arg.ID, arg.Name, arg.Description)
+	return err
+}
+
 const updateCalSub = `-- name: UpdateCalSub :exec
 UPDATE
     user_calendar_subscriptions
@@ -6051,7 +8716,8 @@ SELECT
     tgt_schedule_id,
     tgt_rotation_id,
     tgt_escalation_policy_id,
-    tgt_user_id
+    tgt_user_id,
+    tgt_team_id
 FROM
     user_favorites
 WHERE
@@ -6065,7 +8731,9 @@ WHERE
         OR (tgt_escalation_policy_id NOTNULL
             AND $5::bool)
         OR (tgt_user_id NOTNULL
-            AND $6::bool))
+            AND $6::bool)
+        OR (tgt_team_id NOTNULL
+            AND $7::bool))
 `
 
 type UserFavFindAllParams struct {
@@ -6075,6 +8743,7 @@ type UserFavFindAllParams struct {
 	AllowRotations          bool
 	AllowEscalationPolicies bool
 	AllowUsers              bool
+	AllowTeams              bool
 }
 
 type UserFavFindAllRow struct {
@@ -6083,6 +8752,7 @@ type UserFavFindAllRow struct {
 	TgtRotationID         uuid.NullUUID
 	TgtEscalationPolicyID uuid.NullUUID
 	TgtUserID             uuid.NullUUID
+	TgtTeamID             uuid.NullUUID
 }
 
 func (q *Queries) UserFavFindAll(ctx context.Context, arg UserFavFindAllParams) ([]UserFavFindAllRow, error) {
@@ -6093,6 +8763,7 @@ func (q *Queries) UserFavFindAll(ctx context.Context, arg UserFavFindAllParams)
 		arg.AllowRotations,
 		arg.AllowEscalationPolicies,
 		arg.AllowUsers,
+		arg.AllowTeams,
 	)
 	if err != nil {
 		return nil, err
@@ -6107,6 +8778,7 @@ func (q *Queries) UserFavFindAll(ctx context.Context, arg UserFavFindAllParams)
 			&i.TgtRotationID,
 			&i.TgtEscalationPolicyID,
 			&i.TgtUserID,
+			&i.TgtTeamID,
 		); err != nil {
 			return nil, err
 		}
@@ -6122,8 +8794,8 @@ func (q *Queries) UserFavFindAll(ctx context.Context, arg UserFavFindAllParams)
 }
 
 const userFavSet = `-- name: UserFavSet :exec
-INSERT INTO user_favorites(user_id, tgt_service_id, tgt_schedule_id, tgt_rotation_id, tgt_escalation_policy_id, tgt_user_id)
-    VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO user_favorites(user_id, tgt_service_id, tgt_schedule_id, tgt_rotation_id, tgt_escalation_policy_id, tgt_user_id, tgt_team_id)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)
 ON CONFLICT
     DO NOTHING
 `
@@ -6135,6 +8807,7 @@ type UserFavSetParams struct {
 	TgtRotationID         uuid.NullUUID
 	TgtEscalationPolicyID uuid.NullUUID
 	TgtUserID             uuid.NullUUID
+	TgtTeamID             uuid.NullUUID
 }
 
 func (q *Queries) UserFavSet(ctx context.Context, arg UserFavSetParams) error {
@@ -6145,6 +8818,7 @@ func (q *Queries) UserFavSet(ctx context.Context, arg UserFavSetParams) error {
 		arg.TgtRotationID,
 		arg.TgtEscalationPolicyID,
 		arg.TgtUserID,
+		arg.TgtTeamID,
 	)
 	return err
 }
@@ -6152,11 +8826,12 @@ func (q *Queries) UserFavSet(ctx context.Context, arg UserFavSetParams) error {
 const userFavUnset = `-- name: UserFavUnset :exec
 DELETE FROM user_favorites
 WHERE user_id = $1
-    AND tgt_service_id = $2
-    OR tgt_schedule_id = $3
-    OR tgt_rotation_id = $4
-    OR tgt_escalation_policy_id = $5
-    OR tgt_user_id = $6
+    AND (tgt_service_id = $2
+        OR tgt_schedule_id = $3
+        OR tgt_rotation_id = $4
+        OR tgt_escalation_policy_id = $5
+        OR tgt_user_id = $6
+        OR tgt_team_id = $7)
 `
 
 type UserFavUnsetParams struct {
@@ -6166,6 +8841,7 @@ type UserFavUnsetParams struct {
 	TgtRotationID         uuid.NullUUID
 	TgtEscalationPolicyID uuid.NullUUID
 	TgtUserID             uuid.NullUUID
+	TgtTeamID             uuid.NullUUID
 }
 
 func (q *Queries) UserFavUnset(ctx context.Context, arg UserFavUnsetParams) error {
@@ -6176,6 +8852,286 @@ func (q *Queries) UserFavUnset(ctx context.Context, arg UserFavUnsetParams) erro
 		arg.TgtRotationID,
 		arg.TgtEscalationPolicyID,
 		arg.TgtUserID,
+		arg.TgtTeamID,
 	)
 	return err
 }
+
+const webhookSecretFind = `-- name: WebhookSecretFind :one
+SELECT
+    secret
+FROM
+    webhook_signing_secrets
+WHERE
+    webhook_url = $1
+`
+
+func (q *Queries) WebhookSecretFind(ctx context.Context, webhookUrl string) ([]byte, error) {
+	row := q.db.QueryRowContext(ctx, webhookSecretFind, webhookUrl)
+	var secret []byte
+	err := row.Scan(&secret)
+	return secret, err
+}
+
+const webhookSecretInsert = `-- name: WebhookSecretInsert :exec
+INSERT INTO webhook_signing_secrets(webhook_url, secret)
+    VALUES ($1, $2)
+ON CONFLICT (webhook_url)
+    DO NOTHING
+`
+
+type WebhookSecretInsertParams struct {
+	WebhookUrl string
+	Secret     []byte
+}
+
+// Inserts a new signing secret for the webhook URL, unless one already exists.
+func (q *Queries) WebhookSecretInsert(ctx context.Context, arg WebhookSecretInsertParams) error {
+	_, err := q.db.ExecContext(ctx, webhookSecretInsert, arg.WebhookUrl, arg.Secret)
+	return err
+}
+
+const webhookSecretReset = `-- name: WebhookSecretReset :exec
+INSERT INTO webhook_signing_secrets(webhook_url, secret)
+    VALUES ($1, $2)
+ON CONFLICT (webhook_url)
+    DO UPDATE SET
+        secret = excluded.secret, created_at = now()
+`
+
+type WebhookSecretResetParams struct {
+	WebhookUrl string
+	Secret     []byte
+}
+
+// Replaces the signing secret for the webhook URL.
+func (q *Queries) WebhookSecretReset(ctx context.Context, arg WebhookSecretResetParams) error {
+	_, err := q.db.ExecContext(ctx, webhookSecretReset, arg.WebhookUrl, arg.Secret)
+	return err
+}
+
+const webhookSecretUserOwnsURL = `-- name: WebhookSecretUserOwnsURL :one
+SELECT
+    (EXISTS (
+        SELECT
+        FROM
+            user_contact_methods cm
+        WHERE
+            cm.user_id = $1
+            AND cm.dest ->> 'Type' = 'builtin-webhook'
+            AND cm.dest -> 'Args' ->> 'webhook_url' = $2::text)
+    AND NOT EXISTS (
+        SELECT
+        FROM
+            user_contact_methods other
+        WHERE
+            other.user_id <> $1
+            AND other.dest ->> 'Type' = 'builtin-webhook'
+            AND other.dest -> 'Args' ->> 'webhook_url' = $2::text)
+    AND NOT EXISTS (
+        SELECT
+        FROM
+            notification_channels nc
+        WHERE
+            nc.dest ->> 'Type' = 'builtin-webhook'
+            AND nc.dest -> 'Args' ->> 'webhook_url' = $2::text))::bool
+`
+
+type WebhookSecretUserOwnsURLParams struct {
+	UserID     uuid.UUID
+	WebhookUrl string
+}
+
+// Returns true if the user has a webhook contact method for the URL, and the URL is not used by a
+// notification channel or another user's contact method.
+func (q *Queries) WebhookSecretUserOwnsURL(ctx context.Context, arg WebhookSecretUserOwnsURLParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, webhookSecretUserOwnsURL, arg.UserID, arg.WebhookUrl)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const workloadAcks = `-- name: WorkloadAcks :many
+SELECT
+    l.sub_user_id AS user_id,
+    u.name AS user_name,
+    l.timestamp
+FROM
+    alert_logs l
+    JOIN users u ON u.id = l.sub_user_id
+WHERE
+    l.event = 'acknowledged'
+    AND l.timestamp >= $1::timestamptz
+    AND l.timestamp < $2::timestamptz
+`
+
+type WorkloadAcksParams struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type WorkloadAcksRow struct {
+	UserID    uuid.NullUUID
+	UserName  string
+	Timestamp sql.NullTime
+}
+
+// Get all alert acknowledgements by users within the given time range.
+func (q *Queries) WorkloadAcks(ctx context.Context, arg WorkloadAcksParams) ([]WorkloadAcksRow, error) {
+	rows, err := q.db.QueryContext(ctx, workloadAcks, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkloadAcksRow
+	for rows.Next() {
+		var i WorkloadAcksRow
+		if err := rows.Scan(&i.UserID, &i.UserName, &i.Timestamp); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const workloadNow = `-- name: WorkloadNow :one
+SELECT
+    now()::timestamptz
+`
+
+func (q *Queries) WorkloadNow(ctx context.Context) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, workloadNow)
+	var column_1 time.Time
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const workloadPages = `-- name: WorkloadPages :many
+SELECT
+    om.user_id,
+    u.name AS user_name,
+    om.alert_id,
+    om.sent_at
+FROM
+    outgoing_messages om
+    JOIN users u ON u.id = om.user_id
+WHERE
+    om.message_type IN ('alert_notification', 'alert_notification_bundle')
+    AND om.sent_at >= $1::timestamptz
+    AND om.sent_at < $2::timestamptz
+`
+
+type WorkloadPagesParams struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type WorkloadPagesRow struct {
+	UserID   uuid.NullUUID
+	UserName string
+	AlertID  sql.NullInt64
+	SentAt   sql.NullTime
+}
+
+// Get all alert notifications sent to users within the given time range.
+func (q *Queries) WorkloadPages(ctx context.Context, arg WorkloadPagesParams) ([]WorkloadPagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, workloadPages, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkloadPagesRow
+	for rows.Next() {
+		var i WorkloadPagesRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.UserName,
+			&i.AlertID,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const workloadShifts = `-- name: WorkloadShifts :many
+SELECT
+    s.user_id,
+    u.name AS user_name,
+    s.schedule_id,
+    sched.name AS schedule_name,
+    t.id AS team_id,
+    t.name AS team_name,
+    s.start_time,
+    s.end_time
+FROM
+    schedule_on_call_users s
+    JOIN users u ON u.id = s.user_id
+    JOIN schedules sched ON sched.id = s.schedule_id
+    LEFT JOIN teams t ON t.id = sched.team_id
+WHERE
+    tstzrange(s.start_time, s.end_time) && tstzrange($1::timestamptz, $2::timestamptz)
+`
+
+type WorkloadShiftsParams struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type WorkloadShiftsRow struct {
+	UserID       uuid.UUID
+	UserName     string
+	ScheduleID   uuid.UUID
+	ScheduleName string
+	TeamID       uuid.NullUUID
+	TeamName     sql.NullString
+	StartTime    time.Time
+	EndTime      sql.NullTime
+}
+
+// Get all on-call shifts overlapping the given time range, with the team owning each schedule.
+func (q *Queries) WorkloadShifts(ctx context.Context, arg WorkloadShiftsParams) ([]WorkloadShiftsRow, error) {
+	rows, err := q.db.QueryContext(ctx, workloadShifts, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WorkloadShiftsRow
+	for rows.Next() {
+		var i WorkloadShiftsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.UserName,
+			&i.ScheduleID,
+			&i.ScheduleName,
+			&i.TeamID,
+			&i.TeamName,
+			&i.StartTime,
+			&i.EndTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
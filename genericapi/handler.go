@@ -79,6 +79,7 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 	details := r.FormValue("details")
 	action := r.FormValue("action")
 	dedup := r.FormValue("dedup")
+	severity := r.FormValue("severity")
 
 	meta := make(map[string]string)
 	for _, v := range r.Form["meta"] {
@@ -97,8 +98,8 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 		}
 
 		var b struct {
-			Summary, Details, Action, Dedup *string
-			Meta                            map[string]string
+			Summary, Details, Action, Dedup, Severity *string
+			Meta                                      map[string]string
 		}
 		err = json.Unmarshal(data, &b)
 		if errutil.HTTPError(ctx, w, validation.WrapError(err)) {
@@ -117,6 +118,9 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 		if b.Action != nil {
 			action = *b.Action
 		}
+		if b.Severity != nil {
+			severity = *b.Severity
+		}
 		if b.Meta != nil {
 			meta = b.Meta
 		}
@@ -129,6 +133,10 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 
 	summary = validate.SanitizeText(summary, alert.MaxSummaryLength)
 	details = validate.SanitizeText(details, alert.MaxDetailsLength)
+	sev, err := alert.ParseSeverity(severity)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
 
 	a := &alert.Alert{
 		Summary:   summary,
@@ -137,6 +145,7 @@ func (h *Handler) ServeCreateAlert(w http.ResponseWriter, r *http.Request) {
 		ServiceID: serviceID,
 		Dedup:     alert.NewUserDedup(dedup),
 		Status:    status,
+		Severity:  sev,
 	}
 
 	var resp struct {
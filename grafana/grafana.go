@@ -71,6 +71,16 @@ func clientError(w http.ResponseWriter, code int, err error) bool {
 	return true
 }
 
+// parseSeverity returns the alert severity from a label or parameter value, ignoring unknown values.
+func parseSeverity(s string) alert.Severity {
+	sev, err := alert.ParseSeverity(s)
+	if err != nil {
+		return alert.DefaultSeverity
+	}
+
+	return sev
+}
+
 func alertsFromLegacy(ctx context.Context, req *http.Request, serviceID string, data []byte) ([]alert.Alert, error) {
 	var g struct {
 		RuleName string
@@ -117,6 +127,7 @@ func alertsFromLegacy(ctx context.Context, req *http.Request, serviceID string,
 		ServiceID: serviceID,
 		Source:    alert.SourceGrafana,
 		Dedup:     alert.NewUserDedup(req.FormValue("dedup")),
+		Severity:  parseSeverity(req.FormValue("severity")),
 	}}, nil
 }
 
@@ -166,6 +177,7 @@ func alertsFromV1(ctx context.Context, serviceID string, data []byte) ([]alert.A
 			ServiceID: serviceID,
 			Source:    alert.SourceGrafana,
 			Dedup:     alert.NewUserDedup(a.Fingerprint),
+			Severity:  parseSeverity(a.Labels["severity"]),
 		})
 	}
 
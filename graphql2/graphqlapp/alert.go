@@ -169,15 +169,30 @@ func (a *AlertLogEntry) notificationSentState(ctx context.Context, obj *alertlog
 func (a *AlertLogEntry) createdState(ctx context.Context, obj *alertlog.Entry) (*graphql2.NotificationState, error) {
 	e := *obj
 	meta, ok := e.Meta(ctx).(*alertlog.CreatedMetaData)
-	if !ok || meta == nil || !meta.EPNoSteps {
+	if !ok || meta == nil {
 		return nil, nil
 	}
 
 	status := graphql2.NotificationStatusWarn
-	return &graphql2.NotificationState{
-		Details: "No escalation policy steps",
-		Status:  &status,
-	}, nil
+	switch {
+	case meta.MaintenanceWindowID != "":
+		return &graphql2.NotificationState{
+			Details: "Service in maintenance window, not escalated",
+			Status:  &status,
+		}, nil
+	case meta.IncidentID != 0:
+		return &graphql2.NotificationState{
+			Details: fmt.Sprintf("Grouped into incident #%d, not escalated", meta.IncidentID),
+			Status:  &status,
+		}, nil
+	case meta.EPNoSteps:
+		return &graphql2.NotificationState{
+			Details: "No escalation policy steps",
+			Status:  &status,
+		}, nil
+	}
+
+	return nil, nil
 }
 
 func (a *AlertLogEntry) State(ctx context.Context, obj *alertlog.Entry) (*graphql2.NotificationState, error) {
@@ -290,6 +305,7 @@ func (q *Query) Alerts(ctx context.Context, opts *graphql2.AlertSearchOptions) (
 				s.Status = append(s.Status, alert.StatusClosed)
 			}
 		}
+		s.Severity = opts.FilterBySeverity
 		if opts.Sort != nil {
 			switch *opts.Sort {
 			case graphql2.AlertSearchSortStatusID:
@@ -415,6 +431,10 @@ func (m *Mutation) CreateAlert(ctx context.Context, input graphql2.CreateAlertIn
 		a.Dedup = alert.NewUserDedup(*input.Dedup)
 	}
 
+	if input.Severity != nil {
+		a.Severity = *input.Severity
+	}
+
 	var meta map[string]string
 	if input.Meta != nil {
 		meta = make(map[string]string, len(input.Meta))
@@ -600,8 +620,14 @@ func (m *Mutation) EscalateAlerts(ctx context.Context, ids []int) ([]alert.Alert
 }
 
 func (m *Mutation) UpdateAlerts(ctx context.Context, args graphql2.UpdateAlertsInput) ([]alert.Alert, error) {
-	if args.NewStatus != nil && args.NoiseReason != nil {
-		return nil, validation.NewGenericError("cannot set both 'newStatus' and 'noiseReason'")
+	var n int
+	for _, set := range []bool{args.NewStatus != nil, args.NoiseReason != nil, args.SnoozeMinutes != nil} {
+		if set {
+			n++
+		}
+	}
+	if n > 1 {
+		return nil, validation.NewGenericError("only one of 'newStatus', 'noiseReason', or 'snoozeMinutes' may be set")
 	}
 
 	var updatedIDs []int
@@ -633,6 +659,14 @@ func (m *Mutation) UpdateAlerts(ctx context.Context, args graphql2.UpdateAlertsI
 		}
 	}
 
+	if args.SnoozeMinutes != nil {
+		var err error
+		updatedIDs, err = m.AlertStore.SnoozeManyAlerts(ctx, args.AlertIDs, time.Duration(*args.SnoozeMinutes)*time.Minute)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return m.AlertStore.FindMany(ctx, updatedIDs)
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/target/goalert/alert"
 	"github.com/target/goalert/alert/alertlog"
 	"github.com/target/goalert/alert/alertmetrics"
+	"github.com/target/goalert/alert/incident"
 	"github.com/target/goalert/apikey"
 	"github.com/target/goalert/auth"
 	"github.com/target/goalert/auth/authlink"
@@ -38,6 +39,7 @@ import (
 	"github.com/target/goalert/notification/nfydest"
 	"github.com/target/goalert/notification/slack"
 	"github.com/target/goalert/notification/twilio"
+	"github.com/target/goalert/notification/webhook"
 	"github.com/target/goalert/notificationchannel"
 	"github.com/target/goalert/oncall"
 	"github.com/target/goalert/override"
@@ -45,8 +47,12 @@ import (
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/schedule/shiftswap"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/maintenance"
+	"github.com/target/goalert/service/routing"
 	"github.com/target/goalert/swo"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/timezone"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
@@ -56,6 +62,7 @@ import (
 	"github.com/target/goalert/util/errutil"
 	"github.com/target/goalert/util/log"
 	"github.com/target/goalert/validation"
+	"github.com/target/goalert/workload"
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -68,6 +75,7 @@ type App struct {
 	NRStore           *notificationrule.Store
 	NCStore           *notificationchannel.Store
 	AlertStore        *alert.Store
+	IncidentStore     *incident.Store
 	AlertMetricsStore *alertmetrics.Store
 	AlertLogStore     *alertlog.Store
 	ServiceStore      *service.Store
@@ -81,10 +89,15 @@ type App struct {
 	LabelStore        *label.Store
 	RuleStore         *rule.Store
 	OverrideStore     *override.Store
+	ShiftSwapStore    *shiftswap.Store
+	WorkloadStore     *workload.Store
+	TeamStore         *team.Store
 	ConfigStore       *config.Store
 	LimitStore        *limit.Store
 	SlackStore        *slack.ChannelSender
 	HeartbeatStore    *heartbeat.Store
+	MaintStore        *maintenance.Store
+	RoutingStore      *routing.Store
 	NoticeStore       *notice.Store
 	APIKeyStore       *apikey.Store
 
@@ -104,6 +117,8 @@ type App struct {
 	SWO *swo.Manager
 
 	DestReg *nfydest.Registry
+
+	WebhookSecretStore *webhook.SecretStore
 }
 
 type fieldErr struct {
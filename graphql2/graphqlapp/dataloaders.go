@@ -16,6 +16,7 @@ import (
 	"github.com/target/goalert/schedule"
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/util/sqlutil"
@@ -36,6 +37,7 @@ type loaders struct {
 	Rotation                  *dataloader.Loader[string, rotation.Rotation]
 	Schedule                  *dataloader.Loader[string, schedule.Schedule]
 	Service                   *dataloader.Loader[string, service.Service]
+	Team                      *dataloader.Loader[string, team.Team]
 	User                      *dataloader.Loader[string, user.User]
 	CM                        *dataloader.Loader[string, contactmethod.ContactMethod]
 	Heartbeat                 *dataloader.Loader[string, heartbeat.Monitor]
@@ -65,6 +67,7 @@ func (a *App) registerLoaders(ctx context.Context) context.Context {
 		Rotation:                  dataloader.NewStoreLoader(ctx, a.RotationStore.FindMany, func(r rotation.Rotation) string { return r.ID }),
 		Schedule:                  dataloader.NewStoreLoader(ctx, a.ScheduleStore.FindMany, func(s schedule.Schedule) string { return s.ID }),
 		Service:                   dataloader.NewStoreLoader(ctx, a.ServiceStore.FindMany, func(s service.Service) string { return s.ID }),
+		Team:                      dataloader.NewStoreLoader(ctx, a.TeamStore.FindMany, func(t team.Team) string { return t.ID }),
 		User:                      dataloader.NewStoreLoader(ctx, a.UserStore.FindMany, func(u user.User) string { return u.ID }),
 		CM:                        dataloader.NewStoreLoaderWithDB(ctx, a.DB, a.CMStore.FindMany, func(cm contactmethod.ContactMethod) string { return cm.ID.String() }),
 		Heartbeat:                 dataloader.NewStoreLoader(ctx, a.HeartbeatStore.FindMany, func(hb heartbeat.Monitor) string { return hb.ID }),
@@ -127,6 +130,9 @@ func (a *App) closeLoaders(ctx context.Context) {
 	if loader.Service != nil {
 		loader.Service.Close()
 	}
+	if loader.Team != nil {
+		loader.Team.Close()
+	}
 	if loader.User != nil {
 		loader.User.Close()
 	}
@@ -238,6 +244,15 @@ func (app *App) FindOneRotation(ctx context.Context, id string) (*rotation.Rotat
 	return loader.FetchOne(ctx, id)
 }
 
+func (app *App) FindOneTeam(ctx context.Context, id string) (*team.Team, error) {
+	loader := loadersFrom(ctx).Team
+	if loader == nil {
+		return app.TeamStore.FindOne(ctx, id)
+	}
+
+	return loader.FetchOne(ctx, id)
+}
+
 func (app *App) FindOneSchedule(ctx context.Context, id string) (*schedule.Schedule, error) {
 	loader := loadersFrom(ctx).Schedule
 	if loader == nil {
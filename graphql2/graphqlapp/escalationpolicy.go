@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/target/goalert/assignment"
@@ -20,6 +21,8 @@ import (
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/search"
 	"github.com/target/goalert/user"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/timeutil"
 	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 )
@@ -116,6 +119,14 @@ func (m *Mutation) CreateEscalationPolicyStep(ctx context.Context, input graphql
 		}
 		if input.EscalationPolicyID != nil {
 			s.PolicyID = *input.EscalationPolicyID
+			err := m.TeamStore.CheckModifyTx(ctx, tx, assignment.EscalationPolicyTarget(s.PolicyID))
+			if err != nil {
+				return err
+			}
+		}
+		err := setStepConditions(s, input.WeekdayFilter, input.Start, input.End, input.TimeZone, input.Matches)
+		if err != nil {
+			return err
 		}
 
 		step, err = m.PolicyStore.CreateStepTx(ctx, tx, s)
@@ -189,6 +200,11 @@ func (m *Mutation) CreateEscalationPolicy(ctx context.Context, input graphql2.Cr
 			}
 		}
 
+		err = (*App)(m).setOwnerTeam(ctx, tx, assignment.EscalationPolicyTarget(pol.ID), input.TeamID)
+		if err != nil {
+			return err
+		}
+
 		for i, step := range input.Steps {
 			step.EscalationPolicyID = &pol.ID
 			_, err = m.CreateEscalationPolicyStep(ctx, step)
@@ -217,6 +233,10 @@ func (m *Mutation) UpdateEscalationPolicy(ctx context.Context, input graphql2.Up
 		if err != nil {
 			return err
 		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.EscalationPolicyTarget(ep.ID))
+		if err != nil {
+			return err
+		}
 
 		if input.Name != nil {
 			ep.Name = *input.Name
@@ -235,6 +255,11 @@ func (m *Mutation) UpdateEscalationPolicy(ctx context.Context, input graphql2.Up
 			return err
 		}
 
+		err = (*App)(m).setOwnerTeam(ctx, tx, assignment.EscalationPolicyTarget(ep.ID), input.TeamID)
+		if err != nil {
+			return err
+		}
+
 		if input.StepIDs != nil {
 			// get current steps on policy
 			steps, err := m.PolicyStore.FindAllStepsTx(ctx, tx, input.ID)
@@ -288,6 +313,10 @@ func (m *Mutation) UpdateEscalationPolicyStep(ctx context.Context, input graphql
 		if err != nil {
 			return err
 		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.EscalationPolicyTarget(step.PolicyID))
+		if err != nil {
+			return err
+		}
 
 		// update delay if provided
 		if input.DelayMinutes != nil {
@@ -309,6 +338,19 @@ func (m *Mutation) UpdateEscalationPolicyStep(ctx context.Context, input graphql
 			}
 		}
 
+		// update conditions if any are provided
+		if input.WeekdayFilter != nil || input.Start != nil || input.End != nil || input.TimeZone != nil || input.Matches != nil {
+			err = setStepConditions(step, input.WeekdayFilter, input.Start, input.End, input.TimeZone, input.Matches)
+			if err != nil {
+				return err
+			}
+
+			err = m.PolicyStore.UpdateStepConditionsTx(ctx, tx, step)
+			if err != nil {
+				return err
+			}
+		}
+
 		// update targets if provided
 		if input.Actions != nil {
 			// get current actions
@@ -356,6 +398,39 @@ func (m *Mutation) UpdateEscalationPolicyStep(ctx context.Context, input graphql
 	return true, err
 }
 
+// setStepConditions applies any provided condition fields to the step.
+func setStepConditions(s *escalation.Step, wf *timeutil.WeekdayFilter, start, end *timeutil.Clock, tz *string, matches []escalation.StepMatch) error {
+	if wf != nil {
+		s.WeekdayFilter = *wf
+	}
+	if start != nil {
+		s.Start = *start
+	}
+	if end != nil {
+		s.End = *end
+	}
+	if tz != nil {
+		loc, err := util.LoadLocation(*tz)
+		if err != nil {
+			return validation.NewFieldError("timeZone", err.Error())
+		}
+		s.TimeZone = loc
+	}
+	if matches != nil {
+		s.Matches = matches
+	}
+
+	return nil
+}
+
+func (a *EscalationPolicyStep) TimeZone(ctx context.Context, raw *escalation.Step) (string, error) {
+	if raw.TimeZone == nil {
+		return time.UTC.String(), nil
+	}
+
+	return raw.TimeZone.String(), nil
+}
+
 func (a *EscalationPolicyStep) Actions(ctx context.Context, raw *escalation.Step) ([]gadb.DestV1, error) {
 	return a.PolicyStore.FindAllStepActionsTx(ctx, nil, raw.ID)
 }
@@ -432,6 +507,7 @@ func (q *Query) EscalationPolicies(ctx context.Context, opts *graphql2.Escalatio
 		searchOpts.Search = *opts.Search
 	}
 	searchOpts.Omit = opts.Omit
+	searchOpts.TeamIDs = opts.TeamIDs
 	if opts.After != nil && *opts.After != "" {
 		err = search.ParseCursor(*opts.After, &searchOpts)
 		if err != nil {
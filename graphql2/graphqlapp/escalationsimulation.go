@@ -0,0 +1,273 @@
+package graphqlapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation/simulation"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/oncall"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/service/maintenance"
+	"github.com/target/goalert/service/routing"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/user/contactmethod"
+	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/validation/validate"
+)
+
+type SimulatedNotification App
+
+func (a *App) SimulatedNotification() graphql2.SimulatedNotificationResolver {
+	return (*SimulatedNotification)(a)
+}
+
+func (a *SimulatedNotification) User(ctx context.Context, raw *simulation.Notification) (*user.User, error) {
+	if raw.UserID == "" {
+		return nil, nil
+	}
+
+	return (*App)(a).FindOneUser(ctx, raw.UserID)
+}
+
+func (a *SimulatedNotification) ContactMethod(ctx context.Context, raw *simulation.Notification) (*contactmethod.ContactMethod, error) {
+	if raw.ContactMethodID == uuid.Nil {
+		return nil, nil
+	}
+
+	return (*App)(a).FindOneCM(ctx, raw.ContactMethodID)
+}
+
+func (q *Query) SimulateEscalation(ctx context.Context, input graphql2.SimulateEscalationInput) ([]simulation.Notification, error) {
+	a := simulation.Alert{
+		Start:    time.Now(),
+		Severity: alert.DefaultSeverity,
+	}
+	if input.Start != nil {
+		a.Start = *input.Start
+	}
+	a.Start = a.Start.Truncate(time.Minute)
+	dur := 60
+	if input.DurationMinutes != nil {
+		dur = *input.DurationMinutes
+	}
+	if input.Severity != nil {
+		a.Severity = *input.Severity
+	}
+	if input.Metadata != nil {
+		a.Metadata = make(map[string]string, len(input.Metadata))
+		for _, m := range input.Metadata {
+			a.Metadata[m.Key] = m.Value
+		}
+	}
+	var summary, details, source string
+	if input.Summary != nil {
+		summary = *input.Summary
+	}
+	if input.Details != nil {
+		details = *input.Details
+	}
+	if input.Source != nil {
+		source = *input.Source
+	}
+	err := validate.Many(
+		validate.UUID("ServiceID", input.ServiceID),
+		validate.Range("DurationMinutes", dur, 1, 24*60),
+		validate.Text("Summary", summary, 0, alert.MaxSummaryLength),
+		validate.Text("Details", details, 0, alert.MaxDetailsLength),
+		validate.Text("Source", source, 0, 255),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := (*App)(q).FindOneService(ctx, input.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// match the engine: maintenance windows and routing rules are applied before escalation
+	windows, err := q.MaintStore.FindAllByService(ctx, svc.ID)
+	if err != nil {
+		return nil, err
+	}
+	if maintenance.ActiveAt(windows, a.Start) != nil {
+		// the alert would be suppressed, or created without escalation
+		return nil, nil
+	}
+
+	epID := svc.EscalationPolicyID
+	rules, err := q.RoutingStore.FindAllByService(ctx, svc.ID)
+	if err != nil {
+		return nil, err
+	}
+	var m routing.Matcher
+	rule, err := m.Match(rules, routing.Env(summary, details, source, a.Metadata))
+	if err != nil {
+		// a broken rule does not prevent alerts from being created
+		log.Log(ctx, fmt.Errorf("simulate escalation: route alert: %w", err))
+		rule = nil
+	}
+	switch {
+	case rule != nil && rule.Drop:
+		return nil, nil
+	case rule != nil && rule.EscalationPolicyID != "":
+		epID = rule.EscalationPolicyID
+	}
+
+	pol, err := (*App)(q).FindOnePolicy(ctx, epID)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := q.PolicyStore.FindAllStepsTx(ctx, nil, pol.ID)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := q.LabelStore.FindAllByTarget(ctx, q.DB, assignment.ServiceTarget(svc.ID))
+	if err != nil {
+		return nil, err
+	}
+	a.Labels = make(map[string]string, len(labels))
+	for _, l := range labels {
+		a.Labels[l.Key] = l.Value
+	}
+
+	end := a.Start.Add(time.Duration(dur) * time.Minute)
+	src := &simulationSource{
+		App:   (*App)(q),
+		start: a.Start,
+		end:   end,
+
+		shifts: make(map[string][]oncall.Shift),
+		rots:   make(map[string]*oncall.ResolvedRotation),
+	}
+
+	return simulation.Simulate(ctx, src, *pol, steps, a, end)
+}
+
+// simulationSource implements simulation.Source, caching schedule shifts and rotation state
+// for the simulated time span.
+type simulationSource struct {
+	*App
+	start, end time.Time
+
+	shifts map[string][]oncall.Shift
+	rots   map[string]*oncall.ResolvedRotation
+}
+
+func (src *simulationSource) StepActions(ctx context.Context, stepID uuid.UUID) ([]gadb.DestV1, error) {
+	return src.PolicyStore.FindAllStepActionsTx(ctx, nil, stepID)
+}
+
+func (src *simulationSource) NotificationRules(ctx context.Context, userID string) ([]notificationrule.NotificationRule, error) {
+	rules, err := src.NRStore.FindAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	cms, err := src.CMStore.FindAll(ctx, src.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+	disabled := make(map[uuid.UUID]bool, len(cms))
+	for _, cm := range cms {
+		if cm.Disabled {
+			disabled[cm.ID] = true
+		}
+	}
+
+	// messages are never sent to disabled contact methods
+	enabled := rules[:0]
+	for _, r := range rules {
+		if disabled[r.ContactMethodID] {
+			continue
+		}
+		enabled = append(enabled, r)
+	}
+
+	return enabled, nil
+}
+
+func (src *simulationSource) OnCallUserIDs(ctx context.Context, dest gadb.DestV1, t time.Time) ([]string, error) {
+	switch dest.Type {
+	case schedule.DestTypeSchedule:
+		return src.scheduleUserIDs(ctx, dest.Arg(schedule.FieldScheduleID), t)
+	case rotation.DestTypeRotation:
+		return src.rotationUserIDs(ctx, dest.Arg(rotation.FieldRotationID), t)
+	}
+
+	return nil, fmt.Errorf("unsupported destination type %s", dest.Type)
+}
+
+func (src *simulationSource) scheduleUserIDs(ctx context.Context, id string, t time.Time) ([]string, error) {
+	shifts, ok := src.shifts[id]
+	if !ok {
+		var err error
+		shifts, err = src.OnCallStore.HistoryBySchedule(ctx, id, src.start, src.end)
+		if err != nil {
+			return nil, err
+		}
+		src.shifts[id] = shifts
+	}
+
+	var userIDs []string
+	for _, s := range shifts {
+		if t.Before(s.Start) {
+			continue
+		}
+		if !s.End.IsZero() && !t.Before(s.End) {
+			continue
+		}
+		userIDs = append(userIDs, s.UserID)
+	}
+
+	return userIDs, nil
+}
+
+func (src *simulationSource) rotationUserIDs(ctx context.Context, id string, t time.Time) ([]string, error) {
+	rot, ok := src.rots[id]
+	if !ok {
+		r, err := src.RotationStore.FindRotation(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		rot = &oncall.ResolvedRotation{Rotation: *r}
+		state, err := src.RotationStore.State(ctx, id)
+		if errors.Is(err, rotation.ErrNoState) {
+			// no participants
+			src.rots[id] = rot
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rot.CurrentIndex = state.Position
+		rot.CurrentStart = state.ShiftStart
+
+		parts, err := src.RotationStore.FindAllParticipants(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range parts {
+			rot.Users = append(rot.Users, p.Target.TargetID())
+		}
+		if rot.Type == rotation.TypeFollowTheSun {
+			rot.Rotation = rot.WithParticipants(rot.Users)
+		}
+		src.rots[id] = rot
+	}
+
+	userID := rot.UserID(t)
+	if userID == "" {
+		return nil, nil
+	}
+
+	return []string{userID}, nil
+}
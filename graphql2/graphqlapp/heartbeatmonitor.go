@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/heartbeat"
@@ -36,6 +37,12 @@ func (m *Mutation) CreateHeartbeatMonitor(ctx context.Context, input graphql2.Cr
 		serviceID = *input.ServiceID
 	}
 	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		if serviceID != "" {
+			err := m.TeamStore.CheckModifyTx(ctx, tx, assignment.ServiceTarget(serviceID))
+			if err != nil {
+				return err
+			}
+		}
 		var details string
 		if input.AdditionalDetails != nil {
 			details = *input.AdditionalDetails
@@ -58,6 +65,10 @@ func (m *Mutation) UpdateHeartbeatMonitor(ctx context.Context, input graphql2.Up
 		if err != nil {
 			return err
 		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.HeartbeatMonitorTarget(hb.ID))
+		if err != nil {
+			return err
+		}
 		if input.Name != nil {
 			hb.Name = *input.Name
 		}
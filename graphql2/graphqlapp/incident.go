@@ -0,0 +1,54 @@
+package graphqlapp
+
+import (
+	context "context"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/alert/incident"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/validation"
+)
+
+type Incident App
+
+func (a *App) Incident() graphql2.IncidentResolver { return (*Incident)(a) }
+
+func (a *Incident) Status(ctx context.Context, inc *incident.Incident) (graphql2.AlertStatus, error) {
+	return (*Alert)(a).Status(ctx, &alert.Alert{Status: inc.Status})
+}
+
+func (a *Incident) Alerts(ctx context.Context, inc *incident.Incident) ([]alert.Alert, error) {
+	ids, err := a.IncidentStore.AlertIDs(ctx, inc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.AlertStore.FindMany(ctx, ids)
+}
+
+func (a *Alert) Incident(ctx context.Context, raw *alert.Alert) (*incident.Incident, error) {
+	return a.IncidentStore.FindByAlert(ctx, raw.ID)
+}
+
+func (q *Query) Incident(ctx context.Context, id int) (*incident.Incident, error) {
+	return q.IncidentStore.FindOne(ctx, id)
+}
+
+func (m *Mutation) UpdateIncident(ctx context.Context, input graphql2.UpdateIncidentInput) (bool, error) {
+	var status alert.Status
+	switch input.NewStatus {
+	case graphql2.AlertStatusStatusAcknowledged:
+		status = alert.StatusActive
+	case graphql2.AlertStatusStatusClosed:
+		status = alert.StatusClosed
+	default:
+		return false, validation.NewFieldError("newStatus", "must be acknowledged or closed")
+	}
+
+	err := m.IncidentStore.UpdateStatus(ctx, input.ID, status)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
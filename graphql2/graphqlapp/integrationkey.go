@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	"github.com/google/uuid"
+	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/gadb"
 	"github.com/target/goalert/graphql2"
@@ -50,6 +51,10 @@ func (m *Mutation) GenerateKeyToken(ctx context.Context, keyID string) (string,
 	if err != nil {
 		return "", err
 	}
+	err = m.TeamStore.CheckModifyTx(ctx, nil, assignment.IntegrationKeyTarget(keyID))
+	if err != nil {
+		return "", err
+	}
 	return m.IntKeyStore.GenerateToken(ctx, m.DB, id)
 }
 
@@ -58,6 +63,10 @@ func (m *Mutation) DeleteSecondaryToken(ctx context.Context, keyID string) (bool
 	if err != nil {
 		return false, err
 	}
+	err = m.TeamStore.CheckModifyTx(ctx, nil, assignment.IntegrationKeyTarget(keyID))
+	if err != nil {
+		return false, err
+	}
 
 	err = m.IntKeyStore.DeleteSecondaryToken(ctx, m.DB, id)
 	if err != nil {
@@ -72,6 +81,10 @@ func (m *Mutation) PromoteSecondaryToken(ctx context.Context, keyID string) (boo
 	if err != nil {
 		return false, err
 	}
+	err = m.TeamStore.CheckModifyTx(ctx, nil, assignment.IntegrationKeyTarget(keyID))
+	if err != nil {
+		return false, err
+	}
 
 	err = m.IntKeyStore.PromoteSecondaryToken(ctx, m.DB, id)
 	if err != nil {
@@ -104,6 +117,10 @@ func (m *Mutation) UpdateKeyConfig(ctx context.Context, input graphql2.UpdateKey
 		if err != nil {
 			return err
 		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.IntegrationKeyTarget(input.KeyID))
+		if err != nil {
+			return err
+		}
 
 		cfg, err := m.IntKeyStore.Config(ctx, tx, id)
 		if err != nil {
@@ -189,6 +206,12 @@ func (m *Mutation) CreateIntegrationKey(ctx context.Context, input graphql2.Crea
 		serviceID = *input.ServiceID
 	}
 	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		if serviceID != "" {
+			err := m.TeamStore.CheckModifyTx(ctx, tx, assignment.ServiceTarget(serviceID))
+			if err != nil {
+				return err
+			}
+		}
 		key = &integrationkey.IntegrationKey{
 			ServiceID: serviceID,
 			Name:      input.Name,
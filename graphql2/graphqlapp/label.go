@@ -4,6 +4,7 @@ import (
 	context "context"
 	"database/sql"
 
+	"github.com/target/goalert/assignment"
 	"github.com/target/goalert/config"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/label"
@@ -133,6 +134,16 @@ func (q *Query) Labels(ctx context.Context, input *graphql2.LabelSearchOptions)
 }
 func (m *Mutation) SetLabel(ctx context.Context, input graphql2.SetLabelInput) (bool, error) {
 	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		if input.Target != nil {
+			switch input.Target.Type {
+			case assignment.TargetTypeService, assignment.TargetTypeEscalationPolicy, assignment.TargetTypeSchedule, assignment.TargetTypeRotation:
+				err := m.TeamStore.CheckModifyTx(ctx, tx, input.Target)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
 		cfg := config.FromContext(ctx)
 		if cfg.General.DisableLabelCreation {
 			allLabels, err := m.LabelStore.UniqueKeysTx(ctx, tx)
@@ -0,0 +1,146 @@
+package graphqlapp
+
+import (
+	context "context"
+	"database/sql"
+	"time"
+
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/maintenance"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+)
+
+type MaintenanceWindow App
+
+func (a *App) MaintenanceWindow() graphql2.MaintenanceWindowResolver {
+	return (*MaintenanceWindow)(a)
+}
+
+type SuppressedAlert App
+
+func (a *App) SuppressedAlert() graphql2.SuppressedAlertResolver {
+	return (*SuppressedAlert)(a)
+}
+
+func (a *SuppressedAlert) WindowID(ctx context.Context, sa *maintenance.SuppressedAlert) (*string, error) {
+	if sa.WindowID == "" {
+		return nil, nil
+	}
+
+	return &sa.WindowID, nil
+}
+
+func (a *MaintenanceWindow) WeekdayFilter(ctx context.Context, w *maintenance.Window) (*timeutil.WeekdayFilter, error) {
+	if !w.IsRecurring() {
+		return nil, nil
+	}
+
+	return &w.WeekdayFilter, nil
+}
+
+func (a *MaintenanceWindow) TimeZone(ctx context.Context, w *maintenance.Window) (string, error) {
+	return w.TimeZone.String(), nil
+}
+
+func (a *MaintenanceWindow) Active(ctx context.Context, w *maintenance.Window) (bool, error) {
+	return w.ActiveAt(time.Now()), nil
+}
+
+func (s *Service) MaintenanceWindows(ctx context.Context, raw *service.Service) ([]maintenance.Window, error) {
+	return s.MaintStore.FindAllByService(ctx, raw.ID)
+}
+
+func (s *Service) SuppressedAlerts(ctx context.Context, raw *service.Service) ([]maintenance.SuppressedAlert, error) {
+	return s.MaintStore.FindSuppressedByService(ctx, raw.ID)
+}
+
+func (m *Mutation) CreateMaintenanceWindow(ctx context.Context, input graphql2.CreateMaintenanceWindowInput) (w *maintenance.Window, err error) {
+	w = &maintenance.Window{
+		ServiceID: input.ServiceID,
+		Start:     input.Start,
+		End:       input.End,
+	}
+	if input.Description != nil {
+		w.Description = *input.Description
+	}
+	if input.WeekdayFilter != nil {
+		w.WeekdayFilter = *input.WeekdayFilter
+	}
+	if input.TimeZone != nil {
+		w.TimeZone, err = util.LoadLocation(*input.TimeZone)
+		if err != nil {
+			return nil, validation.NewFieldError("timeZone", err.Error())
+		}
+	}
+	if input.SuppressAlerts != nil {
+		w.SuppressAlerts = *input.SuppressAlerts
+	}
+
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		err := m.TeamStore.CheckModifyTx(ctx, tx, assignment.ServiceTarget(w.ServiceID))
+		if err != nil {
+			return err
+		}
+
+		w, err = m.MaintStore.CreateTx(ctx, tx, w)
+		return err
+	})
+	return w, err
+}
+
+func (m *Mutation) UpdateMaintenanceWindow(ctx context.Context, input graphql2.UpdateMaintenanceWindowInput) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		w, err := m.MaintStore.FindOneTx(ctx, tx, input.ID)
+		if err != nil {
+			return err
+		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.ServiceTarget(w.ServiceID))
+		if err != nil {
+			return err
+		}
+		if input.Description != nil {
+			w.Description = *input.Description
+		}
+		if input.Start != nil {
+			w.Start = *input.Start
+		}
+		if input.End != nil {
+			w.End = *input.End
+		}
+		if input.WeekdayFilter != nil {
+			w.WeekdayFilter = *input.WeekdayFilter
+		}
+		if input.TimeZone != nil {
+			w.TimeZone, err = util.LoadLocation(*input.TimeZone)
+			if err != nil {
+				return validation.NewFieldError("timeZone", err.Error())
+			}
+		}
+		if input.SuppressAlerts != nil {
+			w.SuppressAlerts = *input.SuppressAlerts
+		}
+
+		return m.MaintStore.UpdateTx(ctx, tx, w)
+	})
+	return err == nil, err
+}
+
+func (m *Mutation) DeleteMaintenanceWindow(ctx context.Context, id string) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		w, err := m.MaintStore.FindOneTx(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.ServiceTarget(w.ServiceID))
+		if err != nil {
+			return err
+		}
+
+		return m.MaintStore.DeleteTx(ctx, tx, id)
+	})
+	return err == nil, err
+}
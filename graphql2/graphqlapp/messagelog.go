@@ -110,6 +110,8 @@ func msgTypeFriendlyName(msgType gadb.EnumOutgoingMessagesType) string {
 		return "On-Call Notification"
 	case gadb.EnumOutgoingMessagesTypeSignalMessage:
 		return "Signal Message"
+	case gadb.EnumOutgoingMessagesTypeShiftSwapRequest:
+		return "Shift Swap Request"
 	case gadb.EnumOutgoingMessagesTypeAlertStatusUpdateBundle:
 		return "Status Bundle" // deprecated
 	case gadb.EnumOutgoingMessagesTypeTestNotification:
@@ -103,6 +103,11 @@ func (a *Mutation) SetScheduleOnCallNotificationRules(ctx context.Context, input
 	}
 
 	err = withContextTx(ctx, a.DB, func(ctx context.Context, tx *sql.Tx) error {
+		err := a.TeamStore.CheckModifyTx(ctx, tx, assignment.ScheduleTarget(schedID.String()))
+		if err != nil {
+			return err
+		}
+
 		rules := make([]schedule.OnCallNotificationRule, 0, len(input.Rules))
 		for _, r := range input.Rules {
 			info, err := a.DestReg.TypeInfo(ctx, r.Dest.Type)
@@ -153,6 +158,11 @@ func (a *Mutation) SetTemporarySchedule(ctx context.Context, input graphql2.SetT
 	}
 
 	err = withContextTx(ctx, a.DB, func(ctx context.Context, tx *sql.Tx) error {
+		err := a.TeamStore.CheckModifyTx(ctx, tx, assignment.ScheduleTarget(schedID.String()))
+		if err != nil {
+			return err
+		}
+
 		if clearSet {
 			return a.ScheduleStore.SetClearTemporarySchedule(ctx, tx, schedID, tmp, *input.ClearStart, *input.ClearEnd)
 		}
@@ -170,6 +180,11 @@ func (a *Mutation) ClearTemporarySchedules(ctx context.Context, input graphql2.C
 	}
 
 	err = withContextTx(ctx, a.DB, func(ctx context.Context, tx *sql.Tx) error {
+		err := a.TeamStore.CheckModifyTx(ctx, tx, assignment.ScheduleTarget(schedID.String()))
+		if err != nil {
+			return err
+		}
+
 		return a.ScheduleStore.ClearTemporarySchedules(ctx, tx, schedID, input.Start, input.End)
 	})
 
@@ -231,8 +246,19 @@ func (a *Mutation) tryDeleteAll(ctx context.Context, input []assignment.RawTarge
 	defer sqlutil.Rollback(ctx, "graphql: delete all", tx)
 
 	m := make(map[assignment.TargetType][]string)
+	var teamOwned []assignment.Target
 	for _, tgt := range input {
 		m[tgt.TargetType()] = append(m[tgt.TargetType()], tgt.TargetID())
+		switch tgt.TargetType() {
+		case assignment.TargetTypeService, assignment.TargetTypeEscalationPolicy, assignment.TargetTypeSchedule,
+			assignment.TargetTypeRotation, assignment.TargetTypeIntegrationKey, assignment.TargetTypeHeartbeatMonitor:
+			teamOwned = append(teamOwned, tgt)
+		}
+	}
+
+	err = a.TeamStore.CheckModifyTx(ctx, tx, teamOwned...)
+	if err != nil {
+		return err
 	}
 
 	order := []assignment.TargetType{
@@ -248,6 +274,7 @@ func (a *Mutation) tryDeleteAll(ctx context.Context, input []assignment.RawTarge
 		assignment.TargetTypeNotificationRule,
 		assignment.TargetTypeContactMethod,
 		assignment.TargetTypeUserSession,
+		assignment.TargetTypeTeam,
 	}
 
 	for _, typ := range order {
@@ -280,6 +307,8 @@ func (a *Mutation) tryDeleteAll(ctx context.Context, input []assignment.RawTarge
 			err = errors.Wrap(a.HeartbeatStore.DeleteTx(ctx, tx, ids...), "delete heartbeat monitors")
 		case assignment.TargetTypeUserSession:
 			err = errors.Wrap(a.AuthHandler.EndUserSessionTx(ctx, tx, ids...), "end user sessions")
+		case assignment.TargetTypeTeam:
+			err = errors.Wrap(a.TeamStore.DeleteManyTx(ctx, tx, ids), "delete teams")
 		default:
 			return validation.NewFieldError("type", "unsupported type "+typ.String())
 		}
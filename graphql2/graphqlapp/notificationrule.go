@@ -3,10 +3,14 @@ package graphqlapp
 import (
 	context "context"
 	"database/sql"
+	"time"
 
+	"github.com/target/goalert/alert"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/user/contactmethod"
 	"github.com/target/goalert/user/notificationrule"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/validation"
 	"github.com/target/goalert/validation/validate"
 )
 
@@ -20,6 +24,25 @@ func (m *Mutation) CreateUserNotificationRule(ctx context.Context, input graphql
 	nr := &notificationrule.NotificationRule{
 		DelayMinutes: input.DelayMinutes,
 	}
+	if input.MinSeverity != nil {
+		nr.MinSeverity = *input.MinSeverity
+	}
+	if input.WeekdayFilter != nil {
+		nr.WeekdayFilter = *input.WeekdayFilter
+	}
+	if input.Start != nil {
+		nr.Start = *input.Start
+	}
+	if input.End != nil {
+		nr.End = *input.End
+	}
+	if input.TimeZone != nil {
+		var err error
+		nr.TimeZone, err = util.LoadLocation(*input.TimeZone)
+		if err != nil {
+			return nil, validation.NewFieldError("timeZone", err.Error())
+		}
+	}
 
 	if input.UserID != nil {
 		nr.UserID = *input.UserID
@@ -48,3 +71,23 @@ func (m *Mutation) CreateUserNotificationRule(ctx context.Context, input graphql
 func (nr *UserNotificationRule) ContactMethod(ctx context.Context, raw *notificationrule.NotificationRule) (*contactmethod.ContactMethod, error) {
 	return (*App)(nr).FindOneCM(ctx, raw.ContactMethodID)
 }
+
+func (nr *UserNotificationRule) MinSeverity(ctx context.Context, raw *notificationrule.NotificationRule) (*alert.Severity, error) {
+	if raw.MinSeverity == "" {
+		return nil, nil
+	}
+
+	return &raw.MinSeverity, nil
+}
+
+func (nr *UserNotificationRule) TimeZone(ctx context.Context, raw *notificationrule.NotificationRule) (string, error) {
+	if raw.TimeZone == nil {
+		return time.UTC.String(), nil
+	}
+
+	return raw.TimeZone.String(), nil
+}
+
+func (nr *UserNotificationRule) Active(ctx context.Context, raw *notificationrule.NotificationRule) (bool, error) {
+	return raw.ActiveAt(time.Now()), nil
+}
@@ -21,9 +21,26 @@ import (
 	"github.com/pkg/errors"
 )
 
-type Rotation App
+type (
+	Rotation       App
+	RotationRegion App
+)
+
+func (a *App) Rotation() graphql2.RotationResolver             { return (*Rotation)(a) }
+func (a *App) RotationRegion() graphql2.RotationRegionResolver { return (*RotationRegion)(a) }
+
+func (r *RotationRegion) Users(ctx context.Context, reg *rotation.Region) ([]user.User, error) {
+	users := make([]user.User, 0, len(reg.UserIDs))
+	for _, id := range reg.UserIDs {
+		u, err := (*App)(r).FindOneUser(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *u)
+	}
 
-func (a *App) Rotation() graphql2.RotationResolver { return (*Rotation)(a) }
+	return users, nil
+}
 
 func (q *Query) Rotation(ctx context.Context, id string) (*rotation.Rotation, error) {
 	return (*App)(q).FindOneRotation(ctx, id)
@@ -36,9 +53,10 @@ func (m *Mutation) CreateRotation(ctx context.Context, input graphql2.CreateRota
 	}
 	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
 		rot := &rotation.Rotation{
-			Name:  input.Name,
-			Type:  input.Type,
-			Start: input.Start.In(loc),
+			Name:    input.Name,
+			Type:    input.Type,
+			Start:   input.Start.In(loc),
+			Regions: input.Regions,
 		}
 		if input.Description != nil {
 			rot.Description = *input.Description
@@ -59,6 +77,14 @@ func (m *Mutation) CreateRotation(ctx context.Context, input graphql2.CreateRota
 			}
 		}
 
+		err = (*App)(m).setOwnerTeam(ctx, tx, assignment.RotationTarget(result.ID), input.TeamID)
+		if err != nil {
+			return err
+		}
+
+		if input.UserIDs == nil && input.Regions != nil {
+			input.UserIDs = result.RegionUserIDs()
+		}
 		if input.UserIDs != nil {
 			err := m.RotationStore.AddRotationUsersTx(ctx, tx, result.ID, input.UserIDs)
 			if err != nil {
@@ -209,6 +235,7 @@ func (q *Query) Rotations(ctx context.Context, opts *graphql2.RotationSearchOpti
 		searchOpts.FavoritesOnly = *opts.FavoritesOnly
 	}
 	searchOpts.Omit = opts.Omit
+	searchOpts.TeamIDs = opts.TeamIDs
 	if opts.After != nil && *opts.After != "" {
 		err = search.ParseCursor(*opts.After, &searchOpts)
 		if err != nil {
@@ -329,6 +356,10 @@ func (m *Mutation) UpdateRotation(ctx context.Context, input graphql2.UpdateRota
 		if err != nil {
 			return err
 		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.RotationTarget(result.ID))
+		if err != nil {
+			return err
+		}
 		var update bool
 		if input.Name != nil {
 			update = true
@@ -350,6 +381,13 @@ func (m *Mutation) UpdateRotation(ctx context.Context, input graphql2.UpdateRota
 			update = true
 			result.ShiftLength = *input.ShiftLength
 		}
+		if input.Regions != nil {
+			update = true
+			result.Regions = input.Regions
+			if input.UserIDs == nil {
+				input.UserIDs = result.RegionUserIDs()
+			}
+		}
 
 		if input.TimeZone != nil {
 			update = true
@@ -374,6 +412,11 @@ func (m *Mutation) UpdateRotation(ctx context.Context, input graphql2.UpdateRota
 			}
 		}
 
+		err = (*App)(m).setOwnerTeam(ctx, tx, assignment.RotationTarget(input.ID), input.TeamID)
+		if err != nil {
+			return err
+		}
+
 		// Update active participant (in rotation state) if specified by input
 		// This should be applicable regardless of whether or not 'UserIDs' as an input has been specified.
 		if input.ActiveUserIndex != nil {
@@ -0,0 +1,59 @@
+package graphqlapp
+
+import (
+	context "context"
+	"database/sql"
+
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/service/routing"
+)
+
+type ServiceRoutingRule App
+
+func (a *App) ServiceRoutingRule() graphql2.ServiceRoutingRuleResolver {
+	return (*ServiceRoutingRule)(a)
+}
+
+func (a *ServiceRoutingRule) EscalationPolicy(ctx context.Context, r *routing.Rule) (*escalation.Policy, error) {
+	if r.EscalationPolicyID == "" {
+		return nil, nil
+	}
+
+	return (*App)(a).FindOnePolicy(ctx, r.EscalationPolicyID)
+}
+
+func (s *Service) RoutingRules(ctx context.Context, raw *service.Service) ([]routing.Rule, error) {
+	return s.RoutingStore.FindAllByService(ctx, raw.ID)
+}
+
+func (m *Mutation) SetServiceRoutingRules(ctx context.Context, input graphql2.SetServiceRoutingRulesInput) (bool, error) {
+	rules := make([]routing.Rule, len(input.Rules))
+	for i, r := range input.Rules {
+		rules[i] = routing.Rule{
+			Name:      r.Name,
+			Condition: r.Condition,
+		}
+		if r.EscalationPolicyID != nil {
+			rules[i].EscalationPolicyID = *r.EscalationPolicyID
+		}
+		if r.Priority != nil {
+			rules[i].Priority = *r.Priority
+		}
+		if r.Drop != nil {
+			rules[i].Drop = *r.Drop
+		}
+	}
+
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		err := m.TeamStore.CheckModifyTx(ctx, tx, assignment.ServiceTarget(input.ServiceID))
+		if err != nil {
+			return err
+		}
+
+		return m.RoutingStore.SetRulesTx(ctx, tx, input.ServiceID, rules)
+	})
+	return err == nil, err
+}
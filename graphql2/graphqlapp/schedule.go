@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/config"
 	"github.com/target/goalert/gadb"
 	"github.com/target/goalert/graphql2"
 	"github.com/target/goalert/label"
@@ -123,6 +124,31 @@ func (s *Schedule) Shifts(ctx context.Context, raw *schedule.Schedule, start, en
 	return shifts, nil
 }
 
+func (s *Schedule) CoverageGaps(ctx context.Context, raw *schedule.Schedule, start, end *time.Time) ([]oncall.Gap, error) {
+	cfg := config.FromContext(ctx)
+	window := 7 * 24 * time.Hour
+	if cfg.Schedules.CoverageCheckHours > 0 {
+		window = time.Duration(cfg.Schedules.CoverageCheckHours) * time.Hour
+	}
+
+	startTime := time.Now()
+	if start != nil {
+		startTime = *start
+	}
+	endTime := startTime.Add(window)
+	if end != nil {
+		endTime = *end
+	}
+	if endTime.Before(startTime) {
+		return nil, validation.NewFieldError("end", "must be after start")
+	}
+	if endTime.After(startTime.AddDate(0, 0, 50)) {
+		return nil, validation.NewFieldError("end", "cannot be more than 50 days past start")
+	}
+
+	return s.OnCallStore.CoverageGapsBySchedule(ctx, raw.ID, startTime, endTime)
+}
+
 func (s *Schedule) TemporarySchedules(ctx context.Context, raw *schedule.Schedule) ([]schedule.TemporarySchedule, error) {
 	id, err := parseUUID("ScheduleID", raw.ID)
 	if err != nil {
@@ -212,6 +238,10 @@ func (m *Mutation) UpdateSchedule(ctx context.Context, input graphql2.UpdateSche
 		if err != nil {
 			return err
 		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.ScheduleTarget(sched.ID))
+		if err != nil {
+			return err
+		}
 		if input.Name != nil {
 			sched.Name = *input.Name
 		}
@@ -223,7 +253,12 @@ func (m *Mutation) UpdateSchedule(ctx context.Context, input graphql2.UpdateSche
 			sched.TimeZone = loc
 		}
 
-		return m.ScheduleStore.UpdateTx(ctx, tx, sched)
+		err = m.ScheduleStore.UpdateTx(ctx, tx, sched)
+		if err != nil {
+			return err
+		}
+
+		return (*App)(m).setOwnerTeam(ctx, tx, assignment.ScheduleTarget(sched.ID), input.TeamID)
 	})
 
 	return err == nil, err
@@ -285,6 +320,10 @@ func (m *Mutation) CreateSchedule(ctx context.Context, input graphql2.CreateSche
 				return err
 			}
 		}
+		err = (*App)(m).setOwnerTeam(ctx, tx, assignment.ScheduleTarget(sched.ID), input.TeamID)
+		if err != nil {
+			return err
+		}
 		for i := range input.Targets {
 			if input.Targets[i].NewRotation == nil {
 				continue
@@ -348,6 +387,7 @@ func (q *Query) Schedules(ctx context.Context, opts *graphql2.ScheduleSearchOpti
 		searchOpts.FavoritesFirst = *opts.FavoritesFirst
 	}
 	searchOpts.Omit = opts.Omit
+	searchOpts.TeamIDs = opts.TeamIDs
 	if opts.After != nil && *opts.After != "" {
 		err = search.ParseCursor(*opts.After, &searchOpts)
 		if err != nil {
@@ -45,6 +45,10 @@ func (m *Mutation) UpdateScheduleTarget(ctx context.Context, input graphql2.Sche
 		if err != nil {
 			return errors.Wrap(err, "lock schedule")
 		}
+		err = m.TeamStore.CheckModifyTx(ctx, tx, assignment.ScheduleTarget(schedID))
+		if err != nil {
+			return err
+		}
 
 		rules, err := m.RuleStore.FindByTargetTx(ctx, tx, schedID, input.Target)
 		if err != nil {
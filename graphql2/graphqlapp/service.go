@@ -55,6 +55,7 @@ func (q *Query) Services(ctx context.Context, opts *graphql2.ServiceSearchOption
 	}
 	searchOpts.Omit = opts.Omit
 	searchOpts.Only = opts.Only
+	searchOpts.TeamIDs = opts.TeamIDs
 	if opts.After != nil && *opts.After != "" {
 		err = search.ParseCursor(*opts.After, &searchOpts)
 		if err != nil {
@@ -249,6 +250,11 @@ func (m *Mutation) CreateService(ctx context.Context, input graphql2.CreateServi
 			}
 		}
 
+		err = (*App)(m).setOwnerTeam(ctx, tx, assignment.ServiceTarget(result.ID), input.TeamID)
+		if err != nil {
+			return err
+		}
+
 		err = validate.Many(
 			validate.Range("NewIntegrationKeys", len(input.NewIntegrationKeys), 0, 5),
 			validate.Range("Labels", len(input.Labels), 0, 5),
@@ -298,6 +304,10 @@ func (a *Mutation) UpdateService(ctx context.Context, input graphql2.UpdateServi
 	if err != nil {
 		return false, err
 	}
+	err = a.TeamStore.CheckModifyTx(ctx, tx, assignment.ServiceTarget(svc.ID))
+	if err != nil {
+		return false, err
+	}
 
 	if input.Name != nil {
 		svc.Name = *input.Name
@@ -318,6 +328,11 @@ func (a *Mutation) UpdateService(ctx context.Context, input graphql2.UpdateServi
 		return false, err
 	}
 
+	err = (*App)(a).setOwnerTeam(ctx, tx, assignment.ServiceTarget(svc.ID), input.TeamID)
+	if err != nil {
+		return false, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return false, err
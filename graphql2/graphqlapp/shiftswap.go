@@ -0,0 +1,120 @@
+package graphqlapp
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/shiftswap"
+	"github.com/target/goalert/user"
+)
+
+type ShiftSwapRequest App
+
+func (a *App) ShiftSwapRequest() graphql2.ShiftSwapRequestResolver { return (*ShiftSwapRequest)(a) }
+
+func (a *ShiftSwapRequest) Schedule(ctx context.Context, raw *shiftswap.Request) (*schedule.Schedule, error) {
+	return (*App)(a).FindOneSchedule(ctx, raw.ScheduleID)
+}
+
+func (a *ShiftSwapRequest) FromUser(ctx context.Context, raw *shiftswap.Request) (*user.User, error) {
+	return (*App)(a).FindOneUser(ctx, raw.FromUserID)
+}
+
+func (a *ShiftSwapRequest) ToUser(ctx context.Context, raw *shiftswap.Request) (*user.User, error) {
+	return (*App)(a).FindOneUser(ctx, raw.ToUserID)
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func (a *ShiftSwapRequest) TradeStart(ctx context.Context, raw *shiftswap.Request) (*time.Time, error) {
+	return timePtr(raw.TradeStart), nil
+}
+
+func (a *ShiftSwapRequest) TradeEnd(ctx context.Context, raw *shiftswap.Request) (*time.Time, error) {
+	return timePtr(raw.TradeEnd), nil
+}
+
+func (a *ShiftSwapRequest) ResolvedAt(ctx context.Context, raw *shiftswap.Request) (*time.Time, error) {
+	return timePtr(raw.ResolvedAt), nil
+}
+
+func (s *Schedule) ShiftSwapRequests(ctx context.Context, raw *schedule.Schedule, statuses []shiftswap.Status) ([]shiftswap.Request, error) {
+	return s.ShiftSwapStore.FindManyBySchedule(ctx, raw.ID, statuses)
+}
+
+func (m *Mutation) CreateShiftSwapRequest(ctx context.Context, input graphql2.CreateShiftSwapRequestInput) (r *shiftswap.Request, err error) {
+	req := &shiftswap.Request{
+		ScheduleID: input.ScheduleID,
+		FromUserID: permission.UserID(ctx),
+		ToUserID:   input.ToUserID,
+		Start:      input.Start,
+		End:        input.End,
+	}
+	if input.FromUserID != nil {
+		req.FromUserID = *input.FromUserID
+	}
+	if input.TradeStart != nil {
+		req.TradeStart = *input.TradeStart
+	}
+	if input.TradeEnd != nil {
+		req.TradeEnd = *input.TradeEnd
+	}
+	if input.Note != nil {
+		req.Note = *input.Note
+	}
+
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		r, err = m.ShiftSwapStore.CreateTx(ctx, tx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (m *Mutation) AcceptShiftSwapRequest(ctx context.Context, id string) (r *shiftswap.Request, err error) {
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		r, err = m.ShiftSwapStore.AcceptTx(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (m *Mutation) DeclineShiftSwapRequest(ctx context.Context, id string) (r *shiftswap.Request, err error) {
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		r, err = m.ShiftSwapStore.DeclineTx(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (m *Mutation) CancelShiftSwapRequest(ctx context.Context, id string) (r *shiftswap.Request, err error) {
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		r, err = m.ShiftSwapStore.CancelTx(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,204 @@
+package graphqlapp
+
+import (
+	context "context"
+	"database/sql"
+
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/search"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/team"
+	"github.com/target/goalert/user"
+)
+
+type (
+	Team       App
+	TeamMember App
+)
+
+func (a *App) Team() graphql2.TeamResolver             { return (*Team)(a) }
+func (a *App) TeamMember() graphql2.TeamMemberResolver { return (*TeamMember)(a) }
+
+// findOwnerTeam returns the team that owns the target, or nil if it is not owned by a team.
+func (a *App) findOwnerTeam(ctx context.Context, tgt assignment.Target) (*team.Team, error) {
+	id, err := a.TeamStore.FindOwner(ctx, tgt)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	return a.FindOneTeam(ctx, id)
+}
+
+// setOwnerTeam sets the team that owns the target if teamID is provided.
+func (a *App) setOwnerTeam(ctx context.Context, tx *sql.Tx, tgt assignment.Target, teamID *string) error {
+	if teamID == nil {
+		return nil
+	}
+
+	return a.TeamStore.SetOwnerTx(ctx, tx, tgt, *teamID)
+}
+
+func (s *Service) Team(ctx context.Context, svc *service.Service) (*team.Team, error) {
+	return (*App)(s).findOwnerTeam(ctx, assignment.ServiceTarget(svc.ID))
+}
+
+func (ep *EscalationPolicy) Team(ctx context.Context, pol *escalation.Policy) (*team.Team, error) {
+	return (*App)(ep).findOwnerTeam(ctx, assignment.EscalationPolicyTarget(pol.ID))
+}
+
+func (s *Schedule) Team(ctx context.Context, sched *schedule.Schedule) (*team.Team, error) {
+	return (*App)(s).findOwnerTeam(ctx, assignment.ScheduleTarget(sched.ID))
+}
+
+func (r *Rotation) Team(ctx context.Context, rot *rotation.Rotation) (*team.Team, error) {
+	return (*App)(r).findOwnerTeam(ctx, assignment.RotationTarget(rot.ID))
+}
+
+func (t *Team) IsFavorite(ctx context.Context, tm *team.Team) (bool, error) {
+	return tm.IsUserFavorite(), nil
+}
+
+func (t *Team) Members(ctx context.Context, tm *team.Team) ([]team.Member, error) {
+	return t.TeamStore.FindMembers(ctx, tm.ID)
+}
+
+func (t *TeamMember) User(ctx context.Context, m *team.Member) (*user.User, error) {
+	return (*App)(t).FindOneUser(ctx, m.UserID)
+}
+
+func (q *Query) Team(ctx context.Context, id string) (*team.Team, error) {
+	return (*App)(q).FindOneTeam(ctx, id)
+}
+
+func (q *Query) Teams(ctx context.Context, opts *graphql2.TeamSearchOptions) (conn *graphql2.TeamConnection, err error) {
+	if opts == nil {
+		opts = &graphql2.TeamSearchOptions{}
+	}
+
+	var searchOpts team.SearchOptions
+	searchOpts.FavoritesUserID = permission.UserID(ctx)
+	if opts.Search != nil {
+		searchOpts.Search = *opts.Search
+	}
+	if opts.MyTeamsOnly != nil && *opts.MyTeamsOnly {
+		searchOpts.MemberUserID = permission.UserID(ctx)
+	}
+	if opts.FavoritesFirst != nil {
+		searchOpts.FavoritesFirst = *opts.FavoritesFirst
+	}
+	if opts.FavoritesOnly != nil {
+		searchOpts.FavoritesOnly = *opts.FavoritesOnly
+	}
+	searchOpts.Omit = opts.Omit
+	if opts.After != nil && *opts.After != "" {
+		err = search.ParseCursor(*opts.After, &searchOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.First != nil {
+		searchOpts.Limit = *opts.First
+	}
+	if searchOpts.Limit == 0 {
+		searchOpts.Limit = 15
+	}
+
+	searchOpts.Limit++
+	teams, err := q.TeamStore.Search(ctx, &searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	conn = new(graphql2.TeamConnection)
+	conn.PageInfo = &graphql2.PageInfo{}
+	if len(teams) == searchOpts.Limit {
+		teams = teams[:len(teams)-1]
+		conn.PageInfo.HasNextPage = true
+	}
+	if len(teams) > 0 {
+		last := teams[len(teams)-1]
+		searchOpts.After.IsFavorite = last.IsUserFavorite()
+		searchOpts.After.Name = last.Name
+
+		cur, err := search.Cursor(searchOpts)
+		if err != nil {
+			return conn, err
+		}
+		conn.PageInfo.EndCursor = &cur
+	}
+	conn.Nodes = teams
+	return conn, err
+}
+
+func (m *Mutation) CreateTeam(ctx context.Context, input graphql2.CreateTeamInput) (result *team.Team, err error) {
+	err = withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		t := &team.Team{Name: input.Name}
+		if input.Description != nil {
+			t.Description = *input.Description
+		}
+
+		result, err = m.TeamStore.CreateTx(ctx, tx, t)
+		if err != nil {
+			return err
+		}
+
+		if input.Favorite != nil && *input.Favorite {
+			err = m.FavoriteStore.Set(ctx, tx, permission.UserID(ctx), assignment.TeamTarget(result.ID))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (m *Mutation) UpdateTeam(ctx context.Context, input graphql2.UpdateTeamInput) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		t, err := m.TeamStore.FindOne(ctx, input.ID)
+		if err != nil {
+			return err
+		}
+		if input.Name != nil {
+			t.Name = *input.Name
+		}
+		if input.Description != nil {
+			t.Description = *input.Description
+		}
+
+		return m.TeamStore.UpdateTx(ctx, tx, t)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) SetTeamMember(ctx context.Context, input graphql2.SetTeamMemberInput) (bool, error) {
+	err := withContextTx(ctx, m.DB, func(ctx context.Context, tx *sql.Tx) error {
+		if input.Role == nil {
+			return m.TeamStore.RemoveMemberTx(ctx, tx, input.TeamID, input.UserID)
+		}
+
+		return m.TeamStore.SetMemberTx(ctx, tx, team.Member{
+			TeamID: input.TeamID,
+			UserID: input.UserID,
+			Role:   *input.Role,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
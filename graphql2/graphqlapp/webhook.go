@@ -0,0 +1,13 @@
+package graphqlapp
+
+import (
+	"context"
+)
+
+func (q *Query) WebhookSigningSecret(ctx context.Context, url string) (string, error) {
+	return q.WebhookSecretStore.SigningSecret(ctx, url)
+}
+
+func (m *Mutation) ResetWebhookSigningSecret(ctx context.Context, url string) (string, error) {
+	return m.WebhookSecretStore.ResetSigningSecret(ctx, url)
+}
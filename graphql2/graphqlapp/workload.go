@@ -0,0 +1,74 @@
+package graphqlapp
+
+import (
+	context "context"
+	"time"
+
+	"github.com/target/goalert/graphql2"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/workload"
+)
+
+type (
+	OnCallWorkloadReport App
+	OnCallWorkloadStats  App
+)
+
+func (a *App) OnCallWorkloadReport() graphql2.OnCallWorkloadReportResolver {
+	return (*OnCallWorkloadReport)(a)
+}
+func (a *App) OnCallWorkloadStats() graphql2.OnCallWorkloadStatsResolver {
+	return (*OnCallWorkloadStats)(a)
+}
+
+func (q *Query) OnCallWorkloadReport(ctx context.Context, input *graphql2.OnCallWorkloadReportInput) (*workload.Report, error) {
+	if input == nil {
+		input = &graphql2.OnCallWorkloadReportInput{}
+	}
+
+	opts := workload.DefaultOptions(time.Now())
+	if input.End != nil {
+		opts = workload.DefaultOptions(*input.End)
+	}
+	if input.Start != nil {
+		opts.Start = *input.Start
+	}
+	if input.TimeZone != nil {
+		loc, err := util.LoadLocation(*input.TimeZone)
+		if err != nil {
+			return nil, validation.NewFieldError("timeZone", err.Error())
+		}
+		opts.TimeZone = loc
+	}
+	if input.WorkdayStart != nil {
+		opts.WorkdayStart = *input.WorkdayStart
+	}
+	if input.WorkdayEnd != nil {
+		opts.WorkdayEnd = *input.WorkdayEnd
+	}
+	if input.NightStart != nil {
+		opts.NightStart = *input.NightStart
+	}
+	if input.NightEnd != nil {
+		opts.NightEnd = *input.NightEnd
+	}
+
+	return q.WorkloadStore.Report(ctx, opts)
+}
+
+func (r *OnCallWorkloadReport) TimeZone(ctx context.Context, rep *workload.Report) (string, error) {
+	return rep.TimeZone.String(), nil
+}
+
+func (s *OnCallWorkloadStats) OnCallHours(ctx context.Context, st *workload.Stats) (float64, error) {
+	return st.OnCall.Hours(), nil
+}
+
+func (s *OnCallWorkloadStats) OffHoursOnCallHours(ctx context.Context, st *workload.Stats) (float64, error) {
+	return st.OffHoursOnCall.Hours(), nil
+}
+
+func (s *OnCallWorkloadStats) Acknowledgements(ctx context.Context, st *workload.Stats) (int, error) {
+	return st.Acks, nil
+}
@@ -15,6 +15,9 @@ func MapConfigHints(cfg config.Hints) []ConfigHint {
 	return []ConfigHint{
 		{ID: "GitHub.AuthCallbackURL", Value: cfg.GitHub.AuthCallbackURL},
 		{ID: "OIDC.RedirectURL", Value: cfg.OIDC.RedirectURL},
+		{ID: "SAML.MetadataURL", Value: cfg.SAML.MetadataURL},
+		{ID: "SAML.ACSURL", Value: cfg.SAML.ACSURL},
+		{ID: "SCIM.BaseURL", Value: cfg.SCIM.BaseURL},
 		{ID: "Mailgun.ForwardURL", Value: cfg.Mailgun.ForwardURL},
 		{ID: "Twilio.MessageWebhookURL", Value: cfg.Twilio.MessageWebhookURL},
 		{ID: "Twilio.VoiceWebhookURL", Value: cfg.Twilio.VoiceWebhookURL},
@@ -35,11 +38,18 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "General.DisableLabelCreation", Type: ConfigTypeBoolean, Description: "Disables the ability to create new labels for services.", Value: fmt.Sprintf("%t", cfg.General.DisableLabelCreation)},
 		{ID: "General.DisableCalendarSubscriptions", Type: ConfigTypeBoolean, Description: "If set, disables all active calendar subscriptions as well as the ability to create new calendar subscriptions.", Value: fmt.Sprintf("%t", cfg.General.DisableCalendarSubscriptions)},
 		{ID: "Services.RequiredLabels", Type: ConfigTypeStringList, Description: "List of label names to require new services to define.", Value: strings.Join(cfg.Services.RequiredLabels, "\n")},
+		{ID: "Schedules.CoverageCheckHours", Type: ConfigTypeInteger, Description: "Schedules are checked this many hours ahead for gaps where nobody is on-call (0 means disable the check).", Value: fmt.Sprintf("%d", cfg.Schedules.CoverageCheckHours)},
+		{ID: "Schedules.CoverageAlertServiceID", Type: ConfigTypeString, Description: "If set, an alert is created on this service for each schedule with a gap in on-call coverage, and closed once the gap is resolved.", Value: cfg.Schedules.CoverageAlertServiceID},
 		{ID: "Maintenance.AlertCleanupDays", Type: ConfigTypeInteger, Description: "Closed alerts will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertCleanupDays)},
 		{ID: "Maintenance.AlertAutoCloseDays", Type: ConfigTypeInteger, Description: "Unacknowledged alerts will automatically be closed after this many days of inactivity. (0 means disable auto-close).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertAutoCloseDays)},
 		{ID: "Maintenance.AutoCloseAckedAlerts", Type: ConfigTypeBoolean, Description: "If set, alerts that are acknowledged will also be automatically closed after the configured number of days of inactivity.", Value: fmt.Sprintf("%t", cfg.Maintenance.AutoCloseAckedAlerts)},
 		{ID: "Maintenance.APIKeyExpireDays", Type: ConfigTypeInteger, Description: "Unused calendar API keys will be disabled after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.APIKeyExpireDays)},
 		{ID: "Maintenance.ScheduleCleanupDays", Type: ConfigTypeInteger, Description: "Schedule on-call history will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.ScheduleCleanupDays)},
+		{ID: "Incidents.Enable", Type: ConfigTypeBoolean, Description: "Group related new alerts into incidents. Only the first alert of an incident is escalated; acknowledging or closing it (or the incident) applies to every alert in the incident.", Value: fmt.Sprintf("%t", cfg.Incidents.Enable)},
+		{ID: "Incidents.WindowMinutes", Type: ConfigTypeInteger, Description: "New alerts are added to a matching open incident if it was created within this many minutes (default 30).", Value: fmt.Sprintf("%d", cfg.Incidents.WindowMinutes)},
+		{ID: "Incidents.LabelKeys", Type: ConfigTypeStringList, Description: "Group alerts from services that have the same value for any of these label keys.", Value: strings.Join(cfg.Incidents.LabelKeys, "\n")},
+		{ID: "Incidents.DedupPrefixes", Type: ConfigTypeStringList, Description: "Group alerts whose dedup key starts with any of these prefixes (one incident per prefix).", Value: strings.Join(cfg.Incidents.DedupPrefixes, "\n")},
+		{ID: "Incidents.Expressions", Type: ConfigTypeStringList, Description: "List of 'name=expression' pairs. Alerts matching the same expression are grouped into an incident with that name. Expressions have access to 'alert' and 'service'.", Value: strings.Join(cfg.Incidents.Expressions, "\n")},
 		{ID: "Auth.RefererURLs", Type: ConfigTypeStringList, Description: "Allowed referer URLs for auth and redirects.", Value: strings.Join(cfg.Auth.RefererURLs, "\n"), Deprecated: "Use --public-url flag instead, which takes precedence."},
 		{ID: "Auth.DisableBasic", Type: ConfigTypeBoolean, Description: "Disallow username/password login.", Value: fmt.Sprintf("%t", cfg.Auth.DisableBasic)},
 		{ID: "GitHub.Enable", Type: ConfigTypeBoolean, Description: "Enable GitHub authentication.", Value: fmt.Sprintf("%t", cfg.GitHub.Enable)},
@@ -48,6 +58,7 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "GitHub.ClientSecret", Type: ConfigTypeString, Description: "", Value: cfg.GitHub.ClientSecret, Password: true},
 		{ID: "GitHub.AllowedUsers", Type: ConfigTypeStringList, Description: "Allow any of the listed GitHub usernames to authenticate. Use '*' to allow any user.", Value: strings.Join(cfg.GitHub.AllowedUsers, "\n")},
 		{ID: "GitHub.AllowedOrgs", Type: ConfigTypeStringList, Description: "Allow any member of any listed GitHub org (or team, using the format 'org/team') to authenticate.", Value: strings.Join(cfg.GitHub.AllowedOrgs, "\n")},
+		{ID: "GitHub.AdminOrgs", Type: ConfigTypeStringList, Description: "Members of any listed GitHub org (or team, using the format 'org/team') are given the admin role on login, other users are given the user role. If empty, roles are not changed.", Value: strings.Join(cfg.GitHub.AdminOrgs, "\n")},
 		{ID: "GitHub.EnterpriseURL", Type: ConfigTypeString, Description: "GitHub URL (without /api) when used with GitHub Enterprise.", Value: cfg.GitHub.EnterpriseURL},
 		{ID: "OIDC.Enable", Type: ConfigTypeBoolean, Description: "Enable OpenID Connect authentication.", Value: fmt.Sprintf("%t", cfg.OIDC.Enable)},
 		{ID: "OIDC.NewUsers", Type: ConfigTypeBoolean, Description: "Allow new user creation via OIDC authentication.", Value: fmt.Sprintf("%t", cfg.OIDC.NewUsers)},
@@ -59,6 +70,39 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "OIDC.UserInfoEmailPath", Type: ConfigTypeString, Description: "JMESPath expression to find email address in UserInfo. If set, the email claim will be ignored in favor of this. (suggestion: email).", Value: cfg.OIDC.UserInfoEmailPath},
 		{ID: "OIDC.UserInfoEmailVerifiedPath", Type: ConfigTypeString, Description: "JMESPath expression to find email verification state in UserInfo. If set, the email_verified claim will be ignored in favor of this. (suggestion: email_verified).", Value: cfg.OIDC.UserInfoEmailVerifiedPath},
 		{ID: "OIDC.UserInfoNamePath", Type: ConfigTypeString, Description: "JMESPath expression to find full name in UserInfo. If set, the name claim will be ignored in favor of this. (suggestion: name || cn || join(' ', [firstname, lastname]))", Value: cfg.OIDC.UserInfoNamePath},
+		{ID: "OIDC.UserInfoGroupsPath", Type: ConfigTypeString, Description: "JMESPath expression to find the list of groups in UserInfo. Required to use AdminGroups. (suggestion: groups)", Value: cfg.OIDC.UserInfoGroupsPath},
+		{ID: "OIDC.AdminGroups", Type: ConfigTypeStringList, Description: "Users in any listed group are given the admin role on login, other users are given the user role. If empty, roles are not changed.", Value: strings.Join(cfg.OIDC.AdminGroups, "\n")},
+		{ID: "LDAP.Enable", Type: ConfigTypeBoolean, Description: "Enable LDAP authentication.", Value: fmt.Sprintf("%t", cfg.LDAP.Enable)},
+		{ID: "LDAP.NewUsers", Type: ConfigTypeBoolean, Description: "Allow new user creation via LDAP authentication.", Value: fmt.Sprintf("%t", cfg.LDAP.NewUsers)},
+		{ID: "LDAP.OverrideName", Type: ConfigTypeString, Description: "Set the name/label on the login page to something other than LDAP.", Value: cfg.LDAP.OverrideName},
+		{ID: "LDAP.URL", Type: ConfigTypeString, Description: "URL of the directory server (e.g., ldaps://ldap.example.com or ldap://ldap.example.com:389).", Value: cfg.LDAP.URL},
+		{ID: "LDAP.StartTLS", Type: ConfigTypeBoolean, Description: "Upgrade ldap:// connections using StartTLS.", Value: fmt.Sprintf("%t", cfg.LDAP.StartTLS)},
+		{ID: "LDAP.InsecureSkipVerify", Type: ConfigTypeBoolean, Description: "Skip TLS certificate verification of the directory server. Not recommended.", Value: fmt.Sprintf("%t", cfg.LDAP.InsecureSkipVerify)},
+		{ID: "LDAP.BindDN", Type: ConfigTypeString, Description: "DN used to search for users. If blank, searches are made anonymously.", Value: cfg.LDAP.BindDN},
+		{ID: "LDAP.BindPassword", Type: ConfigTypeString, Description: "", Value: cfg.LDAP.BindPassword, Password: true},
+		{ID: "LDAP.BaseDN", Type: ConfigTypeString, Description: "DN to search for users under.", Value: cfg.LDAP.BaseDN},
+		{ID: "LDAP.UserFilter", Type: ConfigTypeString, Description: "Filter used to find a user, where {username} is replaced with the login username. If left blank, (uid={username}) will be used.", Value: cfg.LDAP.UserFilter},
+		{ID: "LDAP.SubjectAttribute", Type: ConfigTypeString, Description: "Attribute that uniquely identifies a user. If left blank, the user's DN will be used.", Value: cfg.LDAP.SubjectAttribute},
+		{ID: "LDAP.NameAttribute", Type: ConfigTypeString, Description: "Attribute containing the user's full name. If left blank, cn will be used.", Value: cfg.LDAP.NameAttribute},
+		{ID: "LDAP.EmailAttribute", Type: ConfigTypeString, Description: "Attribute containing the user's email address. If left blank, mail will be used.", Value: cfg.LDAP.EmailAttribute},
+		{ID: "LDAP.GroupAttribute", Type: ConfigTypeString, Description: "Attribute listing the DNs of groups the user is a member of. If left blank, memberOf will be used.", Value: cfg.LDAP.GroupAttribute},
+		{ID: "LDAP.AllowedGroups", Type: ConfigTypeStringList, Description: "Only allow members of any listed group DN to authenticate. If empty, any user found is allowed.", Value: strings.Join(cfg.LDAP.AllowedGroups, "\n")},
+		{ID: "LDAP.AdminGroups", Type: ConfigTypeStringList, Description: "Members of any listed group DN are given the admin role on login, other users are given the user role. If empty, roles are not changed.", Value: strings.Join(cfg.LDAP.AdminGroups, "\n")},
+		{ID: "SAML.Enable", Type: ConfigTypeBoolean, Description: "Enable SAML 2.0 authentication.", Value: fmt.Sprintf("%t", cfg.SAML.Enable)},
+		{ID: "SAML.NewUsers", Type: ConfigTypeBoolean, Description: "Allow new user creation via SAML authentication.", Value: fmt.Sprintf("%t", cfg.SAML.NewUsers)},
+		{ID: "SAML.OverrideName", Type: ConfigTypeString, Description: "Set the name/label on the login page to something other than SAML.", Value: cfg.SAML.OverrideName},
+		{ID: "SAML.IdPMetadataURL", Type: ConfigTypeString, Description: "URL of the identity provider's SAML metadata.", Value: cfg.SAML.IdPMetadataURL},
+		{ID: "SAML.IdPMetadata", Type: ConfigTypeString, Description: "SAML metadata XML of the identity provider. If set, IdPMetadataURL is ignored.", Value: cfg.SAML.IdPMetadata},
+		{ID: "SAML.EntityID", Type: ConfigTypeString, Description: "Entity ID of GoAlert as a service provider. If left blank, the metadata URL will be used.", Value: cfg.SAML.EntityID},
+		{ID: "SAML.Certificate", Type: ConfigTypeString, Description: "PEM-encoded certificate published in the service provider metadata.", Value: cfg.SAML.Certificate},
+		{ID: "SAML.PrivateKey", Type: ConfigTypeString, Description: "PEM-encoded RSA or ECDSA private key for Certificate, used to sign authentication requests.", Value: cfg.SAML.PrivateKey, Password: true},
+		{ID: "SAML.AllowIdPInitiated", Type: ConfigTypeBoolean, Description: "Allow logins started from the identity provider, without a prior request from GoAlert.", Value: fmt.Sprintf("%t", cfg.SAML.AllowIdPInitiated)},
+		{ID: "SAML.SubjectAttribute", Type: ConfigTypeString, Description: "Attribute that uniquely identifies a user. If left blank, the persistent NameID will be used.", Value: cfg.SAML.SubjectAttribute},
+		{ID: "SAML.NameAttribute", Type: ConfigTypeString, Description: "Attribute containing the user's full name. If left blank, displayName will be used.", Value: cfg.SAML.NameAttribute},
+		{ID: "SAML.EmailAttribute", Type: ConfigTypeString, Description: "Attribute containing the user's email address. If left blank, email will be used.", Value: cfg.SAML.EmailAttribute},
+		{ID: "SCIM.Enable", Type: ConfigTypeBoolean, Description: "Enable the SCIM 2.0 provisioning API. Requests must use an admin API key created for SCIM.", Value: fmt.Sprintf("%t", cfg.SCIM.Enable)},
+		{ID: "SCIM.AuthProvider", Type: ConfigTypeString, Description: "Login provider (oidc, saml, github, or ldap) that provisioned users will be linked to, so they can login. If left blank, provisioned users are not linked to a login provider.", Value: cfg.SCIM.AuthProvider},
+		{ID: "SCIM.AuthSubjectID", Type: ConfigTypeString, Description: "SCIM attribute (userName or externalId) matching the subject ID of AuthProvider. If left blank, userName will be used.", Value: cfg.SCIM.AuthSubjectID},
 		{ID: "Mailgun.Enable", Type: ConfigTypeBoolean, Description: "", Value: fmt.Sprintf("%t", cfg.Mailgun.Enable)},
 		{ID: "Mailgun.APIKey", Type: ConfigTypeString, Description: "Set this to the HTTP webhook signing key.", Value: cfg.Mailgun.APIKey, Password: true},
 		{ID: "Mailgun.EmailDomain", Type: ConfigTypeString, Description: "The TO address for all incoming alerts.", Value: cfg.Mailgun.EmailDomain},
@@ -69,6 +113,7 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "Slack.SigningSecret", Type: ConfigTypeString, Description: "Signing secret to verify requests from slack.", Value: cfg.Slack.SigningSecret, Password: true},
 		{ID: "Slack.InteractiveMessages", Type: ConfigTypeBoolean, Description: "Enable interactive messages (e.g. buttons).", Value: fmt.Sprintf("%t", cfg.Slack.InteractiveMessages)},
 		{ID: "Slack.DisableBroadcastThreadReplies", Type: ConfigTypeBoolean, Description: "Disable broadcasting alert status updates in threads to the main channel.", Value: fmt.Sprintf("%t", cfg.Slack.DisableBroadcastThreadReplies)},
+		{ID: "MSTeams.Enable", Type: ConfigTypeBoolean, Description: "Enables Microsoft Teams (or compatible) incoming webhooks as a destination for escalation steps and on-call notifications. Webhook.AllowedURLs also applies to these URLs.", Value: fmt.Sprintf("%t", cfg.MSTeams.Enable)},
 		{ID: "Twilio.Enable", Type: ConfigTypeBoolean, Description: "Enables sending and processing of Voice and SMS messages through the Twilio notification provider.", Value: fmt.Sprintf("%t", cfg.Twilio.Enable)},
 		{ID: "Twilio.VoiceName", Type: ConfigTypeString, Description: "The Twilio voice to use for Text To Speech for phone calls. See https://www.twilio.com/docs/voice/twiml/say/text-speech#polly-standard-and-neural-voices", Value: cfg.Twilio.VoiceName},
 		{ID: "Twilio.VoiceLanguage", Type: ConfigTypeString, Description: "The Twilio voice language to use for Text To Speech for phone calls. See https://www.twilio.com/docs/voice/twiml/say/text-speech#polly-standard-and-neural-voices", Value: cfg.Twilio.VoiceLanguage},
@@ -90,6 +135,17 @@ func MapConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "SMTP.Password", Type: ConfigTypeString, Description: "Password for authentication.", Value: cfg.SMTP.Password, Password: true},
 		{ID: "Webhook.Enable", Type: ConfigTypeBoolean, Description: "Enables webhook as a contact method.", Value: fmt.Sprintf("%t", cfg.Webhook.Enable)},
 		{ID: "Webhook.AllowedURLs", Type: ConfigTypeStringList, Description: "If set, allows webhooks for these domains only.", Value: strings.Join(cfg.Webhook.AllowedURLs, "\n")},
+		{ID: "Ticketing.Enable", Type: ConfigTypeBoolean, Description: "Enables opening tickets in an external ticketing system from escalation steps.", Value: fmt.Sprintf("%t", cfg.Ticketing.Enable)},
+		{ID: "Ticketing.APIURL", Type: ConfigTypeString, Description: "Base URL of the ticketing system REST API. Tickets are created with POST {APIURL}/tickets and updated with PATCH {APIURL}/tickets/{id}.", Value: cfg.Ticketing.APIURL},
+		{ID: "Ticketing.APIKey", Type: ConfigTypeString, Description: "Bearer token used to authenticate requests to the ticketing system.", Value: cfg.Ticketing.APIKey, Password: true},
+		{ID: "Ticketing.FieldMapping", Type: ConfigTypeStringList, Description: "List of 'field=expression' pairs that set or override fields of new tickets. Expressions have access to 'alert' and 'queue'.", Value: strings.Join(cfg.Ticketing.FieldMapping, "\n")},
+		{ID: "Ticketing.OpenStatus", Type: ConfigTypeString, Description: "Ticket status for unacknowledged alerts (default 'open').", Value: cfg.Ticketing.OpenStatus},
+		{ID: "Ticketing.AcknowledgedStatus", Type: ConfigTypeString, Description: "Ticket status for acknowledged alerts (default 'acknowledged').", Value: cfg.Ticketing.AcknowledgedStatus},
+		{ID: "Ticketing.ClosedStatus", Type: ConfigTypeString, Description: "Ticket status for closed alerts (default 'closed').", Value: cfg.Ticketing.ClosedStatus},
+		{ID: "Ticketing.CallbackSecret", Type: ConfigTypeString, Description: "Secret used to verify signed requests to the ticketing callback endpoint (/api/v2/ticketing/callback).", Value: cfg.Ticketing.CallbackSecret, Password: true},
+		{ID: "EventSink.Enable", Type: ConfigTypeBoolean, Description: "Publishes alert status changes, escalations, and on-call shift changes as CloudEvents to an HTTP endpoint.", Value: fmt.Sprintf("%t", cfg.EventSink.Enable)},
+		{ID: "EventSink.URL", Type: ConfigTypeString, Description: "URL that events are sent to with POST, one event per request, in structured JSON mode.", Value: cfg.EventSink.URL},
+		{ID: "EventSink.AuthorizationHeader", Type: ConfigTypeString, Description: "If set, the value of the Authorization header for each request (e.g., 'Bearer <token>').", Value: cfg.EventSink.AuthorizationHeader, Password: true},
 		{ID: "Feedback.Enable", Type: ConfigTypeBoolean, Description: "Enables Feedback link in nav bar.", Value: fmt.Sprintf("%t", cfg.Feedback.Enable)},
 		{ID: "Feedback.OverrideURL", Type: ConfigTypeString, Description: "Use a custom URL for Feedback link in nav bar.", Value: cfg.Feedback.OverrideURL},
 	}
@@ -108,17 +164,26 @@ func MapPublicConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "General.DisableLabelCreation", Type: ConfigTypeBoolean, Description: "Disables the ability to create new labels for services.", Value: fmt.Sprintf("%t", cfg.General.DisableLabelCreation)},
 		{ID: "General.DisableCalendarSubscriptions", Type: ConfigTypeBoolean, Description: "If set, disables all active calendar subscriptions as well as the ability to create new calendar subscriptions.", Value: fmt.Sprintf("%t", cfg.General.DisableCalendarSubscriptions)},
 		{ID: "Services.RequiredLabels", Type: ConfigTypeStringList, Description: "List of label names to require new services to define.", Value: strings.Join(cfg.Services.RequiredLabels, "\n")},
+		{ID: "Schedules.CoverageCheckHours", Type: ConfigTypeInteger, Description: "Schedules are checked this many hours ahead for gaps where nobody is on-call (0 means disable the check).", Value: fmt.Sprintf("%d", cfg.Schedules.CoverageCheckHours)},
+		{ID: "Schedules.CoverageAlertServiceID", Type: ConfigTypeString, Description: "If set, an alert is created on this service for each schedule with a gap in on-call coverage, and closed once the gap is resolved.", Value: cfg.Schedules.CoverageAlertServiceID},
 		{ID: "Maintenance.AlertCleanupDays", Type: ConfigTypeInteger, Description: "Closed alerts will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertCleanupDays)},
 		{ID: "Maintenance.AlertAutoCloseDays", Type: ConfigTypeInteger, Description: "Unacknowledged alerts will automatically be closed after this many days of inactivity. (0 means disable auto-close).", Value: fmt.Sprintf("%d", cfg.Maintenance.AlertAutoCloseDays)},
 		{ID: "Maintenance.AutoCloseAckedAlerts", Type: ConfigTypeBoolean, Description: "If set, alerts that are acknowledged will also be automatically closed after the configured number of days of inactivity.", Value: fmt.Sprintf("%t", cfg.Maintenance.AutoCloseAckedAlerts)},
 		{ID: "Maintenance.APIKeyExpireDays", Type: ConfigTypeInteger, Description: "Unused calendar API keys will be disabled after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.APIKeyExpireDays)},
 		{ID: "Maintenance.ScheduleCleanupDays", Type: ConfigTypeInteger, Description: "Schedule on-call history will be deleted after this many days (0 means disable cleanup).", Value: fmt.Sprintf("%d", cfg.Maintenance.ScheduleCleanupDays)},
+		{ID: "Incidents.Enable", Type: ConfigTypeBoolean, Description: "Group related new alerts into incidents. Only the first alert of an incident is escalated; acknowledging or closing it (or the incident) applies to every alert in the incident.", Value: fmt.Sprintf("%t", cfg.Incidents.Enable)},
+		{ID: "Incidents.WindowMinutes", Type: ConfigTypeInteger, Description: "New alerts are added to a matching open incident if it was created within this many minutes (default 30).", Value: fmt.Sprintf("%d", cfg.Incidents.WindowMinutes)},
+		{ID: "Incidents.LabelKeys", Type: ConfigTypeStringList, Description: "Group alerts from services that have the same value for any of these label keys.", Value: strings.Join(cfg.Incidents.LabelKeys, "\n")},
+		{ID: "Incidents.DedupPrefixes", Type: ConfigTypeStringList, Description: "Group alerts whose dedup key starts with any of these prefixes (one incident per prefix).", Value: strings.Join(cfg.Incidents.DedupPrefixes, "\n")},
 		{ID: "Auth.DisableBasic", Type: ConfigTypeBoolean, Description: "Disallow username/password login.", Value: fmt.Sprintf("%t", cfg.Auth.DisableBasic)},
 		{ID: "GitHub.Enable", Type: ConfigTypeBoolean, Description: "Enable GitHub authentication.", Value: fmt.Sprintf("%t", cfg.GitHub.Enable)},
 		{ID: "OIDC.Enable", Type: ConfigTypeBoolean, Description: "Enable OpenID Connect authentication.", Value: fmt.Sprintf("%t", cfg.OIDC.Enable)},
+		{ID: "LDAP.Enable", Type: ConfigTypeBoolean, Description: "Enable LDAP authentication.", Value: fmt.Sprintf("%t", cfg.LDAP.Enable)},
+		{ID: "SAML.Enable", Type: ConfigTypeBoolean, Description: "Enable SAML 2.0 authentication.", Value: fmt.Sprintf("%t", cfg.SAML.Enable)},
 		{ID: "Mailgun.Enable", Type: ConfigTypeBoolean, Description: "", Value: fmt.Sprintf("%t", cfg.Mailgun.Enable)},
 		{ID: "Slack.Enable", Type: ConfigTypeBoolean, Description: "", Value: fmt.Sprintf("%t", cfg.Slack.Enable)},
 		{ID: "Slack.DisableBroadcastThreadReplies", Type: ConfigTypeBoolean, Description: "Disable broadcasting alert status updates in threads to the main channel.", Value: fmt.Sprintf("%t", cfg.Slack.DisableBroadcastThreadReplies)},
+		{ID: "MSTeams.Enable", Type: ConfigTypeBoolean, Description: "Enables Microsoft Teams (or compatible) incoming webhooks as a destination for escalation steps and on-call notifications. Webhook.AllowedURLs also applies to these URLs.", Value: fmt.Sprintf("%t", cfg.MSTeams.Enable)},
 		{ID: "Twilio.Enable", Type: ConfigTypeBoolean, Description: "Enables sending and processing of Voice and SMS messages through the Twilio notification provider.", Value: fmt.Sprintf("%t", cfg.Twilio.Enable)},
 		{ID: "Twilio.FromNumber", Type: ConfigTypeString, Description: "The Twilio number to use for outgoing notifications.", Value: cfg.Twilio.FromNumber},
 		{ID: "Twilio.MessagingServiceSID", Type: ConfigTypeString, Description: "If set, replaces the use of From Number for SMS notifications.", Value: cfg.Twilio.MessagingServiceSID},
@@ -126,6 +191,8 @@ func MapPublicConfigValues(cfg config.Config) []ConfigValue {
 		{ID: "SMTP.From", Type: ConfigTypeString, Description: "The email address messages should be sent from.", Value: cfg.SMTP.From},
 		{ID: "Webhook.Enable", Type: ConfigTypeBoolean, Description: "Enables webhook as a contact method.", Value: fmt.Sprintf("%t", cfg.Webhook.Enable)},
 		{ID: "Webhook.AllowedURLs", Type: ConfigTypeStringList, Description: "If set, allows webhooks for these domains only.", Value: strings.Join(cfg.Webhook.AllowedURLs, "\n")},
+		{ID: "Ticketing.Enable", Type: ConfigTypeBoolean, Description: "Enables opening tickets in an external ticketing system from escalation steps.", Value: fmt.Sprintf("%t", cfg.Ticketing.Enable)},
+		{ID: "EventSink.Enable", Type: ConfigTypeBoolean, Description: "Publishes alert status changes, escalations, and on-call shift changes as CloudEvents to an HTTP endpoint.", Value: fmt.Sprintf("%t", cfg.EventSink.Enable)},
 		{ID: "Feedback.Enable", Type: ConfigTypeBoolean, Description: "Enables Feedback link in nav bar.", Value: fmt.Sprintf("%t", cfg.Feedback.Enable)},
 		{ID: "Feedback.OverrideURL", Type: ConfigTypeString, Description: "Use a custom URL for Feedback link in nav bar.", Value: cfg.Feedback.OverrideURL},
 	}
@@ -197,6 +264,14 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 			cfg.General.DisableCalendarSubscriptions = val
 		case "Services.RequiredLabels":
 			cfg.Services.RequiredLabels = parseStringList(v.Value)
+		case "Schedules.CoverageCheckHours":
+			val, err := parseInt(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Schedules.CoverageCheckHours = val
+		case "Schedules.CoverageAlertServiceID":
+			cfg.Schedules.CoverageAlertServiceID = v.Value
 		case "Maintenance.AlertCleanupDays":
 			val, err := parseInt(v.ID, v.Value)
 			if err != nil {
@@ -227,6 +302,24 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 				return cfg, err
 			}
 			cfg.Maintenance.ScheduleCleanupDays = val
+		case "Incidents.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Incidents.Enable = val
+		case "Incidents.WindowMinutes":
+			val, err := parseInt(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Incidents.WindowMinutes = val
+		case "Incidents.LabelKeys":
+			cfg.Incidents.LabelKeys = parseStringList(v.Value)
+		case "Incidents.DedupPrefixes":
+			cfg.Incidents.DedupPrefixes = parseStringList(v.Value)
+		case "Incidents.Expressions":
+			cfg.Incidents.Expressions = parseStringList(v.Value)
 		case "Auth.RefererURLs":
 			cfg.Auth.RefererURLs = parseStringList(v.Value)
 		case "Auth.DisableBasic":
@@ -255,6 +348,8 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 			cfg.GitHub.AllowedUsers = parseStringList(v.Value)
 		case "GitHub.AllowedOrgs":
 			cfg.GitHub.AllowedOrgs = parseStringList(v.Value)
+		case "GitHub.AdminOrgs":
+			cfg.GitHub.AdminOrgs = parseStringList(v.Value)
 		case "GitHub.EnterpriseURL":
 			cfg.GitHub.EnterpriseURL = v.Value
 		case "OIDC.Enable":
@@ -285,6 +380,104 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 			cfg.OIDC.UserInfoEmailVerifiedPath = v.Value
 		case "OIDC.UserInfoNamePath":
 			cfg.OIDC.UserInfoNamePath = v.Value
+		case "OIDC.UserInfoGroupsPath":
+			cfg.OIDC.UserInfoGroupsPath = v.Value
+		case "OIDC.AdminGroups":
+			cfg.OIDC.AdminGroups = parseStringList(v.Value)
+		case "LDAP.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.LDAP.Enable = val
+		case "LDAP.NewUsers":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.LDAP.NewUsers = val
+		case "LDAP.OverrideName":
+			cfg.LDAP.OverrideName = v.Value
+		case "LDAP.URL":
+			cfg.LDAP.URL = v.Value
+		case "LDAP.StartTLS":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.LDAP.StartTLS = val
+		case "LDAP.InsecureSkipVerify":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.LDAP.InsecureSkipVerify = val
+		case "LDAP.BindDN":
+			cfg.LDAP.BindDN = v.Value
+		case "LDAP.BindPassword":
+			cfg.LDAP.BindPassword = v.Value
+		case "LDAP.BaseDN":
+			cfg.LDAP.BaseDN = v.Value
+		case "LDAP.UserFilter":
+			cfg.LDAP.UserFilter = v.Value
+		case "LDAP.SubjectAttribute":
+			cfg.LDAP.SubjectAttribute = v.Value
+		case "LDAP.NameAttribute":
+			cfg.LDAP.NameAttribute = v.Value
+		case "LDAP.EmailAttribute":
+			cfg.LDAP.EmailAttribute = v.Value
+		case "LDAP.GroupAttribute":
+			cfg.LDAP.GroupAttribute = v.Value
+		case "LDAP.AllowedGroups":
+			cfg.LDAP.AllowedGroups = parseStringList(v.Value)
+		case "LDAP.AdminGroups":
+			cfg.LDAP.AdminGroups = parseStringList(v.Value)
+		case "SAML.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SAML.Enable = val
+		case "SAML.NewUsers":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SAML.NewUsers = val
+		case "SAML.OverrideName":
+			cfg.SAML.OverrideName = v.Value
+		case "SAML.IdPMetadataURL":
+			cfg.SAML.IdPMetadataURL = v.Value
+		case "SAML.IdPMetadata":
+			cfg.SAML.IdPMetadata = v.Value
+		case "SAML.EntityID":
+			cfg.SAML.EntityID = v.Value
+		case "SAML.Certificate":
+			cfg.SAML.Certificate = v.Value
+		case "SAML.PrivateKey":
+			cfg.SAML.PrivateKey = v.Value
+		case "SAML.AllowIdPInitiated":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SAML.AllowIdPInitiated = val
+		case "SAML.SubjectAttribute":
+			cfg.SAML.SubjectAttribute = v.Value
+		case "SAML.NameAttribute":
+			cfg.SAML.NameAttribute = v.Value
+		case "SAML.EmailAttribute":
+			cfg.SAML.EmailAttribute = v.Value
+		case "SCIM.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.SCIM.Enable = val
+		case "SCIM.AuthProvider":
+			cfg.SCIM.AuthProvider = v.Value
+		case "SCIM.AuthSubjectID":
+			cfg.SCIM.AuthSubjectID = v.Value
 		case "Mailgun.Enable":
 			val, err := parseBool(v.ID, v.Value)
 			if err != nil {
@@ -321,6 +514,12 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 				return cfg, err
 			}
 			cfg.Slack.DisableBroadcastThreadReplies = val
+		case "MSTeams.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.MSTeams.Enable = val
 		case "Twilio.Enable":
 			val, err := parseBool(v.ID, v.Value)
 			if err != nil {
@@ -391,6 +590,36 @@ func ApplyConfigValues(cfg config.Config, vals []ConfigValueInput) (config.Confi
 			cfg.Webhook.Enable = val
 		case "Webhook.AllowedURLs":
 			cfg.Webhook.AllowedURLs = parseStringList(v.Value)
+		case "Ticketing.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Ticketing.Enable = val
+		case "Ticketing.APIURL":
+			cfg.Ticketing.APIURL = v.Value
+		case "Ticketing.APIKey":
+			cfg.Ticketing.APIKey = v.Value
+		case "Ticketing.FieldMapping":
+			cfg.Ticketing.FieldMapping = parseStringList(v.Value)
+		case "Ticketing.OpenStatus":
+			cfg.Ticketing.OpenStatus = v.Value
+		case "Ticketing.AcknowledgedStatus":
+			cfg.Ticketing.AcknowledgedStatus = v.Value
+		case "Ticketing.ClosedStatus":
+			cfg.Ticketing.ClosedStatus = v.Value
+		case "Ticketing.CallbackSecret":
+			cfg.Ticketing.CallbackSecret = v.Value
+		case "EventSink.Enable":
+			val, err := parseBool(v.ID, v.Value)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.EventSink.Enable = val
+		case "EventSink.URL":
+			cfg.EventSink.URL = v.Value
+		case "EventSink.AuthorizationHeader":
+			cfg.EventSink.AuthorizationHeader = v.Value
 		case "Feedback.Enable":
 			val, err := parseBool(v.ID, v.Value)
 			if err != nil {
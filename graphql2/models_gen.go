@@ -25,6 +25,7 @@ import (
 	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
 	"github.com/target/goalert/service"
+	"github.com/target/goalert/team"
 	"github.com/target/goalert/user"
 	"github.com/target/goalert/util/timeutil"
 )
@@ -87,6 +88,7 @@ type AlertSearchOptions struct {
 	NotCreatedBefore  *time.Time       `json:"notCreatedBefore,omitempty"`
 	ClosedBefore      *time.Time       `json:"closedBefore,omitempty"`
 	NotClosedBefore   *time.Time       `json:"notClosedBefore,omitempty"`
+	FilterBySeverity  []alert.Severity `json:"filterBySeverity,omitempty"`
 }
 
 // AlertStats returns aggregated statistics about alerts.
@@ -195,6 +197,8 @@ type CreateAlertInput struct {
 	// It can also be used to close an alert using closeMatchingAlert mutation.
 	Dedup *string              `json:"dedup,omitempty"`
 	Meta  []AlertMetadataInput `json:"meta,omitempty"`
+	// Defaults to medium if unset.
+	Severity *alert.Severity `json:"severity,omitempty"`
 }
 
 type CreateBasicAuthInput struct {
@@ -210,6 +214,8 @@ type CreateEscalationPolicyInput struct {
 	Favorite    *bool                             `json:"favorite,omitempty"`
 	Steps       []CreateEscalationPolicyStepInput `json:"steps,omitempty"`
 	Labels      []SetLabelInput                   `json:"labels,omitempty"`
+	// The team that will own the escalation policy.
+	TeamID *string `json:"teamID,omitempty"`
 }
 
 type CreateEscalationPolicyStepInput struct {
@@ -220,6 +226,13 @@ type CreateEscalationPolicyStepInput struct {
 	NewSchedule        *CreateScheduleInput   `json:"newSchedule,omitempty"`
 	Actions            []gadb.DestV1          `json:"actions,omitempty"`
 	MultiAck           *bool                  `json:"multiAck,omitempty"`
+	// Defaults to every day.
+	WeekdayFilter *timeutil.WeekdayFilter `json:"weekdayFilter,omitempty"`
+	Start         *timeutil.Clock         `json:"start,omitempty"`
+	End           *timeutil.Clock         `json:"end,omitempty"`
+	// Defaults to UTC.
+	TimeZone *string                `json:"timeZone,omitempty"`
+	Matches  []escalation.StepMatch `json:"matches,omitempty"`
 }
 
 type CreateGQLAPIKeyInput struct {
@@ -228,6 +241,8 @@ type CreateGQLAPIKeyInput struct {
 	ExpiresAt   time.Time `json:"expiresAt"`
 	Role        UserRole  `json:"role"`
 	Query       string    `json:"query"`
+	// If true, the key will be valid for the SCIM provisioning API instead of GraphQL. Query must be empty and role must be admin.
+	Scim *bool `json:"scim,omitempty"`
 }
 
 type CreateHeartbeatMonitorInput struct {
@@ -250,6 +265,20 @@ type CreateIntegrationKeyInput struct {
 	ExternalSystemName *string `json:"externalSystemName,omitempty"`
 }
 
+type CreateMaintenanceWindowInput struct {
+	ServiceID   string    `json:"serviceID"`
+	Description *string   `json:"description,omitempty"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	// Weekday filter is a 7-item array that indicates which days the window repeats on, starting with Sunday. If null or no days are enabled, the window does not repeat.
+	//
+	// Repeating windows can be at most 24 hours long.
+	WeekdayFilter *timeutil.WeekdayFilter `json:"weekdayFilter,omitempty"`
+	// The time zone used for repeating windows, defaults to UTC.
+	TimeZone       *string `json:"timeZone,omitempty"`
+	SuppressAlerts *bool   `json:"suppressAlerts,omitempty"`
+}
+
 type CreateRotationInput struct {
 	Name        string          `json:"name"`
 	Description *string         `json:"description,omitempty"`
@@ -260,6 +289,10 @@ type CreateRotationInput struct {
 	ShiftLength *int            `json:"shiftLength,omitempty"`
 	UserIDs     []string        `json:"userIDs,omitempty"`
 	Labels      []SetLabelInput `json:"labels,omitempty"`
+	// Required for follow_the_sun rotations. If userIDs is omitted, participants are set from the regions.
+	Regions []rotation.Region `json:"regions,omitempty"`
+	// The team that will own the rotation.
+	TeamID *string `json:"teamID,omitempty"`
 }
 
 type CreateScheduleInput struct {
@@ -270,6 +303,8 @@ type CreateScheduleInput struct {
 	Targets          []ScheduleTargetInput     `json:"targets,omitempty"`
 	NewUserOverrides []CreateUserOverrideInput `json:"newUserOverrides,omitempty"`
 	Labels           []SetLabelInput           `json:"labels,omitempty"`
+	// The team that will own the schedule.
+	TeamID *string `json:"teamID,omitempty"`
 }
 
 type CreateServiceInput struct {
@@ -281,6 +316,27 @@ type CreateServiceInput struct {
 	NewIntegrationKeys   []CreateIntegrationKeyInput   `json:"newIntegrationKeys,omitempty"`
 	Labels               []SetLabelInput               `json:"labels,omitempty"`
 	NewHeartbeatMonitors []CreateHeartbeatMonitorInput `json:"newHeartbeatMonitors,omitempty"`
+	// The team that will own the service.
+	TeamID *string `json:"teamID,omitempty"`
+}
+
+type CreateShiftSwapRequestInput struct {
+	ScheduleID string `json:"scheduleID"`
+	// Defaults to the current user.
+	FromUserID *string   `json:"fromUserID,omitempty"`
+	ToUserID   string    `json:"toUserID"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	// Set both to offer a trade.
+	TradeStart *time.Time `json:"tradeStart,omitempty"`
+	TradeEnd   *time.Time `json:"tradeEnd,omitempty"`
+	Note       *string    `json:"note,omitempty"`
+}
+
+type CreateTeamInput struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Favorite    *bool   `json:"favorite,omitempty"`
 }
 
 type CreateUserCalendarSubscriptionInput struct {
@@ -318,6 +374,13 @@ type CreateUserNotificationRuleInput struct {
 	UserID          *string `json:"userID,omitempty"`
 	ContactMethodID *string `json:"contactMethodID,omitempty"`
 	DelayMinutes    int     `json:"delayMinutes"`
+	// Defaults to every day.
+	WeekdayFilter *timeutil.WeekdayFilter `json:"weekdayFilter,omitempty"`
+	Start         *timeutil.Clock         `json:"start,omitempty"`
+	End           *timeutil.Clock         `json:"end,omitempty"`
+	// Defaults to UTC.
+	TimeZone    *string         `json:"timeZone,omitempty"`
+	MinSeverity *alert.Severity `json:"minSeverity,omitempty"`
 }
 
 type CreateUserOverrideInput struct {
@@ -426,6 +489,8 @@ type EscalationPolicySearchOptions struct {
 	FavoritesOnly *bool `json:"favoritesOnly,omitempty"`
 	// Sort favorite escalation policies first.
 	FavoritesFirst *bool `json:"favoritesFirst,omitempty"`
+	// Include only escalation policies owned by one of the given teams.
+	TeamIDs []string `json:"teamIDs,omitempty"`
 }
 
 // Expr contains helpers for working with Expr expressions.
@@ -482,6 +547,7 @@ type GQLAPIKey struct {
 	ExpiresAt   time.Time       `json:"expiresAt"`
 	Query       string          `json:"query"`
 	Role        UserRole        `json:"role"`
+	Scim        bool            `json:"scim"`
 }
 
 type GQLAPIKeyUsage struct {
@@ -590,6 +656,21 @@ type OnCallServiceAssignment struct {
 	ServiceName          string `json:"serviceName"`
 }
 
+type OnCallWorkloadReportInput struct {
+	// Defaults to 30 days before end.
+	Start *time.Time `json:"start,omitempty"`
+	// Defaults to now.
+	End *time.Time `json:"end,omitempty"`
+	// Used for working hours and night-time. Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+	// Working hours on weekdays (Monday through Friday), on-call time outside of them is off-hours. Defaults to 09:00-17:00.
+	WorkdayStart *timeutil.Clock `json:"workdayStart,omitempty"`
+	WorkdayEnd   *timeutil.Clock `json:"workdayEnd,omitempty"`
+	// Pages sent between nightStart and nightEnd are night-time interruptions. Defaults to 22:00-07:00.
+	NightStart *timeutil.Clock `json:"nightStart,omitempty"`
+	NightEnd   *timeutil.Clock `json:"nightEnd,omitempty"`
+}
+
 type PageInfo struct {
 	EndCursor   *string `json:"endCursor,omitempty"`
 	HasNextPage bool    `json:"hasNextPage"`
@@ -621,6 +702,8 @@ type RotationSearchOptions struct {
 	FavoritesOnly *bool `json:"favoritesOnly,omitempty"`
 	// Sort favorite rotations first.
 	FavoritesFirst *bool `json:"favoritesFirst,omitempty"`
+	// Include only rotations owned by one of the given teams.
+	TeamIDs []string `json:"teamIDs,omitempty"`
 }
 
 type SWOConnection struct {
@@ -672,6 +755,8 @@ type ScheduleSearchOptions struct {
 	FavoritesOnly *bool `json:"favoritesOnly,omitempty"`
 	// Sort favorite services first.
 	FavoritesFirst *bool `json:"favoritesFirst,omitempty"`
+	// Include only schedules owned by one of the given teams.
+	TeamIDs []string `json:"teamIDs,omitempty"`
 }
 
 type ScheduleTarget struct {
@@ -708,6 +793,14 @@ type ServiceConnection struct {
 	PageInfo *PageInfo         `json:"pageInfo"`
 }
 
+type ServiceRoutingRuleInput struct {
+	Name               string  `json:"name"`
+	Condition          string  `json:"condition"`
+	EscalationPolicyID *string `json:"escalationPolicyID,omitempty"`
+	Priority           *string `json:"priority,omitempty"`
+	Drop               *bool   `json:"drop,omitempty"`
+}
+
 type ServiceSearchOptions struct {
 	First  *int     `json:"first,omitempty"`
 	After  *string  `json:"after,omitempty"`
@@ -718,6 +811,8 @@ type ServiceSearchOptions struct {
 	FavoritesOnly *bool `json:"favoritesOnly,omitempty"`
 	// Sort favorite services first.
 	FavoritesFirst *bool `json:"favoritesFirst,omitempty"`
+	// Include only services owned by one of the given teams.
+	TeamIDs []string `json:"teamIDs,omitempty"`
 }
 
 type SetAlertNoiseReasonInput struct {
@@ -742,6 +837,18 @@ type SetScheduleOnCallNotificationRulesInput struct {
 	Rules      []OnCallNotificationRuleInput `json:"rules"`
 }
 
+type SetServiceRoutingRulesInput struct {
+	ServiceID string                    `json:"serviceID"`
+	Rules     []ServiceRoutingRuleInput `json:"rules"`
+}
+
+type SetTeamMemberInput struct {
+	TeamID string `json:"teamID"`
+	UserID string `json:"userID"`
+	// The role to give the user. If null, the user is removed from the team.
+	Role *team.Role `json:"role,omitempty"`
+}
+
 type SetTemporaryScheduleInput struct {
 	ScheduleID string                `json:"scheduleID"`
 	ClearStart *time.Time            `json:"clearStart,omitempty"`
@@ -751,6 +858,23 @@ type SetTemporaryScheduleInput struct {
 	Shifts     []schedule.FixedShift `json:"shifts"`
 }
 
+type SimulateEscalationInput struct {
+	ServiceID string `json:"serviceID"`
+	// The time the alert would be created. Defaults to now.
+	Start *time.Time `json:"start,omitempty"`
+	// How long to simulate escalation for, in minutes. Defaults to 60.
+	DurationMinutes *int `json:"durationMinutes,omitempty"`
+	// Defaults to medium.
+	Severity *alert.Severity `json:"severity,omitempty"`
+	// Used to match routing rules. Each defaults to an empty string.
+	Summary *string `json:"summary,omitempty"`
+	Details *string `json:"details,omitempty"`
+	// The alert source as seen by routing rules (e.g., `generic`, `grafana`, or `universal`).
+	Source *string `json:"source,omitempty"`
+	// Used to match routing rules and escalation policy step conditions.
+	Metadata []AlertMetadataInput `json:"metadata,omitempty"`
+}
+
 type SlackChannelConnection struct {
 	Nodes    []slack.Channel `json:"nodes"`
 	PageInfo *PageInfo       `json:"pageInfo"`
@@ -791,6 +915,24 @@ type SystemLimitInput struct {
 	Value int      `json:"value"`
 }
 
+type TeamConnection struct {
+	Nodes    []team.Team `json:"nodes"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+type TeamSearchOptions struct {
+	First  *int     `json:"first,omitempty"`
+	After  *string  `json:"after,omitempty"`
+	Search *string  `json:"search,omitempty"`
+	Omit   []string `json:"omit,omitempty"`
+	// Include only teams the current user is a member of.
+	MyTeamsOnly *bool `json:"myTeamsOnly,omitempty"`
+	// Include only favorited teams in the results.
+	FavoritesOnly *bool `json:"favoritesOnly,omitempty"`
+	// Sort favorite teams first.
+	FavoritesFirst *bool `json:"favoritesFirst,omitempty"`
+}
+
 type TimeSeriesBucket struct {
 	Start time.Time `json:"start"`
 	End   time.Time `json:"end"`
@@ -836,6 +978,8 @@ type UpdateAlertsInput struct {
 	AlertIDs    []int        `json:"alertIDs"`
 	NewStatus   *AlertStatus `json:"newStatus,omitempty"`
 	NoiseReason *string      `json:"noiseReason,omitempty"`
+	// Acknowledges the alerts and returns them to triggered (escalating from the first step) after the given number of minutes.
+	SnoozeMinutes *int `json:"snoozeMinutes,omitempty"`
 }
 
 type UpdateBasicAuthInput struct {
@@ -850,14 +994,21 @@ type UpdateEscalationPolicyInput struct {
 	Description *string  `json:"description,omitempty"`
 	Repeat      *int     `json:"repeat,omitempty"`
 	StepIDs     []string `json:"stepIDs,omitempty"`
+	// Sets the team that owns the escalation policy. An empty string removes team ownership.
+	TeamID *string `json:"teamID,omitempty"`
 }
 
 type UpdateEscalationPolicyStepInput struct {
-	ID           string                 `json:"id"`
-	DelayMinutes *int                   `json:"delayMinutes,omitempty"`
-	Targets      []assignment.RawTarget `json:"targets,omitempty"`
-	Actions      []gadb.DestV1          `json:"actions,omitempty"`
-	MultiAck     *bool                  `json:"multiAck,omitempty"`
+	ID            string                  `json:"id"`
+	DelayMinutes  *int                    `json:"delayMinutes,omitempty"`
+	Targets       []assignment.RawTarget  `json:"targets,omitempty"`
+	Actions       []gadb.DestV1           `json:"actions,omitempty"`
+	MultiAck      *bool                   `json:"multiAck,omitempty"`
+	WeekdayFilter *timeutil.WeekdayFilter `json:"weekdayFilter,omitempty"`
+	Start         *timeutil.Clock         `json:"start,omitempty"`
+	End           *timeutil.Clock         `json:"end,omitempty"`
+	TimeZone      *string                 `json:"timeZone,omitempty"`
+	Matches       []escalation.StepMatch  `json:"matches,omitempty"`
 }
 
 type UpdateGQLAPIKeyInput struct {
@@ -878,6 +1029,11 @@ type UpdateHeartbeatMonitorInput struct {
 	Muted *string `json:"muted,omitempty"`
 }
 
+type UpdateIncidentInput struct {
+	ID        int         `json:"id"`
+	NewStatus AlertStatus `json:"newStatus"`
+}
+
 type UpdateKeyConfigInput struct {
 	KeyID string           `json:"keyID"`
 	Rules []gadb.UIKRuleV1 `json:"rules,omitempty"`
@@ -893,6 +1049,17 @@ type UpdateKeyConfigInput struct {
 	DefaultActions []gadb.UIKActionV1 `json:"defaultActions,omitempty"`
 }
 
+type UpdateMaintenanceWindowInput struct {
+	ID          string     `json:"id"`
+	Description *string    `json:"description,omitempty"`
+	Start       *time.Time `json:"start,omitempty"`
+	End         *time.Time `json:"end,omitempty"`
+	// Set all days to false to stop the window from repeating.
+	WeekdayFilter  *timeutil.WeekdayFilter `json:"weekdayFilter,omitempty"`
+	TimeZone       *string                 `json:"timeZone,omitempty"`
+	SuppressAlerts *bool                   `json:"suppressAlerts,omitempty"`
+}
+
 type UpdateRotationInput struct {
 	ID          string         `json:"id"`
 	Name        *string        `json:"name,omitempty"`
@@ -904,6 +1071,10 @@ type UpdateRotationInput struct {
 	UserIDs     []string       `json:"userIDs,omitempty"`
 	// The index of the user in `userIDs` to set as the active user. If not provided, the existing active user index will be used.
 	ActiveUserIndex *int `json:"activeUserIndex,omitempty"`
+	// Replaces the regions of a follow_the_sun rotation. If userIDs is omitted, participants are set from the regions.
+	Regions []rotation.Region `json:"regions,omitempty"`
+	// Sets the team that owns the rotation. An empty string removes team ownership.
+	TeamID *string `json:"teamID,omitempty"`
 }
 
 type UpdateScheduleInput struct {
@@ -911,6 +1082,8 @@ type UpdateScheduleInput struct {
 	Name        *string `json:"name,omitempty"`
 	Description *string `json:"description,omitempty"`
 	TimeZone    *string `json:"timeZone,omitempty"`
+	// Sets the team that owns the schedule. An empty string removes team ownership.
+	TeamID *string `json:"teamID,omitempty"`
 }
 
 type UpdateServiceInput struct {
@@ -919,6 +1092,14 @@ type UpdateServiceInput struct {
 	Description          *string    `json:"description,omitempty"`
 	EscalationPolicyID   *string    `json:"escalationPolicyID,omitempty"`
 	MaintenanceExpiresAt *time.Time `json:"maintenanceExpiresAt,omitempty"`
+	// Sets the team that owns the service. An empty string removes team ownership.
+	TeamID *string `json:"teamID,omitempty"`
+}
+
+type UpdateTeamInput struct {
+	ID          string  `json:"id"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 type UpdateUserCalendarSubscriptionInput struct {
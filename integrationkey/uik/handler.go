@@ -57,13 +57,20 @@ func (h *Handler) handleAction(ctx context.Context, act gadb.UIKActionV1) (inser
 			status = alert.StatusClosed
 		}
 
-		_, _, err := h.alertStore.CreateOrUpdate(ctx, &alert.Alert{
+		// unknown severities are ignored, as with other integrations
+		sev, err := alert.ParseSeverity(act.Param("severity"))
+		if err != nil {
+			sev = alert.DefaultSeverity
+		}
+
+		_, _, err = h.alertStore.CreateOrUpdate(ctx, &alert.Alert{
 			ServiceID: permission.ServiceID(ctx),
 			Summary:   act.Param("summary"),
 			Details:   act.Param("details"),
 			Source:    alert.SourceUniversal,
 			Status:    status,
 			Dedup:     alert.NewUserDedup(act.Param("dedup")),
+			Severity:  sev,
 		})
 		if err != nil {
 			return false, err
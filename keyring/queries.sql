@@ -38,3 +38,22 @@ SET
 WHERE
     id = @id;
 
+
+-- name: Keyring_LockWebhookSecrets :exec
+-- Locks the webhook_signing_secrets table so no new secrets can be created.
+LOCK TABLE webhook_signing_secrets IN ACCESS EXCLUSIVE MODE;
+
+-- name: Keyring_GetWebhookSecrets :many
+SELECT
+    webhook_url,
+    secret
+FROM
+    webhook_signing_secrets;
+
+-- name: Keyring_UpdateWebhookSecret :exec
+UPDATE
+    webhook_signing_secrets
+SET
+    secret = @secret
+WHERE
+    webhook_url = @webhook_url;
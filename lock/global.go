@@ -30,4 +30,8 @@ const (
 	//
 	// It must be acquired before the global switchover lock.
 	GlobalSwitchOverExec = uint32(0x1112) // 4370
+
+	// Namespace (first key) of transaction-level locks used when grouping
+	// new alerts into incidents.
+	IncidentGrouping = uint32(0x1350) // 4944
 )
@@ -5,11 +5,13 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime"
 	"net/http"
 	"net/mail"
+	"net/textproto"
 	"strings"
 	"time"
 
@@ -65,6 +67,27 @@ func httpError(ctx context.Context, w http.ResponseWriter, err error) bool {
 // validSignature is used to validate the request from Mailgun.
 // If request is validated true is returned, false otherwise.
 // https://documentation.mailgun.com/en/latest/user_manual.html#securing-webhooks
+// messageSeverity returns the alert severity from the JSON-encoded list of message headers.
+func messageSeverity(headersJSON string) alert.Severity {
+	var headers [][]string
+	_ = json.Unmarshal([]byte(headersJSON), &headers)
+
+	var xPriority, importance string
+	for _, h := range headers {
+		if len(h) != 2 {
+			continue
+		}
+		switch textproto.CanonicalMIMEHeaderKey(h[0]) {
+		case "X-Priority":
+			xPriority = h[1]
+		case "Importance":
+			importance = h[1]
+		}
+	}
+
+	return alert.SeverityFromEmail(xPriority, importance)
+}
+
 func validSignature(ctx context.Context, req *http.Request, apikey string) bool {
 	h := hmac.New(sha256.New, []byte(apikey))
 	_, _ = io.WriteString(h, req.FormValue("timestamp"))
@@ -170,11 +193,12 @@ func (h *ingressHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	details := fmt.Sprintf("From: %s\n\n%s", r.FormValue("from"), r.FormValue("body-plain"))
 	details = validate.SanitizeText(details, alert.MaxDetailsLength)
 	newAlert := &alert.Alert{
-		Summary: summary,
-		Details: details,
-		Status:  alert.StatusTriggered,
-		Source:  alert.SourceEmail,
-		Dedup:   alert.NewUserDedup(dedupStr),
+		Summary:  summary,
+		Details:  details,
+		Status:   alert.StatusTriggered,
+		Source:   alert.SourceEmail,
+		Dedup:    alert.NewUserDedup(dedupStr),
+		Severity: messageSeverity(r.FormValue("message-headers")),
 	}
 
 	err = retry.DoTemporaryError(func(_ int) error {
@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Per-destination secrets used to sign outgoing webhook requests. The secret
+-- is encrypted with the data encryption key(s), the same as keyring and config
+-- payloads.
+CREATE TABLE webhook_signing_secrets(
+    webhook_url text PRIMARY KEY,
+    secret bytea NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE webhook_signing_secrets;
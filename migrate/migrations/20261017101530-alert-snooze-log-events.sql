@@ -0,0 +1,8 @@
+-- +migrate Up notransaction
+ALTER TYPE enum_alert_log_event
+    ADD VALUE IF NOT EXISTS 'snoozed';
+
+ALTER TYPE enum_alert_log_event
+    ADD VALUE IF NOT EXISTS 'snooze_expired';
+
+-- +migrate Down
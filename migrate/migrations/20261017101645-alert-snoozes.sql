@@ -0,0 +1,16 @@
+-- +migrate Up
+UPDATE engine_processing_versions SET "version" = 5 WHERE type_id = 'escalation';
+
+-- Acknowledged alerts that will be returned to triggered (and escalated from
+-- the first step) once snoozed_until has passed.
+CREATE TABLE alert_snoozes(
+    alert_id bigint PRIMARY KEY REFERENCES alerts(id) ON DELETE CASCADE,
+    snoozed_until timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_alert_snoozes_snoozed_until ON alert_snoozes(snoozed_until);
+
+-- +migrate Down
+UPDATE engine_processing_versions SET "version" = 4 WHERE type_id = 'escalation';
+DROP TABLE alert_snoozes;
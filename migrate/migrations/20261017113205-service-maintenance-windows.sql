@@ -0,0 +1,21 @@
+-- +migrate Up
+-- Scheduled maintenance windows for a service. If weekday_filter has any
+-- enabled days, the window repeats on those days (in time_zone) at the
+-- time-of-day of start_time, for the same duration.
+CREATE TABLE service_maintenance_windows(
+    id uuid PRIMARY KEY,
+    service_id uuid NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    description text NOT NULL DEFAULT '',
+    start_time timestamptz NOT NULL,
+    end_time timestamptz NOT NULL,
+    weekday_filter boolean[] NOT NULL DEFAULT '{f,f,f,f,f,f,f}',
+    time_zone text NOT NULL DEFAULT 'UTC',
+    suppress_alerts boolean NOT NULL DEFAULT FALSE,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CHECK (end_time > start_time)
+);
+
+CREATE INDEX idx_service_maintenance_windows_service_id ON service_maintenance_windows(service_id);
+
+-- +migrate Down
+DROP TABLE service_maintenance_windows;
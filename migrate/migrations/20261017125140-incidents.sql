@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Incidents group related alerts. Only the primary alert is escalated; the
+-- rest have their escalation policy state removed when they are grouped.
+CREATE TABLE incidents(
+    id bigserial PRIMARY KEY,
+    title text NOT NULL,
+    group_key text NOT NULL,
+    primary_alert_id bigint NOT NULL UNIQUE REFERENCES alerts(id) ON DELETE CASCADE,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_incidents_group_key ON incidents(group_key, created_at);
+
+CREATE TABLE incident_alerts(
+    alert_id bigint PRIMARY KEY REFERENCES alerts(id) ON DELETE CASCADE,
+    incident_id bigint NOT NULL REFERENCES incidents(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_incident_alerts_incident_id ON incident_alerts(incident_id);
+
+-- +migrate Down
+DROP TABLE incident_alerts;
+DROP TABLE incidents;
@@ -0,0 +1,82 @@
+-- +migrate Up
+-- Ordered routing rules for new alerts of a service. The first rule whose
+-- condition matches can escalate the alert with a different policy, set a
+-- priority, or drop the alert.
+CREATE TABLE service_routing_rules(
+    id uuid PRIMARY KEY,
+    service_id uuid NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    position integer NOT NULL,
+    name text NOT NULL,
+    condition_expr text NOT NULL,
+    escalation_policy_id uuid REFERENCES escalation_policies(id) ON DELETE CASCADE,
+    priority text NOT NULL DEFAULT '',
+    drop_alert boolean NOT NULL DEFAULT FALSE,
+    UNIQUE (service_id, position)
+);
+
+-- Routed alerts are escalated by a policy other than the one of their service.
+ALTER TABLE escalation_policy_state
+    DROP CONSTRAINT svc_ep_fkey;
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_clear_ep_state_on_svc_ep_change()
+    RETURNS TRIGGER
+    AS $$
+BEGIN
+    UPDATE
+        escalation_policy_state
+    SET
+        escalation_policy_id = NEW.escalation_policy_id,
+        escalation_policy_step_id = NULL,
+        loop_count = 0,
+        last_escalation = NULL,
+        next_escalation = NULL,
+        force_escalation = FALSE,
+        escalation_policy_step_number = 0
+    WHERE
+        service_id = NEW.id
+        AND escalation_policy_id = OLD.escalation_policy_id;
+    RETURN NEW;
+END;
+$$
+LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+-- +migrate Down
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_clear_ep_state_on_svc_ep_change()
+    RETURNS TRIGGER
+    AS $$
+BEGIN
+    UPDATE
+        escalation_policy_state
+    SET
+        escalation_policy_id = NEW.escalation_policy_id,
+        escalation_policy_step_id = NULL,
+        loop_count = 0,
+        last_escalation = NULL,
+        next_escalation = NULL,
+        force_escalation = FALSE,
+        escalation_policy_step_number = 0
+    WHERE
+        service_id = NEW.id;
+    RETURN NEW;
+END;
+$$
+LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+UPDATE
+    escalation_policy_state state
+SET
+    escalation_policy_id = svc.escalation_policy_id
+FROM
+    services svc
+WHERE
+    svc.id = state.service_id
+    AND svc.escalation_policy_id != state.escalation_policy_id;
+
+ALTER TABLE escalation_policy_state
+    ADD CONSTRAINT svc_ep_fkey FOREIGN KEY (service_id, escalation_policy_id) REFERENCES services(id, escalation_policy_id) ON UPDATE CASCADE ON DELETE CASCADE DEFERRABLE;
+
+DROP TABLE service_routing_rules;
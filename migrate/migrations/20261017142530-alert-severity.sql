@@ -0,0 +1,39 @@
+-- +migrate Up
+-- Values are ordered from least to most urgent, so they can be compared.
+CREATE TYPE enum_alert_severity AS ENUM(
+    'info',
+    'low',
+    'medium',
+    'high',
+    'critical'
+);
+
+ALTER TABLE alerts
+    ADD COLUMN severity enum_alert_severity NOT NULL DEFAULT 'medium';
+
+-- Notification rules with a min_severity only apply to alerts of at least that severity.
+ALTER TABLE user_notification_rules
+    ADD COLUMN min_severity enum_alert_severity;
+
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 3
+WHERE
+    type_id = 'np_cycle';
+
+-- +migrate Down
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 2
+WHERE
+    type_id = 'np_cycle';
+
+ALTER TABLE user_notification_rules
+    DROP COLUMN min_severity;
+
+ALTER TABLE alerts
+    DROP COLUMN severity;
+
+DROP TYPE enum_alert_severity;
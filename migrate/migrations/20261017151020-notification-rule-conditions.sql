@@ -0,0 +1,30 @@
+-- +migrate Up
+-- Notification rules only apply between start_time and end_time (in time_zone) on
+-- the days enabled in weekday_filter. If start_time and end_time are equal, the rule
+-- applies all day. If end_time is before start_time, the window ends the next day.
+ALTER TABLE user_notification_rules
+    ADD COLUMN weekday_filter boolean[] NOT NULL DEFAULT '{t,t,t,t,t,t,t}',
+    ADD COLUMN start_time time without time zone NOT NULL DEFAULT '00:00',
+    ADD COLUMN end_time time without time zone NOT NULL DEFAULT '00:00',
+    ADD COLUMN time_zone text NOT NULL DEFAULT 'UTC';
+
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 4
+WHERE
+    type_id = 'np_cycle';
+
+-- +migrate Down
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 3
+WHERE
+    type_id = 'np_cycle';
+
+ALTER TABLE user_notification_rules
+    DROP COLUMN weekday_filter,
+    DROP COLUMN start_time,
+    DROP COLUMN end_time,
+    DROP COLUMN time_zone;
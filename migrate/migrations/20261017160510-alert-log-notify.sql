@@ -0,0 +1,19 @@
+-- +migrate Up
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_notify_alert_log() RETURNS TRIGGER AS
+    $$
+    BEGIN
+        NOTIFY "/goalert/alert-log";
+        RETURN NULL;
+    END;
+    $$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+CREATE TRIGGER trg_notify_alert_log
+    AFTER INSERT ON alert_logs
+    FOR EACH STATEMENT
+    EXECUTE PROCEDURE fn_notify_alert_log();
+
+-- +migrate Down
+DROP TRIGGER trg_notify_alert_log ON alert_logs;
+DROP FUNCTION fn_notify_alert_log();
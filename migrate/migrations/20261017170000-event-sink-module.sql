@@ -0,0 +1,12 @@
+-- +migrate Up notransaction
+ALTER TYPE engine_processing_type
+    ADD VALUE IF NOT EXISTS 'event_sink';
+
+INSERT INTO engine_processing_versions(type_id, version)
+    VALUES ('event_sink', 1)
+ON CONFLICT
+    DO NOTHING;
+
+-- +migrate Down
+DELETE FROM engine_processing_versions
+WHERE type_id = 'event_sink';
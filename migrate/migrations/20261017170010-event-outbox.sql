@@ -0,0 +1,80 @@
+-- +migrate Up
+CREATE TABLE event_outbox(
+    id bigserial PRIMARY KEY,
+    event_type text NOT NULL,
+    subject text NOT NULL,
+    data jsonb NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    attempts int NOT NULL DEFAULT 0,
+    last_error text
+);
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_event_outbox_alert_log() RETURNS TRIGGER AS
+    $$
+    BEGIN
+        INSERT INTO event_outbox(event_type, subject, data)
+        SELECT
+            'alert.' || NEW.event,
+            'alerts/' || a.id,
+            jsonb_build_object(
+                'alertID', a.id,
+                'serviceID', a.service_id,
+                'status', a.status,
+                'severity', a.severity,
+                'summary', a.summary,
+                'logID', NEW.id,
+                'message', NEW.message,
+                'meta', NEW.meta)
+        FROM
+            alerts a
+        WHERE
+            a.id = NEW.alert_id;
+        RETURN NULL;
+    END;
+    $$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_event_outbox_shift() RETURNS TRIGGER AS
+    $$
+    BEGIN
+        INSERT INTO event_outbox(event_type, subject, data)
+            VALUES (
+                CASE WHEN TG_OP = 'INSERT' THEN 'oncall.shift.started' ELSE 'oncall.shift.ended' END,
+                'schedules/' || NEW.schedule_id,
+                jsonb_build_object(
+                    'shiftID', NEW.id,
+                    'scheduleID', NEW.schedule_id,
+                    'userID', NEW.user_id,
+                    'start', NEW.start_time,
+                    'end', NEW.end_time));
+        RETURN NULL;
+    END;
+    $$ LANGUAGE 'plpgsql';
+-- +migrate StatementEnd
+
+CREATE TRIGGER trg_event_outbox_alert_log
+    AFTER INSERT ON alert_logs
+    FOR EACH ROW
+    WHEN (NEW.event IN ('created', 'acknowledged', 'closed', 'escalated'))
+    EXECUTE PROCEDURE fn_event_outbox_alert_log();
+
+CREATE TRIGGER trg_event_outbox_shift_start
+    AFTER INSERT ON schedule_on_call_users
+    FOR EACH ROW
+    EXECUTE PROCEDURE fn_event_outbox_shift();
+
+CREATE TRIGGER trg_event_outbox_shift_end
+    AFTER UPDATE OF end_time ON schedule_on_call_users
+    FOR EACH ROW
+    WHEN (OLD.end_time IS NULL AND NEW.end_time NOTNULL)
+    EXECUTE PROCEDURE fn_event_outbox_shift();
+
+-- +migrate Down
+DROP TRIGGER trg_event_outbox_shift_end ON schedule_on_call_users;
+DROP TRIGGER trg_event_outbox_shift_start ON schedule_on_call_users;
+DROP TRIGGER trg_event_outbox_alert_log ON alert_logs;
+DROP FUNCTION fn_event_outbox_shift();
+DROP FUNCTION fn_event_outbox_alert_log();
+DROP TABLE event_outbox;
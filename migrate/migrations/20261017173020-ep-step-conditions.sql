@@ -0,0 +1,94 @@
+-- +migrate Up
+-- Escalation policy steps are skipped outside of the window between start_time and
+-- end_time (in time_zone) on the days enabled in weekday_filter, or when any of
+-- match_conditions are not met by the alert. Match conditions are objects with a
+-- type ('label' or 'metadata'), key, and value.
+ALTER TABLE escalation_policy_steps
+    ADD COLUMN weekday_filter boolean[] NOT NULL DEFAULT '{t,t,t,t,t,t,t}',
+    ADD COLUMN start_time time without time zone NOT NULL DEFAULT '00:00',
+    ADD COLUMN end_time time without time zone NOT NULL DEFAULT '00:00',
+    ADD COLUMN time_zone text NOT NULL DEFAULT 'UTC',
+    ADD COLUMN match_conditions jsonb NOT NULL DEFAULT '[]';
+
+-- must match escalation.Step.ConditionsMet
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION fn_ep_step_conditions_met(_step_id uuid, _alert_id bigint)
+    RETURNS boolean
+    AS $$
+    SELECT
+        CASE WHEN step.start_time = step.end_time THEN
+            step.weekday_filter[loc.today]
+        WHEN step.start_time < step.end_time THEN
+            step.weekday_filter[loc.today]
+            AND loc.clock >= step.start_time
+            AND loc.clock < step.end_time
+        ELSE
+            (step.weekday_filter[loc.today]
+                AND loc.clock >= step.start_time)
+            OR (step.weekday_filter[loc.yesterday]
+                AND loc.clock < step.end_time)
+        END
+        AND NOT EXISTS (
+            SELECT
+            FROM
+                jsonb_array_elements(step.match_conditions) cond
+            WHERE
+                CASE cond ->> 'type'
+                WHEN 'metadata' THEN
+                    NOT EXISTS (
+                        SELECT
+                        FROM
+                            alert_data ad
+                        WHERE
+                            ad.alert_id = _alert_id
+                            AND ad.metadata -> 'AlertMetaV1' ->> (cond ->> 'key') = cond ->> 'value')
+                WHEN 'label' THEN
+                    NOT EXISTS (
+                        SELECT
+                        FROM
+                            alerts a
+                            JOIN labels l ON l.tgt_service_id = a.service_id
+                        WHERE
+                            a.id = _alert_id
+                            AND l.key = cond ->> 'key'
+                            AND l.value = cond ->> 'value')
+                ELSE
+                    TRUE
+                END)
+    FROM
+        escalation_policy_steps step,
+        LATERAL (
+            SELECT
+                extract(dow FROM now() AT TIME ZONE step.time_zone)::int + 1 today,
+                extract(dow FROM now() AT TIME ZONE step.time_zone - interval '1 day')::int + 1 yesterday,
+                (now() AT TIME ZONE step.time_zone)::time clock) loc
+    WHERE
+        step.id = _step_id;
+$$
+LANGUAGE sql
+STABLE;
+-- +migrate StatementEnd
+
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 6
+WHERE
+    type_id = 'escalation';
+
+-- +migrate Down
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 5
+WHERE
+    type_id = 'escalation';
+
+DROP FUNCTION fn_ep_step_conditions_met(uuid, bigint);
+
+ALTER TABLE escalation_policy_steps
+    DROP COLUMN weekday_filter,
+    DROP COLUMN start_time,
+    DROP COLUMN end_time,
+    DROP COLUMN time_zone,
+    DROP COLUMN match_conditions;
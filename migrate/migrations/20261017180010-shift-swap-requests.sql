@@ -0,0 +1,52 @@
+-- +migrate Up
+CREATE TYPE enum_shift_swap_status AS ENUM (
+    'pending',
+    'accepted',
+    'declined',
+    'canceled'
+);
+
+-- A request for to_user_id to cover from_user_id's on-call shift (start_time to end_time)
+-- on a schedule, optionally in exchange for from_user_id covering trade_start_time to
+-- trade_end_time. Overrides are created when the request is accepted.
+CREATE TABLE shift_swap_requests (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    schedule_id uuid NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    from_user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    to_user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_time timestamptz NOT NULL,
+    end_time timestamptz NOT NULL,
+    trade_start_time timestamptz,
+    trade_end_time timestamptz,
+    note text NOT NULL DEFAULT '',
+    status enum_shift_swap_status NOT NULL DEFAULT 'pending',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    resolved_at timestamptz,
+    CONSTRAINT shift_swap_users CHECK (from_user_id <> to_user_id),
+    CONSTRAINT shift_swap_time CHECK (start_time < end_time),
+    CONSTRAINT shift_swap_trade_time CHECK (trade_start_time IS NULL AND trade_end_time IS NULL OR trade_start_time < trade_end_time),
+    CONSTRAINT shift_swap_resolved CHECK ((status = 'pending') = (resolved_at IS NULL))
+);
+
+CREATE INDEX idx_shift_swap_schedule ON shift_swap_requests(schedule_id, created_at);
+
+CREATE INDEX idx_shift_swap_to_user_pending ON shift_swap_requests(to_user_id)
+WHERE
+    status = 'pending';
+
+ALTER TABLE outgoing_messages
+    ADD COLUMN shift_swap_request_id uuid REFERENCES shift_swap_requests(id) ON DELETE CASCADE,
+    ADD CONSTRAINT om_shift_swap_request_id CHECK (message_type <> 'shift_swap_request' OR shift_swap_request_id IS NOT NULL);
+
+CREATE INDEX idx_om_shift_swap_request_id ON outgoing_messages(shift_swap_request_id);
+
+-- +migrate Down
+DELETE FROM outgoing_messages
+WHERE message_type = 'shift_swap_request';
+
+ALTER TABLE outgoing_messages
+    DROP COLUMN shift_swap_request_id;
+
+DROP TABLE shift_swap_requests;
+
+DROP TYPE enum_shift_swap_status;
@@ -0,0 +1,14 @@
+-- +migrate Up
+ALTER TABLE rotations
+    ADD COLUMN regions jsonb NOT NULL DEFAULT '[]';
+
+-- +migrate Down
+UPDATE
+    rotations
+SET
+    type = 'daily'
+WHERE
+    type = 'follow_the_sun';
+
+ALTER TABLE rotations
+    DROP COLUMN regions;
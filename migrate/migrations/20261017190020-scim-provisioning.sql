@@ -0,0 +1,24 @@
+-- +migrate Up
+ALTER TABLE users
+    ADD COLUMN deactivated_at timestamptz;
+
+CREATE TABLE scim_users (
+    user_id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    user_name text NOT NULL,
+    external_id text
+);
+
+CREATE UNIQUE INDEX scim_users_user_name ON scim_users (lower(user_name));
+
+CREATE TABLE scim_groups (
+    rotation_id uuid PRIMARY KEY REFERENCES rotations (id) ON DELETE CASCADE,
+    external_id text
+);
+
+-- +migrate Down
+DROP TABLE scim_groups;
+
+DROP TABLE scim_users;
+
+ALTER TABLE users
+    DROP COLUMN deactivated_at;
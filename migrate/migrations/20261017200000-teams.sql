@@ -0,0 +1,74 @@
+-- +migrate Up
+CREATE TYPE enum_team_role AS ENUM (
+    'member',
+    'owner'
+);
+
+CREATE TABLE teams (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    name text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX teams_name ON teams (lower(name));
+
+-- Members of a team may modify anything owned by the team. Owners may additionally
+-- manage the team itself.
+CREATE TABLE team_members (
+    team_id uuid NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role enum_team_role NOT NULL DEFAULT 'member',
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_members_user_id ON team_members (user_id);
+
+ALTER TABLE services
+    ADD COLUMN team_id uuid REFERENCES teams (id) ON DELETE SET NULL;
+
+ALTER TABLE escalation_policies
+    ADD COLUMN team_id uuid REFERENCES teams (id) ON DELETE SET NULL;
+
+ALTER TABLE schedules
+    ADD COLUMN team_id uuid REFERENCES teams (id) ON DELETE SET NULL;
+
+ALTER TABLE rotations
+    ADD COLUMN team_id uuid REFERENCES teams (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_services_team_id ON services (team_id);
+
+CREATE INDEX idx_escalation_policies_team_id ON escalation_policies (team_id);
+
+CREATE INDEX idx_schedules_team_id ON schedules (team_id);
+
+CREATE INDEX idx_rotations_team_id ON rotations (team_id);
+
+ALTER TABLE user_favorites
+    ADD COLUMN tgt_team_id uuid REFERENCES teams (id) ON DELETE CASCADE,
+    ADD CONSTRAINT user_favorites_user_id_tgt_team_id_key UNIQUE (user_id, tgt_team_id);
+
+-- +migrate Down
+DELETE FROM user_favorites
+WHERE tgt_team_id IS NOT NULL;
+
+ALTER TABLE user_favorites
+    DROP COLUMN tgt_team_id;
+
+ALTER TABLE rotations
+    DROP COLUMN team_id;
+
+ALTER TABLE schedules
+    DROP COLUMN team_id;
+
+ALTER TABLE escalation_policies
+    DROP COLUMN team_id;
+
+ALTER TABLE services
+    DROP COLUMN team_id;
+
+DROP TABLE team_members;
+
+DROP TABLE teams;
+
+DROP TYPE enum_team_role;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- Alerts dropped by a maintenance window that suppresses alerts, kept so
+-- suppression can be audited.
+CREATE TABLE service_maintenance_suppressed_alerts(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    service_id uuid NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    window_id uuid REFERENCES service_maintenance_windows(id) ON DELETE SET NULL,
+    summary text NOT NULL,
+    dedup_key text NOT NULL,
+    suppressed_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_service_maintenance_suppressed_alerts_service_id ON service_maintenance_suppressed_alerts(service_id, suppressed_at);
+
+-- +migrate Down
+DROP TABLE service_maintenance_suppressed_alerts;
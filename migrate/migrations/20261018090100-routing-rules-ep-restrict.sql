@@ -0,0 +1,11 @@
+-- +migrate Up
+-- An escalation policy used by a routing rule can not be deleted until the
+-- rule is changed or removed.
+ALTER TABLE service_routing_rules
+    DROP CONSTRAINT service_routing_rules_escalation_policy_id_fkey,
+    ADD CONSTRAINT service_routing_rules_escalation_policy_id_fkey FOREIGN KEY (escalation_policy_id) REFERENCES escalation_policies(id) ON DELETE RESTRICT;
+
+-- +migrate Down
+ALTER TABLE service_routing_rules
+    DROP CONSTRAINT service_routing_rules_escalation_policy_id_fkey,
+    ADD CONSTRAINT service_routing_rules_escalation_policy_id_fkey FOREIGN KEY (escalation_policy_id) REFERENCES escalation_policies(id) ON DELETE CASCADE;
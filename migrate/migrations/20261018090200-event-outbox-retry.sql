@@ -0,0 +1,34 @@
+-- +migrate Up
+-- Events are claimed before they are sent, retried with a backoff, and kept as
+-- dead letters after too many failed attempts.
+ALTER TABLE event_outbox
+    ADD COLUMN next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    ADD COLUMN claimed_until timestamptz,
+    ADD COLUMN dead_at timestamptz;
+
+-- events are delivered in order for each subject
+CREATE INDEX idx_event_outbox_subject ON event_outbox(subject, id)
+WHERE
+    dead_at IS NULL;
+
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 2
+WHERE
+    type_id = 'event_sink';
+
+-- +migrate Down
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 1
+WHERE
+    type_id = 'event_sink';
+
+DROP INDEX idx_event_outbox_subject;
+
+ALTER TABLE event_outbox
+    DROP COLUMN next_attempt_at,
+    DROP COLUMN claimed_until,
+    DROP COLUMN dead_at;
@@ -0,0 +1,35 @@
+-- +migrate Up
+-- A team can not be deleted while it still owns services, escalation policies,
+-- schedules, or rotations; they must be reassigned or deleted first.
+ALTER TABLE services
+    DROP CONSTRAINT services_team_id_fkey,
+    ADD CONSTRAINT services_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT;
+
+ALTER TABLE escalation_policies
+    DROP CONSTRAINT escalation_policies_team_id_fkey,
+    ADD CONSTRAINT escalation_policies_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT;
+
+ALTER TABLE schedules
+    DROP CONSTRAINT schedules_team_id_fkey,
+    ADD CONSTRAINT schedules_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT;
+
+ALTER TABLE rotations
+    DROP CONSTRAINT rotations_team_id_fkey,
+    ADD CONSTRAINT rotations_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT;
+
+-- +migrate Down
+ALTER TABLE services
+    DROP CONSTRAINT services_team_id_fkey,
+    ADD CONSTRAINT services_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL;
+
+ALTER TABLE escalation_policies
+    DROP CONSTRAINT escalation_policies_team_id_fkey,
+    ADD CONSTRAINT escalation_policies_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL;
+
+ALTER TABLE schedules
+    DROP CONSTRAINT schedules_team_id_fkey,
+    ADD CONSTRAINT schedules_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL;
+
+ALTER TABLE rotations
+    DROP CONSTRAINT rotations_team_id_fkey,
+    ADD CONSTRAINT rotations_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL;
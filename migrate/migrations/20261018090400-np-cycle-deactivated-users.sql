@@ -0,0 +1,16 @@
+-- +migrate Up
+-- notification cycles skip deactivated users
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 5
+WHERE
+    type_id = 'np_cycle';
+
+-- +migrate Down
+UPDATE
+    engine_processing_versions
+SET
+    "version" = 4
+WHERE
+    type_id = 'np_cycle';
@@ -1,7 +1,7 @@
 -- This file is auto-generated by "make db-schema"; DO NOT EDIT
--- DATA=9c24c711c140c9b292c88826edf5f48031982e154b6ad9bd4650eabaa89e1a1b  -
--- DISK=3337dc0962a4acc8cfd3699dd3cb126feece294157e0f694a8c217567f1a0241  -
--- PSQL=3337dc0962a4acc8cfd3699dd3cb126feece294157e0f694a8c217567f1a0241  -
+-- DATA=58311fe3e20cfbe2258a99276cd9ec7661a9a75e8e7da8b5098ecc55ebe6e610  -
+-- DISK=646338815c793d4a02c1f08fa30765fc14776251bba6c17d503006f3b5f36cde  -
+-- PSQL=646338815c793d4a02c1f08fa30765fc14776251bba6c17d503006f3b5f36cde  -
 --
 -- pgdump-lite database dump
 --
@@ -16,6 +16,7 @@ CREATE TYPE engine_processing_type AS ENUM (
 	'cleanup',
 	'compat',
 	'escalation',
+	'event_sink',
 	'heartbeat',
 	'message',
 	'metrics',
@@ -40,6 +41,8 @@ CREATE TYPE enum_alert_log_event AS ENUM (
 	'policy_updated',
 	'reopened',
 	'response_received',
+	'snooze_expired',
+	'snoozed',
 	'status_changed'
 );
 
@@ -50,6 +53,14 @@ CREATE TYPE enum_alert_log_subject_type AS ENUM (
 	'user'
 );
 
+CREATE TYPE enum_alert_severity AS ENUM (
+	'critical',
+	'high',
+	'info',
+	'low',
+	'medium'
+);
+
 CREATE TYPE enum_alert_source AS ENUM (
 	'email',
 	'generic',
@@ -122,6 +133,7 @@ CREATE TYPE enum_outgoing_messages_type AS ENUM (
 	'alert_status_update',
 	'alert_status_update_bundle',
 	'schedule_on_call_notification',
+	'shift_swap_request',
 	'signal_message',
 	'test_notification',
 	'verification_message'
@@ -129,17 +141,30 @@ CREATE TYPE enum_outgoing_messages_type AS ENUM (
 
 CREATE TYPE enum_rotation_type AS ENUM (
 	'daily',
+	'follow_the_sun',
 	'hourly',
 	'monthly',
 	'weekly'
 );
 
+CREATE TYPE enum_shift_swap_status AS ENUM (
+	'accepted',
+	'canceled',
+	'declined',
+	'pending'
+);
+
 CREATE TYPE enum_switchover_state AS ENUM (
 	'idle',
 	'in_progress',
 	'use_next_db'
 );
 
+CREATE TYPE enum_team_role AS ENUM (
+	'member',
+	'owner'
+);
+
 CREATE TYPE enum_throttle_type AS ENUM (
 	'notifications',
 	'notifications_2'
@@ -318,18 +343,19 @@ CREATE OR REPLACE FUNCTION public.fn_clear_ep_state_on_svc_ep_change()
  LANGUAGE plpgsql
 AS $function$
 BEGIN
-    UPDATE escalation_policy_state
+    UPDATE
+        escalation_policy_state
     SET
         escalation_policy_id = NEW.escalation_policy_id,
         escalation_policy_step_id = NULL,
         loop_count = 0,
         last_escalation = NULL,
         next_escalation = NULL,
-        force_escalation = false,
+        force_escalation = FALSE,
         escalation_policy_step_number = 0
-    WHERE service_id = NEW.id
-    ;
-
+    WHERE
+        service_id = NEW.id
+        AND escalation_policy_id = OLD.escalation_policy_id;
     RETURN NEW;
 END;
 $function$
@@ -1001,6 +1027,110 @@ END;
 $function$
 ;
 
+CREATE OR REPLACE FUNCTION public.fn_ep_step_conditions_met(_step_id uuid, _alert_id bigint)
+ RETURNS boolean
+ LANGUAGE sql
+ STABLE
+AS $function$
+    SELECT
+        CASE WHEN step.start_time = step.end_time THEN
+            step.weekday_filter[loc.today]
+        WHEN step.start_time < step.end_time THEN
+            step.weekday_filter[loc.today]
+            AND loc.clock >= step.start_time
+            AND loc.clock < step.end_time
+        ELSE
+            (step.weekday_filter[loc.today]
+                AND loc.clock >= step.start_time)
+            OR (step.weekday_filter[loc.yesterday]
+                AND loc.clock < step.end_time)
+        END
+        AND NOT EXISTS (
+            SELECT
+            FROM
+                jsonb_array_elements(step.match_conditions) cond
+            WHERE
+                CASE cond ->> 'type'
+                WHEN 'metadata' THEN
+                    NOT EXISTS (
+                        SELECT
+                        FROM
+                            alert_data ad
+                        WHERE
+                            ad.alert_id = _alert_id
+                            AND ad.metadata -> 'AlertMetaV1' ->> (cond ->> 'key') = cond ->> 'value')
+                WHEN 'label' THEN
+                    NOT EXISTS (
+                        SELECT
+                        FROM
+                            alerts a
+                            JOIN labels l ON l.tgt_service_id = a.service_id
+                        WHERE
+                            a.id = _alert_id
+                            AND l.key = cond ->> 'key'
+                            AND l.value = cond ->> 'value')
+                ELSE
+                    TRUE
+                END)
+    FROM
+        escalation_policy_steps step,
+        LATERAL (
+            SELECT
+                extract(dow FROM now() AT TIME ZONE step.time_zone)::int + 1 today,
+                extract(dow FROM now() AT TIME ZONE step.time_zone - interval '1 day')::int + 1 yesterday,
+                (now() AT TIME ZONE step.time_zone)::time clock) loc
+    WHERE
+        step.id = _step_id;
+$function$
+;
+
+CREATE OR REPLACE FUNCTION public.fn_event_outbox_alert_log()
+ RETURNS trigger
+ LANGUAGE plpgsql
+AS $function$
+    BEGIN
+        INSERT INTO event_outbox(event_type, subject, data)
+        SELECT
+            'alert.' || NEW.event,
+            'alerts/' || a.id,
+            jsonb_build_object(
+                'alertID', a.id,
+                'serviceID', a.service_id,
+                'status', a.status,
+                'severity', a.severity,
+                'summary', a.summary,
+                'logID', NEW.id,
+                'message', NEW.message,
+                'meta', NEW.meta)
+        FROM
+            alerts a
+        WHERE
+            a.id = NEW.alert_id;
+        RETURN NULL;
+    END;
+    $function$
+;
+
+CREATE OR REPLACE FUNCTION public.fn_event_outbox_shift()
+ RETURNS trigger
+ LANGUAGE plpgsql
+AS $function$
+    BEGIN
+        INSERT INTO event_outbox(event_type, subject, data)
+            VALUES (
+                CASE WHEN TG_OP = 'INSERT' THEN 'oncall.shift.started' ELSE 'oncall.shift.ended' END,
+                'schedules/' || NEW.schedule_id,
+                jsonb_build_object(
+                    'shiftID', NEW.id,
+                    'scheduleID', NEW.schedule_id,
+                    'userID', NEW.user_id,
+                    'start', NEW.start_time,
+                    'end', NEW.end_time));
+        RETURN NULL;
+    END;
+    $function$
+;
+
 CREATE OR REPLACE FUNCTION public.fn_inc_ep_step_number_on_insert()
  RETURNS trigger
  LANGUAGE plpgsql
@@ -1236,6 +1366,17 @@ END;
 $function$
 ;
 
+CREATE OR REPLACE FUNCTION public.fn_notify_alert_log()
+ RETURNS trigger
+ LANGUAGE plpgsql
+AS $function$
+    BEGIN
+        NOTIFY "/goalert/alert-log";
+        RETURN NULL;
+    END;
+    $function$
+;
+
 CREATE OR REPLACE FUNCTION public.fn_notify_config_refresh()
  RETURNS trigger
  LANGUAGE plpgsql
@@ -1616,6 +1757,9 @@ CREATE INDEX idx_alert_logs_int_id ON public.alert_logs USING btree (sub_integra
 CREATE INDEX idx_alert_logs_user_id ON public.alert_logs USING btree (sub_user_id);
 CREATE INDEX idx_closed_events ON public.alert_logs USING btree ("timestamp") WHERE (event = 'closed'::enum_alert_log_event);
 
+CREATE TRIGGER trg_event_outbox_alert_log AFTER INSERT ON public.alert_logs FOR EACH ROW WHEN ((new.event = ANY (ARRAY['created'::enum_alert_log_event, 'acknowledged'::enum_alert_log_event, 'closed'::enum_alert_log_event, 'escalated'::enum_alert_log_event]))) EXECUTE FUNCTION fn_event_outbox_alert_log();
+CREATE TRIGGER trg_notify_alert_log AFTER INSERT ON public.alert_logs FOR EACH STATEMENT EXECUTE FUNCTION fn_notify_alert_log();
+
 
 CREATE TABLE alert_metrics (
 	alert_id bigint NOT NULL,
@@ -1635,6 +1779,18 @@ CREATE UNIQUE INDEX alert_metrics_id_key ON public.alert_metrics USING btree (id
 CREATE UNIQUE INDEX alert_metrics_pkey ON public.alert_metrics USING btree (alert_id);
 
 
+CREATE TABLE alert_snoozes (
+	alert_id bigint NOT NULL,
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	snoozed_until timestamp with time zone NOT NULL,
+	CONSTRAINT alert_snoozes_alert_id_fkey FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+	CONSTRAINT alert_snoozes_pkey PRIMARY KEY (alert_id)
+);
+
+CREATE UNIQUE INDEX alert_snoozes_pkey ON public.alert_snoozes USING btree (alert_id);
+CREATE INDEX idx_alert_snoozes_snoozed_until ON public.alert_snoozes USING btree (snoozed_until);
+
+
 CREATE TABLE alert_status_subscriptions (
 	alert_id bigint NOT NULL,
 	channel_id uuid,
@@ -1664,6 +1820,7 @@ CREATE TABLE alerts (
 	last_escalation timestamp with time zone DEFAULT now(),
 	last_processed timestamp with time zone,
 	service_id uuid,
+	severity enum_alert_severity DEFAULT 'medium'::enum_alert_severity NOT NULL,
 	source enum_alert_source DEFAULT 'manual'::enum_alert_source NOT NULL,
 	status enum_alert_status DEFAULT 'triggered'::enum_alert_status NOT NULL,
 	summary text NOT NULL,
@@ -1823,13 +1980,16 @@ CREATE TABLE escalation_policies (
 	name text NOT NULL,
 	repeat integer DEFAULT 0 NOT NULL,
 	step_count integer DEFAULT 0 NOT NULL,
+	team_id uuid,
 	CONSTRAINT escalation_policies_name_key UNIQUE (name),
-	CONSTRAINT escalation_policies_pkey PRIMARY KEY (id)
+	CONSTRAINT escalation_policies_pkey PRIMARY KEY (id),
+	CONSTRAINT escalation_policies_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT
 );
 
 CREATE UNIQUE INDEX escalation_policies_name ON public.escalation_policies USING btree (lower(name));
 CREATE UNIQUE INDEX escalation_policies_name_key ON public.escalation_policies USING btree (name);
 CREATE UNIQUE INDEX escalation_policies_pkey ON public.escalation_policies USING btree (id);
+CREATE INDEX idx_escalation_policies_team_id ON public.escalation_policies USING btree (team_id);
 CREATE INDEX idx_search_escalation_policies_desc_eng ON public.escalation_policies USING gin (to_tsvector('english'::regconfig, replace(lower(description), '.'::text, ' '::text)));
 CREATE INDEX idx_search_escalation_policies_name_eng ON public.escalation_policies USING gin (to_tsvector('english'::regconfig, replace(lower(name), '.'::text, ' '::text)));
 
@@ -1896,8 +2056,7 @@ CREATE TABLE escalation_policy_state (
 	CONSTRAINT escalation_policy_state_escalation_policy_step_id_fkey FOREIGN KEY (escalation_policy_step_id) REFERENCES escalation_policy_steps(id) ON DELETE SET NULL,
 	CONSTRAINT escalation_policy_state_pkey PRIMARY KEY (alert_id),
 	CONSTRAINT escalation_policy_state_service_id_fkey FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE,
-	CONSTRAINT escalation_policy_state_uniq_id UNIQUE (id),
-	CONSTRAINT svc_ep_fkey FOREIGN KEY (service_id, escalation_policy_id) REFERENCES services(id, escalation_policy_id) ON UPDATE CASCADE ON DELETE CASCADE DEFERRABLE
+	CONSTRAINT escalation_policy_state_uniq_id UNIQUE (id)
 );
 
 CREATE INDEX escalation_policy_state_next_escalation_force_escalation_idx ON public.escalation_policy_state USING btree (next_escalation, force_escalation);
@@ -1912,10 +2071,15 @@ CREATE TRIGGER trg_30_trig_alert_on_force_escalation AFTER UPDATE ON public.esca
 
 CREATE TABLE escalation_policy_steps (
 	delay integer DEFAULT 1 NOT NULL,
+	end_time time without time zone DEFAULT '00:00:00'::time without time zone NOT NULL,
 	escalation_policy_id uuid NOT NULL,
 	id uuid DEFAULT gen_random_uuid() NOT NULL,
+	match_conditions jsonb DEFAULT '[]'::jsonb NOT NULL,
 	multi_ack boolean DEFAULT false NOT NULL,
+	start_time time without time zone DEFAULT '00:00:00'::time without time zone NOT NULL,
 	step_number integer DEFAULT '-1'::integer NOT NULL,
+	time_zone text DEFAULT 'UTC'::text NOT NULL,
+	weekday_filter boolean[] DEFAULT '{t,t,t,t,t,t,t}'::boolean[] NOT NULL,
 	CONSTRAINT escalation_policy_steps_escalation_policy_id_fkey FOREIGN KEY (escalation_policy_id) REFERENCES escalation_policies(id) ON DELETE CASCADE,
 	CONSTRAINT escalation_policy_steps_escalation_policy_id_step_number_key UNIQUE (escalation_policy_id, step_number) DEFERRABLE INITIALLY DEFERRED,
 	CONSTRAINT escalation_policy_steps_pkey PRIMARY KEY (id)
@@ -1934,6 +2098,24 @@ CREATE CONSTRAINT TRIGGER trg_ep_step_number_no_gaps AFTER UPDATE ON public.esca
 CREATE TRIGGER trg_inc_ep_step_number_on_insert BEFORE INSERT ON public.escalation_policy_steps FOR EACH ROW EXECUTE FUNCTION fn_inc_ep_step_number_on_insert();
 
 
+CREATE TABLE event_outbox (
+	attempts integer DEFAULT 0 NOT NULL,
+	claimed_until timestamp with time zone,
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	data jsonb NOT NULL,
+	dead_at timestamp with time zone,
+	event_type text NOT NULL,
+	id bigint DEFAULT nextval('event_outbox_id_seq'::regclass) NOT NULL,
+	last_error text,
+	next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+	subject text NOT NULL,
+	CONSTRAINT event_outbox_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX event_outbox_pkey ON public.event_outbox USING btree (id);
+CREATE INDEX idx_event_outbox_subject ON public.event_outbox USING btree (subject, id) WHERE (dead_at IS NULL);
+
+
 CREATE TABLE gorp_migrations (
 	applied_at timestamp with time zone,
 	id text NOT NULL,
@@ -2000,6 +2182,34 @@ CREATE INDEX idx_heartbeat_monitor_service ON public.heartbeat_monitors USING bt
 CREATE CONSTRAINT TRIGGER trg_enforce_heartbeat_monitor_limit AFTER INSERT ON public.heartbeat_monitors NOT DEFERRABLE INITIALLY IMMEDIATE FOR EACH ROW EXECUTE FUNCTION fn_enforce_heartbeat_limit();
 
 
+CREATE TABLE incident_alerts (
+	alert_id bigint NOT NULL,
+	incident_id bigint NOT NULL,
+	CONSTRAINT incident_alerts_alert_id_fkey FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+	CONSTRAINT incident_alerts_incident_id_fkey FOREIGN KEY (incident_id) REFERENCES incidents(id) ON DELETE CASCADE,
+	CONSTRAINT incident_alerts_pkey PRIMARY KEY (alert_id)
+);
+
+CREATE INDEX idx_incident_alerts_incident_id ON public.incident_alerts USING btree (incident_id);
+CREATE UNIQUE INDEX incident_alerts_pkey ON public.incident_alerts USING btree (alert_id);
+
+
+CREATE TABLE incidents (
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	group_key text NOT NULL,
+	id bigint DEFAULT nextval('incidents_id_seq'::regclass) NOT NULL,
+	primary_alert_id bigint NOT NULL,
+	title text NOT NULL,
+	CONSTRAINT incidents_pkey PRIMARY KEY (id),
+	CONSTRAINT incidents_primary_alert_id_fkey FOREIGN KEY (primary_alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+	CONSTRAINT incidents_primary_alert_id_key UNIQUE (primary_alert_id)
+);
+
+CREATE INDEX idx_incidents_group_key ON public.incidents USING btree (group_key, created_at);
+CREATE UNIQUE INDEX incidents_pkey ON public.incidents USING btree (id);
+CREATE UNIQUE INDEX incidents_primary_alert_id_key ON public.incidents USING btree (primary_alert_id);
+
+
 CREATE TABLE integration_keys (
 	external_system_name text,
 	id uuid DEFAULT gen_random_uuid() NOT NULL,
@@ -2138,6 +2348,7 @@ CREATE TABLE outgoing_messages (
 	sending_deadline timestamp with time zone,
 	sent_at timestamp with time zone,
 	service_id uuid,
+	shift_swap_request_id uuid,
 	src_value text,
 	status_alert_ids bigint[],
 	status_details text DEFAULT ''::text NOT NULL,
@@ -2149,6 +2360,7 @@ CREATE TABLE outgoing_messages (
 	CONSTRAINT om_processed_no_fired_sent CHECK ((last_status = ANY (ARRAY['pending'::enum_outgoing_messages_status, 'sending'::enum_outgoing_messages_status, 'failed'::enum_outgoing_messages_status, 'bundled'::enum_outgoing_messages_status])) OR fired_at IS NULL AND sent_at IS NOT NULL),
 	CONSTRAINT om_sending_deadline_reqd CHECK (last_status <> 'sending'::enum_outgoing_messages_status OR sending_deadline IS NOT NULL),
 	CONSTRAINT om_sending_fired_no_sent CHECK (last_status <> 'sending'::enum_outgoing_messages_status OR fired_at IS NOT NULL AND sent_at IS NULL),
+	CONSTRAINT om_shift_swap_request_id CHECK (message_type <> 'shift_swap_request'::enum_outgoing_messages_type OR shift_swap_request_id IS NOT NULL),
 	CONSTRAINT om_status_alert_ids CHECK (message_type <> 'alert_status_update_bundle'::enum_outgoing_messages_type OR status_alert_ids IS NOT NULL),
 	CONSTRAINT om_status_update_log_id CHECK (message_type <> 'alert_status_update'::enum_outgoing_messages_type OR alert_log_id IS NOT NULL),
 	CONSTRAINT om_user_cm_or_channel CHECK (user_id IS NOT NULL AND contact_method_id IS NOT NULL AND channel_id IS NULL OR channel_id IS NOT NULL AND contact_method_id IS NULL AND user_id IS NULL),
@@ -2161,6 +2373,7 @@ CREATE TABLE outgoing_messages (
 	CONSTRAINT outgoing_messages_pkey PRIMARY KEY (id),
 	CONSTRAINT outgoing_messages_schedule_id_fkey FOREIGN KEY (schedule_id) REFERENCES schedules(id) ON DELETE CASCADE,
 	CONSTRAINT outgoing_messages_service_id_fkey FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE,
+	CONSTRAINT outgoing_messages_shift_swap_request_id_fkey FOREIGN KEY (shift_swap_request_id) REFERENCES shift_swap_requests(id) ON DELETE CASCADE,
 	CONSTRAINT outgoing_messages_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
 	CONSTRAINT outgoing_messages_user_verification_code_id_fkey FOREIGN KEY (user_verification_code_id) REFERENCES user_verification_codes(id) ON DELETE CASCADE,
 	CONSTRAINT verify_needs_id CHECK (message_type <> 'verification_message'::enum_outgoing_messages_type OR user_verification_code_id IS NOT NULL)
@@ -2172,6 +2385,7 @@ CREATE INDEX idx_om_cm_sent ON public.outgoing_messages USING btree (contact_met
 CREATE INDEX idx_om_ep_sent ON public.outgoing_messages USING btree (escalation_policy_id, sent_at);
 CREATE INDEX idx_om_last_status_sent ON public.outgoing_messages USING btree (last_status, sent_at);
 CREATE INDEX idx_om_service_sent ON public.outgoing_messages USING btree (service_id, sent_at);
+CREATE INDEX idx_om_shift_swap_request_id ON public.outgoing_messages USING btree (shift_swap_request_id);
 CREATE INDEX idx_om_user_sent ON public.outgoing_messages USING btree (user_id, sent_at);
 CREATE INDEX idx_om_vcode_id ON public.outgoing_messages USING btree (user_verification_code_id);
 CREATE INDEX idx_outgoing_messages_notif_cycle ON public.outgoing_messages USING btree (cycle_id);
@@ -2372,15 +2586,19 @@ CREATE TABLE rotations (
 	last_processed timestamp with time zone,
 	name text NOT NULL,
 	participant_count integer DEFAULT 0 NOT NULL,
+	regions jsonb DEFAULT '[]'::jsonb NOT NULL,
 	shift_length bigint DEFAULT 1 NOT NULL,
 	start_time timestamp with time zone DEFAULT now() NOT NULL,
+	team_id uuid,
 	time_zone text NOT NULL,
 	type enum_rotation_type NOT NULL,
 	CONSTRAINT rotations_name_unique UNIQUE (name),
 	CONSTRAINT rotations_pkey PRIMARY KEY (id),
-	CONSTRAINT rotations_shift_length_check CHECK (shift_length > 0)
+	CONSTRAINT rotations_shift_length_check CHECK (shift_length > 0),
+	CONSTRAINT rotations_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT
 );
 
+CREATE INDEX idx_rotations_team_id ON public.rotations USING btree (team_id);
 CREATE INDEX idx_search_rotations_desc_eng ON public.rotations USING gin (to_tsvector('english'::regconfig, replace(lower(description), '.'::text, ' '::text)));
 CREATE INDEX idx_search_rotations_name_eng ON public.rotations USING gin (to_tsvector('english'::regconfig, replace(lower(name), '.'::text, ' '::text)));
 CREATE UNIQUE INDEX rotations_name ON public.rotations USING btree (lower(name));
@@ -2420,6 +2638,9 @@ CREATE INDEX idx_sched_oncall_times ON public.schedule_on_call_users USING spgis
 CREATE UNIQUE INDEX idx_schedule_on_call_once ON public.schedule_on_call_users USING btree (schedule_id, user_id) WHERE (end_time IS NULL);
 CREATE UNIQUE INDEX schedule_on_call_users_uniq_id ON public.schedule_on_call_users USING btree (id);
 
+CREATE TRIGGER trg_event_outbox_shift_end AFTER UPDATE OF end_time ON public.schedule_on_call_users FOR EACH ROW WHEN (((old.end_time IS NULL) AND (new.end_time IS NOT NULL))) EXECUTE FUNCTION fn_event_outbox_shift();
+CREATE TRIGGER trg_event_outbox_shift_start AFTER INSERT ON public.schedule_on_call_users FOR EACH ROW EXECUTE FUNCTION fn_event_outbox_shift();
+
 
 CREATE TABLE schedule_rules (
 	created_at timestamp with time zone DEFAULT now() NOT NULL,
@@ -2457,11 +2678,14 @@ CREATE TABLE schedules (
 	id uuid DEFAULT gen_random_uuid() NOT NULL,
 	last_processed timestamp with time zone,
 	name text NOT NULL,
+	team_id uuid,
 	time_zone text NOT NULL,
 	CONSTRAINT schedules_name_key UNIQUE (name),
-	CONSTRAINT schedules_pkey PRIMARY KEY (id)
+	CONSTRAINT schedules_pkey PRIMARY KEY (id),
+	CONSTRAINT schedules_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT
 );
 
+CREATE INDEX idx_schedules_team_id ON public.schedules USING btree (team_id);
 CREATE INDEX idx_search_schedules_desc_eng ON public.schedules USING gin (to_tsvector('english'::regconfig, replace(lower(description), '.'::text, ' '::text)));
 CREATE INDEX idx_search_schedules_name_eng ON public.schedules USING gin (to_tsvector('english'::regconfig, replace(lower(name), '.'::text, ' '::text)));
 CREATE UNIQUE INDEX schedules_name ON public.schedules USING btree (lower(name));
@@ -2469,15 +2693,93 @@ CREATE UNIQUE INDEX schedules_name_key ON public.schedules USING btree (name);
 CREATE UNIQUE INDEX schedules_pkey ON public.schedules USING btree (id);
 
 
+CREATE TABLE scim_groups (
+	external_id text,
+	rotation_id uuid NOT NULL,
+	CONSTRAINT scim_groups_pkey PRIMARY KEY (rotation_id),
+	CONSTRAINT scim_groups_rotation_id_fkey FOREIGN KEY (rotation_id) REFERENCES rotations(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX scim_groups_pkey ON public.scim_groups USING btree (rotation_id);
+
+
+CREATE TABLE scim_users (
+	external_id text,
+	user_id uuid NOT NULL,
+	user_name text NOT NULL,
+	CONSTRAINT scim_users_pkey PRIMARY KEY (user_id),
+	CONSTRAINT scim_users_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX scim_users_pkey ON public.scim_users USING btree (user_id);
+CREATE UNIQUE INDEX scim_users_user_name ON public.scim_users USING btree (lower(user_name));
+
+
+CREATE TABLE service_maintenance_suppressed_alerts (
+	dedup_key text NOT NULL,
+	id uuid DEFAULT gen_random_uuid() NOT NULL,
+	service_id uuid NOT NULL,
+	summary text NOT NULL,
+	suppressed_at timestamp with time zone DEFAULT now() NOT NULL,
+	window_id uuid,
+	CONSTRAINT service_maintenance_suppressed_alerts_pkey PRIMARY KEY (id),
+	CONSTRAINT service_maintenance_suppressed_alerts_service_id_fkey FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE,
+	CONSTRAINT service_maintenance_suppressed_alerts_window_id_fkey FOREIGN KEY (window_id) REFERENCES service_maintenance_windows(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_service_maintenance_suppressed_alerts_service_id ON public.service_maintenance_suppressed_alerts USING btree (service_id, suppressed_at);
+CREATE UNIQUE INDEX service_maintenance_suppressed_alerts_pkey ON public.service_maintenance_suppressed_alerts USING btree (id);
+
+
+CREATE TABLE service_maintenance_windows (
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	description text DEFAULT ''::text NOT NULL,
+	end_time timestamp with time zone NOT NULL,
+	id uuid NOT NULL,
+	service_id uuid NOT NULL,
+	start_time timestamp with time zone NOT NULL,
+	suppress_alerts boolean DEFAULT false NOT NULL,
+	time_zone text DEFAULT 'UTC'::text NOT NULL,
+	weekday_filter boolean[] DEFAULT '{f,f,f,f,f,f,f}'::boolean[] NOT NULL,
+	CONSTRAINT service_maintenance_windows_check CHECK ((end_time > start_time)),
+	CONSTRAINT service_maintenance_windows_pkey PRIMARY KEY (id),
+	CONSTRAINT service_maintenance_windows_service_id_fkey FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_service_maintenance_windows_service_id ON public.service_maintenance_windows USING btree (service_id);
+CREATE UNIQUE INDEX service_maintenance_windows_pkey ON public.service_maintenance_windows USING btree (id);
+
+
+CREATE TABLE service_routing_rules (
+	condition_expr text NOT NULL,
+	drop_alert boolean DEFAULT false NOT NULL,
+	escalation_policy_id uuid,
+	id uuid NOT NULL,
+	name text NOT NULL,
+	position integer NOT NULL,
+	priority text DEFAULT ''::text NOT NULL,
+	service_id uuid NOT NULL,
+	CONSTRAINT service_routing_rules_escalation_policy_id_fkey FOREIGN KEY (escalation_policy_id) REFERENCES escalation_policies(id) ON DELETE RESTRICT,
+	CONSTRAINT service_routing_rules_pkey PRIMARY KEY (id),
+	CONSTRAINT service_routing_rules_service_id_fkey FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE,
+	CONSTRAINT service_routing_rules_service_id_position_key UNIQUE (service_id, "position")
+);
+
+CREATE UNIQUE INDEX service_routing_rules_pkey ON public.service_routing_rules USING btree (id);
+CREATE UNIQUE INDEX service_routing_rules_service_id_position_key ON public.service_routing_rules USING btree (service_id, "position");
+
+
 CREATE TABLE services (
 	description text DEFAULT ''::text NOT NULL,
 	escalation_policy_id uuid NOT NULL,
 	id uuid DEFAULT gen_random_uuid() NOT NULL,
 	maintenance_expires_at timestamp with time zone,
 	name text NOT NULL,
+	team_id uuid,
 	CONSTRAINT services_escalation_policy_id_fkey FOREIGN KEY (escalation_policy_id) REFERENCES escalation_policies(id),
 	CONSTRAINT services_name_key UNIQUE (name),
 	CONSTRAINT services_pkey PRIMARY KEY (id),
+	CONSTRAINT services_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE RESTRICT,
 	CONSTRAINT svc_ep_uniq UNIQUE (id, escalation_policy_id)
 );
 
@@ -2486,11 +2788,40 @@ CREATE INDEX idx_search_services_name_eng ON public.services USING gin (to_tsvec
 CREATE UNIQUE INDEX services_name ON public.services USING btree (lower(name));
 CREATE UNIQUE INDEX services_name_key ON public.services USING btree (name);
 CREATE UNIQUE INDEX services_pkey ON public.services USING btree (id);
+CREATE INDEX idx_services_team_id ON public.services USING btree (team_id);
 CREATE UNIQUE INDEX svc_ep_uniq ON public.services USING btree (id, escalation_policy_id);
 
 CREATE TRIGGER trg_10_clear_ep_state_on_svc_ep_change AFTER UPDATE ON public.services FOR EACH ROW WHEN ((old.escalation_policy_id <> new.escalation_policy_id)) EXECUTE FUNCTION fn_clear_ep_state_on_svc_ep_change();
 
 
+CREATE TABLE shift_swap_requests (
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	end_time timestamp with time zone NOT NULL,
+	from_user_id uuid NOT NULL,
+	id uuid DEFAULT gen_random_uuid() NOT NULL,
+	note text DEFAULT ''::text NOT NULL,
+	resolved_at timestamp with time zone,
+	schedule_id uuid NOT NULL,
+	start_time timestamp with time zone NOT NULL,
+	status enum_shift_swap_status DEFAULT 'pending'::enum_shift_swap_status NOT NULL,
+	to_user_id uuid NOT NULL,
+	trade_end_time timestamp with time zone,
+	trade_start_time timestamp with time zone,
+	CONSTRAINT shift_swap_requests_from_user_id_fkey FOREIGN KEY (from_user_id) REFERENCES users(id) ON DELETE CASCADE,
+	CONSTRAINT shift_swap_requests_pkey PRIMARY KEY (id),
+	CONSTRAINT shift_swap_requests_schedule_id_fkey FOREIGN KEY (schedule_id) REFERENCES schedules(id) ON DELETE CASCADE,
+	CONSTRAINT shift_swap_requests_to_user_id_fkey FOREIGN KEY (to_user_id) REFERENCES users(id) ON DELETE CASCADE,
+	CONSTRAINT shift_swap_resolved CHECK ((status = 'pending'::enum_shift_swap_status) = (resolved_at IS NULL)),
+	CONSTRAINT shift_swap_time CHECK (start_time < end_time),
+	CONSTRAINT shift_swap_trade_time CHECK (trade_start_time IS NULL AND trade_end_time IS NULL OR trade_start_time < trade_end_time),
+	CONSTRAINT shift_swap_users CHECK (from_user_id <> to_user_id)
+);
+
+CREATE INDEX idx_shift_swap_schedule ON public.shift_swap_requests USING btree (schedule_id, created_at);
+CREATE INDEX idx_shift_swap_to_user_pending ON public.shift_swap_requests USING btree (to_user_id) WHERE (status = 'pending'::enum_shift_swap_status);
+CREATE UNIQUE INDEX shift_swap_requests_pkey ON public.shift_swap_requests USING btree (id);
+
+
 CREATE TABLE switchover_log (
 	data jsonb NOT NULL,
 	id bigint NOT NULL,
@@ -2512,6 +2843,31 @@ CREATE TABLE switchover_state (
 CREATE UNIQUE INDEX switchover_state_pkey ON public.switchover_state USING btree (ok);
 
 
+CREATE TABLE team_members (
+	role enum_team_role DEFAULT 'member'::enum_team_role NOT NULL,
+	team_id uuid NOT NULL,
+	user_id uuid NOT NULL,
+	CONSTRAINT team_members_pkey PRIMARY KEY (team_id, user_id),
+	CONSTRAINT team_members_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+	CONSTRAINT team_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_team_members_user_id ON public.team_members USING btree (user_id);
+CREATE UNIQUE INDEX team_members_pkey ON public.team_members USING btree (team_id, user_id);
+
+
+CREATE TABLE teams (
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	description text DEFAULT ''::text NOT NULL,
+	id uuid DEFAULT gen_random_uuid() NOT NULL,
+	name text NOT NULL,
+	CONSTRAINT teams_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX teams_name ON public.teams USING btree (lower(name));
+CREATE UNIQUE INDEX teams_pkey ON public.teams USING btree (id);
+
+
 CREATE TABLE twilio_sms_callbacks (
 	alert_id bigint,
 	callback_id uuid NOT NULL,
@@ -2635,12 +2991,14 @@ CREATE TABLE user_favorites (
 	tgt_rotation_id uuid,
 	tgt_schedule_id uuid,
 	tgt_service_id uuid,
+	tgt_team_id uuid,
 	tgt_user_id uuid,
 	user_id uuid NOT NULL,
 	CONSTRAINT user_favorites_tgt_escalation_policy_id_fkey FOREIGN KEY (tgt_escalation_policy_id) REFERENCES escalation_policies(id) ON DELETE CASCADE,
 	CONSTRAINT user_favorites_tgt_rotation_id_fkey FOREIGN KEY (tgt_rotation_id) REFERENCES rotations(id) ON DELETE CASCADE,
 	CONSTRAINT user_favorites_tgt_schedule_id_fkey FOREIGN KEY (tgt_schedule_id) REFERENCES schedules(id) ON DELETE CASCADE,
 	CONSTRAINT user_favorites_tgt_service_id_fkey FOREIGN KEY (tgt_service_id) REFERENCES services(id) ON DELETE CASCADE,
+	CONSTRAINT user_favorites_tgt_team_id_fkey FOREIGN KEY (tgt_team_id) REFERENCES teams(id) ON DELETE CASCADE,
 	CONSTRAINT user_favorites_tgt_user_id_fkey FOREIGN KEY (tgt_user_id) REFERENCES users(id) ON DELETE CASCADE,
 	CONSTRAINT user_favorites_uniq_id UNIQUE (id),
 	CONSTRAINT user_favorites_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
@@ -2648,6 +3006,7 @@ CREATE TABLE user_favorites (
 	CONSTRAINT user_favorites_user_id_tgt_rotation_id_key UNIQUE (user_id, tgt_rotation_id),
 	CONSTRAINT user_favorites_user_id_tgt_schedule_id_key UNIQUE (user_id, tgt_schedule_id),
 	CONSTRAINT user_favorites_user_id_tgt_service_id_key UNIQUE (user_id, tgt_service_id),
+	CONSTRAINT user_favorites_user_id_tgt_team_id_key UNIQUE (user_id, tgt_team_id),
 	CONSTRAINT user_favorites_user_id_tgt_user_id_key UNIQUE (user_id, tgt_user_id)
 );
 
@@ -2656,6 +3015,7 @@ CREATE UNIQUE INDEX user_favorites_user_id_tgt_escalation_policy_id_key ON publi
 CREATE UNIQUE INDEX user_favorites_user_id_tgt_rotation_id_key ON public.user_favorites USING btree (user_id, tgt_rotation_id);
 CREATE UNIQUE INDEX user_favorites_user_id_tgt_schedule_id_key ON public.user_favorites USING btree (user_id, tgt_schedule_id);
 CREATE UNIQUE INDEX user_favorites_user_id_tgt_service_id_key ON public.user_favorites USING btree (user_id, tgt_service_id);
+CREATE UNIQUE INDEX user_favorites_user_id_tgt_team_id_key ON public.user_favorites USING btree (user_id, tgt_team_id);
 CREATE UNIQUE INDEX user_favorites_user_id_tgt_user_id_key ON public.user_favorites USING btree (user_id, tgt_user_id);
 
 
@@ -2663,8 +3023,13 @@ CREATE TABLE user_notification_rules (
 	contact_method_id uuid NOT NULL,
 	created_at timestamp with time zone DEFAULT now(),
 	delay_minutes integer DEFAULT 0 NOT NULL,
+	end_time time without time zone DEFAULT '00:00:00'::time without time zone NOT NULL,
 	id uuid DEFAULT gen_random_uuid() NOT NULL,
+	min_severity enum_alert_severity,
+	start_time time without time zone DEFAULT '00:00:00'::time without time zone NOT NULL,
+	time_zone text DEFAULT 'UTC'::text NOT NULL,
 	user_id uuid NOT NULL,
+	weekday_filter boolean[] DEFAULT '{t,t,t,t,t,t,t}'::boolean[] NOT NULL,
 	CONSTRAINT user_notification_rules_contact_method_id_delay_minutes_key UNIQUE (contact_method_id, delay_minutes),
 	CONSTRAINT user_notification_rules_contact_method_id_fkey FOREIGN KEY (contact_method_id) REFERENCES user_contact_methods(id) ON DELETE CASCADE,
 	CONSTRAINT user_notification_rules_pkey PRIMARY KEY (id),
@@ -2732,6 +3097,7 @@ CREATE TABLE users (
 	alert_status_log_contact_method_id uuid,
 	avatar_url text DEFAULT ''::text NOT NULL,
 	bio text DEFAULT ''::text NOT NULL,
+	deactivated_at timestamp with time zone,
 	email text DEFAULT ''::text NOT NULL,
 	id uuid NOT NULL,
 	name text NOT NULL,
@@ -2747,6 +3113,16 @@ CREATE INDEX idx_user_status_updates ON public.users USING btree (alert_status_l
 CREATE TRIGGER trg_enforce_status_update_same_user BEFORE INSERT OR UPDATE ON public.users FOR EACH ROW EXECUTE FUNCTION fn_enforce_status_update_same_user();
 
 
+CREATE TABLE webhook_signing_secrets (
+	created_at timestamp with time zone DEFAULT now() NOT NULL,
+	secret bytea NOT NULL,
+	webhook_url text NOT NULL,
+	CONSTRAINT webhook_signing_secrets_pkey PRIMARY KEY (webhook_url)
+);
+
+CREATE UNIQUE INDEX webhook_signing_secrets_pkey ON public.webhook_signing_secrets USING btree (webhook_url);
+
+
 -- Sequences
 
 CREATE SEQUENCE incident_number_seq
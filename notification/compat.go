@@ -11,6 +11,7 @@ type (
 	Verification        = nfymsg.Verification
 	SignalMessage       = nfymsg.SignalMessage
 	ScheduleOnCallUsers = nfymsg.ScheduleOnCallUsers
+	ShiftSwapRequest    = nfymsg.ShiftSwapRequest
 
 	State = nfymsg.State
 	User  = nfymsg.User
@@ -95,6 +95,20 @@ func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*no
 			},
 		}}
 		e.Body.Outros = []string{"You are receiving this message because you have status updates enabled. Visit your Profile page to change this."}
+	case notification.ShiftSwapRequest:
+		subject = fmt.Sprintf("Shift Swap Request: %s", m.ScheduleName)
+		e.Body.Title = "Shift Swap Request"
+		e.Body.Intros = []string{m.Summary()}
+		if m.Note != "" {
+			e.Body.Intros = append(e.Body.Intros, m.Note)
+		}
+		e.Body.Actions = []hermes.Action{{
+			Instructions: "Accept or decline the request from the schedule page.",
+			Button: hermes.Button{
+				Text: "Open Schedule",
+				Link: m.ScheduleURL,
+			},
+		}}
 	default:
 		return nil, errors.New("message type not supported")
 	}
@@ -21,4 +21,6 @@ const (
 	MessageTypeScheduleOnCallUsers = gadb.EnumOutgoingMessagesTypeScheduleOnCallNotification
 
 	MessageTypeSignalMessage = gadb.EnumOutgoingMessagesTypeSignalMessage
+
+	MessageTypeShiftSwapRequest = gadb.EnumOutgoingMessagesTypeShiftSwapRequest
 )
@@ -0,0 +1,183 @@
+package msteams
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+)
+
+// maxDetailsLength is the number of characters of alert details included in a card.
+const maxDetailsLength = 1000
+
+// Card element and action types used by messages.
+//
+// See https://adaptivecards.io/explorer/ for the full schema.
+type (
+	// webhookMessage is the body of a request to an incoming webhook.
+	webhookMessage struct {
+		Type        string       `json:"type"`
+		Attachments []attachment `json:"attachments"`
+	}
+
+	attachment struct {
+		ContentType string       `json:"contentType"`
+		Content     adaptiveCard `json:"content"`
+	}
+
+	adaptiveCard struct {
+		Schema  string   `json:"$schema"`
+		Type    string   `json:"type"`
+		Version string   `json:"version"`
+		Body    []any    `json:"body"`
+		Actions []action `json:"actions,omitempty"`
+	}
+
+	textBlock struct {
+		Type     string `json:"type"`
+		Text     string `json:"text"`
+		Size     string `json:"size,omitempty"`
+		Weight   string `json:"weight,omitempty"`
+		Color    string `json:"color,omitempty"`
+		IsSubtle bool   `json:"isSubtle,omitempty"`
+		Wrap     bool   `json:"wrap"`
+	}
+
+	factSet struct {
+		Type  string `json:"type"`
+		Facts []fact `json:"facts"`
+	}
+
+	fact struct {
+		Title string `json:"title"`
+		Value string `json:"value"`
+	}
+
+	action struct {
+		Type  string `json:"type"`
+		Title string `json:"title"`
+		URL   string `json:"url"`
+	}
+)
+
+func newMessage(body []any, actions ...action) webhookMessage {
+	return webhookMessage{
+		Type: "message",
+		Attachments: []attachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: adaptiveCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    body,
+				Actions: actions,
+			},
+		}},
+	}
+}
+
+func title(text, color string) textBlock {
+	return textBlock{Type: "TextBlock", Text: text, Size: "Medium", Weight: "Bolder", Color: color, Wrap: true}
+}
+
+func text(text string) textBlock {
+	return textBlock{Type: "TextBlock", Text: text, Wrap: true}
+}
+
+func subtle(text string) textBlock {
+	return textBlock{Type: "TextBlock", Text: text, IsSubtle: true, Wrap: true}
+}
+
+func openURL(title, url string) action {
+	return action{Type: "Action.OpenUrl", Title: title, URL: url}
+}
+
+// alertActionURL returns a link to the alert page that asks the user to confirm the action (`acknowledge` or `close`).
+//
+// Incoming webhooks can't send actions back to GoAlert, so the user completes them from the UI.
+func alertActionURL(cfg config.Config, alertID int, act string) string {
+	return cfg.CallbackURL(fmt.Sprintf("/alerts/%d", alertID), url.Values{"action": []string{act}})
+}
+
+func stateInfo(state notification.AlertState) (name, color string) {
+	switch state {
+	case notification.AlertStateAcknowledged:
+		return "Acknowledged", "Warning"
+	case notification.AlertStateClosed:
+		return "Closed", "Good"
+	}
+
+	return "Unacknowledged", "Attention"
+}
+
+// alertCard builds the card for a new alert or an alert status update.
+func alertCard(cfg config.Config, alertID int, summary, details, serviceName, logEntry string, state notification.AlertState) webhookMessage {
+	stateName, color := stateInfo(state)
+	body := []any{
+		title(fmt.Sprintf("Alert #%d: %s", alertID, summary), color),
+	}
+
+	details = strings.TrimSpace(details)
+	if r := []rune(details); len(r) > maxDetailsLength {
+		details = strings.TrimSpace(string(r[:maxDetailsLength])) + " ..."
+	}
+	if details != "" {
+		body = append(body, text(details))
+	}
+
+	facts := []fact{{Title: "Status", Value: stateName}}
+	if serviceName != "" {
+		facts = append(facts, fact{Title: "Service", Value: serviceName})
+	}
+	body = append(body, factSet{Type: "FactSet", Facts: facts})
+	if logEntry != "" {
+		body = append(body, subtle(logEntry))
+	}
+
+	var actions []action
+	switch state {
+	case notification.AlertStateUnacknowledged:
+		actions = append(actions,
+			openURL("Acknowledge", alertActionURL(cfg, alertID, "acknowledge")),
+			openURL("Close", alertActionURL(cfg, alertID, "close")),
+		)
+	case notification.AlertStateAcknowledged:
+		actions = append(actions, openURL("Close", alertActionURL(cfg, alertID, "close")))
+	}
+	actions = append(actions, openURL("View Alert", cfg.CallbackURL(fmt.Sprintf("/alerts/%d", alertID))))
+
+	return newMessage(body, actions...)
+}
+
+// renderMessage returns the card for a notification message.
+func renderMessage(cfg config.Config, msg notification.Message) (webhookMessage, error) {
+	switch m := msg.(type) {
+	case notification.Test:
+		return newMessage([]any{text(fmt.Sprintf("This is a test message from %s.", cfg.ApplicationName()))}), nil
+	case notification.Alert:
+		return alertCard(cfg, m.AlertID, m.Summary, m.Details, m.ServiceName, "", notification.AlertStateUnacknowledged), nil
+	case notification.AlertStatus:
+		return alertCard(cfg, m.AlertID, m.Summary, "", m.ServiceName, m.LogEntry, m.NewAlertState), nil
+	case notification.AlertBundle:
+		return newMessage([]any{
+			title(fmt.Sprintf("%s has %d unacknowledged alerts", m.ServiceName, m.Count), "Attention"),
+		}, openURL("View Alerts", cfg.CallbackURL(fmt.Sprintf("/services/%s/alerts", m.ServiceID)))), nil
+	case notification.ScheduleOnCallUsers:
+		body := []any{title(fmt.Sprintf("On-call users for %s", m.ScheduleName), "")}
+		if len(m.Users) == 0 {
+			body = append(body, subtle("No users are on-call."))
+		} else {
+			users := make([]string, len(m.Users))
+			for i, u := range m.Users {
+				users[i] = fmt.Sprintf("- [%s](%s)", u.Name, u.URL)
+			}
+			body = append(body, text(strings.Join(users, "\n")))
+		}
+
+		return newMessage(body, openURL("View Schedule", m.ScheduleURL)), nil
+	}
+
+	return webhookMessage{}, fmt.Errorf("message type '%T' not supported", msg)
+}
@@ -0,0 +1,58 @@
+package msteams
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+)
+
+func TestRenderMessage(t *testing.T) {
+	var cfg config.Config
+	cfg.General.PublicURL = "http://example.com"
+
+	msg, err := renderMessage(cfg, notification.Alert{AlertID: 123, Summary: "disk full", Details: "sda1", ServiceName: "db"})
+	require.NoError(t, err)
+	require.Len(t, msg.Attachments, 1)
+	card := msg.Attachments[0].Content
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	assert.Equal(t, "Alert #123: disk full", card.Body[0].(textBlock).Text)
+	assert.Equal(t, []action{
+		openURL("Acknowledge", "http://example.com/alerts/123?action=acknowledge"),
+		openURL("Close", "http://example.com/alerts/123?action=close"),
+		openURL("View Alert", "http://example.com/alerts/123"),
+	}, card.Actions)
+
+	msg, err = renderMessage(cfg, notification.AlertStatus{AlertID: 123, Summary: "disk full", LogEntry: "Acknowledged by Bob", NewAlertState: notification.AlertStateAcknowledged})
+	require.NoError(t, err)
+	card = msg.Attachments[0].Content
+	assert.Equal(t, "Warning", card.Body[0].(textBlock).Color)
+	assert.Equal(t, "Acknowledged by Bob", card.Body[len(card.Body)-1].(textBlock).Text)
+	assert.Equal(t, "Close", card.Actions[0].Title, "only close for acknowledged alerts")
+
+	msg, err = renderMessage(cfg, notification.AlertStatus{AlertID: 123, NewAlertState: notification.AlertStateClosed})
+	require.NoError(t, err)
+	assert.Len(t, msg.Attachments[0].Content.Actions, 1, "only view for closed alerts")
+
+	msg, err = renderMessage(cfg, notification.ScheduleOnCallUsers{
+		ScheduleName: "Primary",
+		ScheduleURL:  "http://example.com/schedules/1",
+		Users:        []notification.User{{Name: "Bob", URL: "http://example.com/users/2"}},
+	})
+	require.NoError(t, err)
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `- [Bob](http://example.com/users/2)`)
+
+	_, err = renderMessage(cfg, notification.Verification{Code: "123"})
+	assert.Error(t, err)
+
+	long := strings.Repeat("é", maxDetailsLength+10)
+	msg, err = renderMessage(cfg, notification.Alert{AlertID: 1, Details: long})
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("é", maxDetailsLength)+" ...", msg.Attachments[0].Content.Body[1].(textBlock).Text)
+}
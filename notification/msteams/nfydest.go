@@ -0,0 +1,80 @@
+package msteams
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	DestTypeMSTeams = "builtin-msteams-webhook"
+	FieldWebhookURL = "msteams_webhook_url"
+	FallbackIconURL = "builtin://msteams"
+)
+
+func NewMSTeamsDest(webhookURL string) gadb.DestV1 {
+	return gadb.NewDestV1(DestTypeMSTeams, FieldWebhookURL, webhookURL)
+}
+
+var _ nfydest.Provider = (*Sender)(nil)
+
+func (*Sender) ID() string { return DestTypeMSTeams }
+
+func (*Sender) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
+	cfg := config.FromContext(ctx)
+	return &nfydest.TypeInfo{
+		Type:                       DestTypeMSTeams,
+		Name:                       "Microsoft Teams",
+		Enabled:                    cfg.MSTeams.Enable,
+		SupportsAlertNotifications: true,
+		SupportsStatusUpdates:      true,
+		SupportsOnCallNotify:       true,
+		RequiredFields: []nfydest.FieldConfig{{
+			FieldID:            FieldWebhookURL,
+			Label:              "Incoming Webhook URL",
+			PlaceholderText:    "https://example.webhook.office.com/...",
+			InputType:          "url",
+			Hint:               "The URL of an incoming webhook that accepts adaptive cards.",
+			SupportsValidation: true,
+		}},
+	}, nil
+}
+
+func (*Sender) ValidateField(ctx context.Context, fieldID, value string) error {
+	cfg := config.FromContext(ctx)
+	switch fieldID {
+	case FieldWebhookURL:
+		err := validate.AbsoluteURL(FieldWebhookURL, value)
+		if err != nil {
+			return err
+		}
+		if !cfg.ValidWebhookURL(value) {
+			return validation.NewGenericError("url is not allowed by administator")
+		}
+
+		return nil
+	}
+
+	return validation.NewGenericError("unknown field ID")
+}
+
+func (*Sender) DisplayInfo(ctx context.Context, args map[string]string) (*nfydest.DisplayInfo, error) {
+	if args == nil {
+		args = make(map[string]string)
+	}
+
+	u, err := url.Parse(args[FieldWebhookURL])
+	if err != nil {
+		return nil, validation.WrapError(err)
+	}
+	return &nfydest.DisplayInfo{
+		IconURL:     FallbackIconURL,
+		IconAltText: "Microsoft Teams",
+		Text:        u.Hostname(),
+	}, nil
+}
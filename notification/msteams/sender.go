@@ -0,0 +1,67 @@
+package msteams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/notification/webhook"
+)
+
+// Sender posts adaptive cards to Microsoft Teams (or compatible) incoming webhooks.
+type Sender struct {
+	Client *http.Client
+}
+
+func NewSender(ctx context.Context, client *http.Client) *Sender {
+	return &Sender{Client: client}
+}
+
+var _ nfydest.MessageSender = &Sender{}
+
+// SendMessage posts the card for the message to the destination's incoming webhook.
+func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*notification.SentMessage, error) {
+	cfg := config.FromContext(ctx)
+
+	card, err := renderMessage(cfg, msg)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(card)
+	if err != nil {
+		return nil, err
+	}
+
+	webURL := msg.DestArg(FieldWebhookURL)
+	if !cfg.ValidWebhookURL(webURL) {
+		// fail permanently if the URL is not currently valid/allowed
+		return &notification.SentMessage{
+			State:        notification.StateFailedPerm,
+			StateDetails: "invalid or not allowed URL",
+		}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", webURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return webhook.Result(nil, err), nil
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	return webhook.Result(resp, nil), nil
+}
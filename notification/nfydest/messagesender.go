@@ -48,7 +48,7 @@ func (r *Registry) SendMessage(ctx context.Context, msg nfymsg.Message) (*nfymsg
 		if !info.SupportsUserVerification {
 			return nil, ErrUnsupported
 		}
-	case nfymsg.Test:
+	case nfymsg.Test, nfymsg.ShiftSwapRequest:
 	case nfymsg.SignalMessage:
 		if !info.SupportsSignals {
 			return nil, ErrUnsupported
@@ -0,0 +1,44 @@
+package nfymsg
+
+import (
+	"fmt"
+	"time"
+)
+
+// ShiftSwapRequest is a Message asking a user to cover another user's on-call shift.
+type ShiftSwapRequest struct {
+	Base
+
+	RequestID    string
+	ScheduleID   string
+	ScheduleName string
+	ScheduleURL  string
+
+	// FromUserName is the name of the user requesting coverage.
+	FromUserName string
+
+	// Start and End are the bounds of the shift, in the schedule's time zone.
+	Start, End time.Time
+
+	// TradeStart and TradeEnd are set if the requesting user offered to cover
+	// a shift in exchange.
+	TradeStart, TradeEnd time.Time
+
+	Note string
+}
+
+const shiftTimeFormat = "Mon Jan 2 3:04 PM MST"
+
+// IsTrade returns true if the request is for a trade of shifts.
+func (r ShiftSwapRequest) IsTrade() bool { return !r.TradeStart.IsZero() }
+
+// Summary returns a plain-text description of the request.
+func (r ShiftSwapRequest) Summary() string {
+	s := fmt.Sprintf("%s asked you to cover their shift on %s from %s to %s",
+		r.FromUserName, r.ScheduleName, r.Start.Format(shiftTimeFormat), r.End.Format(shiftTimeFormat))
+	if r.IsTrade() {
+		s += fmt.Sprintf(", in exchange for covering yours from %s to %s", r.TradeStart.Format(shiftTimeFormat), r.TradeEnd.Format(shiftTimeFormat))
+	}
+
+	return s + "."
+}
@@ -488,6 +488,12 @@ func (s *ChannelSender) SendMessage(ctx context.Context, msg notification.Messag
 		opts = append(opts, slack.MsgOptionText(t.Param("message"), false))
 	case notification.ScheduleOnCallUsers:
 		opts = append(opts, slack.MsgOptionText(s.onCallNotificationText(ctx, t), false))
+	case notification.ShiftSwapRequest:
+		text := slackutilsx.EscapeMessage(t.Summary())
+		if t.Note != "" {
+			text += "\n\n> " + slackutilsx.EscapeMessage(t.Note)
+		}
+		opts = append(opts, slack.MsgOptionText(fmt.Sprintf("%s\n\n<%s|Respond to request>", text, t.ScheduleURL), false))
 	default:
 		return nil, errors.Errorf("unsupported message type: %T", t)
 	}
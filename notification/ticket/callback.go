@@ -0,0 +1,93 @@
+package ticket
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification/webhook"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/validation"
+)
+
+// Callback actions supported by ServeCallback.
+const (
+	ActionAcknowledge = "acknowledge"
+	ActionClose       = "close"
+)
+
+// CallbackRequest is the body of a request from the ticketing system to update an alert.
+type CallbackRequest struct {
+	AlertID  int    `json:"alert_id"`
+	TicketID string `json:"ticket_id"`
+	Action   string `json:"action"`
+}
+
+// ServeCallback handles signed requests from the ticketing system to acknowledge or close the alert a ticket
+// was opened for.
+//
+// Requests are signed the same way as outgoing webhooks, using Ticketing.CallbackSecret.
+func (s *Sender) ServeCallback(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	if !cfg.Ticketing.Enable || cfg.Ticketing.CallbackSecret == "" {
+		http.Error(w, "not enabled", http.StatusNotFound)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, req.Body, 64*1024))
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	if !webhook.ValidSignature(cfg.Ticketing.CallbackSecret, time.Now(), 5*time.Minute,
+		req.Header.Get(webhook.HeaderTimestamp), req.Header.Get(webhook.HeaderSignature), data) {
+		errutil.HTTPError(ctx, w, permission.Unauthorized())
+		return
+	}
+
+	var cb CallbackRequest
+	err = json.Unmarshal(data, &cb)
+	if errutil.HTTPError(ctx, w, validation.WrapError(err)) {
+		return
+	}
+
+	var status alert.Status
+	switch cb.Action {
+	case ActionAcknowledge:
+		status = alert.StatusActive
+	case ActionClose:
+		status = alert.StatusClosed
+	default:
+		errutil.HTTPError(ctx, w, validation.NewFieldErrorf("action", "unknown action '%s'", cb.Action))
+		return
+	}
+
+	ctx = permission.SystemContext(ctx, "TicketingCallback")
+
+	// Only allow updates for the ticket that was opened for the alert.
+	meta, err := s.alerts.Metadata(ctx, s.db, cb.AlertID)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	if cb.TicketID == "" || meta[MetaTicketID] != cb.TicketID {
+		errutil.HTTPError(ctx, w, validation.NewFieldError("ticket_id", "does not match alert"))
+		return
+	}
+
+	err = s.alerts.UpdateStatus(ctx, cb.AlertID, status)
+	if alert.IsAlreadyAcknowledged(err) || alert.IsAlreadyClosed(err) {
+		// ignore errors from duplicate requests
+		err = nil
+	}
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,82 @@
+package ticket
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+)
+
+// ticketStatus returns the configured ticket status for an alert state.
+func ticketStatus(cfg config.Config, state notification.AlertState) string {
+	switch state {
+	case notification.AlertStateAcknowledged:
+		if cfg.Ticketing.AcknowledgedStatus != "" {
+			return cfg.Ticketing.AcknowledgedStatus
+		}
+		return "acknowledged"
+	case notification.AlertStateClosed:
+		if cfg.Ticketing.ClosedStatus != "" {
+			return cfg.Ticketing.ClosedStatus
+		}
+		return "closed"
+	}
+
+	if cfg.Ticketing.OpenStatus != "" {
+		return cfg.Ticketing.OpenStatus
+	}
+	return "open"
+}
+
+// ticketBody returns the body of the request to open a ticket for an alert.
+//
+// Each entry in Ticketing.FieldMapping sets (or overrides) a field of the default body with the result of an
+// expression. Expressions have access to `alert`, `queue`, and the `sprintf` function.
+func ticketBody(cfg config.Config, queue string, a notification.Alert) (map[string]any, error) {
+	alertURL := cfg.CallbackURL(fmt.Sprintf("/alerts/%d", a.AlertID))
+	body := map[string]any{
+		"queue":        queue,
+		"summary":      a.Summary,
+		"details":      a.Details,
+		"status":       ticketStatus(cfg, notification.AlertStateUnacknowledged),
+		"alert_id":     a.AlertID,
+		"alert_url":    alertURL,
+		"service_id":   a.ServiceID,
+		"service_name": a.ServiceName,
+	}
+
+	env := map[string]any{
+		"sprintf": fmt.Sprintf,
+		"queue":   queue,
+		"alert": map[string]any{
+			"id":          a.AlertID,
+			"summary":     a.Summary,
+			"details":     a.Details,
+			"serviceID":   a.ServiceID,
+			"serviceName": a.ServiceName,
+			"meta":        a.Meta,
+			"url":         alertURL,
+		},
+	}
+
+	for _, str := range cfg.Ticketing.FieldMapping {
+		field, exprStr, ok := strings.Cut(str, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid field mapping '%s'", str)
+		}
+
+		prog, err := expr.Compile(exprStr, expr.AllowUndefinedVariables(), expr.Optimize(true))
+		if err != nil {
+			return nil, fmt.Errorf("compile field mapping for '%s': %w", field, err)
+		}
+		val, err := expr.Run(prog, env)
+		if err != nil {
+			return nil, fmt.Errorf("evaluate field mapping for '%s': %w", field, err)
+		}
+		body[field] = val
+	}
+
+	return body, nil
+}
@@ -0,0 +1,72 @@
+package ticket
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+)
+
+func TestTicketBody(t *testing.T) {
+	var cfg config.Config
+	cfg.General.PublicURL = "http://example.com"
+	a := notification.Alert{
+		AlertID:     123,
+		Summary:     "disk full",
+		ServiceName: "db",
+		Meta:        map[string]string{"team": "storage"},
+	}
+
+	body, err := ticketBody(cfg, "OPS", a)
+	require.NoError(t, err)
+	assert.Equal(t, "OPS", body["queue"])
+	assert.Equal(t, "disk full", body["summary"])
+	assert.Equal(t, "open", body["status"])
+	assert.Equal(t, "http://example.com/alerts/123", body["alert_url"])
+
+	cfg.Ticketing.OpenStatus = "New"
+	cfg.Ticketing.FieldMapping = []string{
+		`summary=sprintf("[%s] %s", alert.serviceName, alert.summary)`,
+		`assignee=alert.meta.team`,
+		`labels=["goalert", queue]`,
+	}
+	body, err = ticketBody(cfg, "OPS", a)
+	require.NoError(t, err)
+	assert.Equal(t, "New", body["status"])
+	assert.Equal(t, "[db] disk full", body["summary"])
+	assert.Equal(t, "storage", body["assignee"])
+	assert.Equal(t, []any{"goalert", "OPS"}, body["labels"])
+
+	cfg.Ticketing.FieldMapping = []string{`summary=alert.summary +`}
+	_, err = ticketBody(cfg, "OPS", a)
+	assert.Error(t, err, "invalid expression")
+}
+
+func TestTicketStatus(t *testing.T) {
+	var cfg config.Config
+	assert.Equal(t, "open", ticketStatus(cfg, notification.AlertStateUnacknowledged))
+	assert.Equal(t, "acknowledged", ticketStatus(cfg, notification.AlertStateAcknowledged))
+	assert.Equal(t, "closed", ticketStatus(cfg, notification.AlertStateClosed))
+
+	cfg.Ticketing.ClosedStatus = "Resolved"
+	assert.Equal(t, "Resolved", ticketStatus(cfg, notification.AlertStateClosed))
+}
+
+func TestParseTicketID(t *testing.T) {
+	id, err := parseTicketID(json.RawMessage(`"OPS-12"`))
+	require.NoError(t, err)
+	assert.Equal(t, "OPS-12", id)
+
+	id, err = parseTicketID(json.RawMessage(`12345678901`))
+	require.NoError(t, err)
+	assert.Equal(t, "12345678901", id, "large numbers are kept exact")
+
+	_, err = parseTicketID(json.RawMessage(`null`))
+	assert.Error(t, err)
+
+	_, err = parseTicketID(nil)
+	assert.Error(t, err)
+}
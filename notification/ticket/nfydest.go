@@ -0,0 +1,66 @@
+package ticket
+
+import (
+	"context"
+
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+const (
+	DestTypeTicket  = "builtin-ticket"
+	FieldQueue      = "queue"
+	FallbackIconURL = "builtin://ticket"
+)
+
+func NewTicketDest(queue string) gadb.DestV1 {
+	return gadb.NewDestV1(DestTypeTicket, FieldQueue, queue)
+}
+
+var _ nfydest.Provider = (*Sender)(nil)
+
+func (*Sender) ID() string { return DestTypeTicket }
+
+func (*Sender) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
+	cfg := config.FromContext(ctx)
+	return &nfydest.TypeInfo{
+		Type:                       DestTypeTicket,
+		Name:                       "Ticket",
+		Enabled:                    cfg.Ticketing.Enable,
+		SupportsAlertNotifications: true,
+		SupportsStatusUpdates:      true,
+		StatusUpdatesRequired:      true,
+		RequiredFields: []nfydest.FieldConfig{{
+			FieldID:            FieldQueue,
+			Label:              "Queue",
+			PlaceholderText:    "OPS",
+			Hint:               "The queue or project new tickets are opened in.",
+			HintURL:            "/docs#ticketing",
+			SupportsValidation: true,
+		}},
+	}, nil
+}
+
+func (*Sender) ValidateField(ctx context.Context, fieldID, value string) error {
+	switch fieldID {
+	case FieldQueue:
+		return validate.ASCII(FieldQueue, value, 1, 255)
+	}
+
+	return validation.NewGenericError("unknown field ID")
+}
+
+func (*Sender) DisplayInfo(ctx context.Context, args map[string]string) (*nfydest.DisplayInfo, error) {
+	if args == nil {
+		args = make(map[string]string)
+	}
+
+	return &nfydest.DisplayInfo{
+		IconURL:     FallbackIconURL,
+		IconAltText: "Ticket",
+		Text:        args[FieldQueue],
+	}, nil
+}
@@ -0,0 +1,212 @@
+package ticket
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/notification/webhook"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/sqlutil"
+)
+
+// MetaTicketID is the alert metadata key used to store the ID of the ticket opened for an alert.
+const MetaTicketID = "ticket_id"
+
+// Sender opens tickets in an external ticketing system and keeps their status in sync with alerts.
+type Sender struct {
+	Client *http.Client
+
+	db     *sql.DB
+	alerts *alert.Store
+}
+
+func NewSender(ctx context.Context, client *http.Client, db *sql.DB, alerts *alert.Store) *Sender {
+	return &Sender{
+		Client: client,
+		db:     db,
+		alerts: alerts,
+	}
+}
+
+var _ nfydest.MessageSender = &Sender{}
+
+// SendMessage opens a ticket for Alert messages, and updates the ticket status for AlertStatus messages.
+func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*notification.SentMessage, error) {
+	cfg := config.FromContext(ctx)
+	if !cfg.Ticketing.Enable {
+		return &notification.SentMessage{
+			State:        notification.StateFailedPerm,
+			StateDetails: "ticketing is disabled",
+		}, nil
+	}
+
+	switch m := msg.(type) {
+	case notification.Alert:
+		if m.OriginalStatus != nil {
+			// A ticket was already opened for this alert (e.g., a repeated escalation step), so keep using it.
+			return &notification.SentMessage{
+				ExternalID: m.OriginalStatus.ProviderMessageID.ExternalID,
+				State:      notification.StateSent,
+			}, nil
+		}
+
+		body, err := ticketBody(cfg, msg.DestArg(FieldQueue), m)
+		if err != nil {
+			// a broken mapping will fail the same way on retry
+			return &notification.SentMessage{
+				State:        notification.StateFailedPerm,
+				StateDetails: err.Error(),
+			}, nil
+		}
+
+		var resp struct {
+			ID json.RawMessage `json:"id"`
+		}
+		res := s.request(ctx, cfg, "POST", "tickets", body, &resp)
+		if res != nil {
+			return res, nil
+		}
+		ticketID, err := parseTicketID(resp.ID)
+		if err != nil {
+			return &notification.SentMessage{
+				State:        notification.StateFailedPerm,
+				StateDetails: err.Error(),
+			}, nil
+		}
+
+		err = s.setTicketID(ctx, m.AlertID, ticketID)
+		if err != nil {
+			// The ticket exists at this point, so retrying would open a duplicate.
+			log.Log(ctx, fmt.Errorf("store ticket ID for alert %d: %w", m.AlertID, err))
+		}
+
+		return &notification.SentMessage{
+			ExternalID: ticketID,
+			State:      notification.StateSent,
+		}, nil
+	case notification.AlertStatus:
+		ticketID := m.OriginalStatus.ProviderMessageID.ExternalID
+		if ticketID == "" {
+			return &notification.SentMessage{
+				State:        notification.StateFailedPerm,
+				StateDetails: "no ticket for alert",
+			}, nil
+		}
+
+		body := map[string]any{
+			"status":  ticketStatus(cfg, m.NewAlertState),
+			"comment": m.LogEntry,
+		}
+		res := s.request(ctx, cfg, "PATCH", "tickets/"+url.PathEscape(ticketID), body, nil)
+		if res != nil {
+			return res, nil
+		}
+
+		return &notification.SentMessage{State: notification.StateSent}, nil
+	}
+
+	return nil, fmt.Errorf("message type '%T' not supported", msg)
+}
+
+// setTicketID records the ticket ID in the alert metadata, keeping any existing keys.
+func (s *Sender) setTicketID(ctx context.Context, alertID int, ticketID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "ticket: set ticket ID", tx)
+
+	meta, err := s.alerts.Metadata(ctx, tx, alertID)
+	if err != nil {
+		return err
+	}
+	meta[MetaTicketID] = ticketID
+
+	err = s.alerts.SetMetadataTx(ctx, tx, alertID, meta)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// request sends a JSON request to the ticketing API, decoding the response into dst if it is non-nil.
+//
+// A non-nil result is returned if the request failed.
+func (s *Sender) request(ctx context.Context, cfg config.Config, method, path string, body, dst any) *notification.SentMessage {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return &notification.SentMessage{State: notification.StateFailedPerm, StateDetails: err.Error()}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	reqURL := strings.TrimSuffix(cfg.Ticketing.APIURL, "/") + "/" + path
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(data))
+	if err != nil {
+		return &notification.SentMessage{State: notification.StateFailedPerm, StateDetails: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if cfg.Ticketing.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.Ticketing.APIKey)
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return webhook.Result(nil, err)
+	}
+	defer resp.Body.Close()
+
+	if res := webhook.Result(resp, nil); res.State != notification.StateSent {
+		return res
+	}
+	if dst == nil {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		return nil
+	}
+
+	err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(dst)
+	if err != nil {
+		return &notification.SentMessage{State: notification.StateFailedPerm, StateDetails: "decode response: " + err.Error()}
+	}
+
+	return nil
+}
+
+// parseTicketID returns the ticket ID from a create response, which may be a JSON string or number.
+func parseTicketID(raw json.RawMessage) (string, error) {
+	var id any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	err := dec.Decode(&id)
+	if err != nil {
+		return "", fmt.Errorf("invalid ticket ID in response: %w", err)
+	}
+
+	var str string
+	switch v := id.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+	}
+	if str == "" {
+		return "", fmt.Errorf("missing ticket ID in response")
+	}
+
+	return str, nil
+}
@@ -0,0 +1,59 @@
+package ticket
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/devtools/mockticket"
+	"github.com/target/goalert/notification"
+	"github.com/target/goalert/notification/nfymsg"
+)
+
+func TestSender_AlertStatus(t *testing.T) {
+	mock := mockticket.NewServer(mockticket.Config{APIKey: "key"})
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	var cfg config.Config
+	cfg.Ticketing.Enable = true
+	cfg.Ticketing.APIURL = srv.URL
+	cfg.Ticketing.APIKey = "key"
+	cfg.Ticketing.AcknowledgedStatus = "In Progress"
+	ctx := cfg.Context(context.Background())
+
+	s := NewSender(ctx, srv.Client(), nil, nil)
+
+	// create a ticket directly, as opening one through SendMessage requires the alert store
+	res := s.request(ctx, cfg, "POST", "tickets", map[string]any{"status": "open", "alert_id": 1}, nil)
+	require.Nil(t, res)
+
+	msg := notification.AlertStatus{
+		Base:          nfymsg.Base{Dest: NewTicketDest("OPS")},
+		AlertID:       1,
+		NewAlertState: notification.AlertStateAcknowledged,
+		LogEntry:      "Acknowledged by Bob",
+	}
+	msg.OriginalStatus.ProviderMessageID.ExternalID = "1"
+
+	sent, err := s.SendMessage(ctx, msg)
+	require.NoError(t, err)
+	assert.Equal(t, notification.StateSent, sent.State)
+
+	tkt, ok := mock.Ticket("1")
+	require.True(t, ok)
+	assert.Equal(t, "In Progress", tkt.Status)
+
+	msg.OriginalStatus.ProviderMessageID.ExternalID = "2"
+	sent, err = s.SendMessage(ctx, msg)
+	require.NoError(t, err)
+	assert.Equal(t, notification.StateFailedPerm, sent.State, "unknown ticket")
+
+	cfg.Ticketing.APIKey = "wrong"
+	sent, err = s.SendMessage(cfg.Context(ctx), msg)
+	require.NoError(t, err)
+	assert.Equal(t, notification.StateFailedPerm, sent.State, "bad API key")
+}
@@ -227,6 +227,8 @@ func (voice *VoiceOptions) setMsgParams(msg notification.Message) (err error) {
 		voice.CallType = CallTypeTest
 	case notification.Verification:
 		voice.CallType = CallTypeVerify
+	case notification.ShiftSwapRequest:
+		voice.CallType = CallTypeShiftSwap
 	default:
 		return errors.Errorf("unhandled message type: %T", t)
 	}
@@ -139,6 +139,11 @@ func (s *SMS) SendMessage(ctx context.Context, msg notification.Message) (*notif
 		message = fmt.Sprintf("%s: Test message.", cfg.ApplicationName())
 	case notification.Verification:
 		message = fmt.Sprintf("%s: Verification code: %s", cfg.ApplicationName(), t.Code)
+	case notification.ShiftSwapRequest:
+		message = fmt.Sprintf("%s: %s", cfg.ApplicationName(), t.Summary())
+		if canContainURL(ctx, destNumber) {
+			message += " Respond at " + t.ScheduleURL
+		}
 	default:
 		return nil, errors.Errorf("unhandled message type %T", t)
 	}
@@ -43,6 +43,7 @@ const (
 	CallTypeAlertStatus = CallType("alert-status")
 	CallTypeTest        = CallType("test")
 	CallTypeVerify      = CallType("verify")
+	CallTypeShiftSwap   = CallType("shift-swap")
 	CallTypeStop        = CallType("stop")
 
 	// Possible keys pressed from the Menu mapped to their actions.
@@ -131,7 +132,8 @@ func (v *Voice) ServeCall(w http.ResponseWriter, req *http.Request) {
 		v.ServeAlert(w, req)
 	case CallTypeAlertStatus:
 		v.ServeAlertStatus(w, req)
-	case CallTypeTest:
+	case CallTypeTest, CallTypeShiftSwap:
+		// shift swap requests are informational, like test calls
 		v.ServeTest(w, req)
 	case CallTypeStop:
 		v.ServeStop(w, req)
@@ -625,6 +627,8 @@ func buildMessage(prefix string, msg notification.Message) (message string, err
 		message = fmt.Sprintf("%s with a status update for alert '%s'. %s", prefix, t.Summary, message)
 	case notification.Test:
 		message = fmt.Sprintf("%s with a test message.", prefix)
+	case notification.ShiftSwapRequest:
+		message = fmt.Sprintf("%s with a shift swap request. %s", prefix, t.Summary())
 	case notification.Verification:
 		message = fmt.Sprintf(
 			"%s with your %d-digit verification code. The code is: %s. Again, your %d-digit verification code is: %s.",
@@ -3,6 +3,7 @@ package twilio
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/target/goalert/notification"
@@ -76,6 +77,23 @@ func TestBuildMessage(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("%s with your 4-digit verification code. The code is: %s. Again, your 4-digit verification code is: %s.", prefix, spellCode("1234"), spellCode("1234")), result)
 	assert.NoError(t, err)
 
+	// ShiftSwapRequest Notification
+	loc := time.FixedZone("CDT", -5*3600)
+	result, err = buildMessage(
+		prefix,
+		notification.ShiftSwapRequest{
+			Base:         nfymsg.Base{ID: "2"},
+			ScheduleName: "Primary",
+			FromUserName: "Ann",
+			Start:        time.Date(2026, 10, 19, 9, 0, 0, 0, loc),
+			End:          time.Date(2026, 10, 19, 17, 0, 0, 0, loc),
+			TradeStart:   time.Date(2026, 10, 20, 9, 0, 0, 0, loc),
+			TradeEnd:     time.Date(2026, 10, 20, 17, 0, 0, 0, loc),
+		},
+	)
+	assert.Equal(t, fmt.Sprintf("%s with a shift swap request. Ann asked you to cover their shift on Primary from Mon Oct 19 9:00 AM CDT to Mon Oct 19 5:00 PM CDT, in exchange for covering yours from Tue Oct 20 9:00 AM CDT to Tue Oct 20 5:00 PM CDT.", prefix), result)
+	assert.NoError(t, err)
+
 	// Bad Type
 	result, err = buildMessage(
 		prefix,
@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"context"
+	"mime"
 	"net/url"
 
 	"github.com/target/goalert/config"
@@ -12,11 +13,14 @@ import (
 )
 
 const (
-	DestTypeWebhook  = "builtin-webhook"
-	FieldWebhookURL  = "webhook_url"
-	ParamBody        = "body"
-	ParamContentType = "content_type"
-	FallbackIconURL  = "builtin://webhook"
+	DestTypeWebhook   = "builtin-webhook"
+	FieldWebhookURL   = "webhook_url"
+	FieldBodyTemplate = "body_template"
+	FieldContentType  = "content_type"
+	FieldHeaders      = "headers"
+	ParamBody         = "body"
+	ParamContentType  = "content_type"
+	FallbackIconURL   = "builtin://webhook"
 )
 
 func NewWebhookDest(url string) gadb.DestV1 {
@@ -47,6 +51,28 @@ func (Sender) TypeInfo(ctx context.Context) (*nfydest.TypeInfo, error) {
 			Hint:               "Webhook Documentation",
 			HintURL:            "/docs#webhooks",
 			SupportsValidation: true,
+		}, {
+			FieldID:            FieldBodyTemplate,
+			Label:              "Body Template (optional)",
+			PlaceholderText:    `{"text": msg.Summary}`,
+			InputType:          "text",
+			Hint:               "An expression for the request body; if empty, the default payload is sent.",
+			HintURL:            "/docs#webhooks",
+			SupportsValidation: true,
+		}, {
+			FieldID:            FieldContentType,
+			Label:              "Content Type (optional)",
+			PlaceholderText:    "application/json",
+			InputType:          "text",
+			Hint:               "The Content-Type header of the request.",
+			SupportsValidation: true,
+		}, {
+			FieldID:            FieldHeaders,
+			Label:              "Extra Headers (optional)",
+			PlaceholderText:    `{"Authorization": "Bearer ..."}`,
+			InputType:          "text",
+			Hint:               "A JSON object of additional request headers.",
+			SupportsValidation: true,
 		}},
 		DynamicParams: []nfydest.DynamicParamConfig{
 			{
@@ -76,6 +102,30 @@ func (s *Sender) ValidateField(ctx context.Context, fieldID, value string) error
 			return validation.NewGenericError("url is not allowed by administator")
 		}
 
+		return nil
+	case FieldBodyTemplate:
+		if value == "" {
+			return nil
+		}
+		_, err := compileTemplate(value)
+		if err != nil {
+			return validation.NewGenericError(err.Error())
+		}
+		return nil
+	case FieldContentType:
+		if value == "" {
+			return nil
+		}
+		_, _, err := mime.ParseMediaType(value)
+		if err != nil {
+			return validation.NewGenericError("invalid content type")
+		}
+		return nil
+	case FieldHeaders:
+		_, err := parseHeaders(value)
+		if err != nil {
+			return validation.NewGenericError(err.Error())
+		}
 		return nil
 	}
 
@@ -0,0 +1,50 @@
+-- name: WebhookSecretInsert :exec
+-- Inserts a new signing secret for the webhook URL, unless one already exists.
+INSERT INTO webhook_signing_secrets(webhook_url, secret)
+    VALUES (@webhook_url, @secret)
+ON CONFLICT (webhook_url)
+    DO NOTHING;
+
+-- name: WebhookSecretFind :one
+SELECT
+    secret
+FROM
+    webhook_signing_secrets
+WHERE
+    webhook_url = @webhook_url;
+
+-- name: WebhookSecretReset :exec
+-- Replaces the signing secret for the webhook URL.
+INSERT INTO webhook_signing_secrets(webhook_url, secret)
+    VALUES (@webhook_url, @secret)
+ON CONFLICT (webhook_url)
+    DO UPDATE SET
+        secret = excluded.secret, created_at = now();
+
+-- name: WebhookSecretUserOwnsURL :one
+-- Returns true if the user has a webhook contact method for the URL, and the URL is not used by a
+-- notification channel or another user's contact method.
+SELECT
+    (EXISTS (
+        SELECT
+        FROM
+            user_contact_methods cm
+        WHERE
+            cm.user_id = @user_id
+            AND cm.dest ->> 'Type' = 'builtin-webhook'
+            AND cm.dest -> 'Args' ->> 'webhook_url' = @webhook_url::text)
+    AND NOT EXISTS (
+        SELECT
+        FROM
+            user_contact_methods other
+        WHERE
+            other.user_id <> @user_id
+            AND other.dest ->> 'Type' = 'builtin-webhook'
+            AND other.dest -> 'Args' ->> 'webhook_url' = @webhook_url::text)
+    AND NOT EXISTS (
+        SELECT
+        FROM
+            notification_channels nc
+        WHERE
+            nc.dest ->> 'Type' = 'builtin-webhook'
+            AND nc.dest -> 'Args' ->> 'webhook_url' = @webhook_url::text))::bool;
@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/keyring"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation/validate"
+)
+
+// secretSize is the number of random bytes in a generated signing secret.
+const secretSize = 32
+
+// SecretStore manages the per-destination secrets used to sign outgoing webhook requests.
+//
+// Secrets are stored encrypted with the data encryption keys and are created on first use.
+type SecretStore struct {
+	db   *sql.DB
+	keys keyring.Keys
+}
+
+// NewSecretStore creates a new SecretStore using the provided keys for encryption.
+func NewSecretStore(ctx context.Context, db *sql.DB, keys keyring.Keys) *SecretStore {
+	return &SecretStore{db: db, keys: keys}
+}
+
+func (s *SecretStore) newSecret() (plain string, enc []byte, err error) {
+	buf := make([]byte, secretSize)
+	_, err = rand.Read(buf)
+	if err != nil {
+		return "", nil, err
+	}
+	plain = base64.RawURLEncoding.EncodeToString(buf)
+
+	enc, err = s.keys.Encrypt("WEBHOOK SECRET", []byte(plain))
+	if err != nil {
+		return "", nil, fmt.Errorf("encrypt secret: %w", err)
+	}
+
+	return plain, enc, nil
+}
+
+// signingSecret returns the signing secret for the webhook URL, creating it if necessary.
+func (s *SecretStore) signingSecret(ctx context.Context, webhookURL string) (string, error) {
+	q := gadb.New(s.db)
+	data, err := q.WebhookSecretFind(ctx, webhookURL)
+	if errors.Is(err, sql.ErrNoRows) {
+		_, enc, err := s.newSecret()
+		if err != nil {
+			return "", err
+		}
+		err = q.WebhookSecretInsert(ctx, gadb.WebhookSecretInsertParams{
+			WebhookUrl: webhookURL,
+			Secret:     enc,
+		})
+		if err != nil {
+			return "", fmt.Errorf("insert secret: %w", err)
+		}
+
+		// re-read in case another instance created it first
+		data, err = q.WebhookSecretFind(ctx, webhookURL)
+	}
+	if err != nil {
+		return "", fmt.Errorf("lookup secret: %w", err)
+	}
+
+	plain, _, err := s.keys.Decrypt(data)
+	if err != nil {
+		return "", fmt.Errorf("decrypt secret: %w", err)
+	}
+
+	return string(plain), nil
+}
+
+func (s *SecretStore) checkAccess(ctx context.Context, webhookURL string) error {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.User)
+	if err != nil {
+		return err
+	}
+	if permission.Admin(ctx) {
+		return nil
+	}
+
+	// The secret is shared by everything sending to the URL, so a user may only access it if they are the
+	// only one using it. Otherwise a contact method could be used to read or reset the secret of another
+	// user's, or an escalation policy's, webhook.
+	ok, err := gadb.New(s.db).WebhookSecretUserOwnsURL(ctx, gadb.WebhookSecretUserOwnsURLParams{
+		UserID:     permission.UserNullUUID(ctx).UUID,
+		WebhookUrl: webhookURL,
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return permission.NewAccessDenied("only admins, or the only owner of a matching contact method, may access the signing secret")
+	}
+
+	return nil
+}
+
+// SigningSecret returns the signing secret for the webhook URL, creating it if necessary.
+//
+// Admins may access the secret for any URL, other users only for URLs used by one of their own contact methods
+// and nothing else.
+func (s *SecretStore) SigningSecret(ctx context.Context, webhookURL string) (string, error) {
+	err := validate.AbsoluteURL("URL", webhookURL)
+	if err != nil {
+		return "", err
+	}
+	err = s.checkAccess(ctx, webhookURL)
+	if err != nil {
+		return "", err
+	}
+
+	return s.signingSecret(ctx, webhookURL)
+}
+
+// ResetSigningSecret replaces the signing secret for the webhook URL and returns the new value.
+//
+// The same access rules as SigningSecret apply.
+func (s *SecretStore) ResetSigningSecret(ctx context.Context, webhookURL string) (string, error) {
+	err := validate.AbsoluteURL("URL", webhookURL)
+	if err != nil {
+		return "", err
+	}
+	err = s.checkAccess(ctx, webhookURL)
+	if err != nil {
+		return "", err
+	}
+
+	plain, enc, err := s.newSecret()
+	if err != nil {
+		return "", err
+	}
+
+	err = gadb.New(s.db).WebhookSecretReset(ctx, gadb.WebhookSecretResetParams{
+		WebhookUrl: webhookURL,
+		Secret:     enc,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return plain, nil
+}
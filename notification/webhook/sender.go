@@ -3,9 +3,10 @@ package webhook
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/target/goalert/config"
@@ -15,6 +16,8 @@ import (
 
 type Sender struct {
 	Client *http.Client
+
+	secrets *SecretStore
 }
 
 // POSTDataAlert represents fields in outgoing alert notification.
@@ -27,7 +30,7 @@ type POSTDataAlert struct {
 	ServiceID   string
 	ServiceName string
 	Meta        map[string]string
-	GoAlertURL string
+	GoAlertURL  string
 }
 
 // POSTDataAlertBundle represents fields in outgoing alert bundle notification.
@@ -88,15 +91,32 @@ type POSTDataOnCallNotification struct {
 	ScheduleURL  string
 }
 
+// POSTDataShiftSwapRequest represents fields in outgoing shift swap request notification.
+type POSTDataShiftSwapRequest struct {
+	AppName      string
+	Type         string
+	RequestID    string
+	ScheduleID   string
+	ScheduleName string
+	ScheduleURL  string
+	FromUserName string
+	Start        time.Time
+	End          time.Time
+	TradeStart   *time.Time `json:",omitempty"`
+	TradeEnd     *time.Time `json:",omitempty"`
+	Note         string
+}
+
 // POSTDataTest represents fields in outgoing test notification.
 type POSTDataTest struct {
 	AppName string
 	Type    string
 }
 
-func NewSender(ctx context.Context, client *http.Client) *Sender {
+func NewSender(ctx context.Context, client *http.Client, secrets *SecretStore) *Sender {
 	return &Sender{
-		Client: client,
+		Client:  client,
+		secrets: secrets,
 	}
 }
 
@@ -105,7 +125,107 @@ var _ nfydest.MessageSender = &Sender{}
 // Send will send an alert for the provided message type
 func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*notification.SentMessage, error) {
 	cfg := config.FromContext(ctx)
-	var payload interface{}
+
+	var data []byte
+	contentType := "application/json"
+	if m, ok := msg.(notification.SignalMessage); ok {
+		data = []byte(m.Param(ParamBody))
+		if ct := m.Param(ParamContentType); ct != "" {
+			contentType = ct
+		}
+	} else {
+		payload, err := defaultPayload(cfg, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err = renderBody(msg.DestArg(FieldBodyTemplate), msg, payload)
+		if err != nil {
+			// a broken template will fail the same way on retry
+			return &notification.SentMessage{
+				State:        notification.StateFailedPerm,
+				StateDetails: err.Error(),
+			}, nil
+		}
+		if ct := msg.DestArg(FieldContentType); ct != "" {
+			contentType = ct
+		}
+	}
+
+	headers, err := parseHeaders(msg.DestArg(FieldHeaders))
+	if err != nil {
+		return &notification.SentMessage{
+			State:        notification.StateFailedPerm,
+			StateDetails: err.Error(),
+		}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+
+	webURL := msg.DestArg(FieldWebhookURL)
+	if !cfg.ValidWebhookURL(webURL) {
+		// fail permanently if the URL is not currently valid/allowed
+		return &notification.SentMessage{
+			State:        notification.StateFailedPerm,
+			StateDetails: "invalid or not allowed URL",
+		}, nil
+	}
+
+	secret, err := s.secrets.signingSecret(ctx, webURL)
+	if err != nil {
+		return nil, fmt.Errorf("get signing secret: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", webURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for name, vals := range headers {
+		req.Header[name] = vals
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(HeaderMessageID, msg.MsgID())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Signature(secret, now, data))
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return Result(nil, err), nil
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	return Result(resp, nil), nil
+}
+
+// Result returns the result of an HTTP request to a webhook-style endpoint, given the response or the
+// error returned by the client.
+//
+// Connection errors, timeouts, and 408, 429, or 5xx responses are temporary failures that will be retried
+// by the message queue. Other non-2xx responses (e.g., 404) are not expected to succeed on retry.
+func Result(resp *http.Response, err error) *notification.SentMessage {
+	if err != nil {
+		return &notification.SentMessage{State: notification.StateFailedTemp, StateDetails: err.Error()}
+	}
+
+	details := fmt.Sprintf("HTTP %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return &notification.SentMessage{State: notification.StateSent}
+	case resp.StatusCode == http.StatusRequestTimeout,
+		resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode >= 500:
+		return &notification.SentMessage{State: notification.StateFailedTemp, StateDetails: details}
+	}
+
+	return &notification.SentMessage{State: notification.StateFailedPerm, StateDetails: details}
+}
+
+// defaultPayload returns the default payload for the provided message type.
+func defaultPayload(cfg config.Config, msg notification.Message) (payload any, err error) {
 	switch m := msg.(type) {
 	case notification.Test:
 		payload = POSTDataTest{
@@ -167,38 +287,27 @@ func (s *Sender) SendMessage(ctx context.Context, msg notification.Message) (*no
 			ScheduleName: m.ScheduleName,
 			ScheduleURL:  m.ScheduleURL,
 		}
+	case notification.ShiftSwapRequest:
+		data := POSTDataShiftSwapRequest{
+			AppName:      cfg.ApplicationName(),
+			Type:         "ShiftSwapRequest",
+			RequestID:    m.RequestID,
+			ScheduleID:   m.ScheduleID,
+			ScheduleName: m.ScheduleName,
+			ScheduleURL:  m.ScheduleURL,
+			FromUserName: m.FromUserName,
+			Start:        m.Start,
+			End:          m.End,
+			Note:         m.Note,
+		}
+		if m.IsTrade() {
+			data.TradeStart = &m.TradeStart
+			data.TradeEnd = &m.TradeEnd
+		}
+		payload = data
 	default:
 		return nil, fmt.Errorf("message type '%T' not supported", m)
 	}
 
-	data, err := json.Marshal(payload)
-	if err != nil {
-		return nil, err
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
-	defer cancel()
-
-	webURL := msg.DestArg(FieldWebhookURL)
-	if !cfg.ValidWebhookURL(webURL) {
-		// fail permanently if the URL is not currently valid/allowed
-		return &notification.SentMessage{
-			State:        notification.StateFailedPerm,
-			StateDetails: "invalid or not allowed URL",
-		}, nil
-	}
-
-	req, err := http.NewRequestWithContext(ctx, "POST", webURL, bytes.NewReader(data))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", "application/json")
-
-	_, err = http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	return &notification.SentMessage{State: notification.StateSent}, nil
+	return payload, nil
 }
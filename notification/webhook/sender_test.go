@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/notification"
+)
+
+func TestResult(t *testing.T) {
+	check := func(code int, expState notification.State, expDetails string) {
+		t.Helper()
+		res := Result(&http.Response{StatusCode: code}, nil)
+		assert.Equal(t, expState, res.State, "state for %d", code)
+		assert.Equal(t, expDetails, res.StateDetails, "details for %d", code)
+	}
+
+	check(http.StatusOK, notification.StateSent, "")
+	check(http.StatusAccepted, notification.StateSent, "")
+	check(http.StatusNoContent, notification.StateSent, "")
+	check(http.StatusTooManyRequests, notification.StateFailedTemp, "HTTP 429 Too Many Requests")
+	check(http.StatusBadGateway, notification.StateFailedTemp, "HTTP 502 Bad Gateway")
+	check(http.StatusRequestTimeout, notification.StateFailedTemp, "HTTP 408 Request Timeout")
+	check(http.StatusNotFound, notification.StateFailedPerm, "HTTP 404 Not Found")
+	check(http.StatusUnauthorized, notification.StateFailedPerm, "HTTP 401 Unauthorized")
+
+	res := Result(nil, errors.New("connection refused"))
+	assert.Equal(t, notification.StateFailedTemp, res.State)
+	assert.Equal(t, "connection refused", res.StateDetails)
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// HeaderTimestamp contains the unix timestamp (in seconds) the request was signed at.
+	HeaderTimestamp = "X-GoAlert-Timestamp"
+
+	// HeaderSignature contains the signature of the request.
+	HeaderSignature = "X-GoAlert-Signature"
+
+	// HeaderMessageID contains the ID of the message being sent, it is the same for all attempts of a message.
+	HeaderMessageID = "X-GoAlert-Message-ID"
+)
+
+// Signature generates a signature for a webhook request.
+//
+// It is the hex-encoded HMAC-SHA256 of `v1:<timestamp>:<body>` using the destination's secret, prefixed with `v1=`.
+func Signature(secret string, ts time.Time, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, err := fmt.Fprintf(h, "v1:%d:%s", ts.Unix(), body)
+	if err != nil {
+		panic(err)
+	}
+
+	return "v1=" + hex.EncodeToString(h.Sum(nil))
+}
+
+// ValidSignature returns true if the signature and timestamp header values are valid for the body and secret,
+// and the timestamp is within maxAge of now.
+func ValidSignature(secret string, now time.Time, maxAge time.Duration, tsHeader, sigHeader string, body []byte) bool {
+	unixSec, err := strconv.ParseInt(tsHeader, 10, 64)
+	if err != nil {
+		return false
+	}
+	ts := time.Unix(unixSec, 0)
+	if now.Sub(ts).Abs() > maxAge {
+		return false
+	}
+
+	return hmac.Equal([]byte(sigHeader), []byte(Signature(secret, ts, body)))
+}
@@ -0,0 +1,22 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignature(t *testing.T) {
+	body := []byte(`{"Type":"Test"}`)
+	ts := time.Unix(1700000000, 0)
+
+	sig := Signature("secret-value", ts, body)
+	assert.Equal(t, "v1=92745cbcfe3f99ead65152f550aeb2c59b780f5440a859ac8246f6fd688456ab", sig)
+
+	assert.True(t, ValidSignature("secret-value", ts.Add(time.Minute), 5*time.Minute, "1700000000", sig, body))
+	assert.False(t, ValidSignature("secret-value", ts.Add(10*time.Minute), 5*time.Minute, "1700000000", sig, body), "expired")
+	assert.False(t, ValidSignature("other-secret", ts, 5*time.Minute, "1700000000", sig, body), "wrong secret")
+	assert.False(t, ValidSignature("secret-value", ts, 5*time.Minute, "1700000001", sig, body), "wrong timestamp")
+	assert.False(t, ValidSignature("secret-value", ts, 5*time.Minute, "1700000000", sig, []byte(`{}`)), "wrong body")
+}
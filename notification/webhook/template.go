@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/target/goalert/notification"
+)
+
+// compileTemplate compiles a body template using the same options as universal integration key rules.
+func compileTemplate(tmpl string) (*vm.Program, error) {
+	return expr.Compile(tmpl, expr.AllowUndefinedVariables(), expr.Optimize(true))
+}
+
+// renderBody returns the request body for a message.
+//
+// If tmpl is empty, the default payload is encoded as JSON. Otherwise tmpl is evaluated as an expression with
+// `msg` set to the notification message, `payload` set to the default payload, and the `sprintf` function
+// available (as with universal integration key rules). A string result is used as-is, any other
+// result is encoded as JSON.
+func renderBody(tmpl string, msg notification.Message, payload any) ([]byte, error) {
+	if tmpl == "" {
+		return json.Marshal(payload)
+	}
+
+	prog, err := compileTemplate(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("compile body template: %w", err)
+	}
+
+	env := map[string]any{
+		"sprintf": fmt.Sprintf,
+		"msg":     msg,
+		"payload": payload,
+	}
+
+	res, err := expr.Run(prog, env)
+	if err != nil {
+		return nil, fmt.Errorf("run body template: %w", err)
+	}
+
+	switch v := res.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("encode body template result: %w", err)
+	}
+
+	return data, nil
+}
+
+// parseHeaders parses a JSON object of extra request headers.
+func parseHeaders(value string) (http.Header, error) {
+	h := make(http.Header)
+	if value == "" {
+		return h, nil
+	}
+
+	var m map[string]string
+	err := json.Unmarshal([]byte(value), &m)
+	if err != nil {
+		return nil, errors.New("headers must be a JSON object of string values")
+	}
+
+	for name, val := range m {
+		if name == "" || strings.ContainsAny(name, " \t\r\n:") {
+			return nil, fmt.Errorf("invalid header name '%s'", name)
+		}
+		if strings.ContainsAny(val, "\r\n") {
+			return nil, fmt.Errorf("invalid value for header '%s'", name)
+		}
+
+		name = textproto.CanonicalMIMEHeaderKey(name)
+		if name == "Content-Type" || strings.HasPrefix(name, "X-Goalert-") {
+			return nil, fmt.Errorf("header '%s' is reserved", name)
+		}
+		h.Set(name, val)
+	}
+
+	return h, nil
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/notification"
+)
+
+func TestRenderBody(t *testing.T) {
+	msg := notification.Alert{
+		AlertID: 123,
+		Summary: "disk full",
+		Meta:    map[string]string{"component": "db"},
+	}
+	payload := POSTDataAlert{Type: "Alert", AlertID: 123, GoAlertURL: "http://example.com/alerts/123"}
+
+	data, err := renderBody("", msg, payload)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"AlertID":123`, "default payload")
+
+	data, err = renderBody(`sprintf("#%d: %s", msg.AlertID, msg.Summary)`, msg, payload)
+	require.NoError(t, err)
+	assert.Equal(t, "#123: disk full", string(data), "string result used as-is")
+
+	data, err = renderBody(`{"text": msg.Summary, "component": msg.Meta.component, "link": payload.GoAlertURL}`, msg, payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text":"disk full","component":"db","link":"http://example.com/alerts/123"}`, string(data), "map result encoded as JSON")
+
+	_, err = renderBody(`{"text": `, msg, payload)
+	assert.Error(t, err, "invalid template")
+}
+
+func TestParseHeaders(t *testing.T) {
+	h, err := parseHeaders("")
+	require.NoError(t, err)
+	assert.Empty(t, h)
+
+	h, err = parseHeaders(`{"authorization": "Bearer abc", "X-Custom": "1"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer abc", h.Get("Authorization"))
+	assert.Equal(t, "1", h.Get("X-Custom"))
+
+	_, err = parseHeaders(`["a"]`)
+	assert.Error(t, err, "not an object")
+
+	_, err = parseHeaders(`{"Content-Type": "text/plain"}`)
+	assert.Error(t, err, "reserved")
+
+	_, err = parseHeaders(`{"X-GoAlert-Signature": "v1=abc"}`)
+	assert.Error(t, err, "reserved")
+
+	_, err = parseHeaders(`{"Bad Name": "x"}`)
+	assert.Error(t, err, "invalid name")
+
+	_, err = parseHeaders(`{"X-Test": "a\r\nb"}`)
+	assert.Error(t, err, "invalid value")
+}
@@ -0,0 +1,62 @@
+package oncall
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// A Gap is a span of time where nobody is on-call.
+type Gap struct {
+	Start time.Time `json:"start_time"`
+	End   time.Time `json:"end_time"`
+}
+
+// CoverageGaps returns the spans of time between start and end that are not covered by any of the shifts.
+// Shifts with a zero End are treated as continuing past end.
+func CoverageGaps(shifts []Shift, start, end time.Time) []Gap {
+	shifts = append([]Shift(nil), shifts...)
+	sort.Slice(shifts, func(i, j int) bool { return shifts[i].Start.Before(shifts[j].Start) })
+
+	var gaps []Gap
+	covered := start
+	for _, s := range shifts {
+		if !covered.Before(end) {
+			break
+		}
+		if s.Start.After(covered) {
+			gaps = append(gaps, Gap{Start: covered, End: minTime(s.Start, end)})
+		}
+		if s.End.IsZero() {
+			covered = end
+			break
+		}
+		if s.End.After(covered) {
+			covered = s.End
+		}
+	}
+	if covered.Before(end) {
+		gaps = append(gaps, Gap{Start: covered, End: end})
+	}
+
+	return gaps
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// CoverageGapsBySchedule returns the spans of time between start and end where nobody is on-call for the given schedule.
+func (s *Store) CoverageGapsBySchedule(ctx context.Context, scheduleID string, start, end time.Time) ([]Gap, error) {
+	start = start.Truncate(time.Minute)
+	end = end.Truncate(time.Minute)
+	shifts, err := s.HistoryBySchedule(ctx, scheduleID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return CoverageGaps(shifts, start, end), nil
+}
@@ -0,0 +1,37 @@
+package oncall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoverageGaps(t *testing.T) {
+	start := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return start.Add(time.Duration(h) * time.Hour) }
+	end := at(24)
+
+	assert.Equal(t, []Gap{{Start: start, End: end}}, CoverageGaps(nil, start, end), "no shifts")
+
+	assert.Empty(t, CoverageGaps([]Shift{
+		{UserID: "a", Start: at(-1), End: at(12)},
+		{UserID: "b", Start: at(12)},
+	}, start, end), "fully covered")
+
+	assert.Equal(t, []Gap{
+		{Start: start, End: at(2)},
+		{Start: at(10), End: at(12)},
+		{Start: at(20), End: end},
+	}, CoverageGaps([]Shift{
+		{UserID: "b", Start: at(6), End: at(10)},
+		{UserID: "a", Start: at(2), End: at(8)},
+		{UserID: "c", Start: at(12), End: at(20)},
+		{UserID: "d", Start: at(13), End: at(14)},
+	}, start, end), "overlapping shifts")
+
+	assert.Equal(t, []Gap{{Start: at(12), End: end}}, CoverageGaps([]Shift{
+		{UserID: "a", Start: at(-5), End: at(12)},
+		{UserID: "b", Start: at(30), End: at(40)},
+	}, start, end), "shift after end")
+}
@@ -36,6 +36,10 @@ func (r *ResolvedRotation) UserID(t time.Time) string {
 	if r == nil || len(r.Users) == 0 {
 		return ""
 	}
+	if r.Type == rotation.TypeFollowTheSun {
+		// regions are limited to participants when loaded
+		return r.RegionUserID(t)
+	}
 	if len(r.Users) == 1 {
 		return r.Users[0]
 	}
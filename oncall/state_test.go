@@ -174,6 +174,34 @@ func TestResolvedRotation_UserID(t *testing.T) {
 	}
 }
 
+func TestResolvedRotation_UserID_FollowTheSun(t *testing.T) {
+	rot := &ResolvedRotation{
+		Rotation: rotation.Rotation{
+			ID:          "rot",
+			Type:        rotation.TypeFollowTheSun,
+			Start:       time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			ShiftLength: 1,
+			Regions: []rotation.Region{
+				{TimeZone: "UTC", Start: timeutil.NewClock(8, 0), UserIDs: []string{"a"}},
+				{TimeZone: "America/New_York", Start: timeutil.NewClock(8, 0), UserIDs: []string{"b"}},
+			},
+		},
+		Users: []string{"a", "b"},
+	}
+
+	check := func(at time.Time, exp string) {
+		t.Helper()
+		if id := rot.UserID(at); id != exp {
+			t.Errorf("UserID(%s) = '%s'; want '%s'", at, id, exp)
+		}
+	}
+
+	check(time.Date(2018, 1, 2, 7, 59, 0, 0, time.UTC), "b")
+	check(time.Date(2018, 1, 2, 8, 0, 0, 0, time.UTC), "a")
+	check(time.Date(2018, 1, 2, 12, 59, 0, 0, time.UTC), "a")
+	check(time.Date(2018, 1, 2, 13, 0, 0, 0, time.UTC), "b")
+}
+
 func TestState_CalculateShifts(t *testing.T) {
 	check := func(name string, start, end time.Time, s *state, exp []Shift) {
 		t.Helper()
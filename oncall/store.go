@@ -3,6 +3,7 @@ package oncall
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/target/goalert/override"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/rotation"
 	"github.com/target/goalert/schedule/rule"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/sqlutil"
@@ -120,6 +122,7 @@ func NewStore(ctx context.Context, db *sql.DB, ruleStore *rule.Store, schedStore
 				rot.start_time,
 				rot.shift_length,
 				rot.time_zone,
+				rot.regions,
 				state.position,
 				state.shift_start
 			from schedule_rules rule
@@ -243,10 +246,15 @@ func (s *Store) HistoryBySchedule(ctx context.Context, scheduleID string, start,
 	for rows.Next() {
 		var rot ResolvedRotation
 		var rotTZ string
-		err = rows.Scan(&rot.ID, &rot.Type, &rot.Start, &rot.ShiftLength, &rotTZ, &rot.CurrentIndex, &rot.CurrentStart)
+		var regions []byte
+		err = rows.Scan(&rot.ID, &rot.Type, &rot.Start, &rot.ShiftLength, &rotTZ, &regions, &rot.CurrentIndex, &rot.CurrentStart)
 		if err != nil {
 			return nil, errors.Wrap(err, "scan rotation info")
 		}
+		err = json.Unmarshal(regions, &rot.Regions)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse rotation regions")
+		}
 		loc, err := util.LoadLocation(rotTZ)
 		if err != nil {
 			return nil, errors.Wrap(err, "load time zone info")
@@ -269,6 +277,12 @@ func (s *Store) HistoryBySchedule(ctx context.Context, scheduleID string, start,
 		}
 		rots[rotID].Users = append(rots[rotID].Users, userID)
 	}
+	for _, rot := range rots {
+		if rot.Type != rotation.TypeFollowTheSun {
+			continue
+		}
+		rot.Rotation = rot.WithParticipants(rot.Users)
+	}
 
 	rawRules, err := s.ruleStore.FindAllTx(ctx, tx, scheduleID)
 	if err != nil {
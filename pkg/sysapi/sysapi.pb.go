@@ -9,6 +9,7 @@ package sysapi
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AlertEvent_Type int32
+
+const (
+	AlertEvent_TYPE_UNSPECIFIED    AlertEvent_Type = 0
+	AlertEvent_TYPE_CREATED        AlertEvent_Type = 1
+	AlertEvent_TYPE_STATUS_CHANGED AlertEvent_Type = 2
+	AlertEvent_TYPE_LOG            AlertEvent_Type = 3
+)
+
+// Enum value maps for AlertEvent_Type.
+var (
+	AlertEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_CREATED",
+		2: "TYPE_STATUS_CHANGED",
+		3: "TYPE_LOG",
+	}
+	AlertEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":    0,
+		"TYPE_CREATED":        1,
+		"TYPE_STATUS_CHANGED": 2,
+		"TYPE_LOG":            3,
+	}
+)
+
+func (x AlertEvent_Type) Enum() *AlertEvent_Type {
+	p := new(AlertEvent_Type)
+	*p = x
+	return p
+}
+
+func (x AlertEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_sysapi_sysapi_proto_enumTypes[0].Descriptor()
+}
+
+func (AlertEvent_Type) Type() protoreflect.EnumType {
+	return &file_pkg_sysapi_sysapi_proto_enumTypes[0]
+}
+
+func (x AlertEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertEvent_Type.Descriptor instead.
+func (AlertEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{56, 0}
+}
+
 type UsersWithoutAuthProviderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProviderId    string                 `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
@@ -397,41 +450,2859 @@ func (x *AuthSubject) GetSubjectId() string {
 	return ""
 }
 
-var File_pkg_sysapi_sysapi_proto protoreflect.FileDescriptor
+// Destination is a notification destination, e.g., a phone number, webhook, or schedule.
+type Destination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Args          map[string]string      `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_pkg_sysapi_sysapi_proto_rawDesc = "" +
-	"\n" +
-	"\x17pkg/sysapi/sysapi.proto\x12\n" +
-	"goalert.v1\"B\n" +
-	"\x1fUsersWithoutAuthProviderRequest\x12\x1f\n" +
-	"\vprovider_id\x18\x01 \x01(\tR\n" +
-	"providerId\"J\n" +
-	"\x15SetAuthSubjectRequest\x121\n" +
-	"\asubject\x18\x01 \x01(\v2\x17.goalert.v1.AuthSubjectR\asubject\"D\n" +
-	"\bUserInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"\x18\n" +
-	"\x16SetAuthSubjectResponse\",\n" +
-	"\x11DeleteUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x14\n" +
-	"\x12DeleteUserResponse\"O\n" +
-	"\x13AuthSubjectsRequest\x12\x1f\n" +
-	"\vprovider_id\x18\x01 \x01(\tR\n" +
-	"providerId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"f\n" +
-	"\vAuthSubject\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
-	"\vprovider_id\x18\x02 \x01(\tR\n" +
-	"providerId\x12\x1d\n" +
-	"\n" +
-	"subject_id\x18\x03 \x01(\tR\tsubjectId2\xe3\x02\n" +
-	"\x06SysAPI\x12L\n" +
-	"\fAuthSubjects\x12\x1f.goalert.v1.AuthSubjectsRequest\x1a\x17.goalert.v1.AuthSubject\"\x000\x01\x12M\n" +
-	"\n" +
-	"DeleteUser\x12\x1d.goalert.v1.DeleteUserRequest\x1a\x1e.goalert.v1.DeleteUserResponse\"\x00\x12a\n" +
-	"\x18UsersWithoutAuthProvider\x12+.goalert.v1.UsersWithoutAuthProviderRequest\x1a\x14.goalert.v1.UserInfo\"\x000\x01\x12Y\n" +
-	"\x0eSetAuthSubject\x12!.goalert.v1.SetAuthSubjectRequest\x1a\".goalert.v1.SetAuthSubjectResponse\"\x00B&Z$github.com/target/goalert/pkg/sysapib\x06proto3"
+func (x *Destination) Reset() {
+	*x = Destination{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Destination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Destination) ProtoMessage() {}
+
+func (x *Destination) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Destination.ProtoReflect.Descriptor instead.
+func (*Destination) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Destination) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Destination) GetArgs() map[string]string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type User struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// role is either "user" or "admin".
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{10}
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateUserRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ContactMethod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Dest          *Destination           `protobuf:"bytes,4,opt,name=dest,proto3" json:"dest,omitempty"`
+	Disabled      bool                   `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	StatusUpdates bool                   `protobuf:"varint,6,opt,name=status_updates,json=statusUpdates,proto3" json:"status_updates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactMethod) Reset() {
+	*x = ContactMethod{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactMethod) ProtoMessage() {}
+
+func (x *ContactMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactMethod.ProtoReflect.Descriptor instead.
+func (*ContactMethod) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ContactMethod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContactMethod) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ContactMethod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContactMethod) GetDest() *Destination {
+	if x != nil {
+		return x.Dest
+	}
+	return nil
+}
+
+func (x *ContactMethod) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ContactMethod) GetStatusUpdates() bool {
+	if x != nil {
+		return x.StatusUpdates
+	}
+	return false
+}
+
+type ListContactMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactMethodsRequest) Reset() {
+	*x = ListContactMethodsRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactMethodsRequest) ProtoMessage() {}
+
+func (x *ListContactMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListContactMethodsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateContactMethodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Dest          *Destination           `protobuf:"bytes,3,opt,name=dest,proto3" json:"dest,omitempty"`
+	StatusUpdates bool                   `protobuf:"varint,4,opt,name=status_updates,json=statusUpdates,proto3" json:"status_updates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContactMethodRequest) Reset() {
+	*x = CreateContactMethodRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContactMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactMethodRequest) ProtoMessage() {}
+
+func (x *CreateContactMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactMethodRequest.ProtoReflect.Descriptor instead.
+func (*CreateContactMethodRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateContactMethodRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateContactMethodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateContactMethodRequest) GetDest() *Destination {
+	if x != nil {
+		return x.Dest
+	}
+	return nil
+}
+
+func (x *CreateContactMethodRequest) GetStatusUpdates() bool {
+	if x != nil {
+		return x.StatusUpdates
+	}
+	return false
+}
+
+type UpdateContactMethodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StatusUpdates bool                   `protobuf:"varint,3,opt,name=status_updates,json=statusUpdates,proto3" json:"status_updates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactMethodRequest) Reset() {
+	*x = UpdateContactMethodRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactMethodRequest) ProtoMessage() {}
+
+func (x *UpdateContactMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactMethodRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContactMethodRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateContactMethodRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateContactMethodRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateContactMethodRequest) GetStatusUpdates() bool {
+	if x != nil {
+		return x.StatusUpdates
+	}
+	return false
+}
+
+type DeleteContactMethodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactMethodRequest) Reset() {
+	*x = DeleteContactMethodRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactMethodRequest) ProtoMessage() {}
+
+func (x *DeleteContactMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactMethodRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContactMethodRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteContactMethodRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteContactMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactMethodResponse) Reset() {
+	*x = DeleteContactMethodResponse{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactMethodResponse) ProtoMessage() {}
+
+func (x *DeleteContactMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactMethodResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContactMethodResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{19}
+}
+
+type Service struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	EscalationPolicyId string                 `protobuf:"bytes,4,opt,name=escalation_policy_id,json=escalationPolicyId,proto3" json:"escalation_policy_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Service) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Service) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Service) GetEscalationPolicyId() string {
+	if x != nil {
+		return x.EscalationPolicyId
+	}
+	return ""
+}
+
+type ListServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesRequest) Reset() {
+	*x = ListServicesRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesRequest) ProtoMessage() {}
+
+func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListServicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{21}
+}
+
+type GetServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceRequest) Reset() {
+	*x = GetServiceRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRequest) ProtoMessage() {}
+
+func (x *GetServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetServiceRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type CreateServiceRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EscalationPolicyId string                 `protobuf:"bytes,3,opt,name=escalation_policy_id,json=escalationPolicyId,proto3" json:"escalation_policy_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateServiceRequest) Reset() {
+	*x = CreateServiceRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceRequest) ProtoMessage() {}
+
+func (x *CreateServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateServiceRequest) GetEscalationPolicyId() string {
+	if x != nil {
+		return x.EscalationPolicyId
+	}
+	return ""
+}
+
+type UpdateServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServiceRequest) Reset() {
+	*x = UpdateServiceRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceRequest) ProtoMessage() {}
+
+func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateServiceRequest) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type DeleteServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceRequest) Reset() {
+	*x = DeleteServiceRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceRequest) ProtoMessage() {}
+
+func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteServiceRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type DeleteServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceResponse) Reset() {
+	*x = DeleteServiceResponse{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceResponse) ProtoMessage() {}
+
+func (x *DeleteServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{26}
+}
+
+type EscalationPolicy struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Repeat        int32                   `protobuf:"varint,4,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Steps         []*EscalationPolicyStep `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationPolicy) Reset() {
+	*x = EscalationPolicy{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationPolicy) ProtoMessage() {}
+
+func (x *EscalationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationPolicy.ProtoReflect.Descriptor instead.
+func (*EscalationPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EscalationPolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EscalationPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EscalationPolicy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EscalationPolicy) GetRepeat() int32 {
+	if x != nil {
+		return x.Repeat
+	}
+	return 0
+}
+
+func (x *EscalationPolicy) GetSteps() []*EscalationPolicyStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type EscalationPolicyStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DelayMinutes  int32                  `protobuf:"varint,1,opt,name=delay_minutes,json=delayMinutes,proto3" json:"delay_minutes,omitempty"`
+	MultiAck      bool                   `protobuf:"varint,2,opt,name=multi_ack,json=multiAck,proto3" json:"multi_ack,omitempty"`
+	Actions       []*Destination         `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationPolicyStep) Reset() {
+	*x = EscalationPolicyStep{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationPolicyStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationPolicyStep) ProtoMessage() {}
+
+func (x *EscalationPolicyStep) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationPolicyStep.ProtoReflect.Descriptor instead.
+func (*EscalationPolicyStep) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EscalationPolicyStep) GetDelayMinutes() int32 {
+	if x != nil {
+		return x.DelayMinutes
+	}
+	return 0
+}
+
+func (x *EscalationPolicyStep) GetMultiAck() bool {
+	if x != nil {
+		return x.MultiAck
+	}
+	return false
+}
+
+func (x *EscalationPolicyStep) GetActions() []*Destination {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type ListEscalationPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEscalationPoliciesRequest) Reset() {
+	*x = ListEscalationPoliciesRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEscalationPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEscalationPoliciesRequest) ProtoMessage() {}
+
+func (x *ListEscalationPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEscalationPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListEscalationPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{29}
+}
+
+type GetEscalationPolicyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EscalationPolicyId string                 `protobuf:"bytes,1,opt,name=escalation_policy_id,json=escalationPolicyId,proto3" json:"escalation_policy_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetEscalationPolicyRequest) Reset() {
+	*x = GetEscalationPolicyRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *GetEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetEscalationPolicyRequest) GetEscalationPolicyId() string {
+	if x != nil {
+		return x.EscalationPolicyId
+	}
+	return ""
+}
+
+type CreateEscalationPolicyRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Repeat        int32                   `protobuf:"varint,3,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Steps         []*EscalationPolicyStep `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEscalationPolicyRequest) Reset() {
+	*x = CreateEscalationPolicyRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *CreateEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreateEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateEscalationPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEscalationPolicyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateEscalationPolicyRequest) GetRepeat() int32 {
+	if x != nil {
+		return x.Repeat
+	}
+	return 0
+}
+
+func (x *CreateEscalationPolicyRequest) GetSteps() []*EscalationPolicyStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// UpdateEscalationPolicyRequest replaces the settings and steps of an escalation policy.
+type UpdateEscalationPolicyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EscalationPolicy *EscalationPolicy      `protobuf:"bytes,1,opt,name=escalation_policy,json=escalationPolicy,proto3" json:"escalation_policy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateEscalationPolicyRequest) Reset() {
+	*x = UpdateEscalationPolicyRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateEscalationPolicyRequest) GetEscalationPolicy() *EscalationPolicy {
+	if x != nil {
+		return x.EscalationPolicy
+	}
+	return nil
+}
+
+type DeleteEscalationPolicyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EscalationPolicyId string                 `protobuf:"bytes,1,opt,name=escalation_policy_id,json=escalationPolicyId,proto3" json:"escalation_policy_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteEscalationPolicyRequest) Reset() {
+	*x = DeleteEscalationPolicyRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEscalationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEscalationPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteEscalationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEscalationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEscalationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteEscalationPolicyRequest) GetEscalationPolicyId() string {
+	if x != nil {
+		return x.EscalationPolicyId
+	}
+	return ""
+}
+
+type DeleteEscalationPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEscalationPolicyResponse) Reset() {
+	*x = DeleteEscalationPolicyResponse{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEscalationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEscalationPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteEscalationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEscalationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEscalationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{34}
+}
+
+type Schedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Schedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Schedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Schedule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Schedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{36}
+}
+
+type GetScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleRequest) ProtoMessage() {}
+
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type CreateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type UpdateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateScheduleRequest) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type DeleteScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type DeleteScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{41}
+}
+
+type Rotation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// type is one of "weekly", "daily", "hourly", or "monthly".
+	Type        string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	ShiftLength int32                  `protobuf:"varint,5,opt,name=shift_length,json=shiftLength,proto3" json:"shift_length,omitempty"`
+	Start       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	TimeZone    string                 `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// participant_user_ids is the ordered list of users in the rotation.
+	ParticipantUserIds []string `protobuf:"bytes,8,rep,name=participant_user_ids,json=participantUserIds,proto3" json:"participant_user_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Rotation) Reset() {
+	*x = Rotation{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rotation) ProtoMessage() {}
+
+func (x *Rotation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rotation.ProtoReflect.Descriptor instead.
+func (*Rotation) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Rotation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rotation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rotation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Rotation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Rotation) GetShiftLength() int32 {
+	if x != nil {
+		return x.ShiftLength
+	}
+	return 0
+}
+
+func (x *Rotation) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Rotation) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Rotation) GetParticipantUserIds() []string {
+	if x != nil {
+		return x.ParticipantUserIds
+	}
+	return nil
+}
+
+type ListRotationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRotationsRequest) Reset() {
+	*x = ListRotationsRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRotationsRequest) ProtoMessage() {}
+
+func (x *ListRotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRotationsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{43}
+}
+
+type GetRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RotationId    string                 `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRotationRequest) Reset() {
+	*x = GetRotationRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRotationRequest) ProtoMessage() {}
+
+func (x *GetRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRotationRequest.ProtoReflect.Descriptor instead.
+func (*GetRotationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetRotationRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+type CreateRotationRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type               string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ShiftLength        int32                  `protobuf:"varint,4,opt,name=shift_length,json=shiftLength,proto3" json:"shift_length,omitempty"`
+	Start              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	TimeZone           string                 `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	ParticipantUserIds []string               `protobuf:"bytes,7,rep,name=participant_user_ids,json=participantUserIds,proto3" json:"participant_user_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateRotationRequest) Reset() {
+	*x = CreateRotationRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRotationRequest) ProtoMessage() {}
+
+func (x *CreateRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRotationRequest.ProtoReflect.Descriptor instead.
+func (*CreateRotationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateRotationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRotationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRotationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateRotationRequest) GetShiftLength() int32 {
+	if x != nil {
+		return x.ShiftLength
+	}
+	return 0
+}
+
+func (x *CreateRotationRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CreateRotationRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateRotationRequest) GetParticipantUserIds() []string {
+	if x != nil {
+		return x.ParticipantUserIds
+	}
+	return nil
+}
+
+// UpdateRotationRequest replaces the settings and participants of a rotation.
+type UpdateRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rotation      *Rotation              `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRotationRequest) Reset() {
+	*x = UpdateRotationRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRotationRequest) ProtoMessage() {}
+
+func (x *UpdateRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRotationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRotationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateRotationRequest) GetRotation() *Rotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+type DeleteRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RotationId    string                 `protobuf:"bytes,1,opt,name=rotation_id,json=rotationId,proto3" json:"rotation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRotationRequest) Reset() {
+	*x = DeleteRotationRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRotationRequest) ProtoMessage() {}
+
+func (x *DeleteRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRotationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRotationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteRotationRequest) GetRotationId() string {
+	if x != nil {
+		return x.RotationId
+	}
+	return ""
+}
+
+type DeleteRotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRotationResponse) Reset() {
+	*x = DeleteRotationResponse{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRotationResponse) ProtoMessage() {}
+
+func (x *DeleteRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRotationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRotationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{48}
+}
+
+type Override struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// add_user_id and remove_user_id are both set to replace a user.
+	AddUserId     string                 `protobuf:"bytes,3,opt,name=add_user_id,json=addUserId,proto3" json:"add_user_id,omitempty"`
+	RemoveUserId  string                 `protobuf:"bytes,4,opt,name=remove_user_id,json=removeUserId,proto3" json:"remove_user_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Override) Reset() {
+	*x = Override{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Override) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Override) ProtoMessage() {}
+
+func (x *Override) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Override.ProtoReflect.Descriptor instead.
+func (*Override) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Override) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Override) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *Override) GetAddUserId() string {
+	if x != nil {
+		return x.AddUserId
+	}
+	return ""
+}
+
+func (x *Override) GetRemoveUserId() string {
+	if x != nil {
+		return x.RemoveUserId
+	}
+	return ""
+}
+
+func (x *Override) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Override) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type ListOverridesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOverridesRequest) Reset() {
+	*x = ListOverridesRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverridesRequest) ProtoMessage() {}
+
+func (x *ListOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListOverridesRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ListOverridesRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListOverridesRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type CreateOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Override      *Override              `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOverrideRequest) Reset() {
+	*x = CreateOverrideRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOverrideRequest) ProtoMessage() {}
+
+func (x *CreateOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOverrideRequest.ProtoReflect.Descriptor instead.
+func (*CreateOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateOverrideRequest) GetOverride() *Override {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type DeleteOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOverrideRequest) Reset() {
+	*x = DeleteOverrideRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOverrideRequest) ProtoMessage() {}
+
+func (x *DeleteOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteOverrideRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOverrideResponse) Reset() {
+	*x = DeleteOverrideResponse{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOverrideResponse) ProtoMessage() {}
+
+func (x *DeleteOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOverrideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{53}
+}
+
+type WatchAlertsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// service_id, if set, only includes events for alerts of the service.
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// after_event_id, if set, resumes the stream after the given event ID.
+	AfterEventId  int64 `protobuf:"varint,2,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAlertsRequest) Reset() {
+	*x = WatchAlertsRequest{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAlertsRequest) ProtoMessage() {}
+
+func (x *WatchAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAlertsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *WatchAlertsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *WatchAlertsRequest) GetAfterEventId() int64 {
+	if x != nil {
+		return x.AfterEventId
+	}
+	return 0
+}
+
+type Alert struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceId string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// status is one of "triggered", "active" (acknowledged), or "closed".
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	Severity      string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Alert) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Alert) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *Alert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Alert) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Alert) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Alert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AlertEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id identifies the event, and can be used as after_event_id to resume a stream.
+	// Events are sent in ID order, except that an event committed late may follow events with higher IDs.
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      AlertEvent_Type        `protobuf:"varint,2,opt,name=type,proto3,enum=goalert.v1.AlertEvent_Type" json:"type,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// alert is the state of the alert when the event was sent.
+	Alert *Alert `protobuf:"bytes,4,opt,name=alert,proto3" json:"alert,omitempty"`
+	// message is the log message for the event, e.g., "Acknowledged by Joe".
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertEvent) Reset() {
+	*x = AlertEvent{}
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertEvent) ProtoMessage() {}
+
+func (x *AlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_sysapi_sysapi_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertEvent.ProtoReflect.Descriptor instead.
+func (*AlertEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_sysapi_sysapi_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AlertEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetType() AlertEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return AlertEvent_TYPE_UNSPECIFIED
+}
+
+func (x *AlertEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AlertEvent) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *AlertEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_pkg_sysapi_sysapi_proto protoreflect.FileDescriptor
+
+const file_pkg_sysapi_sysapi_proto_rawDesc = "" +
+	"\n" +
+	"\x17pkg/sysapi/sysapi.proto\x12\n" +
+	"goalert.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"B\n" +
+	"\x1fUsersWithoutAuthProviderRequest\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\tR\n" +
+	"providerId\"J\n" +
+	"\x15SetAuthSubjectRequest\x121\n" +
+	"\asubject\x18\x01 \x01(\v2\x17.goalert.v1.AuthSubjectR\asubject\"D\n" +
+	"\bUserInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\"\x18\n" +
+	"\x16SetAuthSubjectResponse\",\n" +
+	"\x11DeleteUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x14\n" +
+	"\x12DeleteUserResponse\"O\n" +
+	"\x13AuthSubjectsRequest\x12\x1f\n" +
+	"\vprovider_id\x18\x01 \x01(\tR\n" +
+	"providerId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"f\n" +
+	"\vAuthSubject\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\tR\n" +
+	"providerId\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x03 \x01(\tR\tsubjectId\"\x91\x01\n" +
+	"\vDestination\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x125\n" +
+	"\x04args\x18\x02 \x03(\v2!.goalert.v1.Destination.ArgsEntryR\x04args\x1a7\n" +
+	"\tArgsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"\x12\n" +
+	"\x10ListUsersRequest\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"Q\n" +
+	"\x11CreateUserRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"9\n" +
+	"\x11UpdateUserRequest\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.goalert.v1.UserR\x04user\"\xbc\x01\n" +
+	"\rContactMethod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12+\n" +
+	"\x04dest\x18\x04 \x01(\v2\x17.goalert.v1.DestinationR\x04dest\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\x12%\n" +
+	"\x0estatus_updates\x18\x06 \x01(\bR\rstatusUpdates\"4\n" +
+	"\x19ListContactMethodsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x9d\x01\n" +
+	"\x1aCreateContactMethodRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x04dest\x18\x03 \x01(\v2\x17.goalert.v1.DestinationR\x04dest\x12%\n" +
+	"\x0estatus_updates\x18\x04 \x01(\bR\rstatusUpdates\"g\n" +
+	"\x1aUpdateContactMethodRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0estatus_updates\x18\x03 \x01(\bR\rstatusUpdates\",\n" +
+	"\x1aDeleteContactMethodRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bDeleteContactMethodResponse\"\x81\x01\n" +
+	"\aService\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x120\n" +
+	"\x14escalation_policy_id\x18\x04 \x01(\tR\x12escalationPolicyId\"\x15\n" +
+	"\x13ListServicesRequest\"2\n" +
+	"\x11GetServiceRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"~\n" +
+	"\x14CreateServiceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x120\n" +
+	"\x14escalation_policy_id\x18\x03 \x01(\tR\x12escalationPolicyId\"E\n" +
+	"\x14UpdateServiceRequest\x12-\n" +
+	"\aservice\x18\x01 \x01(\v2\x13.goalert.v1.ServiceR\aservice\"5\n" +
+	"\x14DeleteServiceRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"\x17\n" +
+	"\x15DeleteServiceResponse\"\xa8\x01\n" +
+	"\x10EscalationPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06repeat\x18\x04 \x01(\x05R\x06repeat\x126\n" +
+	"\x05steps\x18\x05 \x03(\v2 .goalert.v1.EscalationPolicyStepR\x05steps\"\x8b\x01\n" +
+	"\x14EscalationPolicyStep\x12#\n" +
+	"\rdelay_minutes\x18\x01 \x01(\x05R\fdelayMinutes\x12\x1b\n" +
+	"\tmulti_ack\x18\x02 \x01(\bR\bmultiAck\x121\n" +
+	"\aactions\x18\x03 \x03(\v2\x17.goalert.v1.DestinationR\aactions\"\x1f\n" +
+	"\x1dListEscalationPoliciesRequest\"N\n" +
+	"\x1aGetEscalationPolicyRequest\x120\n" +
+	"\x14escalation_policy_id\x18\x01 \x01(\tR\x12escalationPolicyId\"\xa5\x01\n" +
+	"\x1dCreateEscalationPolicyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06repeat\x18\x03 \x01(\x05R\x06repeat\x126\n" +
+	"\x05steps\x18\x04 \x03(\v2 .goalert.v1.EscalationPolicyStepR\x05steps\"j\n" +
+	"\x1dUpdateEscalationPolicyRequest\x12I\n" +
+	"\x11escalation_policy\x18\x01 \x01(\v2\x1c.goalert.v1.EscalationPolicyR\x10escalationPolicy\"Q\n" +
+	"\x1dDeleteEscalationPolicyRequest\x120\n" +
+	"\x14escalation_policy_id\x18\x01 \x01(\tR\x12escalationPolicyId\" \n" +
+	"\x1eDeleteEscalationPolicyResponse\"m\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"\x16\n" +
+	"\x14ListSchedulesRequest\"5\n" +
+	"\x12GetScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"j\n" +
+	"\x15CreateScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"I\n" +
+	"\x15UpdateScheduleRequest\x120\n" +
+	"\bschedule\x18\x01 \x01(\v2\x14.goalert.v1.ScheduleR\bschedule\"8\n" +
+	"\x15DeleteScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"\x18\n" +
+	"\x16DeleteScheduleResponse\"\x88\x02\n" +
+	"\bRotation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12!\n" +
+	"\fshift_length\x18\x05 \x01(\x05R\vshiftLength\x120\n" +
+	"\x05start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x1b\n" +
+	"\ttime_zone\x18\a \x01(\tR\btimeZone\x120\n" +
+	"\x14participant_user_ids\x18\b \x03(\tR\x12participantUserIds\"\x16\n" +
+	"\x14ListRotationsRequest\"5\n" +
+	"\x12GetRotationRequest\x12\x1f\n" +
+	"\vrotation_id\x18\x01 \x01(\tR\n" +
+	"rotationId\"\x85\x02\n" +
+	"\x15CreateRotationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12!\n" +
+	"\fshift_length\x18\x04 \x01(\x05R\vshiftLength\x120\n" +
+	"\x05start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x1b\n" +
+	"\ttime_zone\x18\x06 \x01(\tR\btimeZone\x120\n" +
+	"\x14participant_user_ids\x18\a \x03(\tR\x12participantUserIds\"I\n" +
+	"\x15UpdateRotationRequest\x120\n" +
+	"\brotation\x18\x01 \x01(\v2\x14.goalert.v1.RotationR\brotation\"8\n" +
+	"\x15DeleteRotationRequest\x12\x1f\n" +
+	"\vrotation_id\x18\x01 \x01(\tR\n" +
+	"rotationId\"\x18\n" +
+	"\x16DeleteRotationResponse\"\xe1\x01\n" +
+	"\bOverride\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12\x1e\n" +
+	"\vadd_user_id\x18\x03 \x01(\tR\taddUserId\x12$\n" +
+	"\x0eremove_user_id\x18\x04 \x01(\tR\fremoveUserId\x120\n" +
+	"\x05start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\x97\x01\n" +
+	"\x14ListOverridesRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"I\n" +
+	"\x15CreateOverrideRequest\x120\n" +
+	"\boverride\x18\x01 \x01(\v2\x14.goalert.v1.OverrideR\boverride\"'\n" +
+	"\x15DeleteOverrideRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteOverrideResponse\"Y\n" +
+	"\x12WatchAlertsRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12$\n" +
+	"\x0eafter_event_id\x18\x02 \x01(\x03R\fafterEventId\"\xd9\x01\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\x12\x1a\n" +
+	"\bseverity\x18\x06 \x01(\tR\bseverity\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa1\x02\n" +
+	"\n" +
+	"AlertEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12/\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1b.goalert.v1.AlertEvent.TypeR\x04type\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12'\n" +
+	"\x05alert\x18\x04 \x01(\v2\x11.goalert.v1.AlertR\x05alert\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"U\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_CREATED\x10\x01\x12\x17\n" +
+	"\x13TYPE_STATUS_CHANGED\x10\x02\x12\f\n" +
+	"\bTYPE_LOG\x10\x032\xae\x17\n" +
+	"\x06SysAPI\x12L\n" +
+	"\fAuthSubjects\x12\x1f.goalert.v1.AuthSubjectsRequest\x1a\x17.goalert.v1.AuthSubject\"\x000\x01\x12M\n" +
+	"\n" +
+	"DeleteUser\x12\x1d.goalert.v1.DeleteUserRequest\x1a\x1e.goalert.v1.DeleteUserResponse\"\x00\x12a\n" +
+	"\x18UsersWithoutAuthProvider\x12+.goalert.v1.UsersWithoutAuthProviderRequest\x1a\x14.goalert.v1.UserInfo\"\x000\x01\x12Y\n" +
+	"\x0eSetAuthSubject\x12!.goalert.v1.SetAuthSubjectRequest\x1a\".goalert.v1.SetAuthSubjectResponse\"\x00\x12?\n" +
+	"\tListUsers\x12\x1c.goalert.v1.ListUsersRequest\x1a\x10.goalert.v1.User\"\x000\x01\x129\n" +
+	"\aGetUser\x12\x1a.goalert.v1.GetUserRequest\x1a\x10.goalert.v1.User\"\x00\x12?\n" +
+	"\n" +
+	"CreateUser\x12\x1d.goalert.v1.CreateUserRequest\x1a\x10.goalert.v1.User\"\x00\x12?\n" +
+	"\n" +
+	"UpdateUser\x12\x1d.goalert.v1.UpdateUserRequest\x1a\x10.goalert.v1.User\"\x00\x12Z\n" +
+	"\x12ListContactMethods\x12%.goalert.v1.ListContactMethodsRequest\x1a\x19.goalert.v1.ContactMethod\"\x000\x01\x12Z\n" +
+	"\x13CreateContactMethod\x12&.goalert.v1.CreateContactMethodRequest\x1a\x19.goalert.v1.ContactMethod\"\x00\x12Z\n" +
+	"\x13UpdateContactMethod\x12&.goalert.v1.UpdateContactMethodRequest\x1a\x19.goalert.v1.ContactMethod\"\x00\x12h\n" +
+	"\x13DeleteContactMethod\x12&.goalert.v1.DeleteContactMethodRequest\x1a'.goalert.v1.DeleteContactMethodResponse\"\x00\x12H\n" +
+	"\fListServices\x12\x1f.goalert.v1.ListServicesRequest\x1a\x13.goalert.v1.Service\"\x000\x01\x12B\n" +
+	"\n" +
+	"GetService\x12\x1d.goalert.v1.GetServiceRequest\x1a\x13.goalert.v1.Service\"\x00\x12H\n" +
+	"\rCreateService\x12 .goalert.v1.CreateServiceRequest\x1a\x13.goalert.v1.Service\"\x00\x12H\n" +
+	"\rUpdateService\x12 .goalert.v1.UpdateServiceRequest\x1a\x13.goalert.v1.Service\"\x00\x12V\n" +
+	"\rDeleteService\x12 .goalert.v1.DeleteServiceRequest\x1a!.goalert.v1.DeleteServiceResponse\"\x00\x12e\n" +
+	"\x16ListEscalationPolicies\x12).goalert.v1.ListEscalationPoliciesRequest\x1a\x1c.goalert.v1.EscalationPolicy\"\x000\x01\x12]\n" +
+	"\x13GetEscalationPolicy\x12&.goalert.v1.GetEscalationPolicyRequest\x1a\x1c.goalert.v1.EscalationPolicy\"\x00\x12c\n" +
+	"\x16CreateEscalationPolicy\x12).goalert.v1.CreateEscalationPolicyRequest\x1a\x1c.goalert.v1.EscalationPolicy\"\x00\x12c\n" +
+	"\x16UpdateEscalationPolicy\x12).goalert.v1.UpdateEscalationPolicyRequest\x1a\x1c.goalert.v1.EscalationPolicy\"\x00\x12q\n" +
+	"\x16DeleteEscalationPolicy\x12).goalert.v1.DeleteEscalationPolicyRequest\x1a*.goalert.v1.DeleteEscalationPolicyResponse\"\x00\x12K\n" +
+	"\rListSchedules\x12 .goalert.v1.ListSchedulesRequest\x1a\x14.goalert.v1.Schedule\"\x000\x01\x12E\n" +
+	"\vGetSchedule\x12\x1e.goalert.v1.GetScheduleRequest\x1a\x14.goalert.v1.Schedule\"\x00\x12K\n" +
+	"\x0eCreateSchedule\x12!.goalert.v1.CreateScheduleRequest\x1a\x14.goalert.v1.Schedule\"\x00\x12K\n" +
+	"\x0eUpdateSchedule\x12!.goalert.v1.UpdateScheduleRequest\x1a\x14.goalert.v1.Schedule\"\x00\x12Y\n" +
+	"\x0eDeleteSchedule\x12!.goalert.v1.DeleteScheduleRequest\x1a\".goalert.v1.DeleteScheduleResponse\"\x00\x12K\n" +
+	"\rListRotations\x12 .goalert.v1.ListRotationsRequest\x1a\x14.goalert.v1.Rotation\"\x000\x01\x12E\n" +
+	"\vGetRotation\x12\x1e.goalert.v1.GetRotationRequest\x1a\x14.goalert.v1.Rotation\"\x00\x12K\n" +
+	"\x0eCreateRotation\x12!.goalert.v1.CreateRotationRequest\x1a\x14.goalert.v1.Rotation\"\x00\x12K\n" +
+	"\x0eUpdateRotation\x12!.goalert.v1.UpdateRotationRequest\x1a\x14.goalert.v1.Rotation\"\x00\x12Y\n" +
+	"\x0eDeleteRotation\x12!.goalert.v1.DeleteRotationRequest\x1a\".goalert.v1.DeleteRotationResponse\"\x00\x12K\n" +
+	"\rListOverrides\x12 .goalert.v1.ListOverridesRequest\x1a\x14.goalert.v1.Override\"\x000\x01\x12K\n" +
+	"\x0eCreateOverride\x12!.goalert.v1.CreateOverrideRequest\x1a\x14.goalert.v1.Override\"\x00\x12Y\n" +
+	"\x0eDeleteOverride\x12!.goalert.v1.DeleteOverrideRequest\x1a\".goalert.v1.DeleteOverrideResponse\"\x00\x12I\n" +
+	"\vWatchAlerts\x12\x1e.goalert.v1.WatchAlertsRequest\x1a\x16.goalert.v1.AlertEvent\"\x000\x01B&Z$github.com/target/goalert/pkg/sysapib\x06proto3"
 
 var (
 	file_pkg_sysapi_sysapi_proto_rawDescOnce sync.Once
@@ -445,32 +3316,171 @@ func file_pkg_sysapi_sysapi_proto_rawDescGZIP() []byte {
 	return file_pkg_sysapi_sysapi_proto_rawDescData
 }
 
-var file_pkg_sysapi_sysapi_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_pkg_sysapi_sysapi_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_sysapi_sysapi_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_pkg_sysapi_sysapi_proto_goTypes = []any{
-	(*UsersWithoutAuthProviderRequest)(nil), // 0: goalert.v1.UsersWithoutAuthProviderRequest
-	(*SetAuthSubjectRequest)(nil),           // 1: goalert.v1.SetAuthSubjectRequest
-	(*UserInfo)(nil),                        // 2: goalert.v1.UserInfo
-	(*SetAuthSubjectResponse)(nil),          // 3: goalert.v1.SetAuthSubjectResponse
-	(*DeleteUserRequest)(nil),               // 4: goalert.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),              // 5: goalert.v1.DeleteUserResponse
-	(*AuthSubjectsRequest)(nil),             // 6: goalert.v1.AuthSubjectsRequest
-	(*AuthSubject)(nil),                     // 7: goalert.v1.AuthSubject
+	(AlertEvent_Type)(0),                    // 0: goalert.v1.AlertEvent.Type
+	(*UsersWithoutAuthProviderRequest)(nil), // 1: goalert.v1.UsersWithoutAuthProviderRequest
+	(*SetAuthSubjectRequest)(nil),           // 2: goalert.v1.SetAuthSubjectRequest
+	(*UserInfo)(nil),                        // 3: goalert.v1.UserInfo
+	(*SetAuthSubjectResponse)(nil),          // 4: goalert.v1.SetAuthSubjectResponse
+	(*DeleteUserRequest)(nil),               // 5: goalert.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),              // 6: goalert.v1.DeleteUserResponse
+	(*AuthSubjectsRequest)(nil),             // 7: goalert.v1.AuthSubjectsRequest
+	(*AuthSubject)(nil),                     // 8: goalert.v1.AuthSubject
+	(*Destination)(nil),                     // 9: goalert.v1.Destination
+	(*User)(nil),                            // 10: goalert.v1.User
+	(*ListUsersRequest)(nil),                // 11: goalert.v1.ListUsersRequest
+	(*GetUserRequest)(nil),                  // 12: goalert.v1.GetUserRequest
+	(*CreateUserRequest)(nil),               // 13: goalert.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),               // 14: goalert.v1.UpdateUserRequest
+	(*ContactMethod)(nil),                   // 15: goalert.v1.ContactMethod
+	(*ListContactMethodsRequest)(nil),       // 16: goalert.v1.ListContactMethodsRequest
+	(*CreateContactMethodRequest)(nil),      // 17: goalert.v1.CreateContactMethodRequest
+	(*UpdateContactMethodRequest)(nil),      // 18: goalert.v1.UpdateContactMethodRequest
+	(*DeleteContactMethodRequest)(nil),      // 19: goalert.v1.DeleteContactMethodRequest
+	(*DeleteContactMethodResponse)(nil),     // 20: goalert.v1.DeleteContactMethodResponse
+	(*Service)(nil),                         // 21: goalert.v1.Service
+	(*ListServicesRequest)(nil),             // 22: goalert.v1.ListServicesRequest
+	(*GetServiceRequest)(nil),               // 23: goalert.v1.GetServiceRequest
+	(*CreateServiceRequest)(nil),            // 24: goalert.v1.CreateServiceRequest
+	(*UpdateServiceRequest)(nil),            // 25: goalert.v1.UpdateServiceRequest
+	(*DeleteServiceRequest)(nil),            // 26: goalert.v1.DeleteServiceRequest
+	(*DeleteServiceResponse)(nil),           // 27: goalert.v1.DeleteServiceResponse
+	(*EscalationPolicy)(nil),                // 28: goalert.v1.EscalationPolicy
+	(*EscalationPolicyStep)(nil),            // 29: goalert.v1.EscalationPolicyStep
+	(*ListEscalationPoliciesRequest)(nil),   // 30: goalert.v1.ListEscalationPoliciesRequest
+	(*GetEscalationPolicyRequest)(nil),      // 31: goalert.v1.GetEscalationPolicyRequest
+	(*CreateEscalationPolicyRequest)(nil),   // 32: goalert.v1.CreateEscalationPolicyRequest
+	(*UpdateEscalationPolicyRequest)(nil),   // 33: goalert.v1.UpdateEscalationPolicyRequest
+	(*DeleteEscalationPolicyRequest)(nil),   // 34: goalert.v1.DeleteEscalationPolicyRequest
+	(*DeleteEscalationPolicyResponse)(nil),  // 35: goalert.v1.DeleteEscalationPolicyResponse
+	(*Schedule)(nil),                        // 36: goalert.v1.Schedule
+	(*ListSchedulesRequest)(nil),            // 37: goalert.v1.ListSchedulesRequest
+	(*GetScheduleRequest)(nil),              // 38: goalert.v1.GetScheduleRequest
+	(*CreateScheduleRequest)(nil),           // 39: goalert.v1.CreateScheduleRequest
+	(*UpdateScheduleRequest)(nil),           // 40: goalert.v1.UpdateScheduleRequest
+	(*DeleteScheduleRequest)(nil),           // 41: goalert.v1.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),          // 42: goalert.v1.DeleteScheduleResponse
+	(*Rotation)(nil),                        // 43: goalert.v1.Rotation
+	(*ListRotationsRequest)(nil),            // 44: goalert.v1.ListRotationsRequest
+	(*GetRotationRequest)(nil),              // 45: goalert.v1.GetRotationRequest
+	(*CreateRotationRequest)(nil),           // 46: goalert.v1.CreateRotationRequest
+	(*UpdateRotationRequest)(nil),           // 47: goalert.v1.UpdateRotationRequest
+	(*DeleteRotationRequest)(nil),           // 48: goalert.v1.DeleteRotationRequest
+	(*DeleteRotationResponse)(nil),          // 49: goalert.v1.DeleteRotationResponse
+	(*Override)(nil),                        // 50: goalert.v1.Override
+	(*ListOverridesRequest)(nil),            // 51: goalert.v1.ListOverridesRequest
+	(*CreateOverrideRequest)(nil),           // 52: goalert.v1.CreateOverrideRequest
+	(*DeleteOverrideRequest)(nil),           // 53: goalert.v1.DeleteOverrideRequest
+	(*DeleteOverrideResponse)(nil),          // 54: goalert.v1.DeleteOverrideResponse
+	(*WatchAlertsRequest)(nil),              // 55: goalert.v1.WatchAlertsRequest
+	(*Alert)(nil),                           // 56: goalert.v1.Alert
+	(*AlertEvent)(nil),                      // 57: goalert.v1.AlertEvent
+	nil,                                     // 58: goalert.v1.Destination.ArgsEntry
+	(*timestamppb.Timestamp)(nil),           // 59: google.protobuf.Timestamp
 }
 var file_pkg_sysapi_sysapi_proto_depIdxs = []int32{
-	7, // 0: goalert.v1.SetAuthSubjectRequest.subject:type_name -> goalert.v1.AuthSubject
-	6, // 1: goalert.v1.SysAPI.AuthSubjects:input_type -> goalert.v1.AuthSubjectsRequest
-	4, // 2: goalert.v1.SysAPI.DeleteUser:input_type -> goalert.v1.DeleteUserRequest
-	0, // 3: goalert.v1.SysAPI.UsersWithoutAuthProvider:input_type -> goalert.v1.UsersWithoutAuthProviderRequest
-	1, // 4: goalert.v1.SysAPI.SetAuthSubject:input_type -> goalert.v1.SetAuthSubjectRequest
-	7, // 5: goalert.v1.SysAPI.AuthSubjects:output_type -> goalert.v1.AuthSubject
-	5, // 6: goalert.v1.SysAPI.DeleteUser:output_type -> goalert.v1.DeleteUserResponse
-	2, // 7: goalert.v1.SysAPI.UsersWithoutAuthProvider:output_type -> goalert.v1.UserInfo
-	3, // 8: goalert.v1.SysAPI.SetAuthSubject:output_type -> goalert.v1.SetAuthSubjectResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: goalert.v1.SetAuthSubjectRequest.subject:type_name -> goalert.v1.AuthSubject
+	58, // 1: goalert.v1.Destination.args:type_name -> goalert.v1.Destination.ArgsEntry
+	10, // 2: goalert.v1.UpdateUserRequest.user:type_name -> goalert.v1.User
+	9,  // 3: goalert.v1.ContactMethod.dest:type_name -> goalert.v1.Destination
+	9,  // 4: goalert.v1.CreateContactMethodRequest.dest:type_name -> goalert.v1.Destination
+	21, // 5: goalert.v1.UpdateServiceRequest.service:type_name -> goalert.v1.Service
+	29, // 6: goalert.v1.EscalationPolicy.steps:type_name -> goalert.v1.EscalationPolicyStep
+	9,  // 7: goalert.v1.EscalationPolicyStep.actions:type_name -> goalert.v1.Destination
+	29, // 8: goalert.v1.CreateEscalationPolicyRequest.steps:type_name -> goalert.v1.EscalationPolicyStep
+	28, // 9: goalert.v1.UpdateEscalationPolicyRequest.escalation_policy:type_name -> goalert.v1.EscalationPolicy
+	36, // 10: goalert.v1.UpdateScheduleRequest.schedule:type_name -> goalert.v1.Schedule
+	59, // 11: goalert.v1.Rotation.start:type_name -> google.protobuf.Timestamp
+	59, // 12: goalert.v1.CreateRotationRequest.start:type_name -> google.protobuf.Timestamp
+	43, // 13: goalert.v1.UpdateRotationRequest.rotation:type_name -> goalert.v1.Rotation
+	59, // 14: goalert.v1.Override.start:type_name -> google.protobuf.Timestamp
+	59, // 15: goalert.v1.Override.end:type_name -> google.protobuf.Timestamp
+	59, // 16: goalert.v1.ListOverridesRequest.start:type_name -> google.protobuf.Timestamp
+	59, // 17: goalert.v1.ListOverridesRequest.end:type_name -> google.protobuf.Timestamp
+	50, // 18: goalert.v1.CreateOverrideRequest.override:type_name -> goalert.v1.Override
+	59, // 19: goalert.v1.Alert.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 20: goalert.v1.AlertEvent.type:type_name -> goalert.v1.AlertEvent.Type
+	59, // 21: goalert.v1.AlertEvent.timestamp:type_name -> google.protobuf.Timestamp
+	56, // 22: goalert.v1.AlertEvent.alert:type_name -> goalert.v1.Alert
+	7,  // 23: goalert.v1.SysAPI.AuthSubjects:input_type -> goalert.v1.AuthSubjectsRequest
+	5,  // 24: goalert.v1.SysAPI.DeleteUser:input_type -> goalert.v1.DeleteUserRequest
+	1,  // 25: goalert.v1.SysAPI.UsersWithoutAuthProvider:input_type -> goalert.v1.UsersWithoutAuthProviderRequest
+	2,  // 26: goalert.v1.SysAPI.SetAuthSubject:input_type -> goalert.v1.SetAuthSubjectRequest
+	11, // 27: goalert.v1.SysAPI.ListUsers:input_type -> goalert.v1.ListUsersRequest
+	12, // 28: goalert.v1.SysAPI.GetUser:input_type -> goalert.v1.GetUserRequest
+	13, // 29: goalert.v1.SysAPI.CreateUser:input_type -> goalert.v1.CreateUserRequest
+	14, // 30: goalert.v1.SysAPI.UpdateUser:input_type -> goalert.v1.UpdateUserRequest
+	16, // 31: goalert.v1.SysAPI.ListContactMethods:input_type -> goalert.v1.ListContactMethodsRequest
+	17, // 32: goalert.v1.SysAPI.CreateContactMethod:input_type -> goalert.v1.CreateContactMethodRequest
+	18, // 33: goalert.v1.SysAPI.UpdateContactMethod:input_type -> goalert.v1.UpdateContactMethodRequest
+	19, // 34: goalert.v1.SysAPI.DeleteContactMethod:input_type -> goalert.v1.DeleteContactMethodRequest
+	22, // 35: goalert.v1.SysAPI.ListServices:input_type -> goalert.v1.ListServicesRequest
+	23, // 36: goalert.v1.SysAPI.GetService:input_type -> goalert.v1.GetServiceRequest
+	24, // 37: goalert.v1.SysAPI.CreateService:input_type -> goalert.v1.CreateServiceRequest
+	25, // 38: goalert.v1.SysAPI.UpdateService:input_type -> goalert.v1.UpdateServiceRequest
+	26, // 39: goalert.v1.SysAPI.DeleteService:input_type -> goalert.v1.DeleteServiceRequest
+	30, // 40: goalert.v1.SysAPI.ListEscalationPolicies:input_type -> goalert.v1.ListEscalationPoliciesRequest
+	31, // 41: goalert.v1.SysAPI.GetEscalationPolicy:input_type -> goalert.v1.GetEscalationPolicyRequest
+	32, // 42: goalert.v1.SysAPI.CreateEscalationPolicy:input_type -> goalert.v1.CreateEscalationPolicyRequest
+	33, // 43: goalert.v1.SysAPI.UpdateEscalationPolicy:input_type -> goalert.v1.UpdateEscalationPolicyRequest
+	34, // 44: goalert.v1.SysAPI.DeleteEscalationPolicy:input_type -> goalert.v1.DeleteEscalationPolicyRequest
+	37, // 45: goalert.v1.SysAPI.ListSchedules:input_type -> goalert.v1.ListSchedulesRequest
+	38, // 46: goalert.v1.SysAPI.GetSchedule:input_type -> goalert.v1.GetScheduleRequest
+	39, // 47: goalert.v1.SysAPI.CreateSchedule:input_type -> goalert.v1.CreateScheduleRequest
+	40, // 48: goalert.v1.SysAPI.UpdateSchedule:input_type -> goalert.v1.UpdateScheduleRequest
+	41, // 49: goalert.v1.SysAPI.DeleteSchedule:input_type -> goalert.v1.DeleteScheduleRequest
+	44, // 50: goalert.v1.SysAPI.ListRotations:input_type -> goalert.v1.ListRotationsRequest
+	45, // 51: goalert.v1.SysAPI.GetRotation:input_type -> goalert.v1.GetRotationRequest
+	46, // 52: goalert.v1.SysAPI.CreateRotation:input_type -> goalert.v1.CreateRotationRequest
+	47, // 53: goalert.v1.SysAPI.UpdateRotation:input_type -> goalert.v1.UpdateRotationRequest
+	48, // 54: goalert.v1.SysAPI.DeleteRotation:input_type -> goalert.v1.DeleteRotationRequest
+	51, // 55: goalert.v1.SysAPI.ListOverrides:input_type -> goalert.v1.ListOverridesRequest
+	52, // 56: goalert.v1.SysAPI.CreateOverride:input_type -> goalert.v1.CreateOverrideRequest
+	53, // 57: goalert.v1.SysAPI.DeleteOverride:input_type -> goalert.v1.DeleteOverrideRequest
+	55, // 58: goalert.v1.SysAPI.WatchAlerts:input_type -> goalert.v1.WatchAlertsRequest
+	8,  // 59: goalert.v1.SysAPI.AuthSubjects:output_type -> goalert.v1.AuthSubject
+	6,  // 60: goalert.v1.SysAPI.DeleteUser:output_type -> goalert.v1.DeleteUserResponse
+	3,  // 61: goalert.v1.SysAPI.UsersWithoutAuthProvider:output_type -> goalert.v1.UserInfo
+	4,  // 62: goalert.v1.SysAPI.SetAuthSubject:output_type -> goalert.v1.SetAuthSubjectResponse
+	10, // 63: goalert.v1.SysAPI.ListUsers:output_type -> goalert.v1.User
+	10, // 64: goalert.v1.SysAPI.GetUser:output_type -> goalert.v1.User
+	10, // 65: goalert.v1.SysAPI.CreateUser:output_type -> goalert.v1.User
+	10, // 66: goalert.v1.SysAPI.UpdateUser:output_type -> goalert.v1.User
+	15, // 67: goalert.v1.SysAPI.ListContactMethods:output_type -> goalert.v1.ContactMethod
+	15, // 68: goalert.v1.SysAPI.CreateContactMethod:output_type -> goalert.v1.ContactMethod
+	15, // 69: goalert.v1.SysAPI.UpdateContactMethod:output_type -> goalert.v1.ContactMethod
+	20, // 70: goalert.v1.SysAPI.DeleteContactMethod:output_type -> goalert.v1.DeleteContactMethodResponse
+	21, // 71: goalert.v1.SysAPI.ListServices:output_type -> goalert.v1.Service
+	21, // 72: goalert.v1.SysAPI.GetService:output_type -> goalert.v1.Service
+	21, // 73: goalert.v1.SysAPI.CreateService:output_type -> goalert.v1.Service
+	21, // 74: goalert.v1.SysAPI.UpdateService:output_type -> goalert.v1.Service
+	27, // 75: goalert.v1.SysAPI.DeleteService:output_type -> goalert.v1.DeleteServiceResponse
+	28, // 76: goalert.v1.SysAPI.ListEscalationPolicies:output_type -> goalert.v1.EscalationPolicy
+	28, // 77: goalert.v1.SysAPI.GetEscalationPolicy:output_type -> goalert.v1.EscalationPolicy
+	28, // 78: goalert.v1.SysAPI.CreateEscalationPolicy:output_type -> goalert.v1.EscalationPolicy
+	28, // 79: goalert.v1.SysAPI.UpdateEscalationPolicy:output_type -> goalert.v1.EscalationPolicy
+	35, // 80: goalert.v1.SysAPI.DeleteEscalationPolicy:output_type -> goalert.v1.DeleteEscalationPolicyResponse
+	36, // 81: goalert.v1.SysAPI.ListSchedules:output_type -> goalert.v1.Schedule
+	36, // 82: goalert.v1.SysAPI.GetSchedule:output_type -> goalert.v1.Schedule
+	36, // 83: goalert.v1.SysAPI.CreateSchedule:output_type -> goalert.v1.Schedule
+	36, // 84: goalert.v1.SysAPI.UpdateSchedule:output_type -> goalert.v1.Schedule
+	42, // 85: goalert.v1.SysAPI.DeleteSchedule:output_type -> goalert.v1.DeleteScheduleResponse
+	43, // 86: goalert.v1.SysAPI.ListRotations:output_type -> goalert.v1.Rotation
+	43, // 87: goalert.v1.SysAPI.GetRotation:output_type -> goalert.v1.Rotation
+	43, // 88: goalert.v1.SysAPI.CreateRotation:output_type -> goalert.v1.Rotation
+	43, // 89: goalert.v1.SysAPI.UpdateRotation:output_type -> goalert.v1.Rotation
+	49, // 90: goalert.v1.SysAPI.DeleteRotation:output_type -> goalert.v1.DeleteRotationResponse
+	50, // 91: goalert.v1.SysAPI.ListOverrides:output_type -> goalert.v1.Override
+	50, // 92: goalert.v1.SysAPI.CreateOverride:output_type -> goalert.v1.Override
+	54, // 93: goalert.v1.SysAPI.DeleteOverride:output_type -> goalert.v1.DeleteOverrideResponse
+	57, // 94: goalert.v1.SysAPI.WatchAlerts:output_type -> goalert.v1.AlertEvent
+	59, // [59:95] is the sub-list for method output_type
+	23, // [23:59] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_pkg_sysapi_sysapi_proto_init() }
@@ -483,13 +3493,14 @@ func file_pkg_sysapi_sysapi_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_sysapi_sysapi_proto_rawDesc), len(file_pkg_sysapi_sysapi_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_sysapi_sysapi_proto_goTypes,
 		DependencyIndexes: file_pkg_sysapi_sysapi_proto_depIdxs,
+		EnumInfos:         file_pkg_sysapi_sysapi_proto_enumTypes,
 		MessageInfos:      file_pkg_sysapi_sysapi_proto_msgTypes,
 	}.Build()
 	File_pkg_sysapi_sysapi_proto = out.File
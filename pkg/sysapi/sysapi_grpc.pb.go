@@ -23,6 +23,38 @@ const (
 	SysAPI_DeleteUser_FullMethodName               = "/goalert.v1.SysAPI/DeleteUser"
 	SysAPI_UsersWithoutAuthProvider_FullMethodName = "/goalert.v1.SysAPI/UsersWithoutAuthProvider"
 	SysAPI_SetAuthSubject_FullMethodName           = "/goalert.v1.SysAPI/SetAuthSubject"
+	SysAPI_ListUsers_FullMethodName                = "/goalert.v1.SysAPI/ListUsers"
+	SysAPI_GetUser_FullMethodName                  = "/goalert.v1.SysAPI/GetUser"
+	SysAPI_CreateUser_FullMethodName               = "/goalert.v1.SysAPI/CreateUser"
+	SysAPI_UpdateUser_FullMethodName               = "/goalert.v1.SysAPI/UpdateUser"
+	SysAPI_ListContactMethods_FullMethodName       = "/goalert.v1.SysAPI/ListContactMethods"
+	SysAPI_CreateContactMethod_FullMethodName      = "/goalert.v1.SysAPI/CreateContactMethod"
+	SysAPI_UpdateContactMethod_FullMethodName      = "/goalert.v1.SysAPI/UpdateContactMethod"
+	SysAPI_DeleteContactMethod_FullMethodName      = "/goalert.v1.SysAPI/DeleteContactMethod"
+	SysAPI_ListServices_FullMethodName             = "/goalert.v1.SysAPI/ListServices"
+	SysAPI_GetService_FullMethodName               = "/goalert.v1.SysAPI/GetService"
+	SysAPI_CreateService_FullMethodName            = "/goalert.v1.SysAPI/CreateService"
+	SysAPI_UpdateService_FullMethodName            = "/goalert.v1.SysAPI/UpdateService"
+	SysAPI_DeleteService_FullMethodName            = "/goalert.v1.SysAPI/DeleteService"
+	SysAPI_ListEscalationPolicies_FullMethodName   = "/goalert.v1.SysAPI/ListEscalationPolicies"
+	SysAPI_GetEscalationPolicy_FullMethodName      = "/goalert.v1.SysAPI/GetEscalationPolicy"
+	SysAPI_CreateEscalationPolicy_FullMethodName   = "/goalert.v1.SysAPI/CreateEscalationPolicy"
+	SysAPI_UpdateEscalationPolicy_FullMethodName   = "/goalert.v1.SysAPI/UpdateEscalationPolicy"
+	SysAPI_DeleteEscalationPolicy_FullMethodName   = "/goalert.v1.SysAPI/DeleteEscalationPolicy"
+	SysAPI_ListSchedules_FullMethodName            = "/goalert.v1.SysAPI/ListSchedules"
+	SysAPI_GetSchedule_FullMethodName              = "/goalert.v1.SysAPI/GetSchedule"
+	SysAPI_CreateSchedule_FullMethodName           = "/goalert.v1.SysAPI/CreateSchedule"
+	SysAPI_UpdateSchedule_FullMethodName           = "/goalert.v1.SysAPI/UpdateSchedule"
+	SysAPI_DeleteSchedule_FullMethodName           = "/goalert.v1.SysAPI/DeleteSchedule"
+	SysAPI_ListRotations_FullMethodName            = "/goalert.v1.SysAPI/ListRotations"
+	SysAPI_GetRotation_FullMethodName              = "/goalert.v1.SysAPI/GetRotation"
+	SysAPI_CreateRotation_FullMethodName           = "/goalert.v1.SysAPI/CreateRotation"
+	SysAPI_UpdateRotation_FullMethodName           = "/goalert.v1.SysAPI/UpdateRotation"
+	SysAPI_DeleteRotation_FullMethodName           = "/goalert.v1.SysAPI/DeleteRotation"
+	SysAPI_ListOverrides_FullMethodName            = "/goalert.v1.SysAPI/ListOverrides"
+	SysAPI_CreateOverride_FullMethodName           = "/goalert.v1.SysAPI/CreateOverride"
+	SysAPI_DeleteOverride_FullMethodName           = "/goalert.v1.SysAPI/DeleteOverride"
+	SysAPI_WatchAlerts_FullMethodName              = "/goalert.v1.SysAPI/WatchAlerts"
 )
 
 // SysAPIClient is the client API for SysAPI service.
@@ -33,6 +65,40 @@ type SysAPIClient interface {
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	UsersWithoutAuthProvider(ctx context.Context, in *UsersWithoutAuthProviderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UserInfo], error)
 	SetAuthSubject(ctx context.Context, in *SetAuthSubjectRequest, opts ...grpc.CallOption) (*SetAuthSubjectResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	ListContactMethods(ctx context.Context, in *ListContactMethodsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContactMethod], error)
+	CreateContactMethod(ctx context.Context, in *CreateContactMethodRequest, opts ...grpc.CallOption) (*ContactMethod, error)
+	UpdateContactMethod(ctx context.Context, in *UpdateContactMethodRequest, opts ...grpc.CallOption) (*ContactMethod, error)
+	DeleteContactMethod(ctx context.Context, in *DeleteContactMethodRequest, opts ...grpc.CallOption) (*DeleteContactMethodResponse, error)
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Service], error)
+	GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*Service, error)
+	CreateService(ctx context.Context, in *CreateServiceRequest, opts ...grpc.CallOption) (*Service, error)
+	UpdateService(ctx context.Context, in *UpdateServiceRequest, opts ...grpc.CallOption) (*Service, error)
+	DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error)
+	ListEscalationPolicies(ctx context.Context, in *ListEscalationPoliciesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EscalationPolicy], error)
+	GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error)
+	CreateEscalationPolicy(ctx context.Context, in *CreateEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error)
+	UpdateEscalationPolicy(ctx context.Context, in *UpdateEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error)
+	DeleteEscalationPolicy(ctx context.Context, in *DeleteEscalationPolicyRequest, opts ...grpc.CallOption) (*DeleteEscalationPolicyResponse, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Schedule], error)
+	GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	ListRotations(ctx context.Context, in *ListRotationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Rotation], error)
+	GetRotation(ctx context.Context, in *GetRotationRequest, opts ...grpc.CallOption) (*Rotation, error)
+	CreateRotation(ctx context.Context, in *CreateRotationRequest, opts ...grpc.CallOption) (*Rotation, error)
+	UpdateRotation(ctx context.Context, in *UpdateRotationRequest, opts ...grpc.CallOption) (*Rotation, error)
+	DeleteRotation(ctx context.Context, in *DeleteRotationRequest, opts ...grpc.CallOption) (*DeleteRotationResponse, error)
+	ListOverrides(ctx context.Context, in *ListOverridesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Override], error)
+	CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*Override, error)
+	DeleteOverride(ctx context.Context, in *DeleteOverrideRequest, opts ...grpc.CallOption) (*DeleteOverrideResponse, error)
+	// WatchAlerts streams alert events as they happen, starting after the most recent event
+	// (or after_event_id, if set).
+	WatchAlerts(ctx context.Context, in *WatchAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AlertEvent], error)
 }
 
 type sysAPIClient struct {
@@ -101,6 +167,398 @@ func (c *sysAPIClient) SetAuthSubject(ctx context.Context, in *SetAuthSubjectReq
 	return out, nil
 }
 
+func (c *sysAPIClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[2], SysAPI_ListUsers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListUsersRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListUsersClient = grpc.ServerStreamingClient[User]
+
+func (c *sysAPIClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, SysAPI_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, SysAPI_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, SysAPI_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) ListContactMethods(ctx context.Context, in *ListContactMethodsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ContactMethod], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[3], SysAPI_ListContactMethods_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListContactMethodsRequest, ContactMethod]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListContactMethodsClient = grpc.ServerStreamingClient[ContactMethod]
+
+func (c *sysAPIClient) CreateContactMethod(ctx context.Context, in *CreateContactMethodRequest, opts ...grpc.CallOption) (*ContactMethod, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContactMethod)
+	err := c.cc.Invoke(ctx, SysAPI_CreateContactMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) UpdateContactMethod(ctx context.Context, in *UpdateContactMethodRequest, opts ...grpc.CallOption) (*ContactMethod, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContactMethod)
+	err := c.cc.Invoke(ctx, SysAPI_UpdateContactMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) DeleteContactMethod(ctx context.Context, in *DeleteContactMethodRequest, opts ...grpc.CallOption) (*DeleteContactMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteContactMethodResponse)
+	err := c.cc.Invoke(ctx, SysAPI_DeleteContactMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Service], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[4], SysAPI_ListServices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListServicesRequest, Service]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListServicesClient = grpc.ServerStreamingClient[Service]
+
+func (c *sysAPIClient) GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*Service, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Service)
+	err := c.cc.Invoke(ctx, SysAPI_GetService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) CreateService(ctx context.Context, in *CreateServiceRequest, opts ...grpc.CallOption) (*Service, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Service)
+	err := c.cc.Invoke(ctx, SysAPI_CreateService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) UpdateService(ctx context.Context, in *UpdateServiceRequest, opts ...grpc.CallOption) (*Service, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Service)
+	err := c.cc.Invoke(ctx, SysAPI_UpdateService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteServiceResponse)
+	err := c.cc.Invoke(ctx, SysAPI_DeleteService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) ListEscalationPolicies(ctx context.Context, in *ListEscalationPoliciesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EscalationPolicy], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[5], SysAPI_ListEscalationPolicies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListEscalationPoliciesRequest, EscalationPolicy]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListEscalationPoliciesClient = grpc.ServerStreamingClient[EscalationPolicy]
+
+func (c *sysAPIClient) GetEscalationPolicy(ctx context.Context, in *GetEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationPolicy)
+	err := c.cc.Invoke(ctx, SysAPI_GetEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) CreateEscalationPolicy(ctx context.Context, in *CreateEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationPolicy)
+	err := c.cc.Invoke(ctx, SysAPI_CreateEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) UpdateEscalationPolicy(ctx context.Context, in *UpdateEscalationPolicyRequest, opts ...grpc.CallOption) (*EscalationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationPolicy)
+	err := c.cc.Invoke(ctx, SysAPI_UpdateEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) DeleteEscalationPolicy(ctx context.Context, in *DeleteEscalationPolicyRequest, opts ...grpc.CallOption) (*DeleteEscalationPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEscalationPolicyResponse)
+	err := c.cc.Invoke(ctx, SysAPI_DeleteEscalationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Schedule], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[6], SysAPI_ListSchedules_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListSchedulesRequest, Schedule]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListSchedulesClient = grpc.ServerStreamingClient[Schedule]
+
+func (c *sysAPIClient) GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, SysAPI_GetSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, SysAPI_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, SysAPI_UpdateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, SysAPI_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) ListRotations(ctx context.Context, in *ListRotationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Rotation], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[7], SysAPI_ListRotations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListRotationsRequest, Rotation]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListRotationsClient = grpc.ServerStreamingClient[Rotation]
+
+func (c *sysAPIClient) GetRotation(ctx context.Context, in *GetRotationRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, SysAPI_GetRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) CreateRotation(ctx context.Context, in *CreateRotationRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, SysAPI_CreateRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) UpdateRotation(ctx context.Context, in *UpdateRotationRequest, opts ...grpc.CallOption) (*Rotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rotation)
+	err := c.cc.Invoke(ctx, SysAPI_UpdateRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) DeleteRotation(ctx context.Context, in *DeleteRotationRequest, opts ...grpc.CallOption) (*DeleteRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRotationResponse)
+	err := c.cc.Invoke(ctx, SysAPI_DeleteRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) ListOverrides(ctx context.Context, in *ListOverridesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Override], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[8], SysAPI_ListOverrides_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListOverridesRequest, Override]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListOverridesClient = grpc.ServerStreamingClient[Override]
+
+func (c *sysAPIClient) CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*Override, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Override)
+	err := c.cc.Invoke(ctx, SysAPI_CreateOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) DeleteOverride(ctx context.Context, in *DeleteOverrideRequest, opts ...grpc.CallOption) (*DeleteOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOverrideResponse)
+	err := c.cc.Invoke(ctx, SysAPI_DeleteOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysAPIClient) WatchAlerts(ctx context.Context, in *WatchAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AlertEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SysAPI_ServiceDesc.Streams[9], SysAPI_WatchAlerts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAlertsRequest, AlertEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_WatchAlertsClient = grpc.ServerStreamingClient[AlertEvent]
+
 // SysAPIServer is the server API for SysAPI service.
 // All implementations must embed UnimplementedSysAPIServer
 // for forward compatibility.
@@ -109,107 +567,757 @@ type SysAPIServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	UsersWithoutAuthProvider(*UsersWithoutAuthProviderRequest, grpc.ServerStreamingServer[UserInfo]) error
 	SetAuthSubject(context.Context, *SetAuthSubjectRequest) (*SetAuthSubjectResponse, error)
+	ListUsers(*ListUsersRequest, grpc.ServerStreamingServer[User]) error
+	GetUser(context.Context, *GetUserRequest) (*User, error)
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	ListContactMethods(*ListContactMethodsRequest, grpc.ServerStreamingServer[ContactMethod]) error
+	CreateContactMethod(context.Context, *CreateContactMethodRequest) (*ContactMethod, error)
+	UpdateContactMethod(context.Context, *UpdateContactMethodRequest) (*ContactMethod, error)
+	DeleteContactMethod(context.Context, *DeleteContactMethodRequest) (*DeleteContactMethodResponse, error)
+	ListServices(*ListServicesRequest, grpc.ServerStreamingServer[Service]) error
+	GetService(context.Context, *GetServiceRequest) (*Service, error)
+	CreateService(context.Context, *CreateServiceRequest) (*Service, error)
+	UpdateService(context.Context, *UpdateServiceRequest) (*Service, error)
+	DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error)
+	ListEscalationPolicies(*ListEscalationPoliciesRequest, grpc.ServerStreamingServer[EscalationPolicy]) error
+	GetEscalationPolicy(context.Context, *GetEscalationPolicyRequest) (*EscalationPolicy, error)
+	CreateEscalationPolicy(context.Context, *CreateEscalationPolicyRequest) (*EscalationPolicy, error)
+	UpdateEscalationPolicy(context.Context, *UpdateEscalationPolicyRequest) (*EscalationPolicy, error)
+	DeleteEscalationPolicy(context.Context, *DeleteEscalationPolicyRequest) (*DeleteEscalationPolicyResponse, error)
+	ListSchedules(*ListSchedulesRequest, grpc.ServerStreamingServer[Schedule]) error
+	GetSchedule(context.Context, *GetScheduleRequest) (*Schedule, error)
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error)
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*Schedule, error)
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	ListRotations(*ListRotationsRequest, grpc.ServerStreamingServer[Rotation]) error
+	GetRotation(context.Context, *GetRotationRequest) (*Rotation, error)
+	CreateRotation(context.Context, *CreateRotationRequest) (*Rotation, error)
+	UpdateRotation(context.Context, *UpdateRotationRequest) (*Rotation, error)
+	DeleteRotation(context.Context, *DeleteRotationRequest) (*DeleteRotationResponse, error)
+	ListOverrides(*ListOverridesRequest, grpc.ServerStreamingServer[Override]) error
+	CreateOverride(context.Context, *CreateOverrideRequest) (*Override, error)
+	DeleteOverride(context.Context, *DeleteOverrideRequest) (*DeleteOverrideResponse, error)
+	// WatchAlerts streams alert events as they happen, starting after the most recent event
+	// (or after_event_id, if set).
+	WatchAlerts(*WatchAlertsRequest, grpc.ServerStreamingServer[AlertEvent]) error
+	mustEmbedUnimplementedSysAPIServer()
+}
+
+// UnimplementedSysAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSysAPIServer struct{}
+
+func (UnimplementedSysAPIServer) AuthSubjects(*AuthSubjectsRequest, grpc.ServerStreamingServer[AuthSubject]) error {
+	return status.Error(codes.Unimplemented, "method AuthSubjects not implemented")
+}
+func (UnimplementedSysAPIServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedSysAPIServer) UsersWithoutAuthProvider(*UsersWithoutAuthProviderRequest, grpc.ServerStreamingServer[UserInfo]) error {
+	return status.Error(codes.Unimplemented, "method UsersWithoutAuthProvider not implemented")
+}
+func (UnimplementedSysAPIServer) SetAuthSubject(context.Context, *SetAuthSubjectRequest) (*SetAuthSubjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAuthSubject not implemented")
+}
+func (UnimplementedSysAPIServer) ListUsers(*ListUsersRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedSysAPIServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedSysAPIServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedSysAPIServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedSysAPIServer) ListContactMethods(*ListContactMethodsRequest, grpc.ServerStreamingServer[ContactMethod]) error {
+	return status.Error(codes.Unimplemented, "method ListContactMethods not implemented")
+}
+func (UnimplementedSysAPIServer) CreateContactMethod(context.Context, *CreateContactMethodRequest) (*ContactMethod, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateContactMethod not implemented")
+}
+func (UnimplementedSysAPIServer) UpdateContactMethod(context.Context, *UpdateContactMethodRequest) (*ContactMethod, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateContactMethod not implemented")
+}
+func (UnimplementedSysAPIServer) DeleteContactMethod(context.Context, *DeleteContactMethodRequest) (*DeleteContactMethodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteContactMethod not implemented")
+}
+func (UnimplementedSysAPIServer) ListServices(*ListServicesRequest, grpc.ServerStreamingServer[Service]) error {
+	return status.Error(codes.Unimplemented, "method ListServices not implemented")
+}
+func (UnimplementedSysAPIServer) GetService(context.Context, *GetServiceRequest) (*Service, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetService not implemented")
+}
+func (UnimplementedSysAPIServer) CreateService(context.Context, *CreateServiceRequest) (*Service, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateService not implemented")
+}
+func (UnimplementedSysAPIServer) UpdateService(context.Context, *UpdateServiceRequest) (*Service, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateService not implemented")
+}
+func (UnimplementedSysAPIServer) DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteService not implemented")
+}
+func (UnimplementedSysAPIServer) ListEscalationPolicies(*ListEscalationPoliciesRequest, grpc.ServerStreamingServer[EscalationPolicy]) error {
+	return status.Error(codes.Unimplemented, "method ListEscalationPolicies not implemented")
+}
+func (UnimplementedSysAPIServer) GetEscalationPolicy(context.Context, *GetEscalationPolicyRequest) (*EscalationPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEscalationPolicy not implemented")
+}
+func (UnimplementedSysAPIServer) CreateEscalationPolicy(context.Context, *CreateEscalationPolicyRequest) (*EscalationPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEscalationPolicy not implemented")
+}
+func (UnimplementedSysAPIServer) UpdateEscalationPolicy(context.Context, *UpdateEscalationPolicyRequest) (*EscalationPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEscalationPolicy not implemented")
+}
+func (UnimplementedSysAPIServer) DeleteEscalationPolicy(context.Context, *DeleteEscalationPolicyRequest) (*DeleteEscalationPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEscalationPolicy not implemented")
+}
+func (UnimplementedSysAPIServer) ListSchedules(*ListSchedulesRequest, grpc.ServerStreamingServer[Schedule]) error {
+	return status.Error(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedSysAPIServer) GetSchedule(context.Context, *GetScheduleRequest) (*Schedule, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (UnimplementedSysAPIServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedSysAPIServer) UpdateSchedule(context.Context, *UpdateScheduleRequest) (*Schedule, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedSysAPIServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedSysAPIServer) ListRotations(*ListRotationsRequest, grpc.ServerStreamingServer[Rotation]) error {
+	return status.Error(codes.Unimplemented, "method ListRotations not implemented")
+}
+func (UnimplementedSysAPIServer) GetRotation(context.Context, *GetRotationRequest) (*Rotation, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRotation not implemented")
+}
+func (UnimplementedSysAPIServer) CreateRotation(context.Context, *CreateRotationRequest) (*Rotation, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRotation not implemented")
+}
+func (UnimplementedSysAPIServer) UpdateRotation(context.Context, *UpdateRotationRequest) (*Rotation, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRotation not implemented")
+}
+func (UnimplementedSysAPIServer) DeleteRotation(context.Context, *DeleteRotationRequest) (*DeleteRotationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRotation not implemented")
+}
+func (UnimplementedSysAPIServer) ListOverrides(*ListOverridesRequest, grpc.ServerStreamingServer[Override]) error {
+	return status.Error(codes.Unimplemented, "method ListOverrides not implemented")
+}
+func (UnimplementedSysAPIServer) CreateOverride(context.Context, *CreateOverrideRequest) (*Override, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOverride not implemented")
+}
+func (UnimplementedSysAPIServer) DeleteOverride(context.Context, *DeleteOverrideRequest) (*DeleteOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteOverride not implemented")
+}
+func (UnimplementedSysAPIServer) WatchAlerts(*WatchAlertsRequest, grpc.ServerStreamingServer[AlertEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchAlerts not implemented")
+}
+func (UnimplementedSysAPIServer) mustEmbedUnimplementedSysAPIServer() {}
+func (UnimplementedSysAPIServer) testEmbeddedByValue()                {}
+
+// UnsafeSysAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SysAPIServer will
+// result in compilation errors.
+type UnsafeSysAPIServer interface {
 	mustEmbedUnimplementedSysAPIServer()
 }
 
-// UnimplementedSysAPIServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSysAPIServer struct{}
+func RegisterSysAPIServer(s grpc.ServiceRegistrar, srv SysAPIServer) {
+	// If the following call panics, it indicates UnimplementedSysAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SysAPI_ServiceDesc, srv)
+}
+
+func _SysAPI_AuthSubjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AuthSubjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).AuthSubjects(m, &grpc.GenericServerStream[AuthSubjectsRequest, AuthSubject]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_AuthSubjectsServer = grpc.ServerStreamingServer[AuthSubject]
+
+func _SysAPI_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_UsersWithoutAuthProvider_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UsersWithoutAuthProviderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).UsersWithoutAuthProvider(m, &grpc.GenericServerStream[UsersWithoutAuthProviderRequest, UserInfo]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_UsersWithoutAuthProviderServer = grpc.ServerStreamingServer[UserInfo]
+
+func _SysAPI_SetAuthSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAuthSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).SetAuthSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_SetAuthSubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).SetAuthSubject(ctx, req.(*SetAuthSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_ListUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListUsersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).ListUsers(m, &grpc.GenericServerStream[ListUsersRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListUsersServer = grpc.ServerStreamingServer[User]
+
+func _SysAPI_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_ListContactMethods_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListContactMethodsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).ListContactMethods(m, &grpc.GenericServerStream[ListContactMethodsRequest, ContactMethod]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListContactMethodsServer = grpc.ServerStreamingServer[ContactMethod]
+
+func _SysAPI_CreateContactMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContactMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).CreateContactMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_CreateContactMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).CreateContactMethod(ctx, req.(*CreateContactMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_UpdateContactMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).UpdateContactMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_UpdateContactMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).UpdateContactMethod(ctx, req.(*UpdateContactMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_DeleteContactMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).DeleteContactMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_DeleteContactMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).DeleteContactMethod(ctx, req.(*DeleteContactMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_ListServices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListServicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).ListServices(m, &grpc.GenericServerStream[ListServicesRequest, Service]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListServicesServer = grpc.ServerStreamingServer[Service]
+
+func _SysAPI_GetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).GetService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_GetService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).GetService(ctx, req.(*GetServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_CreateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).CreateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_CreateService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).CreateService(ctx, req.(*CreateServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).UpdateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_UpdateService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).DeleteService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_DeleteService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).DeleteService(ctx, req.(*DeleteServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_ListEscalationPolicies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEscalationPoliciesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).ListEscalationPolicies(m, &grpc.GenericServerStream[ListEscalationPoliciesRequest, EscalationPolicy]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListEscalationPoliciesServer = grpc.ServerStreamingServer[EscalationPolicy]
+
+func _SysAPI_GetEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).GetEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_GetEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).GetEscalationPolicy(ctx, req.(*GetEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_CreateEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).CreateEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_CreateEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).CreateEscalationPolicy(ctx, req.(*CreateEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_UpdateEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).UpdateEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_UpdateEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).UpdateEscalationPolicy(ctx, req.(*UpdateEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedSysAPIServer) AuthSubjects(*AuthSubjectsRequest, grpc.ServerStreamingServer[AuthSubject]) error {
-	return status.Error(codes.Unimplemented, "method AuthSubjects not implemented")
+func _SysAPI_DeleteEscalationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEscalationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).DeleteEscalationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_DeleteEscalationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).DeleteEscalationPolicy(ctx, req.(*DeleteEscalationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSysAPIServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+
+func _SysAPI_ListSchedules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSchedulesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).ListSchedules(m, &grpc.GenericServerStream[ListSchedulesRequest, Schedule]{ServerStream: stream})
 }
-func (UnimplementedSysAPIServer) UsersWithoutAuthProvider(*UsersWithoutAuthProviderRequest, grpc.ServerStreamingServer[UserInfo]) error {
-	return status.Error(codes.Unimplemented, "method UsersWithoutAuthProvider not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_ListSchedulesServer = grpc.ServerStreamingServer[Schedule]
+
+func _SysAPI_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_GetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).GetSchedule(ctx, req.(*GetScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSysAPIServer) SetAuthSubject(context.Context, *SetAuthSubjectRequest) (*SetAuthSubjectResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetAuthSubject not implemented")
+
+func _SysAPI_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSysAPIServer) mustEmbedUnimplementedSysAPIServer() {}
-func (UnimplementedSysAPIServer) testEmbeddedByValue()                {}
 
-// UnsafeSysAPIServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SysAPIServer will
-// result in compilation errors.
-type UnsafeSysAPIServer interface {
-	mustEmbedUnimplementedSysAPIServer()
+func _SysAPI_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_UpdateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSysAPIServer(s grpc.ServiceRegistrar, srv SysAPIServer) {
-	// If the following call panics, it indicates UnimplementedSysAPIServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _SysAPI_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&SysAPI_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(SysAPIServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SysAPI_AuthSubjects_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AuthSubjectsRequest)
+func _SysAPI_ListRotations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRotationsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(SysAPIServer).AuthSubjects(m, &grpc.GenericServerStream[AuthSubjectsRequest, AuthSubject]{ServerStream: stream})
+	return srv.(SysAPIServer).ListRotations(m, &grpc.GenericServerStream[ListRotationsRequest, Rotation]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SysAPI_AuthSubjectsServer = grpc.ServerStreamingServer[AuthSubject]
+type SysAPI_ListRotationsServer = grpc.ServerStreamingServer[Rotation]
 
-func _SysAPI_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _SysAPI_GetRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRotationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysAPIServer).DeleteUser(ctx, in)
+		return srv.(SysAPIServer).GetRotation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysAPI_DeleteUser_FullMethodName,
+		FullMethod: SysAPI_GetRotation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysAPIServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(SysAPIServer).GetRotation(ctx, req.(*GetRotationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysAPI_UsersWithoutAuthProvider_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(UsersWithoutAuthProviderRequest)
+func _SysAPI_CreateRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).CreateRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_CreateRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).CreateRotation(ctx, req.(*CreateRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_UpdateRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).UpdateRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_UpdateRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).UpdateRotation(ctx, req.(*UpdateRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_DeleteRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).DeleteRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_DeleteRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).DeleteRotation(ctx, req.(*DeleteRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_ListOverrides_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListOverridesRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(SysAPIServer).UsersWithoutAuthProvider(m, &grpc.GenericServerStream[UsersWithoutAuthProviderRequest, UserInfo]{ServerStream: stream})
+	return srv.(SysAPIServer).ListOverrides(m, &grpc.GenericServerStream[ListOverridesRequest, Override]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type SysAPI_UsersWithoutAuthProviderServer = grpc.ServerStreamingServer[UserInfo]
+type SysAPI_ListOverridesServer = grpc.ServerStreamingServer[Override]
 
-func _SysAPI_SetAuthSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAuthSubjectRequest)
+func _SysAPI_CreateOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOverrideRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysAPIServer).SetAuthSubject(ctx, in)
+		return srv.(SysAPIServer).CreateOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysAPI_SetAuthSubject_FullMethodName,
+		FullMethod: SysAPI_CreateOverride_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysAPIServer).SetAuthSubject(ctx, req.(*SetAuthSubjectRequest))
+		return srv.(SysAPIServer).CreateOverride(ctx, req.(*CreateOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysAPI_DeleteOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysAPIServer).DeleteOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysAPI_DeleteOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysAPIServer).DeleteOverride(ctx, req.(*DeleteOverrideRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysAPI_WatchAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysAPIServer).WatchAlerts(m, &grpc.GenericServerStream[WatchAlertsRequest, AlertEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SysAPI_WatchAlertsServer = grpc.ServerStreamingServer[AlertEvent]
+
 // SysAPI_ServiceDesc is the grpc.ServiceDesc for SysAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +1333,102 @@ var SysAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAuthSubject",
 			Handler:    _SysAPI_SetAuthSubject_Handler,
 		},
+		{
+			MethodName: "GetUser",
+			Handler:    _SysAPI_GetUser_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _SysAPI_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _SysAPI_UpdateUser_Handler,
+		},
+		{
+			MethodName: "CreateContactMethod",
+			Handler:    _SysAPI_CreateContactMethod_Handler,
+		},
+		{
+			MethodName: "UpdateContactMethod",
+			Handler:    _SysAPI_UpdateContactMethod_Handler,
+		},
+		{
+			MethodName: "DeleteContactMethod",
+			Handler:    _SysAPI_DeleteContactMethod_Handler,
+		},
+		{
+			MethodName: "GetService",
+			Handler:    _SysAPI_GetService_Handler,
+		},
+		{
+			MethodName: "CreateService",
+			Handler:    _SysAPI_CreateService_Handler,
+		},
+		{
+			MethodName: "UpdateService",
+			Handler:    _SysAPI_UpdateService_Handler,
+		},
+		{
+			MethodName: "DeleteService",
+			Handler:    _SysAPI_DeleteService_Handler,
+		},
+		{
+			MethodName: "GetEscalationPolicy",
+			Handler:    _SysAPI_GetEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "CreateEscalationPolicy",
+			Handler:    _SysAPI_CreateEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateEscalationPolicy",
+			Handler:    _SysAPI_UpdateEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteEscalationPolicy",
+			Handler:    _SysAPI_DeleteEscalationPolicy_Handler,
+		},
+		{
+			MethodName: "GetSchedule",
+			Handler:    _SysAPI_GetSchedule_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _SysAPI_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _SysAPI_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _SysAPI_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "GetRotation",
+			Handler:    _SysAPI_GetRotation_Handler,
+		},
+		{
+			MethodName: "CreateRotation",
+			Handler:    _SysAPI_CreateRotation_Handler,
+		},
+		{
+			MethodName: "UpdateRotation",
+			Handler:    _SysAPI_UpdateRotation_Handler,
+		},
+		{
+			MethodName: "DeleteRotation",
+			Handler:    _SysAPI_DeleteRotation_Handler,
+		},
+		{
+			MethodName: "CreateOverride",
+			Handler:    _SysAPI_CreateOverride_Handler,
+		},
+		{
+			MethodName: "DeleteOverride",
+			Handler:    _SysAPI_DeleteOverride_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -237,6 +1441,46 @@ var SysAPI_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SysAPI_UsersWithoutAuthProvider_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListUsers",
+			Handler:       _SysAPI_ListUsers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListContactMethods",
+			Handler:       _SysAPI_ListContactMethods_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListServices",
+			Handler:       _SysAPI_ListServices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListEscalationPolicies",
+			Handler:       _SysAPI_ListEscalationPolicies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListSchedules",
+			Handler:       _SysAPI_ListSchedules_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRotations",
+			Handler:       _SysAPI_ListRotations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListOverrides",
+			Handler:       _SysAPI_ListOverrides_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchAlerts",
+			Handler:       _SysAPI_WatchAlerts_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pkg/sysapi/sysapi.proto",
 }
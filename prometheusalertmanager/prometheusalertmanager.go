@@ -91,6 +91,7 @@ type postBody struct {
 	CommonLabels struct {
 		Instance  string
 		AlertName string `json:"alertname"`
+		Severity  string
 	}
 
 	CommonAnnotations struct {
@@ -102,6 +103,7 @@ type postBodyAlert struct {
 	Labels struct {
 		AlertName string
 		Instance  string
+		Severity  string
 	}
 	Annotations struct {
 		Summary string
@@ -153,6 +155,22 @@ func (b postBody) Summary() string {
 	return b.CommonLabels.AlertName + " " + strings.Join(instances, ",")
 }
 
+// Severity returns the common severity label, or the severity label of the first alert if they differ.
+func (b postBody) Severity() alert.Severity {
+	sev := b.CommonLabels.Severity
+	if sev == "" && len(b.Alerts) > 0 {
+		sev = b.Alerts[0].Labels.Severity
+	}
+
+	s, err := alert.ParseSeverity(sev)
+	if err != nil {
+		// unknown severity labels should not prevent the alert from being created
+		return alert.DefaultSeverity
+	}
+
+	return s
+}
+
 func (b postBody) Details(payload string) string {
 	var s strings.Builder
 	if b.ExternalURL != "" {
@@ -228,6 +246,7 @@ func PrometheusAlertmanagerEventsAPI(aDB *alert.Store, intDB *integrationkey.Sto
 			Source:    alert.SourcePrometheusAlertmanager,
 			ServiceID: serviceID,
 			Dedup:     alert.NewUserDedup(summary),
+			Severity:  body.Severity(),
 		}
 
 		err = retry.DoTemporaryError(func(int) error {
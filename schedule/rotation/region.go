@@ -0,0 +1,216 @@
+package rotation
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxRegions is the maximum number of regions a follow-the-sun rotation may have.
+const MaxRegions = 10
+
+// Region is a group of participants in a follow-the-sun rotation.
+//
+// Each day, a region takes over at Start (local to TimeZone) and covers
+// until the next region takes over.
+type Region struct {
+	TimeZone string         `json:"time_zone"`
+	Start    timeutil.Clock `json:"start"`
+	UserIDs  []string       `json:"user_ids"`
+}
+
+// RegionUserIDs returns the unique user IDs across all regions, in order.
+func (r Rotation) RegionUserIDs() []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, reg := range r.Regions {
+		for _, id := range reg.UserIDs {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// WithParticipants returns a copy of the rotation with region members limited
+// to the provided user IDs (e.g., the current participants of the rotation).
+func (r Rotation) WithParticipants(userIDs []string) Rotation {
+	isPart := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		isPart[id] = true
+	}
+
+	regions := make([]Region, len(r.Regions))
+	for i, reg := range r.Regions {
+		regions[i] = reg
+		regions[i].UserIDs = nil
+		for _, id := range reg.UserIDs {
+			if !isPart[id] {
+				continue
+			}
+			regions[i].UserIDs = append(regions[i].UserIDs, id)
+		}
+	}
+	r.Regions = regions
+
+	return r
+}
+
+// regionHandoff returns the handoff time for a region on the day t falls on (in loc), offset by the given number of days.
+func regionHandoff(reg Region, loc *time.Location, t time.Time, dayOffset int) time.Time {
+	y, m, d := t.In(loc).Date()
+	return reg.Start.FirstOfDay(time.Date(y, m, d+dayOffset, 12, 0, 0, 0, loc))
+}
+
+// regionShift returns the index of the region active at t, along with the start and end
+// of its current shift. The index will be -1 if no region is valid.
+//
+// If two regions hand off at the same instant, the first one wins.
+func (r Rotation) regionShift(t time.Time) (idx int, start, end time.Time) {
+	idx = -1
+	for i, reg := range r.Regions {
+		loc, err := util.LoadLocation(reg.TimeZone)
+		if err != nil {
+			continue
+		}
+
+		prev := regionHandoff(reg, loc, t, 0)
+		if prev.After(t) {
+			prev = regionHandoff(reg, loc, t, -1)
+		}
+		next := regionHandoff(reg, loc, t, 0)
+		if !next.After(t) {
+			next = regionHandoff(reg, loc, t, 1)
+		}
+
+		if idx == -1 || prev.After(start) {
+			idx = i
+			start = prev
+		}
+		if end.IsZero() || next.Before(end) {
+			end = next
+		}
+	}
+
+	return idx, start, end
+}
+
+// civilDays returns the number of calendar days from a to b.
+func civilDays(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	da := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	db := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	return int(db.Sub(da) / (24 * time.Hour))
+}
+
+// RegionUserID returns the user on-call at t for a follow-the-sun rotation.
+//
+// Within a region, members take turns covering ShiftLength consecutive days,
+// counted from the rotation start date in the region's time zone. An empty string is
+// returned if the active region has no members.
+func (r Rotation) RegionUserID(t time.Time) string {
+	if r.ShiftLength <= 0 {
+		r.ShiftLength = 1
+	}
+	t = t.Truncate(time.Minute)
+
+	idx, start, _ := r.regionShift(t)
+	if idx == -1 || len(r.Regions[idx].UserIDs) == 0 {
+		return ""
+	}
+	reg := r.Regions[idx]
+	loc, err := util.LoadLocation(reg.TimeZone)
+	if err != nil {
+		return ""
+	}
+
+	n := civilDays(r.Start.In(loc), start.In(loc))
+	turn := n / r.ShiftLength
+	if n < 0 && n%r.ShiftLength != 0 {
+		turn--
+	}
+	turn %= len(reg.UserIDs)
+	if turn < 0 {
+		turn += len(reg.UserIDs)
+	}
+
+	return reg.UserIDs[turn]
+}
+
+func (r *Rotation) normalizeRegions() error {
+	if r.Type != TypeFollowTheSun {
+		r.Regions = nil
+		return nil
+	}
+
+	err := validate.Range("Regions", len(r.Regions), 1, MaxRegions)
+	if err != nil {
+		return err
+	}
+
+	// copy so the caller's regions are not modified
+	r.Regions = append([]Region(nil), r.Regions...)
+	seen := make(map[string]bool, len(r.Regions))
+	for i, reg := range r.Regions {
+		prefix := fmt.Sprintf("Regions[%d].", i)
+		loc, err := util.LoadLocation(reg.TimeZone)
+		if err != nil {
+			return validation.NewFieldError(prefix+"TimeZone", err.Error())
+		}
+		if seen[loc.String()] {
+			return validation.NewFieldError(prefix+"TimeZone", "must be unique")
+		}
+		seen[loc.String()] = true
+		r.Regions[i].TimeZone = loc.String()
+
+		err = validate.Many(
+			validate.Range(prefix+"Start", int(reg.Start/timeutil.Clock(time.Minute)), 0, 24*60-1),
+			validate.Range(prefix+"UserIDs", len(reg.UserIDs), 1, 100),
+			validate.ManyUUID(prefix+"UserIDs", reg.UserIDs, 100),
+		)
+		if err != nil {
+			return err
+		}
+		r.Regions[i].Start = timeutil.Clock(time.Duration(reg.Start).Truncate(time.Minute))
+	}
+
+	return nil
+}
+
+// regionData handles reading and writing regions to the DB format.
+type regionData []Region
+
+// Scan implements the sql.Scanner interface.
+func (r *regionData) Scan(value interface{}) error {
+	switch t := value.(type) {
+	case []byte:
+		return json.Unmarshal(t, r)
+	case string:
+		return json.Unmarshal([]byte(t), r)
+	case nil:
+		*r = nil
+		return nil
+	default:
+		return fmt.Errorf("could not process unknown type for rotation regions: %T", t)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+func (r regionData) Value() (driver.Value, error) {
+	if r == nil {
+		r = regionData{}
+	}
+
+	return json.Marshal(r)
+}
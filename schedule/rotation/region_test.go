@@ -0,0 +1,131 @@
+package rotation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/util/timeutil"
+)
+
+func TestRotation_FollowTheSun(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	rot := Rotation{
+		Type:        TypeFollowTheSun,
+		ShiftLength: 1,
+		Start:       time.Date(2024, 3, 1, 0, 0, 0, 0, ny),
+		Regions: []Region{
+			{TimeZone: "America/New_York", Start: timeutil.NewClock(8, 0), UserIDs: []string{"a", "b"}},
+			{TimeZone: "Europe/London", Start: timeutil.NewClock(8, 0), UserIDs: []string{"c"}},
+		},
+	}
+
+	utc := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2024, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	check := func(desc string, at time.Time, expUser string, expStart, expEnd time.Time) {
+		t.Helper()
+		t.Run(desc, func(t *testing.T) {
+			assert.Equal(t, expUser, rot.RegionUserID(at), "user")
+			assert.Equal(t, expStart.String(), rot.StartTime(at).UTC().String(), "start")
+			assert.Equal(t, expEnd.String(), rot.EndTime(at).UTC().String(), "end")
+		})
+	}
+
+	// EST (UTC-5) and GMT (UTC+0)
+	check("london morning", utc(3, 4, 10, 0), "c", utc(3, 4, 8, 0), utc(3, 4, 13, 0))
+	check("new york", utc(3, 4, 14, 0), "b", utc(3, 4, 13, 0), utc(3, 5, 8, 0))
+	check("new york next day", utc(3, 5, 14, 0), "a", utc(3, 5, 13, 0), utc(3, 6, 8, 0))
+	check("before london", utc(3, 5, 7, 59), "b", utc(3, 4, 13, 0), utc(3, 5, 8, 0))
+	check("at handoff", utc(3, 5, 8, 0), "c", utc(3, 5, 8, 0), utc(3, 5, 13, 0))
+
+	// US DST starts Mar 10; new york now hands off an hour earlier in UTC
+	check("new york after dst", utc(3, 11, 12, 30), "a", utc(3, 11, 12, 0), utc(3, 12, 8, 0))
+	check("london after us dst", utc(3, 11, 11, 59), "c", utc(3, 11, 8, 0), utc(3, 11, 12, 0))
+
+	// UK DST starts Mar 31; london now hands off an hour earlier in UTC
+	check("london after uk dst", utc(4, 1, 7, 30), "c", utc(4, 1, 7, 0), utc(4, 1, 12, 0))
+
+	// before the rotation start date
+	check("before start", utc(2, 29, 14, 0), "b", utc(2, 29, 13, 0), utc(3, 1, 8, 0))
+}
+
+func TestRotation_FollowTheSun_DSTGap(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	rot := Rotation{
+		Type:        TypeFollowTheSun,
+		ShiftLength: 2,
+		Start:       time.Date(2024, 3, 1, 0, 0, 0, 0, ny),
+		Regions: []Region{
+			{TimeZone: "America/New_York", Start: timeutil.NewClock(2, 30), UserIDs: []string{"a", "b"}},
+			{TimeZone: "Asia/Tokyo", Start: timeutil.NewClock(9, 0), UserIDs: []string{"c"}},
+		},
+	}
+
+	// 2:30 AM does not exist on Mar 10 in New York, so the handoff happens at 3:00 AM EDT (07:00 UTC)
+	at := time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC).String(), rot.StartTime(at).UTC().String())
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC).String(), rot.EndTime(at).UTC().String())
+
+	// 9 days since start, 2 days per shift
+	assert.Equal(t, "a", rot.RegionUserID(at))
+	assert.Equal(t, "c", rot.RegionUserID(time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestRotation_WithParticipants(t *testing.T) {
+	rot := Rotation{
+		Type: TypeFollowTheSun,
+		Regions: []Region{
+			{TimeZone: "America/New_York", UserIDs: []string{"a", "b"}},
+			{TimeZone: "Europe/London", UserIDs: []string{"c"}},
+		},
+	}
+
+	filtered := rot.WithParticipants([]string{"b", "c"})
+	assert.Equal(t, []string{"b"}, filtered.Regions[0].UserIDs)
+	assert.Equal(t, []string{"c"}, filtered.Regions[1].UserIDs)
+	assert.Equal(t, []string{"a", "b"}, rot.Regions[0].UserIDs, "original unchanged")
+
+	assert.Equal(t, []string{"a", "b", "c"}, rot.RegionUserIDs())
+}
+
+func TestRotation_Normalize_Regions(t *testing.T) {
+	const userID = "00000000-0000-0000-0000-000000000001"
+	base := Rotation{
+		Name:        "Default",
+		Type:        TypeFollowTheSun,
+		Start:       time.Now(),
+		ShiftLength: 1,
+	}
+
+	_, err := base.Normalize()
+	assert.Error(t, err, "regions required")
+
+	rot := base
+	rot.Regions = []Region{{TimeZone: "America/Chicago", Start: timeutil.NewClock(8, 0), UserIDs: []string{userID}}}
+	_, err = rot.Normalize()
+	assert.NoError(t, err)
+
+	rot.Regions = append(rot.Regions, Region{TimeZone: "America/Chicago", Start: timeutil.NewClock(20, 0), UserIDs: []string{userID}})
+	_, err = rot.Normalize()
+	assert.Error(t, err, "duplicate time zone")
+
+	rot.Regions = []Region{{TimeZone: "Not/AZone", UserIDs: []string{userID}}}
+	_, err = rot.Normalize()
+	assert.Error(t, err, "invalid time zone")
+
+	rot.Regions = []Region{{TimeZone: "America/Chicago"}}
+	_, err = rot.Normalize()
+	assert.Error(t, err, "no users")
+
+	rot.Type = TypeDaily
+	n, err := rot.Normalize()
+	require.NoError(t, err)
+	assert.Empty(t, n.Regions, "regions cleared for other types")
+}
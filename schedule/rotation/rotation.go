@@ -17,6 +17,9 @@ type Rotation struct {
 	Start          time.Time `json:"start"`
 	ShiftLength    int       `json:"shift_length"`
 	isUserFavorite bool
+
+	// Regions is only used for follow-the-sun rotations.
+	Regions []Region `json:"regions,omitempty"`
 }
 
 func (r Rotation) IsUserFavorite() bool {
@@ -91,6 +94,7 @@ func (r Rotation) monthEndTime(t time.Time, n int) time.Time {
 
 // StartTime calculates the start of the "shift" that started at (or was active) at t.
 // For daily, weekly, and monthly rotations, start time will be the previous handoff time (from start).
+// For follow-the-sun rotations, it is the most recent handoff between regions.
 // For monthly rotations, the monthStartTime function is used to recursively handle calculations as the length of months vary.
 func (r Rotation) StartTime(t time.Time) time.Time {
 	if r.ShiftLength <= 0 {
@@ -102,6 +106,15 @@ func (r Rotation) StartTime(t time.Time) time.Time {
 	if r.Type == TypeMonthly {
 		return r.monthStartTime(t, 1)
 	}
+	if r.Type == TypeFollowTheSun {
+		idx, start, _ := r.regionShift(t)
+		if idx != -1 {
+			return start.In(r.Start.Location())
+		}
+
+		// no valid regions, fall back to daily handoffs
+		r.Type = TypeDaily
+	}
 
 	shiftClockLen := r.shiftClock()
 	rem := timeutil.ClockDiff(r.Start, t) % shiftClockLen
@@ -127,6 +140,15 @@ func (r Rotation) EndTime(t time.Time) time.Time {
 	if r.Type == TypeMonthly {
 		return r.monthEndTime(t, 1)
 	}
+	if r.Type == TypeFollowTheSun {
+		idx, _, end := r.regionShift(t)
+		if idx != -1 {
+			return end.In(r.Start.Location())
+		}
+
+		// no valid regions, fall back to daily handoffs
+		r.Type = TypeDaily
+	}
 
 	shiftClockLen := r.shiftClock()
 	rem := timeutil.ClockDiff(r.Start, t) % shiftClockLen
@@ -151,12 +173,17 @@ func (r Rotation) Normalize() (*Rotation, error) {
 	err := validate.Many(
 		validate.IDName("Name", r.Name),
 		validate.Range("ShiftLength", r.ShiftLength, 1, 9000),
-		validate.OneOf("Type", r.Type, TypeMonthly, TypeWeekly, TypeDaily, TypeHourly),
+		validate.OneOf("Type", r.Type, TypeMonthly, TypeWeekly, TypeDaily, TypeHourly, TypeFollowTheSun),
 		validate.Text("Description", r.Description, 1, 255),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	err = r.normalizeRegions()
+	if err != nil {
+		return nil, err
+	}
+
 	return &r, nil
 }
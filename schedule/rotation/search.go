@@ -22,6 +22,9 @@ type SearchOptions struct {
 	// Omit specifies a list of rotation IDs to exclude from the results
 	Omit []string `json:"o,omitempty"`
 
+	// TeamIDs, if set, will limit results to rotations owned by any of the teams.
+	TeamIDs []string `json:"t,omitempty"`
+
 	Limit int `json:"-"`
 
 	// FavoritesOnly controls filtering the results to those marked as favorites by FavoritesUserID.
@@ -49,6 +52,7 @@ var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()
 		rot.start_time, 
 		rot.shift_length, 
 		rot.time_zone, 
+		rot.regions, 
 		fav IS DISTINCT FROM NULL
 	FROM rotations rot
 	{{if not .FavoritesOnly }}LEFT {{end}}JOIN user_favorites fav ON rot.id = fav.tgt_rotation_id AND {{if .FavoritesUserID}}fav.user_id = :favUserID{{else}}false{{end}}
@@ -56,6 +60,9 @@ var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()
 	{{if .Omit}}
 		AND NOT rot.id = any(:omit)
 	{{end}}
+	{{if .TeamIDs}}
+		AND rot.team_id = any(:teamIDs)
+	{{end}}
 	{{if .Search}}
 		AND ({{orderedPrefixSearch "search" "rot.name"}} OR {{contains "search" "rot.description"}} OR {{contains "search" "rot.name"}})
 	{{end}}
@@ -91,6 +98,7 @@ func (opts renderData) Normalize() (*renderData, error) {
 		validate.Search("Search", opts.Search),
 		validate.Range("Limit", opts.Limit, 0, search.MaxResults),
 		validate.ManyUUID("Omit", opts.Omit, 50),
+		validate.ManyUUID("TeamIDs", opts.TeamIDs, 50),
 	)
 	if opts.After.Name != "" {
 		err = validate.Many(err, validate.IDName("After.Name", opts.After.Name))
@@ -111,6 +119,7 @@ func (opts renderData) QueryArgs() []sql.NamedArg {
 		sql.Named("search", opts.Search),
 		sql.Named("afterName", opts.After.Name),
 		sql.Named("omit", sqlutil.UUIDArray(opts.Omit)),
+		sql.Named("teamIDs", sqlutil.UUIDArray(opts.TeamIDs)),
 		sql.Named("favUserID", opts.FavoritesUserID),
 	}
 }
@@ -153,7 +162,7 @@ func (s *Store) Search(ctx context.Context, opts *SearchOptions) ([]Rotation, er
 	var r Rotation
 	var tz string
 	for rows.Next() {
-		err = rows.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.isUserFavorite)
+		err = rows.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, (*regionData)(&r.Regions), &r.isUserFavorite)
 		if err != nil {
 			return nil, err
 		}
@@ -51,14 +51,14 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 
 		lockPart: p.P(`lock rotation_participants, rotation_state in exclusive mode`),
 
-		createRotation: p.P(`INSERT INTO rotations (id, name, description, type, start_time, shift_length, time_zone) VALUES ($1, $2, $3, $4, $5, $6, $7)`),
+		createRotation: p.P(`INSERT INTO rotations (id, name, description, type, start_time, shift_length, time_zone, regions) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`),
 		updateRotation: p.P(`
 			WITH set_shift_start AS (
 				UPDATE rotation_state
 				SET shift_start = now()
 				WHERE rotation_id = $1
 			)
-			UPDATE rotations SET name = $2, description = $3, type = $4, start_time = $5, shift_length = $6, time_zone = $7 WHERE id = $1
+			UPDATE rotations SET name = $2, description = $3, type = $4, start_time = $5, shift_length = $6, time_zone = $7, regions = $8 WHERE id = $1
 		`),
 		findRotation: p.P(`
 			SELECT 
@@ -69,13 +69,14 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 				r.start_time, 
 				r.shift_length, 
 				r.time_zone, 
+				r.regions, 
 				fav IS DISTINCT FROM NULL 
 			FROM rotations r 
 			LEFT JOIN user_favorites fav ON fav.tgt_rotation_id = r.id 
 			AND fav.user_id = $2 
 			WHERE r.id = $1
 		`),
-		findRotationForUpdate: p.P(`SELECT id, name, description, type, start_time, shift_length, time_zone FROM rotations WHERE id = $1 FOR UPDATE`),
+		findRotationForUpdate: p.P(`SELECT id, name, description, type, start_time, shift_length, time_zone, regions FROM rotations WHERE id = $1 FOR UPDATE`),
 		deleteRotation:        p.P(`DELETE FROM rotations WHERE id = ANY($1)`),
 
 		findMany: p.P(`
@@ -87,6 +88,7 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 				r.start_time, 
 				r.shift_length, 
 				r.time_zone,
+				r.regions,
 				fav IS DISTINCT FROM NULL 
 			FROM rotations r 
 			LEFT JOIN user_favorites fav ON fav.tgt_rotation_id = r.id 
@@ -189,7 +191,7 @@ func (s *Store) CreateRotationTx(ctx context.Context, tx *sql.Tx, r *Rotation) (
 
 	n.ID = uuid.New().String()
 
-	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Type, n.Start, n.ShiftLength, n.Start.Location().String())
+	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Type, n.Start, n.ShiftLength, n.Start.Location().String(), regionData(n.Regions))
 	if err != nil {
 		return nil, err
 	}
@@ -216,7 +218,7 @@ func (s *Store) UpdateRotationTx(ctx context.Context, tx *sql.Tx, r *Rotation) e
 		stmt = tx.StmtContext(ctx, stmt)
 	}
 
-	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Type, n.Start, n.ShiftLength, n.Start.Location().String())
+	_, err = stmt.ExecContext(ctx, n.ID, n.Name, n.Description, n.Type, n.Start, n.ShiftLength, n.Start.Location().String(), regionData(n.Regions))
 	return err
 }
 
@@ -243,7 +245,7 @@ func (s *Store) FindMany(ctx context.Context, ids []string) ([]Rotation, error)
 	var tz string
 	result := make([]Rotation, 0, len(ids))
 	for rows.Next() {
-		err = rows.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.isUserFavorite)
+		err = rows.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, (*regionData)(&r.Regions), &r.isUserFavorite)
 		if err != nil {
 			return nil, err
 		}
@@ -271,7 +273,7 @@ func (s *Store) FindRotation(ctx context.Context, id string) (*Rotation, error)
 	row := s.findRotation.QueryRowContext(ctx, id, permission.UserNullUUID(ctx))
 	var r Rotation
 	var tz string
-	err = row.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, &r.isUserFavorite)
+	err = row.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, (*regionData)(&r.Regions), &r.isUserFavorite)
 	if err != nil {
 		return nil, err
 	}
@@ -328,7 +330,7 @@ func (s *Store) FindRotationForUpdateTx(ctx context.Context, tx *sql.Tx, rotatio
 	row := stmt.QueryRowContext(ctx, rotationID)
 	var r Rotation
 	var tz string
-	err = row.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz)
+	err = row.Scan(&r.ID, &r.Name, &r.Description, &r.Type, &r.Start, &r.ShiftLength, &tz, (*regionData)(&r.Regions))
 	if err != nil {
 		return nil, err
 	}
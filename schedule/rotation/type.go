@@ -17,6 +17,10 @@ const (
 	TypeWeekly  Type = "weekly"
 	TypeDaily   Type = "daily"
 	TypeHourly  Type = "hourly"
+
+	// TypeFollowTheSun hands off between regions each day, with each region
+	// covering its working hours in local time.
+	TypeFollowTheSun Type = "follow_the_sun"
 )
 
 // Scan handles reading a Role from the DB format
@@ -36,7 +40,7 @@ func (r *Type) Scan(value interface{}) error {
 // Value converts the Role to the DB representation
 func (r Type) Value() (driver.Value, error) {
 	switch r {
-	case TypeMonthly, TypeWeekly, TypeDaily, TypeHourly:
+	case TypeMonthly, TypeWeekly, TypeDaily, TypeHourly, TypeFollowTheSun:
 		return string(r), nil
 	default:
 		return nil, fmt.Errorf("unknown rotation type specified '%s'", r)
@@ -58,6 +62,8 @@ func (t *Type) UnmarshalGQL(v interface{}) error {
 		*t = TypeDaily
 	case "hourly":
 		*t = TypeHourly
+	case "follow_the_sun":
+		*t = TypeFollowTheSun
 	default:
 		return validation.NewFieldError("Type", "unknown rotation type "+str)
 	}
@@ -76,5 +82,7 @@ func (t Type) MarshalGQL(w io.Writer) {
 		graphql.MarshalString("hourly").MarshalGQL(w)
 	case TypeDaily:
 		graphql.MarshalString("daily").MarshalGQL(w)
+	case TypeFollowTheSun:
+		graphql.MarshalString("follow_the_sun").MarshalGQL(w)
 	}
 }
@@ -31,6 +31,9 @@ type SearchOptions struct {
 	// Omit specifies a list of schedule IDs to exclude from the results.
 	Omit []string `json:"o,omitempty"`
 
+	// TeamIDs, if set, will limit results to schedules owned by any of the teams.
+	TeamIDs []string `json:"t,omitempty"`
+
 	Limit int `json:"-"`
 }
 
@@ -55,6 +58,9 @@ var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()
 	{{if .Omit}}
 		AND NOT sched.id = any(:omit)
 	{{end}}
+	{{if .TeamIDs}}
+		AND sched.team_id = any(:teamIDs)
+	{{end}}
 	{{if .Search}}
 		AND ({{orderedPrefixSearch "search" "sched.name"}} OR {{contains "search" "sched.description"}} OR {{contains "search" "sched.name"}})
 	{{end}}
@@ -89,6 +95,7 @@ func (opts renderData) Normalize() (*renderData, error) {
 		validate.Search("Search", opts.Search),
 		validate.Range("Limit", opts.Limit, 0, search.MaxResults),
 		validate.ManyUUID("Omit", opts.Omit, 50),
+		validate.ManyUUID("TeamIDs", opts.TeamIDs, 50),
 	)
 	if opts.After.Name != "" {
 		err = validate.Many(err, validate.IDName("After.Name", opts.After.Name))
@@ -108,6 +115,7 @@ func (opts renderData) QueryArgs() []sql.NamedArg {
 		sql.Named("search", opts.Search),
 		sql.Named("afterName", opts.After.Name),
 		sql.Named("omit", sqlutil.UUIDArray(opts.Omit)),
+		sql.Named("teamIDs", sqlutil.UUIDArray(opts.TeamIDs)),
 		sql.Named("favUserID", opts.FavoritesUserID),
 	}
 }
@@ -0,0 +1,67 @@
+-- name: ShiftSwapCreate :one
+INSERT INTO shift_swap_requests(schedule_id, from_user_id, to_user_id, start_time, end_time, trade_start_time, trade_end_time, note)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+    id, created_at;
+
+-- name: ShiftSwapNotify :exec
+-- Queue a request message to each enabled contact method of the requested user.
+INSERT INTO outgoing_messages(id, message_type, contact_method_id, user_id, shift_swap_request_id)
+SELECT
+    gen_random_uuid(),
+    'shift_swap_request',
+    cm.id,
+    cm.user_id,
+    @request_id::uuid
+FROM
+    user_contact_methods cm
+WHERE
+    cm.user_id = @user_id
+    AND NOT cm.disabled;
+
+-- name: ShiftSwapClearMessages :exec
+-- Remove unsent request messages once a request is resolved.
+DELETE FROM outgoing_messages
+WHERE shift_swap_request_id = @request_id::uuid
+    AND last_status = 'pending';
+
+-- name: ShiftSwapFindOne :one
+SELECT
+    *
+FROM
+    shift_swap_requests
+WHERE
+    id = $1;
+
+-- name: ShiftSwapFindOneForUpdate :one
+SELECT
+    *
+FROM
+    shift_swap_requests
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: ShiftSwapFindManyBySchedule :many
+SELECT
+    *
+FROM
+    shift_swap_requests
+WHERE
+    schedule_id = @schedule_id
+    AND (@statuses::enum_shift_swap_status[] ISNULL
+        OR status = ANY (@statuses::enum_shift_swap_status[]))
+ORDER BY
+    created_at DESC,
+    id;
+
+-- name: ShiftSwapSetStatus :one
+UPDATE
+    shift_swap_requests
+SET
+    status = $2,
+    resolved_at = now()
+WHERE
+    id = $1
+RETURNING
+    resolved_at;
@@ -0,0 +1,96 @@
+// Package shiftswap manages requests between users to cover each other's on-call shifts.
+package shiftswap
+
+import (
+	"io"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Status is the state of a Request.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusDeclined Status = "declined"
+	StatusCanceled Status = "canceled"
+)
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (s *Status) UnmarshalGQL(v interface{}) error {
+	str, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return err
+	}
+
+	*s = Status(str)
+	switch *s {
+	case StatusPending, StatusAccepted, StatusDeclined, StatusCanceled:
+		return nil
+	}
+
+	return validation.NewFieldError("Status", "unknown status "+str)
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (s Status) MarshalGQL(w io.Writer) {
+	graphql.MarshalString(string(s)).MarshalGQL(w)
+}
+
+// A Request asks ToUserID to cover FromUserID's shift on a schedule between Start and End.
+//
+// If TradeStart and TradeEnd are set, FromUserID offers to cover ToUserID's shift between
+// them in exchange.
+type Request struct {
+	ID         string
+	ScheduleID string
+	FromUserID string
+	ToUserID   string
+
+	Start, End           time.Time
+	TradeStart, TradeEnd time.Time
+
+	Note   string
+	Status Status
+
+	CreatedAt  time.Time
+	ResolvedAt time.Time
+}
+
+// IsTrade returns true if the request is for a trade of shifts.
+func (r Request) IsTrade() bool { return !r.TradeStart.IsZero() }
+
+// Normalize will validate fields and return a normalized copy.
+func (r Request) Normalize() (*Request, error) {
+	r.Start = r.Start.Truncate(time.Minute)
+	r.End = r.End.Truncate(time.Minute)
+	r.TradeStart = r.TradeStart.Truncate(time.Minute)
+	r.TradeEnd = r.TradeEnd.Truncate(time.Minute)
+
+	err := validate.Many(
+		validate.UUID("ScheduleID", r.ScheduleID),
+		validate.UUID("FromUserID", r.FromUserID),
+		validate.UUID("ToUserID", r.ToUserID),
+		validate.Text("Note", r.Note, 0, 1000),
+	)
+	if r.ToUserID == r.FromUserID {
+		err = validate.Many(err, validation.NewFieldError("ToUserID", "must be a different user"))
+	}
+	if !r.Start.Before(r.End) {
+		err = validate.Many(err, validation.NewFieldError("End", "must occur after Start time"))
+	}
+	if r.TradeStart.IsZero() != r.TradeEnd.IsZero() {
+		err = validate.Many(err, validation.NewFieldError("TradeEnd", "must be set with TradeStart"))
+	} else if r.IsTrade() && !r.TradeStart.Before(r.TradeEnd) {
+		err = validate.Many(err, validation.NewFieldError("TradeEnd", "must occur after TradeStart time"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,48 @@
+package shiftswap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequest_Normalize(t *testing.T) {
+	start := time.Date(2026, 10, 19, 9, 0, 30, 0, time.UTC)
+	valid := Request{
+		ScheduleID: "a5f2b3e4-1c2d-4e5f-8a9b-0c1d2e3f4a5b",
+		FromUserID: "b5f2b3e4-1c2d-4e5f-8a9b-0c1d2e3f4a5b",
+		ToUserID:   "c5f2b3e4-1c2d-4e5f-8a9b-0c1d2e3f4a5b",
+		Start:      start,
+		End:        start.Add(8 * time.Hour),
+	}
+
+	n, err := valid.Normalize()
+	require.NoError(t, err)
+	assert.Equal(t, start.Truncate(time.Minute), n.Start)
+	assert.False(t, n.IsTrade())
+
+	check := func(desc string, fn func(r *Request)) {
+		t.Helper()
+		r := valid
+		fn(&r)
+		_, err := r.Normalize()
+		assert.Error(t, err, desc)
+	}
+	check("same user", func(r *Request) { r.ToUserID = r.FromUserID })
+	check("end before start", func(r *Request) { r.End = r.Start.Add(-time.Hour) })
+	check("same minute", func(r *Request) { r.End = r.Start.Add(10 * time.Second) })
+	check("trade start only", func(r *Request) { r.TradeStart = r.End })
+	check("trade end before start", func(r *Request) {
+		r.TradeStart = r.End.Add(time.Hour)
+		r.TradeEnd = r.End
+	})
+
+	trade := valid
+	trade.TradeStart = valid.End.Add(time.Hour)
+	trade.TradeEnd = valid.End.Add(2 * time.Hour)
+	n, err = trade.Normalize()
+	require.NoError(t, err)
+	assert.True(t, n.IsTrade())
+}
@@ -0,0 +1,247 @@
+package shiftswap
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/override"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store manages shift swap requests.
+type Store struct {
+	db  *sql.DB
+	ovr *override.Store
+}
+
+// NewStore will create a new Store, using ovr to create overrides for accepted requests.
+func NewStore(ctx context.Context, db *sql.DB, ovr *override.Store) (*Store, error) {
+	return &Store{db: db, ovr: ovr}, nil
+}
+
+func (s *Store) queries(tx *sql.Tx) *gadb.Queries {
+	db := gadb.New(s.db)
+	if tx != nil {
+		db = db.WithTx(tx)
+	}
+	return db
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func fromRow(row gadb.ShiftSwapRequest) Request {
+	return Request{
+		ID:         row.ID.String(),
+		ScheduleID: row.ScheduleID.String(),
+		FromUserID: row.FromUserID.String(),
+		ToUserID:   row.ToUserID.String(),
+		Start:      row.StartTime,
+		End:        row.EndTime,
+		TradeStart: row.TradeStartTime.Time,
+		TradeEnd:   row.TradeEndTime.Time,
+		Note:       row.Note,
+		Status:     Status(row.Status),
+		CreatedAt:  row.CreatedAt,
+		ResolvedAt: row.ResolvedAt.Time,
+	}
+}
+
+// CreateTx creates a new pending request and queues a message to each of the requested user's
+// contact methods. Only the requesting user or an admin may create a request.
+func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, r *Request) (*Request, error) {
+	err := permission.LimitCheckAny(ctx, permission.Admin, permission.MatchUser(r.FromUserID))
+	if err != nil {
+		return nil, err
+	}
+	n, err := r.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	if !n.End.After(time.Now()) {
+		return nil, validation.NewFieldError("End", "must be in the future")
+	}
+
+	db := s.queries(tx)
+	row, err := db.ShiftSwapCreate(ctx, gadb.ShiftSwapCreateParams{
+		ScheduleID:     uuid.MustParse(n.ScheduleID),
+		FromUserID:     uuid.MustParse(n.FromUserID),
+		ToUserID:       uuid.MustParse(n.ToUserID),
+		StartTime:      n.Start,
+		EndTime:        n.End,
+		TradeStartTime: nullTime(n.TradeStart),
+		TradeEndTime:   nullTime(n.TradeEnd),
+		Note:           n.Note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	n.ID = row.ID.String()
+	n.CreatedAt = row.CreatedAt
+	n.Status = StatusPending
+
+	err = db.ShiftSwapNotify(ctx, gadb.ShiftSwapNotifyParams{
+		RequestID: row.ID,
+		UserID:    uuid.MustParse(n.ToUserID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// FindOne returns a single request.
+func (s *Store) FindOne(ctx context.Context, id string) (*Request, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+	reqID, err := validate.ParseUUID("ShiftSwapRequestID", id)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries(nil).ShiftSwapFindOne(ctx, reqID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := fromRow(row)
+	return &r, nil
+}
+
+// FindManyBySchedule returns all requests for a schedule, newest first. If statuses are provided,
+// only requests with a matching status are returned.
+func (s *Store) FindManyBySchedule(ctx context.Context, scheduleID string, statuses []Status) ([]Request, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+	schedID, err := validate.ParseUUID("ScheduleID", scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	var stat []gadb.EnumShiftSwapStatus
+	for _, st := range statuses {
+		stat = append(stat, gadb.EnumShiftSwapStatus(st))
+	}
+
+	rows, err := s.queries(nil).ShiftSwapFindManyBySchedule(ctx, gadb.ShiftSwapFindManyByScheduleParams{
+		ScheduleID: schedID,
+		Statuses:   stat,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Request, len(rows))
+	for i, row := range rows {
+		result[i] = fromRow(row)
+	}
+
+	return result, nil
+}
+
+// resolveTx sets the status of a pending request after checking permissions against it with checks.
+func (s *Store) resolveTx(ctx context.Context, tx *sql.Tx, id string, stat Status, checks func(r Request) []permission.Checker) (*Request, error) {
+	err := permission.LimitCheckAny(ctx, permission.User, permission.Admin)
+	if err != nil {
+		return nil, err
+	}
+	reqID, err := validate.ParseUUID("ShiftSwapRequestID", id)
+	if err != nil {
+		return nil, err
+	}
+
+	db := s.queries(tx)
+	row, err := db.ShiftSwapFindOneForUpdate(ctx, reqID)
+	if err != nil {
+		return nil, err
+	}
+	r := fromRow(row)
+	err = permission.LimitCheckAny(ctx, checks(r)...)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != StatusPending {
+		return nil, validation.NewFieldError("Status", "request is already "+string(r.Status))
+	}
+
+	resolvedAt, err := db.ShiftSwapSetStatus(ctx, gadb.ShiftSwapSetStatusParams{
+		ID:     reqID,
+		Status: gadb.EnumShiftSwapStatus(stat),
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.ResolvedAt = resolvedAt.Time
+	err = db.ShiftSwapClearMessages(ctx, reqID)
+	if err != nil {
+		return nil, err
+	}
+	r.Status = stat
+
+	return &r, nil
+}
+
+// AcceptTx accepts a pending request, creating overrides on the schedule so that the requested
+// user replaces the requesting user for the shift (and the reverse for a trade). Only the
+// requested user or an admin may accept a request.
+func (s *Store) AcceptTx(ctx context.Context, tx *sql.Tx, id string) (*Request, error) {
+	r, err := s.resolveTx(ctx, tx, id, StatusAccepted, func(r Request) []permission.Checker {
+		return []permission.Checker{permission.Admin, permission.MatchUser(r.ToUserID)}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tgt := assignment.ScheduleTarget(r.ScheduleID)
+	_, err = s.ovr.CreateUserOverrideTx(ctx, tx, &override.UserOverride{
+		AddUserID:    r.ToUserID,
+		RemoveUserID: r.FromUserID,
+		Start:        r.Start,
+		End:          r.End,
+		Target:       tgt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsTrade() {
+		return r, nil
+	}
+
+	_, err = s.ovr.CreateUserOverrideTx(ctx, tx, &override.UserOverride{
+		AddUserID:    r.FromUserID,
+		RemoveUserID: r.ToUserID,
+		Start:        r.TradeStart,
+		End:          r.TradeEnd,
+		Target:       tgt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// DeclineTx declines a pending request. Only the requested user or an admin may decline a request.
+func (s *Store) DeclineTx(ctx context.Context, tx *sql.Tx, id string) (*Request, error) {
+	return s.resolveTx(ctx, tx, id, StatusDeclined, func(r Request) []permission.Checker {
+		return []permission.Checker{permission.Admin, permission.MatchUser(r.ToUserID)}
+	})
+}
+
+// CancelTx cancels a pending request. Only the requesting user or an admin may cancel a request.
+func (s *Store) CancelTx(ctx context.Context, tx *sql.Tx, id string) (*Request, error) {
+	return s.resolveTx(ctx, tx, id, StatusCanceled, func(r Request) []permission.Checker {
+		return []permission.Checker{permission.Admin, permission.MatchUser(r.FromUserID)}
+	})
+}
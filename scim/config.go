@@ -0,0 +1,15 @@
+package scim
+
+import (
+	"database/sql"
+
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/user"
+)
+
+// Config contains the values needed to implement the SCIM handler.
+type Config struct {
+	DB            *sql.DB
+	UserStore     *user.Store
+	RotationStore *rotation.Store
+}
@@ -0,0 +1,95 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+)
+
+const errorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+// Error is a SCIM protocol error, returned to the client as-is.
+type Error struct {
+	Status   int
+	SCIMType string
+	Detail   string
+}
+
+func (e *Error) Error() string { return e.Detail }
+
+func badRequest(scimType, detail string) error {
+	return &Error{Status: http.StatusBadRequest, SCIMType: scimType, Detail: detail}
+}
+
+func notFound(detail string) error {
+	return &Error{Status: http.StatusNotFound, Detail: detail}
+}
+
+func conflict(detail string) error {
+	return &Error{Status: http.StatusConflict, SCIMType: "uniqueness", Detail: detail}
+}
+
+// isUniqueViolation returns true if err is a unique constraint violation for the given constraint or index.
+func isUniqueViolation(err error, name string) bool {
+	dbErr := sqlutil.MapError(err)
+	return dbErr != nil && dbErr.Code == "23505" && dbErr.ConstraintName == name
+}
+
+// writeError will respond with a SCIM error response if err is non-nil, returning true. Errors
+// that aren't SCIM errors are mapped the same way as errutil.HTTPError.
+func writeError(ctx context.Context, w http.ResponseWriter, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var scimErr *Error
+	if !errors.As(err, &scimErr) {
+		scimErr = &Error{Detail: err.Error()}
+		err = errutil.MapDBError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			scimErr.Status = http.StatusNotFound
+			scimErr.Detail = "resource not found"
+		case permission.IsUnauthorized(err):
+			scimErr.Status = http.StatusUnauthorized
+		case permission.IsPermissionError(err):
+			scimErr.Status = http.StatusForbidden
+		case validation.IsClientError(err):
+			scimErr.Status = http.StatusBadRequest
+			scimErr.SCIMType = "invalidValue"
+			scimErr.Detail = err.Error()
+		case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+			// let the standard handler deal with timeouts and disconnects
+			return errutil.HTTPError(ctx, w, err)
+		default:
+			log.Log(ctx, err)
+			scimErr.Status = http.StatusInternalServerError
+			scimErr.Detail = http.StatusText(http.StatusInternalServerError)
+		}
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(scimErr.Status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Schemas  []string `json:"schemas"`
+		Status   string   `json:"status"`
+		SCIMType string   `json:"scimType,omitempty"`
+		Detail   string   `json:"detail,omitempty"`
+	}{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(scimErr.Status),
+		SCIMType: scimErr.SCIMType,
+		Detail:   scimErr.Detail,
+	})
+
+	return true
+}
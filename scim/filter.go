@@ -0,0 +1,56 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// filter is a parsed SCIM filter expression. Only a single equality comparison is supported,
+// which is what identity providers use to look up existing resources before provisioning.
+type filter struct {
+	Attr  string
+	Value string
+}
+
+// parseFilter will parse a filter of the form `attr eq "value"`. The attribute name is returned
+// in its canonical form from the list of supported attributes.
+func parseFilter(s string, attrs ...string) (*filter, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	f, err := parseComparison(s)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range attrs {
+		if strings.EqualFold(a, f.Attr) {
+			f.Attr = a
+			return f, nil
+		}
+	}
+
+	return nil, badRequest("invalidFilter", "filtering is only supported on: "+strings.Join(attrs, ", "))
+}
+
+// parseComparison will parse an `attr eq value` expression, where value is any JSON literal.
+func parseComparison(s string) (*filter, error) {
+	name, rest, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return nil, badRequest("invalidFilter", "filter must be of the form: attribute eq \"value\"")
+	}
+	op, rest, ok := strings.Cut(strings.TrimLeft(rest, " "), " ")
+	if !ok || !strings.EqualFold(op, "eq") {
+		return nil, badRequest("invalidFilter", "only the eq operator is supported")
+	}
+
+	var v any
+	err := json.Unmarshal([]byte(strings.TrimSpace(rest)), &v)
+	if err != nil || v == nil {
+		return nil, badRequest("invalidFilter", "filter value must be a quoted string, number, or boolean")
+	}
+
+	return &filter{Attr: name, Value: fmt.Sprint(v)}, nil
+}
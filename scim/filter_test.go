@@ -0,0 +1,35 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	f, err := parseFilter("")
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	f, err = parseFilter(`USERNAME eq "Alice@Example.com"`, "userName", "externalId")
+	require.NoError(t, err)
+	assert.Equal(t, &filter{Attr: "userName", Value: "Alice@Example.com"}, f)
+
+	f, err = parseFilter(`active eq true`, "active")
+	require.NoError(t, err)
+	assert.Equal(t, &filter{Attr: "active", Value: "true"}, f)
+
+	check := func(s string) {
+		t.Helper()
+		_, err := parseFilter(s, "userName")
+		var scimErr *Error
+		require.ErrorAs(t, err, &scimErr, s)
+		assert.Equal(t, "invalidFilter", scimErr.SCIMType, s)
+	}
+	check(`userName`)
+	check(`userName co "foo"`)
+	check(`userName eq foo`)
+	check(`userName eq null`)
+	check(`emails eq "foo"`)
+}
@@ -0,0 +1,415 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+const groupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+
+// Group is the SCIM representation of a group, which is backed by a rotation.
+type Group struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []multiValue `json:"members,omitempty"`
+	Meta        *meta        `json:"meta,omitempty"`
+}
+
+// memberIDs returns the unique, valid user IDs of the group members, in order.
+func (g *Group) memberIDs() ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool, len(g.Members))
+	for _, m := range g.Members {
+		id, err := uuid.Parse(m.Value)
+		if err != nil {
+			return nil, badRequest("invalidValue", "invalid member: "+m.Value)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func groupFromRow(cfg config.Config, r gadb.SCIMGroupFindOneRow) *Group {
+	return &Group{
+		Schemas:     []string{groupSchema},
+		ID:          r.ID.String(),
+		ExternalID:  r.ExternalID.String,
+		DisplayName: r.Name,
+		Meta: &meta{
+			ResourceType: "Group",
+			Location:     location(cfg, "Groups", r.ID.String()),
+		},
+	}
+}
+
+// addMembers will fill in the members of each group.
+func addMembers(ctx context.Context, q *gadb.Queries, groups ...*Group) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(groups))
+	byID := make(map[uuid.UUID]*Group, len(groups))
+	for i, g := range groups {
+		ids[i] = uuid.MustParse(g.ID)
+		byID[ids[i]] = g
+	}
+
+	rows, err := q.SCIMGroupMembers(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, r := range rows {
+		g := byID[r.RotationID]
+		g.Members = append(g.Members, multiValue{Value: r.UserID.String(), Display: r.Name})
+	}
+
+	return nil
+}
+
+func (h *Handler) serveListGroups(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	f, err := parseFilter(req.URL.Query().Get("filter"), "displayName", "externalId")
+	if writeError(ctx, w, err) {
+		return
+	}
+	var nameFilter, externalIDFilter sql.NullString
+	if f != nil && f.Attr == "displayName" {
+		nameFilter = sql.NullString{String: f.Value, Valid: true}
+	}
+	if f != nil && f.Attr == "externalId" {
+		externalIDFilter = sql.NullString{String: f.Value, Valid: true}
+	}
+	startIndex, count := pagination(req)
+
+	q := gadb.New(h.c.DB)
+	total, err := q.SCIMGroupCount(ctx, gadb.SCIMGroupCountParams{DisplayName: nameFilter, ExternalID: externalIDFilter})
+	if writeError(ctx, w, err) {
+		return
+	}
+	rows, err := q.SCIMGroupFindMany(ctx, gadb.SCIMGroupFindManyParams{
+		DisplayName: nameFilter,
+		ExternalID:  externalIDFilter,
+		Skip:        int32(startIndex - 1),
+		Max:         int32(count),
+	})
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	groups := make([]*Group, len(rows))
+	res := listResponse{
+		Schemas:      []string{listSchema},
+		TotalResults: int(total),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(rows),
+		Resources:    make([]any, len(rows)),
+	}
+	for i, r := range rows {
+		groups[i] = groupFromRow(cfg, gadb.SCIMGroupFindOneRow(r))
+		res.Resources[i] = groups[i]
+	}
+	if !excludes(req, "members") {
+		err = addMembers(ctx, q, groups...)
+		if writeError(ctx, w, err) {
+			return
+		}
+	}
+
+	writeJSON(w, req, http.StatusOK, res)
+}
+
+func (h *Handler) findGroup(ctx context.Context, q *gadb.Queries, id uuid.UUID, withMembers bool) (*Group, error) {
+	row, err := q.SCIMGroupFindOne(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, notFound("group not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g := groupFromRow(config.FromContext(ctx), row)
+	if withMembers {
+		err = addMembers(ctx, q, g)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+func (h *Handler) serveGetGroup(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	id, err := parseID(req)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	g, err := h.findGroup(ctx, gadb.New(h.c.DB), id, !excludes(req, "members"))
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	writeJSON(w, req, http.StatusOK, g)
+}
+
+// setMembers will update the rotation participants to match the group members. New members are added
+// to the end of the rotation, and removed members are removed the same way as when a user is deleted.
+//
+// Members that don't exist or are deactivated are ignored.
+func (h *Handler) setMembers(ctx context.Context, tx *sql.Tx, rotationID uuid.UUID, g *Group) error {
+	ids, err := g.memberIDs()
+	if err != nil {
+		return err
+	}
+	q := gadb.New(tx)
+	valid, err := q.SCIMUsersExist(ctx, ids)
+	if err != nil {
+		return err
+	}
+	want := make(map[uuid.UUID]bool, len(valid))
+	for _, id := range valid {
+		want[id] = true
+	}
+
+	cur, err := q.SCIMGroupMembers(ctx, []uuid.UUID{rotationID})
+	if err != nil {
+		return err
+	}
+	have := make(map[uuid.UUID]bool, len(cur))
+	for _, m := range cur {
+		have[m.UserID] = true
+		if want[m.UserID] {
+			continue
+		}
+		err = h.c.UserStore.RemoveFromRotationTx(ctx, tx, m.UserID.String(), rotationID.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	var add []string
+	for _, id := range ids {
+		if want[id] && !have[id] {
+			add = append(add, id.String())
+		}
+	}
+	for len(add) > 0 {
+		n := min(len(add), 50)
+		err = h.c.RotationStore.AddRotationUsersTx(ctx, tx, rotationID.String(), add[:n])
+		if err != nil {
+			return err
+		}
+		add = add[n:]
+	}
+
+	return nil
+}
+
+func validateGroup(g *Group) error {
+	g.DisplayName = strings.TrimSpace(g.DisplayName)
+	if g.DisplayName == "" {
+		return badRequest("invalidValue", "displayName is required")
+	}
+	return validate.IDName("displayName", g.DisplayName)
+}
+
+// serveCreateGroup will link an existing rotation with the same name as the group, or create
+// a new weekly rotation.
+func (h *Handler) serveCreateGroup(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var g Group
+	err := readJSON(req, &g)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = validateGroup(&g)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	tx, err := h.c.DB.BeginTx(ctx, nil)
+	if writeError(ctx, w, err) {
+		return
+	}
+	defer sqlutil.Rollback(ctx, "scim: create group", tx)
+	q := gadb.New(tx)
+
+	rotID, err := q.SCIMRotationFindByName(ctx, g.DisplayName)
+	if errors.Is(err, sql.ErrNoRows) {
+		var rot *rotation.Rotation
+		rot, err = h.c.RotationStore.CreateRotationTx(ctx, tx, &rotation.Rotation{
+			Name:        g.DisplayName,
+			Description: "Managed by SCIM provisioning.",
+			Type:        rotation.TypeWeekly,
+			ShiftLength: 1,
+			Start:       time.Now().UTC(),
+		})
+		if rot != nil {
+			rotID = uuid.MustParse(rot.ID)
+		}
+	}
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	err = q.SCIMGroupInsert(ctx, gadb.SCIMGroupInsertParams{
+		RotationID: rotID,
+		ExternalID: sql.NullString{String: g.ExternalID, Valid: g.ExternalID != ""},
+	})
+	if isUniqueViolation(err, "scim_groups_pkey") {
+		err = conflict("a group with this displayName already exists")
+	}
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = h.setMembers(ctx, tx, rotID, &g)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	res, err := h.findGroup(ctx, q, rotID, true)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = tx.Commit()
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	w.Header().Set("Location", res.Meta.Location)
+	writeJSON(w, req, http.StatusCreated, res)
+}
+
+// modifyGroup will lock the rotation for update, and call fn with the current value. The returned
+// group is then applied and the updated group is written to the response.
+func (h *Handler) modifyGroup(w http.ResponseWriter, req *http.Request, fn func(cur *Group) (*Group, error)) {
+	ctx := req.Context()
+	id, err := parseID(req)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	tx, err := h.c.DB.BeginTx(ctx, nil)
+	if writeError(ctx, w, err) {
+		return
+	}
+	defer sqlutil.Rollback(ctx, "scim: update group", tx)
+	q := gadb.New(tx)
+
+	cur, err := h.findGroup(ctx, q, id, true)
+	if writeError(ctx, w, err) {
+		return
+	}
+	rot, err := h.c.RotationStore.FindRotationForUpdateTx(ctx, tx, id.String())
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	next, err := fn(cur)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = validateGroup(next)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	if next.DisplayName != rot.Name {
+		rot.Name = next.DisplayName
+		err = h.c.RotationStore.UpdateRotationTx(ctx, tx, rot)
+		if writeError(ctx, w, err) {
+			return
+		}
+	}
+	err = q.SCIMGroupUpdate(ctx, gadb.SCIMGroupUpdateParams{
+		RotationID: id,
+		ExternalID: sql.NullString{String: next.ExternalID, Valid: next.ExternalID != ""},
+	})
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = h.setMembers(ctx, tx, id, next)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	res, err := h.findGroup(ctx, q, id, true)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = tx.Commit()
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	writeJSON(w, req, http.StatusOK, res)
+}
+
+func (h *Handler) serveReplaceGroup(w http.ResponseWriter, req *http.Request) {
+	h.modifyGroup(w, req, func(cur *Group) (*Group, error) {
+		var g Group
+		err := readJSON(req, &g)
+		if err != nil {
+			return nil, err
+		}
+
+		return &g, nil
+	})
+}
+
+func (h *Handler) servePatchGroup(w http.ResponseWriter, req *http.Request) {
+	h.modifyGroup(w, req, func(cur *Group) (*Group, error) {
+		var p patchRequest
+		err := readJSON(req, &p)
+		if err != nil {
+			return nil, err
+		}
+
+		return patchResource(groupSchema, cur, new(Group), p.Operations)
+	})
+}
+
+// serveDeleteGroup will unlink the rotation from SCIM. The rotation itself is kept, as it may
+// still be used by escalation policies and schedules.
+func (h *Handler) serveDeleteGroup(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	id, err := parseID(req)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	q := gadb.New(h.c.DB)
+	_, err = h.findGroup(ctx, q, id, false)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = q.SCIMGroupDelete(ctx, id)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,172 @@
+package scim
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/target/goalert/apikey"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/util/log"
+)
+
+const (
+	// BasePath is the path all SCIM endpoints are served under.
+	BasePath = "/api/v2/scim"
+
+	contentType = "application/scim+json"
+
+	listSchema     = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	spConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	// maxResults is the maximum number of resources returned in a single list response.
+	maxResults = 100
+)
+
+// Handler serves the SCIM 2.0 provisioning API, for managing users and groups from
+// an identity provider.
+//
+// Users map directly to GoAlert users. Groups map to rotations, with group members
+// being the rotation participants.
+type Handler struct {
+	c   Config
+	mux *http.ServeMux
+}
+
+// NewHandler creates a new Handler with the given config.
+func NewHandler(c Config) *Handler {
+	h := &Handler{c: c, mux: http.NewServeMux()}
+
+	h.mux.HandleFunc("GET "+BasePath+"/ServiceProviderConfig", h.serveServiceProviderConfig)
+
+	h.mux.HandleFunc("GET "+BasePath+"/Users", h.serveListUsers)
+	h.mux.HandleFunc("POST "+BasePath+"/Users", h.serveCreateUser)
+	h.mux.HandleFunc("GET "+BasePath+"/Users/{id}", h.serveGetUser)
+	h.mux.HandleFunc("PUT "+BasePath+"/Users/{id}", h.serveReplaceUser)
+	h.mux.HandleFunc("PATCH "+BasePath+"/Users/{id}", h.servePatchUser)
+	h.mux.HandleFunc("DELETE "+BasePath+"/Users/{id}", h.serveDeleteUser)
+
+	h.mux.HandleFunc("GET "+BasePath+"/Groups", h.serveListGroups)
+	h.mux.HandleFunc("POST "+BasePath+"/Groups", h.serveCreateGroup)
+	h.mux.HandleFunc("GET "+BasePath+"/Groups/{id}", h.serveGetGroup)
+	h.mux.HandleFunc("PUT "+BasePath+"/Groups/{id}", h.serveReplaceGroup)
+	h.mux.HandleFunc("PATCH "+BasePath+"/Groups/{id}", h.servePatchGroup)
+	h.mux.HandleFunc("DELETE "+BasePath+"/Groups/{id}", h.serveDeleteGroup)
+
+	h.mux.HandleFunc(BasePath+"/", func(w http.ResponseWriter, req *http.Request) {
+		writeError(req.Context(), w, notFound("unknown endpoint"))
+	})
+
+	return h
+}
+
+// ServeHTTP implements the http.Handler interface. Requests must be authenticated with an API key
+// created for SCIM, which is checked here in addition to the role so that session cookies can't be used.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+	if !cfg.SCIM.Enable {
+		writeError(ctx, w, notFound("SCIM provisioning is disabled"))
+		return
+	}
+
+	pol := apikey.PolicyFromContext(ctx)
+	if pol == nil || !pol.SCIM {
+		writeError(ctx, w, permission.Unauthorized())
+		return
+	}
+
+	h.mux.ServeHTTP(w, req)
+}
+
+// location returns the absolute URL of a resource.
+func location(cfg config.Config, resourceType, id string) string {
+	return cfg.CallbackURL(BasePath + "/" + resourceType + "/" + id)
+}
+
+// readJSON will decode the request body into v.
+func readJSON(req *http.Request, v any) error {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(data, v)
+	if err != nil {
+		return badRequest("invalidSyntax", "invalid JSON body: "+err.Error())
+	}
+
+	return nil
+}
+
+// writeJSON will respond with v as a SCIM JSON document.
+func writeJSON(w http.ResponseWriter, req *http.Request, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Log(req.Context(), err)
+	}
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// pagination returns the 1-based startIndex and count from the request query parameters.
+func pagination(req *http.Request) (startIndex, count int) {
+	startIndex, _ = strconv.Atoi(req.URL.Query().Get("startIndex"))
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	count = maxResults
+	if v := req.URL.Query().Get("count"); v != "" {
+		count, _ = strconv.Atoi(v)
+	}
+	if count < 0 {
+		count = 0
+	}
+	if count > maxResults {
+		count = maxResults
+	}
+
+	return startIndex, count
+}
+
+// excludes returns true if the attribute is listed in the excludedAttributes query parameter.
+func excludes(req *http.Request, attr string) bool {
+	for _, a := range strings.Split(req.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(a), attr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Handler) serveServiceProviderConfig(w http.ResponseWriter, req *http.Request) {
+	type supported struct {
+		Supported bool `json:"supported"`
+	}
+	writeJSON(w, req, http.StatusOK, map[string]any{
+		"schemas":        []string{spConfigSchema},
+		"patch":          supported{Supported: true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxResults},
+		"changePassword": supported{},
+		"sort":           supported{},
+		"etag":           supported{},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication using a GoAlert admin API key created for SCIM.",
+			"primary":     true,
+		}},
+	})
+}
@@ -0,0 +1,248 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const patchOpSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+
+// patchRequest is the body of a SCIM PATCH request.
+type patchRequest struct {
+	Schemas    []string  `json:"schemas"`
+	Operations []patchOp `json:"Operations"`
+}
+
+type patchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// patchPath is a parsed attribute path, e.g. `emails[type eq "work"].value`.
+type patchPath struct {
+	Attr   string
+	Filter *filter
+	Sub    string
+}
+
+// parsePath will parse a patch operation path. Attributes of the given core schema may be
+// fully qualified; attributes of any other schema (e.g., extensions) return nil and are ignored.
+func parsePath(schema, p string) (*patchPath, error) {
+	if strings.HasPrefix(strings.ToLower(p), "urn:") {
+		if len(p) <= len(schema) || !strings.EqualFold(p[:len(schema)+1], schema+":") {
+			return nil, nil
+		}
+		p = p[len(schema)+1:]
+	}
+
+	var res patchPath
+	if name, rest, ok := strings.Cut(p, "["); ok {
+		expr, sub, ok := strings.Cut(rest, "]")
+		if !ok {
+			return nil, badRequest("invalidPath", "unterminated filter in path: "+p)
+		}
+		f, err := parseComparison(expr)
+		if err != nil {
+			return nil, badRequest("invalidPath", err.Error())
+		}
+		if sub != "" {
+			sub, ok = strings.CutPrefix(sub, ".")
+			if !ok || sub == "" {
+				return nil, badRequest("invalidPath", "invalid sub-attribute in path: "+p)
+			}
+		}
+		res = patchPath{Attr: name, Filter: f, Sub: sub}
+	} else {
+		res.Attr, res.Sub, _ = strings.Cut(p, ".")
+	}
+	if res.Attr == "" || strings.ContainsAny(res.Attr+res.Sub, "[]. ") {
+		return nil, badRequest("invalidPath", "invalid path: "+p)
+	}
+
+	return &res, nil
+}
+
+// findKey returns the key in m matching name, ignoring case, or name if there is none.
+func findKey(m map[string]any, name string) string {
+	if _, ok := m[name]; ok {
+		return name
+	}
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+// merge will set all values from src in dst, with keys matched ignoring case.
+func merge(dst, src map[string]any) {
+	for k, v := range src {
+		dst[findKey(dst, k)] = v
+	}
+}
+
+func matches(m map[string]any, f *filter) bool {
+	v, ok := m[findKey(m, f.Attr)]
+	if !ok || v == nil {
+		return false
+	}
+	return strings.EqualFold(fmt.Sprint(v), f.Value)
+}
+
+// removeValues returns arr without any elements with a `value` matching one in vals.
+func removeValues(arr []any, vals []any) []any {
+	var out []any
+	for _, e := range arr {
+		m, ok := e.(map[string]any)
+		if !ok {
+			out = append(out, e)
+			continue
+		}
+		var found bool
+		for _, v := range vals {
+			vm, ok := v.(map[string]any)
+			if ok && matches(m, &filter{Attr: "value", Value: fmt.Sprint(vm[findKey(vm, "value")])}) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// applyPatch will apply the operations to the JSON representation of a resource.
+func applyPatch(schema string, res map[string]any, ops []patchOp) error {
+	for _, op := range ops {
+		name := strings.ToLower(op.Op)
+		switch name {
+		case "add", "replace", "remove":
+		default:
+			return badRequest("invalidSyntax", "unsupported patch operation: "+op.Op)
+		}
+
+		var val any
+		if len(op.Value) > 0 {
+			err := json.Unmarshal(op.Value, &val)
+			if err != nil {
+				return badRequest("invalidSyntax", "invalid patch value: "+err.Error())
+			}
+		}
+
+		if op.Path != "" {
+			err := applyPath(schema, res, name, op.Path, val)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if name == "remove" {
+			return badRequest("noTarget", "path is required for remove operations")
+		}
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return badRequest("invalidValue", "value must be an object when path is omitted")
+		}
+		for k, v := range obj {
+			err := applyPath(schema, res, name, k, v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func applyPath(schema string, res map[string]any, op, path string, val any) error {
+	p, err := parsePath(schema, path)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		// unsupported schema extension
+		return nil
+	}
+	key := findKey(res, p.Attr)
+
+	if p.Filter == nil && p.Sub != "" {
+		obj, _ := res[key].(map[string]any)
+		if obj == nil {
+			obj = make(map[string]any)
+		}
+		err = applyPath(schema, obj, op, p.Sub, val)
+		if err != nil {
+			return err
+		}
+		res[key] = obj
+		return nil
+	}
+
+	if p.Filter == nil {
+		arr, isArr := res[key].([]any)
+		obj, isObj := res[key].(map[string]any)
+		valArr, valIsArr := val.([]any)
+		valObj, valIsObj := val.(map[string]any)
+		switch {
+		case op == "remove" && isArr && valIsArr:
+			res[key] = removeValues(arr, valArr)
+		case op == "remove":
+			delete(res, key)
+		case op == "add" && isArr && valIsArr:
+			res[key] = append(arr, valArr...)
+		case op == "add" && isArr && valIsObj:
+			res[key] = append(arr, valObj)
+		case isObj && valIsObj:
+			merge(obj, valObj)
+		default:
+			res[key] = val
+		}
+		return nil
+	}
+
+	arr, _ := res[key].([]any)
+	var out []any
+	var matched bool
+	for _, e := range arr {
+		m, ok := e.(map[string]any)
+		if !ok || !matches(m, p.Filter) {
+			out = append(out, e)
+			continue
+		}
+		matched = true
+		switch {
+		case op == "remove" && p.Sub == "":
+			continue
+		case op == "remove":
+			delete(m, findKey(m, p.Sub))
+		case p.Sub != "":
+			m[findKey(m, p.Sub)] = val
+		default:
+			valObj, ok := val.(map[string]any)
+			if !ok {
+				return badRequest("invalidValue", "value must be an object for path: "+path)
+			}
+			merge(m, valObj)
+		}
+		out = append(out, m)
+	}
+	if !matched && op != "remove" {
+		// add (or replace) a new value matching the filter
+		m := map[string]any{p.Filter.Attr: p.Filter.Value}
+		if p.Sub != "" {
+			m[p.Sub] = val
+		} else if valObj, ok := val.(map[string]any); ok {
+			merge(m, valObj)
+		}
+		out = append(out, m)
+	}
+	res[key] = out
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePath(t *testing.T) {
+	p, err := parsePath(userSchema, "userName")
+	require.NoError(t, err)
+	assert.Equal(t, &patchPath{Attr: "userName"}, p)
+
+	p, err = parsePath(userSchema, userSchema+":name.givenName")
+	require.NoError(t, err)
+	assert.Equal(t, &patchPath{Attr: "name", Sub: "givenName"}, p)
+
+	p, err = parsePath(userSchema, `emails[type eq "work"].value`)
+	require.NoError(t, err)
+	assert.Equal(t, &patchPath{Attr: "emails", Filter: &filter{Attr: "type", Value: "work"}, Sub: "value"}, p)
+
+	p, err = parsePath(userSchema, "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department")
+	require.NoError(t, err)
+	assert.Nil(t, p, "extension attributes are ignored")
+
+	for _, s := range []string{"", `emails[type eq "work"`, `emails[type eq "work"]value`, "a.b.c"} {
+		_, err = parsePath(userSchema, s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestPatchResource(t *testing.T) {
+	check := func(desc string, cur *User, ops string, exp *User) {
+		t.Helper()
+		var p patchRequest
+		require.NoError(t, json.Unmarshal([]byte(`{"Operations":`+ops+`}`), &p), desc)
+		res, err := patchResource(userSchema, cur, new(User), p.Operations)
+		require.NoError(t, err, desc)
+		assert.Equal(t, exp, res, desc)
+	}
+	active := flexBool(true)
+	inactive := flexBool(false)
+
+	check("replace active (string value)",
+		&User{UserName: "alice", Active: &active},
+		`[{"op":"Replace","path":"active","value":"False"}]`,
+		&User{UserName: "alice", Active: &inactive},
+	)
+	check("no path",
+		&User{UserName: "alice", DisplayName: "Alice"},
+		`[{"op":"replace","value":{"displayName":"Alice Smith","name.givenName":"Alice"}}]`,
+		&User{UserName: "alice", DisplayName: "Alice Smith", Name: &userName{GivenName: "Alice"}},
+	)
+	check("filtered sub-attribute",
+		&User{UserName: "alice", Emails: []multiValue{{Value: "a@example.com", Type: "work"}, {Value: "b@example.com", Type: "home"}}},
+		`[{"op":"replace","path":"emails[type eq \"work\"].value","value":"c@example.com"}]`,
+		&User{UserName: "alice", Emails: []multiValue{{Value: "c@example.com", Type: "work"}, {Value: "b@example.com", Type: "home"}}},
+	)
+	check("add missing filtered value",
+		&User{UserName: "alice"},
+		`[{"op":"add","path":"emails[type eq \"work\"].value","value":"a@example.com"}]`,
+		&User{UserName: "alice", Emails: []multiValue{{Value: "a@example.com", Type: "work"}}},
+	)
+
+	var p patchRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"Operations":[{"op":"remove"}]}`), &p))
+	_, err := patchResource(userSchema, &User{}, new(User), p.Operations)
+	var scimErr *Error
+	require.ErrorAs(t, err, &scimErr)
+	assert.Equal(t, "noTarget", scimErr.SCIMType)
+}
+
+func TestPatchResource_Members(t *testing.T) {
+	cur := &Group{DisplayName: "ops", Members: []multiValue{{Value: "a"}, {Value: "b"}}}
+	var p patchRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"Operations":[
+		{"op":"remove","path":"members","value":[{"value":"a"}]},
+		{"op":"add","path":"members","value":[{"value":"c"}]},
+		{"op":"remove","path":"members[value eq \"b\"]"}
+	]}`), &p))
+
+	res, err := patchResource(groupSchema, cur, new(Group), p.Operations)
+	require.NoError(t, err)
+	assert.Equal(t, []multiValue{{Value: "c"}}, res.Members)
+}
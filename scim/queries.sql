@@ -0,0 +1,208 @@
+-- name: SCIMUserFindMany :many
+-- Get SCIM provisioned users, optionally filtered by userName or externalId.
+SELECT
+    u.id,
+    u.name,
+    u.email,
+    u.role,
+    u.deactivated_at,
+    s.user_name,
+    s.external_id
+FROM
+    scim_users s
+    JOIN users u ON u.id = s.user_id
+WHERE (sqlc.narg(user_name)::text IS NULL
+    OR lower(s.user_name) = lower(sqlc.narg(user_name)))
+AND (sqlc.narg(external_id)::text IS NULL
+    OR s.external_id = sqlc.narg(external_id))
+ORDER BY
+    lower(s.user_name)
+OFFSET @skip
+LIMIT @max;
+
+-- name: SCIMUserCount :one
+-- Count SCIM provisioned users, with the same filters as SCIMUserFindMany.
+SELECT
+    count(*)
+FROM
+    scim_users s
+WHERE (sqlc.narg(user_name)::text IS NULL
+    OR lower(s.user_name) = lower(sqlc.narg(user_name)))
+AND (sqlc.narg(external_id)::text IS NULL
+    OR s.external_id = sqlc.narg(external_id));
+
+-- name: SCIMUserFindOne :one
+SELECT
+    u.id,
+    u.name,
+    u.email,
+    u.role,
+    u.deactivated_at,
+    s.user_name,
+    s.external_id
+FROM
+    scim_users s
+    JOIN users u ON u.id = s.user_id
+WHERE
+    s.user_id = $1;
+
+-- name: SCIMUserFindOneForUpdate :one
+SELECT
+    u.id,
+    u.name,
+    u.email,
+    u.role,
+    u.deactivated_at,
+    s.user_name,
+    s.external_id
+FROM
+    scim_users s
+    JOIN users u ON u.id = s.user_id
+WHERE
+    s.user_id = $1
+FOR UPDATE;
+
+-- name: SCIMUserInsert :exec
+INSERT INTO scim_users(user_id, user_name, external_id)
+    VALUES ($1, $2, $3);
+
+-- name: SCIMUserUpdate :exec
+UPDATE
+    scim_users
+SET
+    user_name = $2,
+    external_id = $3
+WHERE
+    user_id = $1;
+
+-- name: SCIMUserDelete :exec
+DELETE FROM scim_users
+WHERE user_id = $1;
+
+-- name: SCIMUnlinkedUserBySubject :one
+-- Find an existing user, not yet provisioned by SCIM, by their login subject.
+SELECT
+    a.user_id
+FROM
+    auth_subjects a
+WHERE
+    a.provider_id = $1
+    AND a.subject_id = $2
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            scim_users s
+        WHERE
+            s.user_id = a.user_id);
+
+-- name: SCIMAuthSubjectInsert :exec
+INSERT INTO auth_subjects(provider_id, subject_id, user_id)
+    VALUES ($1, $2, $3)
+ON CONFLICT (provider_id, subject_id)
+    DO NOTHING;
+
+-- name: SCIMAuthSubjectDelete :exec
+DELETE FROM auth_subjects
+WHERE provider_id = $1
+    AND subject_id = $2
+    AND user_id = $3;
+
+-- name: SCIMGroupFindMany :many
+-- Get rotations linked to SCIM groups, optionally filtered by displayName or externalId.
+SELECT
+    r.id,
+    r.name,
+    g.external_id
+FROM
+    scim_groups g
+    JOIN rotations r ON r.id = g.rotation_id
+WHERE (sqlc.narg(display_name)::text IS NULL
+    OR lower(r.name) = lower(sqlc.narg(display_name)))
+AND (sqlc.narg(external_id)::text IS NULL
+    OR g.external_id = sqlc.narg(external_id))
+ORDER BY
+    lower(r.name)
+OFFSET @skip
+LIMIT @max;
+
+-- name: SCIMGroupCount :one
+-- Count rotations linked to SCIM groups, with the same filters as SCIMGroupFindMany.
+SELECT
+    count(*)
+FROM
+    scim_groups g
+    JOIN rotations r ON r.id = g.rotation_id
+WHERE (sqlc.narg(display_name)::text IS NULL
+    OR lower(r.name) = lower(sqlc.narg(display_name)))
+AND (sqlc.narg(external_id)::text IS NULL
+    OR g.external_id = sqlc.narg(external_id));
+
+-- name: SCIMGroupFindOne :one
+SELECT
+    r.id,
+    r.name,
+    g.external_id
+FROM
+    scim_groups g
+    JOIN rotations r ON r.id = g.rotation_id
+WHERE
+    g.rotation_id = $1;
+
+-- name: SCIMGroupMembers :many
+-- Get the distinct users of each rotation, in participant order.
+SELECT
+    p.rotation_id,
+    p.user_id,
+    u.name
+FROM (
+    SELECT DISTINCT ON (rp.rotation_id, rp.user_id)
+        rp.rotation_id,
+        rp.user_id,
+        rp.position
+    FROM
+        rotation_participants rp
+    WHERE
+        rp.rotation_id = ANY (@rotation_ids::uuid[])
+    ORDER BY
+        rp.rotation_id,
+        rp.user_id,
+        rp.position) p
+    JOIN users u ON u.id = p.user_id
+ORDER BY
+    p.rotation_id,
+    p.position;
+
+-- name: SCIMGroupInsert :exec
+INSERT INTO scim_groups(rotation_id, external_id)
+    VALUES ($1, $2);
+
+-- name: SCIMGroupUpdate :exec
+UPDATE
+    scim_groups
+SET
+    external_id = $2
+WHERE
+    rotation_id = $1;
+
+-- name: SCIMGroupDelete :exec
+DELETE FROM scim_groups
+WHERE rotation_id = $1;
+
+-- name: SCIMRotationFindByName :one
+SELECT
+    id
+FROM
+    rotations
+WHERE
+    lower(name) = lower(@name);
+
+-- name: SCIMUsersExist :many
+-- Get the IDs of the given users that exist and are not deactivated.
+SELECT
+    id
+FROM
+    users
+WHERE
+    id = ANY (@user_ids::uuid[])
+    AND deactivated_at IS NULL;
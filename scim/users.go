@@ -0,0 +1,578 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+const userSchema = "urn:ietf:params:scim:schemas:core:2.0:User"
+
+// flexBool is a boolean that also accepts the strings "true" and "false", as some
+// identity providers send them that way.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		switch strings.ToLower(s) {
+		case "true":
+			*b = true
+			return nil
+		case "false":
+			*b = false
+			return nil
+		}
+	}
+
+	var v bool
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return badRequest("invalidValue", "expected a boolean value")
+	}
+	*b = flexBool(v)
+	return nil
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+type multiValue struct {
+	Value   string   `json:"value"`
+	Display string   `json:"display,omitempty"`
+	Type    string   `json:"type,omitempty"`
+	Primary flexBool `json:"primary,omitempty"`
+}
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// User is the SCIM representation of a user.
+type User struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        *userName    `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Emails      []multiValue `json:"emails,omitempty"`
+	Active      *flexBool    `json:"active,omitempty"`
+	Roles       []multiValue `json:"roles,omitempty"`
+	Meta        *meta        `json:"meta,omitempty"`
+}
+
+// primary returns the primary value, or the first if none are marked primary.
+func primary(vals []multiValue) string {
+	for _, v := range vals {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	if len(vals) > 0 {
+		return vals[0].Value
+	}
+	return ""
+}
+
+// fullName returns the name to use for the GoAlert user. If prev is set, attributes that
+// changed from prev are preferred, so that updating only part of the name is applied.
+func (u *User) fullName(prev *User) string {
+	var name, prevName userName
+	var prevDisplayName string
+	if u.Name != nil {
+		name = *u.Name
+	}
+	if prev != nil {
+		prevDisplayName = prev.DisplayName
+		if prev.Name != nil {
+			prevName = *prev.Name
+		}
+	}
+	changed := func(a, b string) bool { return prev == nil || a != b }
+
+	given := strings.TrimSpace(name.GivenName + " " + name.FamilyName)
+	switch {
+	case u.DisplayName != "" && changed(u.DisplayName, prevDisplayName):
+		return u.DisplayName
+	case name.Formatted != "" && changed(name.Formatted, prevName.Formatted):
+		return name.Formatted
+	case given != "" && changed(given, strings.TrimSpace(prevName.GivenName+" "+prevName.FamilyName)):
+		return given
+	case prevDisplayName != "":
+		return prevDisplayName
+	}
+
+	return u.UserName
+}
+
+// role returns the GoAlert role from the SCIM roles attribute. If no roles are
+// provided, false is returned.
+func (u *User) role() (permission.Role, bool) {
+	if u.Roles == nil {
+		return "", false
+	}
+	for _, r := range u.Roles {
+		if strings.EqualFold(r.Value, string(permission.RoleAdmin)) {
+			return permission.RoleAdmin, true
+		}
+	}
+	return permission.RoleUser, true
+}
+
+func (u *User) isActive() bool { return u.Active == nil || bool(*u.Active) }
+
+// authSubject returns the subject ID to link for the configured login provider.
+func (u *User) authSubject(cfg config.Config) string {
+	if cfg.SCIM.AuthSubjectID == "externalId" {
+		return u.ExternalID
+	}
+	return u.UserName
+}
+
+func userFromRow(cfg config.Config, r gadb.SCIMUserFindOneRow) *User {
+	active := flexBool(!r.DeactivatedAt.Valid)
+	u := &User{
+		Schemas:     []string{userSchema},
+		ID:          r.ID.String(),
+		ExternalID:  r.ExternalID.String,
+		UserName:    r.UserName,
+		Name:        &userName{Formatted: r.Name},
+		DisplayName: r.Name,
+		Active:      &active,
+		Roles:       []multiValue{{Value: string(r.Role), Primary: true}},
+		Meta: &meta{
+			ResourceType: "User",
+			Location:     location(cfg, "Users", r.ID.String()),
+		},
+	}
+	if r.Email != "" {
+		u.Emails = []multiValue{{Value: r.Email, Type: "work", Primary: true}}
+	}
+
+	return u
+}
+
+func (h *Handler) serveListUsers(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	f, err := parseFilter(req.URL.Query().Get("filter"), "userName", "externalId")
+	if writeError(ctx, w, err) {
+		return
+	}
+	var userNameFilter, externalIDFilter sql.NullString
+	if f != nil && f.Attr == "userName" {
+		userNameFilter = sql.NullString{String: f.Value, Valid: true}
+	}
+	if f != nil && f.Attr == "externalId" {
+		externalIDFilter = sql.NullString{String: f.Value, Valid: true}
+	}
+	startIndex, count := pagination(req)
+
+	q := gadb.New(h.c.DB)
+	total, err := q.SCIMUserCount(ctx, gadb.SCIMUserCountParams{UserName: userNameFilter, ExternalID: externalIDFilter})
+	if writeError(ctx, w, err) {
+		return
+	}
+	rows, err := q.SCIMUserFindMany(ctx, gadb.SCIMUserFindManyParams{
+		UserName:   userNameFilter,
+		ExternalID: externalIDFilter,
+		Skip:       int32(startIndex - 1),
+		Max:        int32(count),
+	})
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	res := listResponse{
+		Schemas:      []string{listSchema},
+		TotalResults: int(total),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(rows),
+		Resources:    make([]any, len(rows)),
+	}
+	for i, r := range rows {
+		res.Resources[i] = userFromRow(cfg, gadb.SCIMUserFindOneRow(r))
+	}
+
+	writeJSON(w, req, http.StatusOK, res)
+}
+
+func parseID(req *http.Request) (uuid.UUID, error) {
+	id, err := uuid.Parse(req.PathValue("id"))
+	if err != nil {
+		return uuid.Nil, notFound("resource not found")
+	}
+	return id, nil
+}
+
+func (h *Handler) findUser(ctx context.Context, id uuid.UUID) (*User, error) {
+	row, err := gadb.New(h.c.DB).SCIMUserFindOne(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, notFound("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return userFromRow(config.FromContext(ctx), row), nil
+}
+
+func (h *Handler) serveGetUser(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	id, err := parseID(req)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	u, err := h.findUser(ctx, id)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	writeJSON(w, req, http.StatusOK, u)
+}
+
+// linkSubject will update the auth subject for the configured login provider, if the subject
+// ID has changed from prev.
+func linkSubject(ctx context.Context, q *gadb.Queries, userID uuid.UUID, prev, next *User) error {
+	cfg := config.FromContext(ctx)
+	if cfg.SCIM.AuthProvider == "" {
+		return nil
+	}
+
+	var prevSub string
+	if prev != nil {
+		prevSub = prev.authSubject(cfg)
+	}
+	sub := next.authSubject(cfg)
+	if sub == prevSub {
+		return nil
+	}
+
+	if prevSub != "" {
+		err := q.SCIMAuthSubjectDelete(ctx, gadb.SCIMAuthSubjectDeleteParams{ProviderID: cfg.SCIM.AuthProvider, SubjectID: prevSub, UserID: userID})
+		if err != nil {
+			return err
+		}
+	}
+	if sub == "" {
+		return nil
+	}
+
+	return q.SCIMAuthSubjectInsert(ctx, gadb.SCIMAuthSubjectInsertParams{ProviderID: cfg.SCIM.AuthProvider, SubjectID: sub, UserID: userID})
+}
+
+func validateUser(u *User) error {
+	u.UserName = strings.TrimSpace(u.UserName)
+	if u.UserName == "" {
+		return badRequest("invalidValue", "userName is required")
+	}
+	return validate.Text("userName", u.UserName, 1, 255)
+}
+
+func (h *Handler) serveCreateUser(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+
+	var u User
+	err := readJSON(req, &u)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = validateUser(&u)
+	if writeError(ctx, w, err) {
+		return
+	}
+	if u.Active == nil {
+		active := flexBool(true)
+		u.Active = &active
+	}
+
+	tx, err := h.c.DB.BeginTx(ctx, nil)
+	if writeError(ctx, w, err) {
+		return
+	}
+	defer sqlutil.Rollback(ctx, "scim: create user", tx)
+	q := gadb.New(tx)
+
+	// Existing users that have already logged in with the linked provider are adopted, rather than duplicated.
+	var userID uuid.UUID
+	if sub := u.authSubject(cfg); cfg.SCIM.AuthProvider != "" && sub != "" {
+		userID, err = q.SCIMUnlinkedUserBySubject(ctx, gadb.SCIMUnlinkedUserBySubjectParams{ProviderID: cfg.SCIM.AuthProvider, SubjectID: sub})
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+		}
+		if writeError(ctx, w, err) {
+			return
+		}
+	}
+
+	role, hasRole := u.role()
+	if userID == uuid.Nil {
+		if !hasRole {
+			role = permission.RoleUser
+		}
+		var newUser *user.User
+		newUser, err = h.c.UserStore.InsertTx(ctx, tx, &user.User{
+			Name:  validate.SanitizeName(u.fullName(nil)),
+			Email: validate.SanitizeEmail(primary(u.Emails)),
+			Role:  role,
+		})
+		if writeError(ctx, w, err) {
+			return
+		}
+		userID = uuid.MustParse(newUser.ID)
+	}
+
+	err = q.SCIMUserInsert(ctx, gadb.SCIMUserInsertParams{
+		UserID:     userID,
+		UserName:   u.UserName,
+		ExternalID: sql.NullString{String: u.ExternalID, Valid: u.ExternalID != ""},
+	})
+	if isUniqueViolation(err, "scim_users_user_name") {
+		err = conflict("userName is already in use")
+	}
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	// apply remaining attributes, e.g., to update adopted users or provision as inactive
+	row, err := q.SCIMUserFindOneForUpdate(ctx, userID)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = h.updateUser(ctx, tx, row, &u, nil)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = linkSubject(ctx, q, userID, nil, &u)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	err = tx.Commit()
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	res, err := h.findUser(ctx, userID)
+	if writeError(ctx, w, err) {
+		return
+	}
+	w.Header().Set("Location", res.Meta.Location)
+	writeJSON(w, req, http.StatusCreated, res)
+}
+
+// updateUser will apply next to the user from row. If namePrev is set, it will be used to determine
+// which name attributes have changed.
+func (h *Handler) updateUser(ctx context.Context, tx *sql.Tx, row gadb.SCIMUserFindOneForUpdateRow, next *User, namePrev *User) error {
+	err := validateUser(next)
+	if err != nil {
+		return err
+	}
+
+	err = gadb.New(tx).SCIMUserUpdate(ctx, gadb.SCIMUserUpdateParams{
+		UserID:     row.ID,
+		UserName:   next.UserName,
+		ExternalID: sql.NullString{String: next.ExternalID, Valid: next.ExternalID != ""},
+	})
+	if isUniqueViolation(err, "scim_users_user_name") {
+		return conflict("userName is already in use")
+	}
+	if err != nil {
+		return err
+	}
+
+	err = h.c.UserStore.UpdateTx(ctx, tx, &user.User{
+		ID:    row.ID.String(),
+		Name:  validate.SanitizeName(next.fullName(namePrev)),
+		Email: validate.SanitizeEmail(primary(next.Emails)),
+		Role:  permission.Role(row.Role),
+	})
+	if err != nil {
+		return err
+	}
+
+	if role, ok := next.role(); ok && role != permission.Role(row.Role) {
+		err = h.c.UserStore.SetUserRoleTx(ctx, tx, row.ID.String(), role)
+		if err != nil {
+			return err
+		}
+	}
+
+	wasActive := !row.DeactivatedAt.Valid
+	switch {
+	case next.Active == nil:
+	case wasActive && !next.isActive():
+		err = h.c.UserStore.DeactivateTx(ctx, tx, row.ID.String())
+	case !wasActive && next.isActive():
+		err = h.c.UserStore.ReactivateTx(ctx, tx, row.ID.String())
+	}
+
+	return err
+}
+
+// modifyUser will lock the user for update, and call fn with the current value. The returned
+// user is then applied and the updated user is written to the response.
+func (h *Handler) modifyUser(w http.ResponseWriter, req *http.Request, fn func(cur *User) (next, namePrev *User, err error)) {
+	ctx := req.Context()
+	cfg := config.FromContext(ctx)
+	id, err := parseID(req)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	tx, err := h.c.DB.BeginTx(ctx, nil)
+	if writeError(ctx, w, err) {
+		return
+	}
+	defer sqlutil.Rollback(ctx, "scim: update user", tx)
+	q := gadb.New(tx)
+
+	row, err := q.SCIMUserFindOneForUpdate(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = notFound("user not found")
+	}
+	if writeError(ctx, w, err) {
+		return
+	}
+	cur := userFromRow(cfg, gadb.SCIMUserFindOneRow(row))
+
+	next, namePrev, err := fn(cur)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = h.updateUser(ctx, tx, row, next, namePrev)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = linkSubject(ctx, q, id, cur, next)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	err = tx.Commit()
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	res, err := h.findUser(ctx, id)
+	if writeError(ctx, w, err) {
+		return
+	}
+	writeJSON(w, req, http.StatusOK, res)
+}
+
+func (h *Handler) serveReplaceUser(w http.ResponseWriter, req *http.Request) {
+	h.modifyUser(w, req, func(cur *User) (*User, *User, error) {
+		var u User
+		err := readJSON(req, &u)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &u, nil, nil
+	})
+}
+
+func (h *Handler) servePatchUser(w http.ResponseWriter, req *http.Request) {
+	h.modifyUser(w, req, func(cur *User) (*User, *User, error) {
+		var p patchRequest
+		err := readJSON(req, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		next, err := patchResource(userSchema, cur, new(User), p.Operations)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return next, cur, nil
+	})
+}
+
+// patchResource will apply the patch operations to cur, decoding the result into next.
+func patchResource[T any](schema string, cur, next *T, ops []patchOp) (*T, error) {
+	data, err := json.Marshal(cur)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	err = applyPatch(schema, m, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, next)
+	if err != nil {
+		return nil, badRequest("invalidValue", err.Error())
+	}
+
+	return next, nil
+}
+
+// serveDeleteUser will deactivate the user and remove the SCIM link. The GoAlert user is kept
+// so that alert history is preserved.
+func (h *Handler) serveDeleteUser(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	id, err := parseID(req)
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	tx, err := h.c.DB.BeginTx(ctx, nil)
+	if writeError(ctx, w, err) {
+		return
+	}
+	defer sqlutil.Rollback(ctx, "scim: delete user", tx)
+	q := gadb.New(tx)
+
+	_, err = q.SCIMUserFindOneForUpdate(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = notFound("user not found")
+	}
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = h.c.UserStore.DeactivateTx(ctx, tx, id.String())
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = q.SCIMUserDelete(ctx, id)
+	if writeError(ctx, w, err) {
+		return
+	}
+	err = tx.Commit()
+	if writeError(ctx, w, err) {
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
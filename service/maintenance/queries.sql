@@ -0,0 +1,76 @@
+-- name: MaintWindow_Create :exec
+INSERT INTO service_maintenance_windows(id, service_id, description, start_time, end_time, weekday_filter, time_zone, suppress_alerts)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: MaintWindow_Update :exec
+UPDATE
+    service_maintenance_windows
+SET
+    description = $2,
+    start_time = $3,
+    end_time = $4,
+    weekday_filter = $5,
+    time_zone = $6,
+    suppress_alerts = $7
+WHERE
+    id = $1;
+
+-- name: MaintWindow_Delete :exec
+DELETE FROM service_maintenance_windows
+WHERE id = ANY (@ids::uuid[]);
+
+-- name: MaintWindow_FindOne :one
+SELECT
+    *
+FROM
+    service_maintenance_windows
+WHERE
+    id = $1;
+
+-- name: MaintWindow_FindOneForUpdate :one
+SELECT
+    *
+FROM
+    service_maintenance_windows
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: MaintWindow_FindAllByService :many
+SELECT
+    *
+FROM
+    service_maintenance_windows
+WHERE
+    service_id = $1
+ORDER BY
+    start_time,
+    id;
+
+-- name: MaintWindow_FindStarted :many
+-- Returns windows for the service that have started and may still be active, along with the current DB time.
+SELECT
+    sqlc.embed(w),
+    now()::timestamptz AS now
+FROM
+    service_maintenance_windows w
+WHERE
+    w.service_id = $1
+    AND w.start_time <= now()
+    AND (w.end_time > now()
+        OR w.weekday_filter != '{f,f,f,f,f,f,f}');
+
+-- name: MaintWindow_RecordSuppressed :exec
+INSERT INTO service_maintenance_suppressed_alerts(service_id, window_id, summary, dedup_key)
+    VALUES ($1, $2, $3, $4);
+
+-- name: MaintWindow_FindSuppressedByService :many
+SELECT
+    *
+FROM
+    service_maintenance_suppressed_alerts
+WHERE
+    service_id = $1
+ORDER BY
+    suppressed_at DESC
+LIMIT $2;
@@ -0,0 +1,171 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store manages service maintenance windows.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore creates a new Store.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	return &Store{db: db}, nil
+}
+
+func (s *Store) dbtx(tx *sql.Tx) *gadb.Queries {
+	db := gadb.New(s.db)
+	if tx == nil {
+		return db
+	}
+
+	return db.WithTx(tx)
+}
+
+// CreateTx creates a new maintenance Window.
+func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, w *Window) (*Window, error) {
+	err := permission.LimitCheckAny(ctx, permission.User, permission.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := w.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	id := uuid.New()
+	n.ID = id.String()
+
+	err = s.dbtx(tx).MaintWindow_Create(ctx, gadb.MaintWindow_CreateParams{
+		ID:             id,
+		ServiceID:      uuid.MustParse(n.ServiceID), // already validated in Normalize
+		Description:    n.Description,
+		StartTime:      n.Start,
+		EndTime:        n.End,
+		WeekdayFilter:  n.WeekdayFilter,
+		TimeZone:       n.TimeZone.String(),
+		SuppressAlerts: n.SuppressAlerts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// UpdateTx updates a maintenance Window. The service of a window can not be changed.
+func (s *Store) UpdateTx(ctx context.Context, tx *sql.Tx, w *Window) error {
+	err := permission.LimitCheckAny(ctx, permission.User, permission.Admin)
+	if err != nil {
+		return err
+	}
+
+	n, err := w.Normalize()
+	if err != nil {
+		return err
+	}
+
+	id, err := validate.ParseUUID("WindowID", n.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.dbtx(tx).MaintWindow_Update(ctx, gadb.MaintWindow_UpdateParams{
+		ID:             id,
+		Description:    n.Description,
+		StartTime:      n.Start,
+		EndTime:        n.End,
+		WeekdayFilter:  n.WeekdayFilter,
+		TimeZone:       n.TimeZone.String(),
+		SuppressAlerts: n.SuppressAlerts,
+	})
+}
+
+// DeleteTx deletes the maintenance window(s) with the given ID(s).
+func (s *Store) DeleteTx(ctx context.Context, tx *sql.Tx, idStrs ...string) error {
+	err := permission.LimitCheckAny(ctx, permission.User, permission.Admin)
+	if err != nil {
+		return err
+	}
+
+	ids, err := validate.ParseManyUUID("WindowID", idStrs, 100)
+	if err != nil {
+		return err
+	}
+
+	return s.dbtx(tx).MaintWindow_Delete(ctx, ids)
+}
+
+// FindOneTx returns a maintenance window for updating.
+func (s *Store) FindOneTx(ctx context.Context, tx *sql.Tx, idStr string) (*Window, error) {
+	err := permission.LimitCheckAny(ctx, permission.User, permission.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := validate.ParseUUID("WindowID", idStr)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.dbtx(tx).MaintWindow_FindOneForUpdate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	w := fromDB(row)
+	return &w, nil
+}
+
+// FindAllByService returns all maintenance windows for the given service, ordered by start time.
+func (s *Store) FindAllByService(ctx context.Context, serviceID string) ([]Window, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := validate.ParseUUID("ServiceID", serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.dbtx(nil).MaintWindow_FindAllByService(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Window, len(rows))
+	for i, row := range rows {
+		result[i] = fromDB(row)
+	}
+
+	return result, nil
+}
+
+// ActiveWindow returns the currently active window for the service, using the database clock, or nil if there is none.
+//
+// If more than one window is active, one that suppresses alerts is preferred.
+func ActiveWindow(ctx context.Context, db gadb.DBTX, serviceID uuid.UUID) (*Window, error) {
+	rows, err := gadb.New(db).MaintWindow_FindStarted(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	windows := make([]Window, len(rows))
+	for i, row := range rows {
+		windows[i] = fromDB(row.ServiceMaintenanceWindow)
+	}
+
+	return ActiveAt(windows, rows[0].Now), nil
+}
@@ -0,0 +1,72 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxSuppressedAlerts is the maximum number of suppressed alerts returned for a service.
+const MaxSuppressedAlerts = 100
+
+// A SuppressedAlert records an alert that was dropped because of a maintenance window.
+type SuppressedAlert struct {
+	ServiceID string
+
+	// WindowID is the window that suppressed the alert, or empty if it has since been deleted.
+	WindowID string
+
+	Summary      string
+	DedupKey     string
+	SuppressedAt time.Time
+}
+
+// RecordSuppressed records that an alert was suppressed by the given window.
+func RecordSuppressed(ctx context.Context, db gadb.DBTX, w *Window, summary, dedupKey string) error {
+	return gadb.New(db).MaintWindow_RecordSuppressed(ctx, gadb.MaintWindow_RecordSuppressedParams{
+		ServiceID: uuid.MustParse(w.ServiceID),
+		WindowID:  uuid.NullUUID{UUID: uuid.MustParse(w.ID), Valid: true},
+		Summary:   summary,
+		DedupKey:  dedupKey,
+	})
+}
+
+// FindSuppressedByService returns the most recent alerts suppressed for the given service, newest first.
+func (s *Store) FindSuppressedByService(ctx context.Context, serviceID string) ([]SuppressedAlert, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := validate.ParseUUID("ServiceID", serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.dbtx(nil).MaintWindow_FindSuppressedByService(ctx, gadb.MaintWindow_FindSuppressedByServiceParams{
+		ServiceID: id,
+		Limit:     MaxSuppressedAlerts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SuppressedAlert, len(rows))
+	for i, row := range rows {
+		result[i] = SuppressedAlert{
+			ServiceID:    row.ServiceID.String(),
+			Summary:      row.Summary,
+			DedupKey:     row.DedupKey,
+			SuppressedAt: row.SuppressedAt,
+		}
+		if row.WindowID.Valid {
+			result[i].WindowID = row.WindowID.UUID.String()
+		}
+	}
+
+	return result, nil
+}
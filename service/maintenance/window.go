@@ -0,0 +1,142 @@
+package maintenance
+
+import (
+	"time"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Limits for the duration of a window.
+const (
+	MaxOneTimeDuration   = 30 * 24 * time.Hour
+	MaxRecurringDuration = 24 * time.Hour
+)
+
+// A Window is a scheduled period of maintenance for a service.
+//
+// During a window, alerts from integrations (integration keys, heartbeat monitors, the generic API, etc.)
+// are either dropped or created without escalation. Alerts created manually are not affected.
+type Window struct {
+	ID          string
+	ServiceID   string
+	Description string
+
+	// Start and End are the times of the first occurrence of the window.
+	Start time.Time
+	End   time.Time
+
+	// WeekdayFilter, if any day is enabled, makes the window repeat on each enabled day (in TimeZone)
+	// at the time-of-day of Start, for the same duration.
+	WeekdayFilter timeutil.WeekdayFilter
+	TimeZone      *time.Location
+
+	// SuppressAlerts indicates new alerts are dropped, rather than created without escalation.
+	SuppressAlerts bool
+}
+
+// IsRecurring returns true if the window repeats weekly.
+func (w Window) IsRecurring() bool { return !w.WeekdayFilter.IsNever() }
+
+// Normalize will validate the Window and return a normalized copy.
+func (w Window) Normalize() (*Window, error) {
+	if w.TimeZone == nil {
+		w.TimeZone = time.UTC
+	}
+
+	maxDur := MaxOneTimeDuration
+	if w.IsRecurring() {
+		maxDur = MaxRecurringDuration
+	}
+
+	err := validate.Many(
+		validate.UUID("ServiceID", w.ServiceID),
+		validate.Text("Description", w.Description, 0, 255),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !w.End.After(w.Start) {
+		return nil, validation.NewFieldError("End", "must be after start time")
+	}
+	err = validate.Duration("End", w.End.Sub(w.Start), time.Minute, maxDur)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Start = w.Start.Truncate(time.Minute)
+	w.End = w.End.Truncate(time.Minute)
+
+	return &w, nil
+}
+
+// occurrenceStart returns the start of the occurrence on the same calendar day as t, in the window's time zone.
+func (w Window) occurrenceStart(t time.Time, daysAgo int) time.Time {
+	start := w.Start.In(w.TimeZone)
+	y, m, d := t.In(w.TimeZone).Date()
+	return time.Date(y, m, d-daysAgo, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), w.TimeZone)
+}
+
+// ActiveAt returns true if the window is active at the given time.
+func (w Window) ActiveAt(t time.Time) bool {
+	if t.Before(w.Start) {
+		return false
+	}
+	if !w.IsRecurring() {
+		return t.Before(w.End)
+	}
+
+	dur := w.End.Sub(w.Start)
+
+	// recurring windows are at most 24 hours, so only today's and yesterday's occurrences can be active
+	for daysAgo := 0; daysAgo <= 1; daysAgo++ {
+		start := w.occurrenceStart(t, daysAgo)
+		if start.Before(w.Start) || !w.WeekdayFilter.Day(start.Weekday()) {
+			continue
+		}
+		if !t.Before(start) && t.Before(start.Add(dur)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ActiveAt returns the window active at the given time, or nil if there is none.
+//
+// If more than one window is active, one that suppresses alerts is preferred.
+func ActiveAt(windows []Window, t time.Time) *Window {
+	var active *Window
+	for i, w := range windows {
+		if !w.ActiveAt(t) {
+			continue
+		}
+		if active == nil || (w.SuppressAlerts && !active.SuppressAlerts) {
+			active = &windows[i]
+		}
+	}
+
+	return active
+}
+
+func fromDB(row gadb.ServiceMaintenanceWindow) Window {
+	loc, err := util.LoadLocation(row.TimeZone)
+	if err != nil {
+		// should not happen as the time zone is validated before saving
+		loc = time.UTC
+	}
+
+	return Window{
+		ID:             row.ID.String(),
+		ServiceID:      row.ServiceID.String(),
+		Description:    row.Description,
+		Start:          row.StartTime,
+		End:            row.EndTime,
+		WeekdayFilter:  row.WeekdayFilter,
+		TimeZone:       loc,
+		SuppressAlerts: row.SuppressAlerts,
+	}
+}
@@ -0,0 +1,77 @@
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/util/timeutil"
+)
+
+func TestWindow_ActiveAt(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	at := func(s string) time.Time {
+		t.Helper()
+		tm, err := time.ParseInLocation("2006-01-02 15:04", s, ny)
+		require.NoError(t, err)
+		return tm
+	}
+
+	t.Run("one-time", func(t *testing.T) {
+		w := Window{Start: at("2026-10-05 22:00"), End: at("2026-10-06 02:00"), TimeZone: ny}
+
+		assert.False(t, w.ActiveAt(at("2026-10-05 21:59")))
+		assert.True(t, w.ActiveAt(at("2026-10-05 22:00")))
+		assert.True(t, w.ActiveAt(at("2026-10-06 01:59")))
+		assert.False(t, w.ActiveAt(at("2026-10-06 02:00")))
+		assert.False(t, w.ActiveAt(at("2026-10-12 23:00")))
+	})
+
+	t.Run("recurring", func(t *testing.T) {
+		// Monday 2026-10-05, repeating Mon and Wed 22:00-02:00
+		var days timeutil.WeekdayFilter
+		days.SetDay(time.Monday, true)
+		days.SetDay(time.Wednesday, true)
+		w := Window{Start: at("2026-10-05 22:00"), End: at("2026-10-06 02:00"), TimeZone: ny, WeekdayFilter: days}
+
+		assert.False(t, w.ActiveAt(at("2026-09-28 23:00")), "before first occurrence")
+		assert.True(t, w.ActiveAt(at("2026-10-05 23:00")))
+		assert.True(t, w.ActiveAt(at("2026-10-06 01:00")), "past midnight")
+		assert.False(t, w.ActiveAt(at("2026-10-06 23:00")), "Tuesday")
+		assert.True(t, w.ActiveAt(at("2026-10-07 22:30")), "Wednesday")
+		assert.True(t, w.ActiveAt(at("2026-10-08 01:30")), "Wednesday past midnight")
+		assert.False(t, w.ActiveAt(at("2026-10-08 02:00")))
+		assert.True(t, w.ActiveAt(at("2026-11-02 22:00")), "after DST change")
+	})
+}
+
+func TestActiveAt(t *testing.T) {
+	start := time.Date(2026, 10, 5, 22, 0, 0, 0, time.UTC)
+	windows := []Window{
+		{ID: "early", Start: start.Add(-time.Hour), End: start.Add(time.Hour), TimeZone: time.UTC},
+		{ID: "suppress", Start: start, End: start.Add(time.Hour), TimeZone: time.UTC, SuppressAlerts: true},
+	}
+
+	assert.Nil(t, ActiveAt(windows, start.Add(-2*time.Hour)))
+	assert.Equal(t, "early", ActiveAt(windows, start.Add(-time.Minute)).ID)
+	assert.Equal(t, "suppress", ActiveAt(windows, start).ID, "suppressing window preferred")
+	assert.Nil(t, ActiveAt(windows, start.Add(time.Hour)))
+}
+
+func TestWindow_Normalize(t *testing.T) {
+	start := time.Date(2026, 10, 5, 22, 0, 0, 0, time.UTC)
+	svcID := "9e3c0a2a-0f7b-4f4e-9b8e-0c9a0f3c7a11"
+
+	_, err := Window{ServiceID: svcID, Start: start, End: start}.Normalize()
+	assert.Error(t, err, "end must be after start")
+
+	w, err := Window{ServiceID: svcID, Start: start, End: start.Add(48 * time.Hour)}.Normalize()
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, w.TimeZone, "default time zone")
+
+	_, err = Window{ServiceID: svcID, Start: start, End: start.Add(48 * time.Hour), WeekdayFilter: timeutil.EveryDay()}.Normalize()
+	assert.Error(t, err, "recurring windows are limited to 24 hours")
+}
@@ -0,0 +1,17 @@
+-- name: RoutingRule_FindAllByService :many
+SELECT
+    *
+FROM
+    service_routing_rules
+WHERE
+    service_id = $1
+ORDER BY
+    position;
+
+-- name: RoutingRule_DeleteAllByService :exec
+DELETE FROM service_routing_rules
+WHERE service_id = $1;
+
+-- name: RoutingRule_Insert :exec
+INSERT INTO service_routing_rules(id, service_id, position, name, condition_expr, escalation_policy_id, priority, drop_alert)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
@@ -0,0 +1,167 @@
+package routing
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxRules is the maximum number of routing rules per service.
+const MaxRules = 50
+
+// MetadataKeyPriority is the alert metadata key set by rules with a Priority.
+const MetadataKeyPriority = "priority"
+
+// A Rule is evaluated against each new alert of a service, in order. The first rule with a
+// matching condition is applied and the rest are ignored.
+type Rule struct {
+	ID        string
+	ServiceID string
+	Name      string
+
+	// Condition is an expression that must evaluate to a boolean. It has access to
+	// `alert.summary`, `alert.details`, `alert.source` and `alert.metadata`.
+	Condition string
+
+	// EscalationPolicyID, if set, escalates the alert with this policy instead of the service's.
+	EscalationPolicyID string
+
+	// Priority, if set, is stored in the alert metadata under the "priority" key.
+	Priority string
+
+	// Drop indicates the alert should not be created.
+	Drop bool
+}
+
+func compile(cond string) (*vm.Program, error) {
+	return expr.Compile(cond, expr.AllowUndefinedVariables(), expr.Optimize(true), expr.AsBool())
+}
+
+// Normalize will validate the Rule and return a normalized copy.
+func (r Rule) Normalize() (*Rule, error) {
+	err := validate.Many(
+		validate.UUID("ServiceID", r.ServiceID),
+		validate.Text("Name", r.Name, 1, 255),
+		validate.Text("Condition", r.Condition, 1, 2048),
+		validate.Text("Priority", r.Priority, 0, 64),
+	)
+	if r.EscalationPolicyID != "" {
+		err = validate.Many(err, validate.UUID("EscalationPolicyID", r.EscalationPolicyID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := compile(r.Condition); err != nil {
+		return nil, validation.NewFieldError("Condition", err.Error())
+	}
+
+	switch {
+	case r.Drop && (r.EscalationPolicyID != "" || r.Priority != ""):
+		return nil, validation.NewFieldError("Drop", "cannot be combined with an escalation policy or priority")
+	case !r.Drop && r.EscalationPolicyID == "" && r.Priority == "":
+		return nil, validation.NewFieldError("Drop", "rule must drop the alert, or set an escalation policy or priority")
+	}
+
+	return &r, nil
+}
+
+// Env returns the expression environment for a new alert.
+func Env(summary, details, source string, meta map[string]string) map[string]any {
+	if meta == nil {
+		meta = map[string]string{}
+	}
+
+	return map[string]any{
+		"alert": map[string]any{
+			"summary":  summary,
+			"details":  details,
+			"source":   source,
+			"metadata": meta,
+		},
+	}
+}
+
+// maxCachedPrograms limits the number of compiled conditions kept by a Matcher.
+const maxCachedPrograms = 1000
+
+// A Matcher matches rules against new alerts, caching compiled conditions by their text.
+//
+// The zero value is ready to use, and it is safe for concurrent use.
+type Matcher struct {
+	mx    sync.Mutex
+	progs map[string]*vm.Program
+}
+
+// program returns the compiled condition, compiling and caching it if needed.
+func (m *Matcher) program(cond string) (*vm.Program, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if prog, ok := m.progs[cond]; ok {
+		return prog, nil
+	}
+
+	prog, err := compile(cond)
+	if err != nil {
+		return nil, err
+	}
+	if m.progs == nil || len(m.progs) >= maxCachedPrograms {
+		// conditions are only cached to avoid re-compiling on every alert, so just start over when full
+		m.progs = make(map[string]*vm.Program)
+	}
+	m.progs[cond] = prog
+
+	return prog, nil
+}
+
+// Match returns the first rule whose condition matches the environment, or nil if none match.
+func (m *Matcher) Match(rules []Rule, env any) (*Rule, error) {
+	var v vm.VM
+	for _, r := range rules {
+		prog, err := m.program(r.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("rule '%s': compile: %w", r.Name, err)
+		}
+		res, err := v.Run(prog, env)
+		if err != nil {
+			return nil, fmt.Errorf("rule '%s': run: %w", r.Name, err)
+		}
+		if match, _ := res.(bool); match {
+			return &r, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func fromDB(row gadb.ServiceRoutingRule) Rule {
+	r := Rule{
+		ID:        row.ID.String(),
+		ServiceID: row.ServiceID.String(),
+		Name:      row.Name,
+		Condition: row.ConditionExpr,
+		Priority:  row.Priority,
+		Drop:      row.DropAlert,
+	}
+	if row.EscalationPolicyID.Valid {
+		r.EscalationPolicyID = row.EscalationPolicyID.UUID.String()
+	}
+
+	return r
+}
+
+// parseNullUUID returns a NullUUID for an already-validated ID, or a null value if empty.
+func parseNullUUID(id string) uuid.NullUUID {
+	if id == "" {
+		return uuid.NullUUID{}
+	}
+
+	return uuid.NullUUID{UUID: uuid.MustParse(id), Valid: true}
+}
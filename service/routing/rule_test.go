@@ -0,0 +1,72 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRule_Normalize(t *testing.T) {
+	svcID := "9e3c0a2a-0f7b-4f4e-9b8e-0c9a0f3c7a11"
+	epID := "0f1c0a2a-0f7b-4f4e-9b8e-0c9a0f3c7a11"
+
+	_, err := Rule{ServiceID: svcID, Name: "drop", Condition: "true", Drop: true}.Normalize()
+	assert.NoError(t, err)
+
+	_, err = Rule{ServiceID: svcID, Name: "route", Condition: "true", EscalationPolicyID: epID, Priority: "high"}.Normalize()
+	assert.NoError(t, err)
+
+	_, err = Rule{ServiceID: svcID, Name: "nothing", Condition: "true"}.Normalize()
+	assert.Error(t, err, "rule must have an action")
+
+	_, err = Rule{ServiceID: svcID, Name: "both", Condition: "true", Drop: true, Priority: "high"}.Normalize()
+	assert.Error(t, err, "drop can not be combined")
+
+	_, err = Rule{ServiceID: svcID, Name: "bad", Condition: "alert.summary +", Drop: true}.Normalize()
+	assert.Error(t, err, "invalid condition")
+}
+
+func TestMatch(t *testing.T) {
+	rules := []Rule{
+		{Name: "db", Condition: `alert.metadata.component == "db"`, Priority: "high"},
+		{Name: "noise", Condition: `alert.summary startsWith "test"`, Drop: true},
+		{Name: "disk", Condition: `alert.details contains "disk"`, Priority: "low"},
+	}
+
+	var m Matcher
+	r, err := m.Match(rules, Env("test alert", "disk full", "generic", map[string]string{"component": "db"}))
+	require.NoError(t, err)
+	assert.Equal(t, "db", r.Name, "first matching rule wins")
+
+	r, err = m.Match(rules, Env("test alert", "disk full", "generic", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "noise", r.Name)
+
+	r, err = m.Match(rules, Env("cpu", "disk full", "generic", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "disk", r.Name)
+
+	r, err = m.Match(rules, Env("cpu", "high load", "generic", nil))
+	require.NoError(t, err)
+	assert.Nil(t, r)
+}
+
+func TestMatcher_Cache(t *testing.T) {
+	var m Matcher
+	rules := []Rule{{Name: "db", Condition: `alert.metadata.component == "db"`}}
+
+	_, err := m.Match(rules, Env("", "", "", nil))
+	require.NoError(t, err)
+	prog := m.progs[rules[0].Condition]
+	require.NotNil(t, prog)
+
+	r, err := m.Match(rules, Env("", "", "", map[string]string{"component": "db"}))
+	require.NoError(t, err)
+	assert.Equal(t, "db", r.Name)
+	assert.Same(t, prog, m.progs[rules[0].Condition], "condition should not be re-compiled")
+
+	_, err = m.Match([]Rule{{Name: "bad", Condition: "alert.summary +"}}, Env("", "", "", nil))
+	assert.Error(t, err)
+	assert.Len(t, m.progs, 1, "invalid conditions should not be cached")
+}
@@ -0,0 +1,113 @@
+package routing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store manages service routing rules.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore creates a new Store.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	return &Store{db: db}, nil
+}
+
+func (s *Store) dbtx(tx *sql.Tx) *gadb.Queries {
+	db := gadb.New(s.db)
+	if tx == nil {
+		return db
+	}
+
+	return db.WithTx(tx)
+}
+
+// SetRulesTx replaces all routing rules of the service with the provided (ordered) list.
+func (s *Store) SetRulesTx(ctx context.Context, tx *sql.Tx, serviceID string, rules []Rule) error {
+	err := permission.LimitCheckAny(ctx, permission.User, permission.Admin)
+	if err != nil {
+		return err
+	}
+
+	svcID, err := validate.ParseUUID("ServiceID", serviceID)
+	if err != nil {
+		return err
+	}
+	err = validate.Range("Rules", len(rules), 0, MaxRules)
+	if err != nil {
+		return err
+	}
+
+	normalized := make([]Rule, len(rules))
+	for i, r := range rules {
+		r.ServiceID = serviceID
+		n, err := r.Normalize()
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("Rules[%d].", i), err)
+		}
+		normalized[i] = *n
+	}
+
+	q := s.dbtx(tx)
+	err = q.RoutingRule_DeleteAllByService(ctx, svcID)
+	if err != nil {
+		return err
+	}
+
+	for i, r := range normalized {
+		err = q.RoutingRule_Insert(ctx, gadb.RoutingRule_InsertParams{
+			ID:                 uuid.New(),
+			ServiceID:          svcID,
+			Position:           int32(i),
+			Name:               r.Name,
+			ConditionExpr:      r.Condition,
+			EscalationPolicyID: parseNullUUID(r.EscalationPolicyID),
+			Priority:           r.Priority,
+			DropAlert:          r.Drop,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindAllByService returns the routing rules of the service, in order.
+func (s *Store) FindAllByService(ctx context.Context, serviceID string) ([]Rule, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := validate.ParseUUID("ServiceID", serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindAllByService(ctx, s.db, id)
+}
+
+// FindAllByService returns the routing rules of the service, in order, without permission checks.
+func FindAllByService(ctx context.Context, db gadb.DBTX, serviceID uuid.UUID) ([]Rule, error) {
+	rows, err := gadb.New(db).RoutingRule_FindAllByService(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Rule, len(rows))
+	for i, row := range rows {
+		result[i] = fromDB(row)
+	}
+
+	return result, nil
+}
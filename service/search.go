@@ -31,6 +31,9 @@ type SearchOptions struct {
 	// Only lookup the service IDs present in the request.
 	Only []string `json:"n,omitempty"`
 
+	// TeamIDs, if set, will limit results to services owned by any of the teams.
+	TeamIDs []string `json:"t,omitempty"`
+
 	// FavoritesFirst indicates that services marked as favorite (by FavoritesUserID) should be returned first (before any non-favorites).
 	FavoritesFirst bool `json:"f,omitempty"`
 
@@ -73,6 +76,9 @@ var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()
 	{{if .Only}}
 		AND svc.id = any(:only)
 	{{end}}
+	{{if .TeamIDs}}
+		AND svc.team_id = any(:teamIDs)
+	{{end}}
 	{{- if and .LabelKey .LabelNegate}}
 		AND svc.id NOT IN (
 			SELECT tgt_service_id
@@ -166,6 +172,7 @@ func (opts renderData) Normalize() (*renderData, error) {
 		validate.Range("Limit", opts.Limit, 0, search.MaxResults),
 		validate.ManyUUID("Omit", opts.Omit, 50),
 		validate.ManyUUID("Only", opts.Only, 50),
+		validate.ManyUUID("TeamIDs", opts.TeamIDs, 50),
 	)
 	if opts.After.Name != "" {
 		err = validate.Many(err, validate.IDName("After.Name", opts.After.Name))
@@ -204,6 +211,7 @@ func (opts renderData) QueryArgs() []sql.NamedArg {
 		sql.Named("search", opts.Search),
 		sql.Named("afterName", opts.After.Name),
 		sql.Named("omit", sqlutil.UUIDArray(opts.Omit)),
+		sql.Named("teamIDs", sqlutil.UUIDArray(opts.TeamIDs)),
 		sql.Named("only", sqlutil.UUIDArray(opts.Only)),
 	}
 }
@@ -56,8 +56,12 @@ func Site24x7ToEventsAPI(aDB *alert.Store, intDB *integrationkey.Store) http.Han
 		})
 
 		var site24x7State alert.Status
+		severity := alert.DefaultSeverity
 		switch g.Status {
-		case "DOWN", "CRITICAL", "TROUBLE":
+		case "DOWN", "CRITICAL":
+			site24x7State = alert.StatusTriggered
+			severity = alert.SeverityCritical
+		case "TROUBLE":
 			site24x7State = alert.StatusTriggered
 		case "UP":
 			site24x7State = alert.StatusClosed
@@ -80,6 +84,7 @@ func Site24x7ToEventsAPI(aDB *alert.Store, intDB *integrationkey.Store) http.Han
 			Status:    site24x7State,
 			Source:    alert.SourceSite24x7,
 			ServiceID: serviceID,
+			Severity:  severity,
 			Dedup:     alert.NewUserDedup(r.FormValue("dedup")),
 		}
 
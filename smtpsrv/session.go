@@ -129,6 +129,15 @@ func (s *Session) Rcpt(recipient string, opts *smtp.RcptOptions) error {
 	return nil
 }
 
+// firstHeader returns the first value of the header, or an empty string if it is not set.
+func firstHeader(h map[string][]string, key string) string {
+	if len(h[key]) == 0 {
+		return ""
+	}
+
+	return h[key][0]
+}
+
 // Data is called when a new SMTP message is received.
 func (s *Session) Data(r io.Reader) error {
 	if len(s.authCtx) == 0 {
@@ -157,6 +166,8 @@ func (s *Session) Data(r io.Reader) error {
 		dedup = alert.NewUserDedup(s.dedup)
 	}
 
+	severity := alert.SeverityFromEmail(firstHeader(email.Headers.ExtraHeaders, "X-Priority"), firstHeader(email.Headers.ExtraHeaders, "Importance"))
+
 	for _, authCtx := range s.authCtx {
 		newAlert := &alert.Alert{
 			Summary:   summary,
@@ -165,6 +176,7 @@ func (s *Session) Data(r io.Reader) error {
 			Status:    alert.StatusTriggered,
 			Source:    alert.SourceEmail,
 			Dedup:     dedup,
+			Severity:  severity,
 		}
 
 		err = retry.DoTemporaryError(func(_ int) error {
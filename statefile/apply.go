@@ -0,0 +1,714 @@
+package statefile
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/heartbeat"
+	"github.com/target/goalert/integrationkey"
+	"github.com/target/goalert/label"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/user"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// A Change describes a single change made by Apply.
+type Change struct {
+	Op     string // create, update, or delete
+	Kind   string
+	Name   string
+	Detail string
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %q", c.Op, c.Kind, c.Name)
+	if c.Detail != "" {
+		s += ": " + c.Detail
+	}
+
+	return s
+}
+
+type applier struct {
+	*Stores
+	tx  *sql.Tx
+	cur *current
+
+	changes []Change
+}
+
+func (a *applier) record(op, kind, name, detail string, args ...any) {
+	a.changes = append(a.changes, Change{Op: op, Kind: kind, Name: name, Detail: fmt.Sprintf(detail, args...)})
+}
+
+// Apply updates the DB to match the desired state, and returns the changes made.
+//
+// Rotations, schedules, escalation policies, and services in the desired state are created or
+// updated, with their nested items (participants, rules, steps, labels, integration keys, and
+// heartbeat monitors) replaced to match. Top-level entities missing from the desired state are
+// left unchanged.
+//
+// To preview changes, call Apply and roll back the transaction.
+func (s *Stores) Apply(ctx context.Context, tx *sql.Tx, desired *State) ([]Change, error) {
+	err := desired.validateNames()
+	if err != nil {
+		return nil, err
+	}
+
+	cur, err := s.load(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &applier{Stores: s, tx: tx, cur: cur}
+	for i, r := range desired.Rotations {
+		err = a.applyRotation(ctx, r)
+		if err != nil {
+			return nil, validation.AddPrefix(fmt.Sprintf("rotations[%d].", i), err)
+		}
+	}
+	for i, sched := range desired.Schedules {
+		err = a.applySchedule(ctx, sched)
+		if err != nil {
+			return nil, validation.AddPrefix(fmt.Sprintf("schedules[%d].", i), err)
+		}
+	}
+	for i, p := range desired.EscalationPolicies {
+		err = a.applyPolicy(ctx, p)
+		if err != nil {
+			return nil, validation.AddPrefix(fmt.Sprintf("escalationPolicies[%d].", i), err)
+		}
+	}
+	for i, svc := range desired.Services {
+		err = a.applyService(ctx, svc)
+		if err != nil {
+			return nil, validation.AddPrefix(fmt.Sprintf("services[%d].", i), err)
+		}
+	}
+
+	return a.changes, nil
+}
+
+func checkUnique(field string, names []string) error {
+	seen := make(map[string]bool, len(names))
+	for i, name := range names {
+		if seen[nameKey(name)] {
+			return validation.NewFieldError(fmt.Sprintf("%s[%d].name", field, i), "duplicate name "+name)
+		}
+		seen[nameKey(name)] = true
+	}
+
+	return nil
+}
+
+func (st *State) validateNames() error {
+	var rot, sched, ep, svc []string
+	for _, r := range st.Rotations {
+		rot = append(rot, r.Name)
+	}
+	for _, s := range st.Schedules {
+		sched = append(sched, s.Name)
+	}
+	for _, p := range st.EscalationPolicies {
+		ep = append(ep, p.Name)
+	}
+	for _, s := range st.Services {
+		svc = append(svc, s.Name)
+	}
+
+	return validate.Many(
+		checkUnique("rotations", rot),
+		checkUnique("schedules", sched),
+		checkUnique("escalationPolicies", ep),
+		checkUnique("services", svc),
+	)
+}
+
+func regionEqual(a, b RotationRegion) bool {
+	return a.TimeZone == b.TimeZone && a.Start == b.Start && slices.Equal(a.Users, b.Users)
+}
+
+func (a *applier) applyRotation(ctx context.Context, r Rotation) error {
+	loc, err := util.LoadLocation(r.TimeZone)
+	if err != nil {
+		return validation.NewFieldError("timeZone", err.Error())
+	}
+	rot := rotation.Rotation{
+		Name:        r.Name,
+		Description: r.Description,
+		Type:        rotation.Type(r.Type),
+		ShiftLength: r.ShiftLength,
+		Start:       r.Start.In(loc),
+	}
+	for _, reg := range r.Regions {
+		rot.Regions = append(rot.Regions, rotation.Region{TimeZone: reg.TimeZone, Start: reg.Start, UserIDs: reg.Users})
+	}
+
+	idx := slices.IndexFunc(a.cur.rotations, func(c curRotation) bool { return nameKey(c.Name) == nameKey(r.Name) })
+	if idx == -1 {
+		n, err := a.Rotations.CreateRotationTx(ctx, a.tx, &rot)
+		if err != nil {
+			return err
+		}
+		a.cur.rotationIDs[nameKey(n.Name)] = n.ID
+		a.cur.rotationNames[n.ID] = n.Name
+		a.record("create", "rotation", r.Name, "")
+
+		return a.setParticipants(ctx, n.ID, r.Name, nil, nil, r.Participants)
+	}
+
+	c := a.cur.rotations[idx]
+	if c.Name != r.Name || c.Description != r.Description || c.Type != r.Type || c.ShiftLength != r.ShiftLength ||
+		!c.Start.Equal(r.Start) || c.TimeZone != loc.String() || !slices.EqualFunc(c.Regions, r.Regions, regionEqual) {
+		rot.ID = c.ID
+		err = a.Rotations.UpdateRotationTx(ctx, a.tx, &rot)
+		if err != nil {
+			return err
+		}
+		a.cur.rotationNames[c.ID] = r.Name
+		a.record("update", "rotation", r.Name, "settings")
+	}
+
+	return a.setParticipants(ctx, c.ID, r.Name, c.ParticipantIDs, c.Participants, r.Participants)
+}
+
+// setParticipants updates participants in place, then removes or adds participants at the end, so the
+// current shift is kept when possible.
+func (a *applier) setParticipants(ctx context.Context, rotID, name string, partIDs, users, desired []string) error {
+	for i := 0; i < len(users) && i < len(desired); i++ {
+		if users[i] == desired[i] {
+			continue
+		}
+		err := a.Rotations.UpdateParticipantUserIDTx(ctx, a.tx, partIDs[i], desired[i])
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("participants[%d].", i), err)
+		}
+		a.record("update", "rotation", name, "participant %d is %s", i+1, desired[i])
+	}
+
+	if len(users) > len(desired) {
+		err := a.Rotations.DeleteRotationParticipantsTx(ctx, a.tx, partIDs[len(desired):])
+		if err != nil {
+			return err
+		}
+		a.record("update", "rotation", name, "remove %d participant(s)", len(users)-len(desired))
+	}
+	if len(desired) > len(users) {
+		err := a.Rotations.AddRotationUsersTx(ctx, a.tx, rotID, desired[len(users):])
+		if err != nil {
+			return validation.AddPrefix("participants.", err)
+		}
+		a.record("update", "rotation", name, "add participant(s) %s", strings.Join(desired[len(users):], ", "))
+	}
+
+	return nil
+}
+
+func (a *applier) applySchedule(ctx context.Context, s Schedule) error {
+	loc, err := util.LoadLocation(s.TimeZone)
+	if err != nil {
+		return validation.NewFieldError("timeZone", err.Error())
+	}
+	sched := schedule.Schedule{
+		Name:        s.Name,
+		Description: s.Description,
+		TimeZone:    loc,
+	}
+
+	var c curSchedule
+	idx := slices.IndexFunc(a.cur.schedules, func(c curSchedule) bool { return nameKey(c.Name) == nameKey(s.Name) })
+	if idx == -1 {
+		n, err := a.Schedules.CreateScheduleTx(ctx, a.tx, &sched)
+		if err != nil {
+			return err
+		}
+		a.cur.scheduleIDs[nameKey(n.Name)] = n.ID
+		a.cur.scheduleNames[n.ID] = n.Name
+		a.record("create", "schedule", s.Name, "")
+		c.ID = n.ID
+	} else {
+		c = a.cur.schedules[idx]
+		if c.Name != s.Name || c.Description != s.Description || c.TimeZone != loc.String() {
+			sched.ID = c.ID
+			err = a.Schedules.UpdateTx(ctx, a.tx, &sched)
+			if err != nil {
+				return err
+			}
+			a.cur.scheduleNames[c.ID] = s.Name
+			a.record("update", "schedule", s.Name, "settings")
+		}
+	}
+
+	// rules are matched as a whole, unchanged rules are kept and the rest are replaced
+	existing := make(map[string][]string)
+	for i, r := range c.Rules {
+		key := r.key()
+		existing[key] = append(existing[key], c.RuleIDs[i])
+	}
+	var toCreate []ScheduleRule
+	for _, r := range s.Rules {
+		key := r.key()
+		if len(existing[key]) > 0 {
+			existing[key] = existing[key][1:]
+			continue
+		}
+		toCreate = append(toCreate, r)
+	}
+
+	var toDelete []string
+	for _, key := range slices.Sorted(maps.Keys(existing)) {
+		toDelete = append(toDelete, existing[key]...)
+	}
+	if len(toDelete) > 0 {
+		err = a.ScheduleRules.DeleteManyTx(ctx, a.tx, toDelete)
+		if err != nil {
+			return err
+		}
+		a.record("update", "schedule", s.Name, "remove %d rule(s)", len(toDelete))
+	}
+
+	for i, r := range toCreate {
+		var tgt assignment.Target
+		switch {
+		case r.User != "" && r.Rotation == "":
+			tgt = assignment.UserTarget(r.User)
+		case r.Rotation != "" && r.User == "":
+			rotID, ok := a.cur.rotationIDs[nameKey(r.Rotation)]
+			if !ok {
+				return validation.NewFieldError(fmt.Sprintf("rules[%d].rotation", i), "unknown rotation "+r.Rotation)
+			}
+			tgt = assignment.RotationTarget(rotID)
+		default:
+			return validation.NewFieldError(fmt.Sprintf("rules[%d]", i), "exactly one of user or rotation is required")
+		}
+
+		_, err = a.ScheduleRules.CreateRuleTx(ctx, a.tx, &rule.Rule{
+			ScheduleID:    c.ID,
+			WeekdayFilter: r.WeekdayFilter,
+			Start:         r.Start,
+			End:           r.End,
+			Target:        tgt,
+		})
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("rules[%d].", i), err)
+		}
+		a.record("update", "schedule", s.Name, "add rule for %s", r.targetString())
+	}
+
+	return nil
+}
+
+func (r ScheduleRule) targetString() string {
+	if r.Rotation != "" {
+		return "rotation " + r.Rotation
+	}
+
+	return "user " + r.User
+}
+
+func (r ScheduleRule) key() string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s", r.User, nameKey(r.Rotation), r.WeekdayFilter, r.Start, r.End)
+}
+
+func (act Action) key() string {
+	switch {
+	case act.User != "":
+		return "user:" + act.User
+	case act.Schedule != "":
+		return "schedule:" + nameKey(act.Schedule)
+	case act.Rotation != "":
+		return "rotation:" + nameKey(act.Rotation)
+	}
+
+	var b strings.Builder
+	b.WriteString(act.Type)
+	for _, k := range slices.Sorted(maps.Keys(act.Args)) {
+		fmt.Fprintf(&b, "|%s=%s", k, act.Args[k])
+	}
+
+	return b.String()
+}
+
+// dest returns the destination for the action, resolving schedule and rotation names.
+func (a *applier) dest(act Action) (gadb.DestV1, error) {
+	switch {
+	case act.User != "":
+		return gadb.NewDestV1(user.DestTypeUser, user.FieldUserID, act.User), nil
+	case act.Schedule != "":
+		id, ok := a.cur.scheduleIDs[nameKey(act.Schedule)]
+		if !ok {
+			return gadb.DestV1{}, validation.NewFieldError("schedule", "unknown schedule "+act.Schedule)
+		}
+		return gadb.NewDestV1(schedule.DestTypeSchedule, schedule.FieldScheduleID, id), nil
+	case act.Rotation != "":
+		id, ok := a.cur.rotationIDs[nameKey(act.Rotation)]
+		if !ok {
+			return gadb.DestV1{}, validation.NewFieldError("rotation", "unknown rotation "+act.Rotation)
+		}
+		return gadb.NewDestV1(rotation.DestTypeRotation, rotation.FieldRotationID, id), nil
+	case act.Type == "":
+		return gadb.DestV1{}, validation.NewFieldError("type", "one of user, schedule, rotation, or type is required")
+	}
+
+	return gadb.DestV1{Type: act.Type, Args: act.Args}, nil
+}
+
+func (a *applier) applyPolicy(ctx context.Context, p EscalationPolicy) error {
+	pol := escalation.Policy{
+		Name:        p.Name,
+		Description: p.Description,
+		Repeat:      p.Repeat,
+	}
+
+	var c curPolicy
+	idx := slices.IndexFunc(a.cur.policies, func(c curPolicy) bool { return nameKey(c.Policy.Name) == nameKey(p.Name) })
+	if idx == -1 {
+		n, err := a.Escalation.CreatePolicyTx(ctx, a.tx, &pol)
+		if err != nil {
+			return err
+		}
+		a.cur.policyIDs[nameKey(n.Name)] = n.ID
+		a.cur.policyNames[n.ID] = n.Name
+		a.record("create", "escalation policy", p.Name, "")
+		c.ID = n.ID
+	} else {
+		c = a.cur.policies[idx]
+		if c.Policy.Name != p.Name || c.Policy.Description != p.Description || c.Policy.Repeat != p.Repeat {
+			pol.ID = c.ID
+			err := a.Escalation.UpdatePolicyTx(ctx, a.tx, &pol)
+			if err != nil {
+				return err
+			}
+			a.cur.policyNames[c.ID] = p.Name
+			a.record("update", "escalation policy", p.Name, "settings")
+		}
+	}
+
+	for i, step := range p.Steps {
+		var err error
+		if i < len(c.StepIDs) {
+			err = a.updateStep(ctx, c, i, step)
+		} else {
+			err = a.createStep(ctx, c.ID, p.Name, i, step)
+		}
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("steps[%d].", i), err)
+		}
+	}
+
+	// remove extra steps from the end, so the remaining step numbers are unchanged
+	for i := len(c.StepIDs) - 1; i >= len(p.Steps); i-- {
+		_, err := a.Escalation.DeleteStepTx(ctx, a.tx, c.StepIDs[i])
+		if err != nil {
+			return err
+		}
+		a.record("update", "escalation policy", p.Name, "remove step %d", i+1)
+	}
+
+	return nil
+}
+
+// escalationStep returns the escalation step, including its conditions, described by step.
+func escalationStep(policyID string, step Step) (*escalation.Step, error) {
+	tz := step.TimeZone
+	if tz == "" {
+		tz = "UTC"
+	}
+	loc, err := util.LoadLocation(tz)
+	if err != nil {
+		return nil, validation.NewFieldError("timeZone", err.Error())
+	}
+
+	st := &escalation.Step{
+		PolicyID:     policyID,
+		DelayMinutes: step.DelayMinutes,
+		MultiAck:     step.MultiAck,
+		Start:        step.Start,
+		End:          step.End,
+		TimeZone:     loc,
+	}
+	if step.WeekdayFilter != nil {
+		st.WeekdayFilter = *step.WeekdayFilter
+	}
+	for _, m := range step.Matches {
+		st.Matches = append(st.Matches, escalation.StepMatch{Type: escalation.StepMatchType(m.Type), Key: m.Key, Value: m.Value})
+	}
+
+	return st, nil
+}
+
+// stepConditionsEqual returns true if a and b apply to the same alerts at the same times.
+func stepConditionsEqual(a, b Step) bool {
+	return (a.WeekdayFilter == nil) == (b.WeekdayFilter == nil) && (a.WeekdayFilter == nil || *a.WeekdayFilter == *b.WeekdayFilter) &&
+		a.Start == b.Start && a.End == b.End && a.TimeZone == b.TimeZone && slices.Equal(a.Matches, b.Matches)
+}
+
+func (a *applier) createStep(ctx context.Context, policyID, name string, i int, step Step) error {
+	st, err := escalationStep(policyID, step)
+	if err != nil {
+		return err
+	}
+	n, err := a.Escalation.CreateStepTx(ctx, a.tx, st)
+	if err != nil {
+		return err
+	}
+	a.record("update", "escalation policy", name, "add step %d", i+1)
+
+	for j, act := range step.Actions {
+		d, err := a.dest(act)
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("actions[%d].", j), err)
+		}
+		err = a.Escalation.AddStepActionTx(ctx, a.tx, n.ID, d)
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("actions[%d].", j), err)
+		}
+	}
+
+	return nil
+}
+
+func (a *applier) updateStep(ctx context.Context, c curPolicy, i int, step Step) error {
+	name := c.Policy.Name
+	cur := c.Policy.Steps[i]
+	id := c.StepIDs[i]
+
+	if cur.DelayMinutes != step.DelayMinutes {
+		err := a.Escalation.UpdateStepDelayTx(ctx, a.tx, id, step.DelayMinutes)
+		if err != nil {
+			return err
+		}
+		a.record("update", "escalation policy", name, "step %d delay %d -> %d minutes", i+1, cur.DelayMinutes, step.DelayMinutes)
+	}
+	if cur.MultiAck != step.MultiAck {
+		err := a.Escalation.UpdateStepMultiAckTx(ctx, a.tx, id, step.MultiAck)
+		if err != nil {
+			return err
+		}
+		a.record("update", "escalation policy", name, "step %d multi-ack %t", i+1, step.MultiAck)
+	}
+
+	st, err := escalationStep(c.ID, step)
+	if err != nil {
+		return err
+	}
+	n, err := st.Normalize()
+	if err != nil {
+		return err
+	}
+	if !stepConditionsEqual(cur, exportStep(*n)) {
+		n.ID = id
+		err = a.Escalation.UpdateStepConditionsTx(ctx, a.tx, n)
+		if err != nil {
+			return err
+		}
+		a.record("update", "escalation policy", name, "step %d conditions", i+1)
+	}
+
+	existing := make(map[string]Action, len(cur.Actions))
+	for _, act := range cur.Actions {
+		existing[act.key()] = act
+	}
+	for j, act := range step.Actions {
+		key := act.key()
+		if _, ok := existing[key]; ok {
+			delete(existing, key)
+			continue
+		}
+
+		d, err := a.dest(act)
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("actions[%d].", j), err)
+		}
+		err = a.Escalation.AddStepActionTx(ctx, a.tx, id, d)
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("actions[%d].", j), err)
+		}
+		a.record("update", "escalation policy", name, "step %d add %s", i+1, key)
+	}
+	for _, key := range slices.Sorted(maps.Keys(existing)) {
+		d, err := a.dest(existing[key])
+		if err != nil {
+			return err
+		}
+		err = a.Escalation.DeleteStepActionTx(ctx, a.tx, id, d)
+		if err != nil {
+			return err
+		}
+		a.record("update", "escalation policy", name, "step %d remove %s", i+1, key)
+	}
+
+	return nil
+}
+
+func (a *applier) applyService(ctx context.Context, s Service) error {
+	epID, ok := a.cur.policyIDs[nameKey(s.EscalationPolicy)]
+	if !ok {
+		return validation.NewFieldError("escalationPolicy", "unknown escalation policy "+s.EscalationPolicy)
+	}
+	svc := service.Service{
+		Name:               s.Name,
+		Description:        s.Description,
+		EscalationPolicyID: epID,
+	}
+
+	var c curService
+	idx := slices.IndexFunc(a.cur.services, func(c curService) bool { return nameKey(c.Name) == nameKey(s.Name) })
+	if idx == -1 {
+		n, err := a.Services.CreateServiceTx(ctx, a.tx, &svc)
+		if err != nil {
+			return err
+		}
+		a.record("create", "service", s.Name, "")
+		c.ID = n.ID
+	} else {
+		c = a.cur.services[idx]
+		if c.Name != s.Name || c.Description != s.Description || nameKey(c.EscalationPolicy) != nameKey(s.EscalationPolicy) {
+			svc.ID = c.ID
+			err := a.Services.UpdateTx(ctx, a.tx, &svc)
+			if err != nil {
+				return err
+			}
+			a.record("update", "service", s.Name, "settings")
+		}
+	}
+
+	err := a.setLabels(ctx, c, s)
+	if err != nil {
+		return err
+	}
+	err = a.setIntKeys(ctx, c, s)
+	if err != nil {
+		return err
+	}
+
+	return a.setHeartbeats(ctx, c, s)
+}
+
+func (a *applier) setLabels(ctx context.Context, c curService, s Service) error {
+	tgt := assignment.ServiceTarget(c.ID)
+	for _, key := range slices.Sorted(maps.Keys(s.Labels)) {
+		val := s.Labels[key]
+		if cur, ok := c.Labels[key]; ok && cur == val {
+			continue
+		}
+		err := a.Labels.SetTx(ctx, a.tx, &label.Label{Target: tgt, Key: key, Value: val})
+		if err != nil {
+			return validation.AddPrefix("labels.", err)
+		}
+		a.record("update", "service", s.Name, "set label %s=%s", key, val)
+	}
+	for _, key := range slices.Sorted(maps.Keys(c.Labels)) {
+		if _, ok := s.Labels[key]; ok {
+			continue
+		}
+		err := a.Labels.SetTx(ctx, a.tx, &label.Label{Target: tgt, Key: key})
+		if err != nil {
+			return err
+		}
+		a.record("update", "service", s.Name, "remove label %s", key)
+	}
+
+	return nil
+}
+
+func (a *applier) setIntKeys(ctx context.Context, c curService, s Service) error {
+	keyOf := func(k IntegrationKey) string { return nameKey(k.Name) + "|" + k.Type }
+	existing := make(map[string]string, len(c.IntegrationKeys))
+	for i, k := range c.IntegrationKeys {
+		existing[keyOf(k)] = c.KeyIDs[i]
+	}
+
+	for i, k := range s.IntegrationKeys {
+		if _, ok := existing[keyOf(k)]; ok {
+			delete(existing, keyOf(k))
+			continue
+		}
+		n, err := a.IntKeys.Create(ctx, a.tx, &integrationkey.IntegrationKey{
+			ServiceID: c.ID,
+			Name:      k.Name,
+			Type:      integrationkey.Type(k.Type),
+		})
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("integrationKeys[%d].", i), err)
+		}
+		a.record("update", "service", s.Name, "add %s integration key %q (%s)", k.Type, k.Name, n.ID)
+	}
+
+	if len(existing) == 0 {
+		return nil
+	}
+	ids := slices.Sorted(maps.Values(existing))
+	err := a.IntKeys.DeleteMany(ctx, a.tx, ids)
+	if err != nil {
+		return err
+	}
+	a.record("update", "service", s.Name, "remove %d integration key(s)", len(ids))
+
+	return nil
+}
+
+func (a *applier) setHeartbeats(ctx context.Context, c curService, s Service) error {
+	existing := make(map[string]int, len(c.Heartbeats))
+	for i, m := range c.Heartbeats {
+		existing[nameKey(m.Name)] = i
+	}
+
+	for i, m := range s.Heartbeats {
+		mon := heartbeat.Monitor{
+			ServiceID:         c.ID,
+			Name:              m.Name,
+			Timeout:           time.Duration(m.TimeoutMinutes) * time.Minute,
+			AdditionalDetails: m.AdditionalDetails,
+		}
+		idx, ok := existing[nameKey(m.Name)]
+		if !ok {
+			_, err := a.Heartbeats.CreateTx(ctx, a.tx, &mon)
+			if err != nil {
+				return validation.AddPrefix(fmt.Sprintf("heartbeatMonitors[%d].", i), err)
+			}
+			a.record("update", "service", s.Name, "add heartbeat monitor %q", m.Name)
+			continue
+		}
+		delete(existing, nameKey(m.Name))
+
+		if c.Heartbeats[idx] == m {
+			continue
+		}
+		mon.ID = c.HeartbeatIDs[idx]
+		err := a.Heartbeats.UpdateTx(ctx, a.tx, &mon)
+		if err != nil {
+			return validation.AddPrefix(fmt.Sprintf("heartbeatMonitors[%d].", i), err)
+		}
+		a.record("update", "service", s.Name, "update heartbeat monitor %q", m.Name)
+	}
+
+	if len(existing) == 0 {
+		return nil
+	}
+	var ids []string
+	for _, idx := range existing {
+		ids = append(ids, c.HeartbeatIDs[idx])
+	}
+	slices.Sort(ids)
+	err := a.Heartbeats.DeleteTx(ctx, a.tx, ids...)
+	if err != nil {
+		return err
+	}
+	a.record("update", "service", s.Name, "remove %d heartbeat monitor(s)", len(ids))
+
+	return nil
+}
@@ -0,0 +1,375 @@
+package statefile
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/heartbeat"
+	"github.com/target/goalert/integrationkey"
+	"github.com/target/goalert/label"
+	"github.com/target/goalert/notification/nfydest"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/schedule/rule"
+	"github.com/target/goalert/service"
+	"github.com/target/goalert/user"
+)
+
+// Stores are used to read and update the configuration.
+type Stores struct {
+	DB *sql.DB
+
+	Services      *service.Store
+	Escalation    *escalation.Store
+	Schedules     *schedule.Store
+	ScheduleRules *rule.Store
+	Rotations     *rotation.Store
+	IntKeys       *integrationkey.Store
+	Heartbeats    *heartbeat.Store
+	Labels        *label.Store
+
+	// Registry has the destination types that may be used as escalation policy actions.
+	Registry *nfydest.Registry
+}
+
+// current is the configuration in the DB, along with the IDs needed to update it.
+type current struct {
+	rotations []curRotation
+	schedules []curSchedule
+	policies  []curPolicy
+	services  []curService
+
+	// rotationNames, scheduleNames, and policyNames map IDs to names (and back, by lower-case name).
+	rotationNames, scheduleNames, policyNames map[string]string
+	rotationIDs, scheduleIDs, policyIDs       map[string]string
+}
+
+type curRotation struct {
+	ID             string
+	ParticipantIDs []string
+	Rotation
+}
+
+type curSchedule struct {
+	ID      string
+	RuleIDs []string
+	Schedule
+}
+
+type curPolicy struct {
+	ID      string
+	StepIDs []uuid.UUID
+	Policy  EscalationPolicy
+}
+
+type curService struct {
+	ID           string
+	KeyIDs       []string
+	HeartbeatIDs []string
+	Service
+}
+
+func nameKey(name string) string { return strings.ToLower(name) }
+
+// Export returns the current configuration.
+//
+// An error is returned if an escalation policy has an action of a type not in the Registry.
+func (s *Stores) Export(ctx context.Context, tx *sql.Tx) (*State, error) {
+	cur, err := s.load(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// an action that can not be applied again must not be silently exported
+	for _, p := range cur.policies {
+		for i, st := range p.Policy.Steps {
+			for _, act := range st.Actions {
+				if act.Type == "" || s.Registry.Provider(act.Type) != nil {
+					continue
+				}
+
+				return nil, fmt.Errorf("escalation policy %s: step %d: action type '%s' is not supported in state files", p.Policy.Name, i+1, act.Type)
+			}
+		}
+	}
+
+	return cur.State(), nil
+}
+
+// State returns the exported form of the current configuration.
+func (cur *current) State() *State {
+	st := &State{Version: Version}
+	for _, r := range cur.rotations {
+		st.Rotations = append(st.Rotations, r.Rotation)
+	}
+	for _, sched := range cur.schedules {
+		st.Schedules = append(st.Schedules, sched.Schedule)
+	}
+	for _, p := range cur.policies {
+		st.EscalationPolicies = append(st.EscalationPolicies, p.Policy)
+	}
+	for _, svc := range cur.services {
+		st.Services = append(st.Services, svc.Service)
+	}
+
+	return st
+}
+
+func (s *Stores) load(ctx context.Context, tx *sql.Tx) (*current, error) {
+	cur := &current{
+		rotationNames: make(map[string]string),
+		scheduleNames: make(map[string]string),
+		policyNames:   make(map[string]string),
+		rotationIDs:   make(map[string]string),
+		scheduleIDs:   make(map[string]string),
+		policyIDs:     make(map[string]string),
+	}
+	q := gadb.New(tx)
+
+	rotIDs, err := q.StateFile_RotationIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list rotations: %w", err)
+	}
+	for _, id := range rotIDs {
+		r, err := s.loadRotation(ctx, tx, id.String())
+		if err != nil {
+			return nil, fmt.Errorf("rotation %s: %w", id, err)
+		}
+		cur.rotations = append(cur.rotations, *r)
+		cur.rotationNames[r.ID] = r.Name
+		cur.rotationIDs[nameKey(r.Name)] = r.ID
+	}
+
+	scheds, err := s.Schedules.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list schedules: %w", err)
+	}
+	sort.Slice(scheds, func(i, j int) bool { return nameKey(scheds[i].Name) < nameKey(scheds[j].Name) })
+	for _, sched := range scheds {
+		cur.scheduleNames[sched.ID] = sched.Name
+		cur.scheduleIDs[nameKey(sched.Name)] = sched.ID
+	}
+	for _, sched := range scheds {
+		c, err := s.loadSchedule(ctx, tx, cur, sched)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s: %w", sched.ID, err)
+		}
+		cur.schedules = append(cur.schedules, *c)
+	}
+
+	epIDs, err := q.StateFile_EscalationPolicyIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list escalation policies: %w", err)
+	}
+	for _, id := range epIDs {
+		p, err := s.loadPolicy(ctx, tx, cur, id.String())
+		if err != nil {
+			return nil, fmt.Errorf("escalation policy %s: %w", id, err)
+		}
+		cur.policies = append(cur.policies, *p)
+		cur.policyNames[p.ID] = p.Policy.Name
+		cur.policyIDs[nameKey(p.Policy.Name)] = p.ID
+	}
+
+	svcIDs, err := q.StateFile_ServiceIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list services: %w", err)
+	}
+	for _, id := range svcIDs {
+		svc, err := s.loadService(ctx, tx, cur, id.String())
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", id, err)
+		}
+		cur.services = append(cur.services, *svc)
+	}
+
+	return cur, nil
+}
+
+func (s *Stores) loadRotation(ctx context.Context, tx *sql.Tx, id string) (*curRotation, error) {
+	r, err := s.Rotations.FindRotation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	parts, err := s.Rotations.FindAllParticipantsTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &curRotation{
+		ID: r.ID,
+		Rotation: Rotation{
+			Name:        r.Name,
+			Description: r.Description,
+			Type:        string(r.Type),
+			ShiftLength: r.ShiftLength,
+			Start:       r.Start,
+			TimeZone:    r.Start.Location().String(),
+		},
+	}
+	for _, reg := range r.Regions {
+		c.Regions = append(c.Regions, RotationRegion{TimeZone: reg.TimeZone, Start: reg.Start, Users: reg.UserIDs})
+	}
+	for _, p := range parts {
+		c.ParticipantIDs = append(c.ParticipantIDs, p.ID)
+		c.Participants = append(c.Participants, p.Target.TargetID())
+	}
+
+	return c, nil
+}
+
+func (s *Stores) loadSchedule(ctx context.Context, tx *sql.Tx, cur *current, sched schedule.Schedule) (*curSchedule, error) {
+	rules, err := s.ScheduleRules.FindAllTx(ctx, tx, sched.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &curSchedule{
+		ID: sched.ID,
+		Schedule: Schedule{
+			Name:        sched.Name,
+			Description: sched.Description,
+			TimeZone:    sched.TimeZone.String(),
+		},
+	}
+	for _, r := range rules {
+		sr := ScheduleRule{
+			WeekdayFilter: r.WeekdayFilter,
+			Start:         r.Start,
+			End:           r.End,
+		}
+		switch r.Target.TargetType() {
+		case assignment.TargetTypeUser:
+			sr.User = r.Target.TargetID()
+		case assignment.TargetTypeRotation:
+			sr.Rotation = cur.rotationNames[r.Target.TargetID()]
+		default:
+			return nil, fmt.Errorf("rule %s: unsupported target type %s", r.ID, r.Target.TargetType())
+		}
+		c.RuleIDs = append(c.RuleIDs, r.ID)
+		c.Rules = append(c.Rules, sr)
+	}
+
+	return c, nil
+}
+
+func (s *Stores) loadPolicy(ctx context.Context, tx *sql.Tx, cur *current, id string) (*curPolicy, error) {
+	p, err := s.Escalation.FindOnePolicyTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := s.Escalation.FindAllStepsTx(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &curPolicy{
+		ID: p.ID,
+		Policy: EscalationPolicy{
+			Name:        p.Name,
+			Description: p.Description,
+			Repeat:      p.Repeat,
+		},
+	}
+	for _, step := range steps {
+		dests, err := s.Escalation.FindAllStepActionsTx(ctx, tx, step.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		st := exportStep(step)
+		for _, d := range dests {
+			st.Actions = append(st.Actions, cur.actionFromDest(d))
+		}
+		c.StepIDs = append(c.StepIDs, step.ID)
+		c.Policy.Steps = append(c.Policy.Steps, st)
+	}
+
+	return c, nil
+}
+
+// exportStep returns the settings and conditions of a step, leaving out the defaults (every day, UTC).
+func exportStep(step escalation.Step) Step {
+	st := Step{DelayMinutes: step.DelayMinutes, MultiAck: step.MultiAck, Start: step.Start, End: step.End}
+	if !step.WeekdayFilter.IsAlways() {
+		wf := step.WeekdayFilter
+		st.WeekdayFilter = &wf
+	}
+	if step.TimeZone != nil && step.TimeZone.String() != "UTC" {
+		st.TimeZone = step.TimeZone.String()
+	}
+	for _, m := range step.Matches {
+		st.Matches = append(st.Matches, StepMatch{Type: string(m.Type), Key: m.Key, Value: m.Value})
+	}
+
+	return st
+}
+
+func (s *Stores) loadService(ctx context.Context, tx *sql.Tx, cur *current, id string) (*curService, error) {
+	svc, err := s.Services.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := s.Labels.FindAllByTarget(ctx, tx, assignment.ServiceTarget(id))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.IntKeys.FindAllByService(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	monitors, err := s.Heartbeats.FindAllByService(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &curService{
+		ID: svc.ID,
+		Service: Service{
+			Name:             svc.Name,
+			Description:      svc.Description,
+			EscalationPolicy: cur.policyNames[svc.EscalationPolicyID],
+		},
+	}
+	if len(labels) > 0 {
+		c.Labels = make(map[string]string, len(labels))
+		for _, l := range labels {
+			c.Labels[l.Key] = l.Value
+		}
+	}
+	for _, k := range keys {
+		c.KeyIDs = append(c.KeyIDs, k.ID)
+		c.IntegrationKeys = append(c.IntegrationKeys, IntegrationKey{Name: k.Name, Type: string(k.Type)})
+	}
+	for _, m := range monitors {
+		c.HeartbeatIDs = append(c.HeartbeatIDs, m.ID)
+		c.Heartbeats = append(c.Heartbeats, HeartbeatMonitor{
+			Name:              m.Name,
+			TimeoutMinutes:    int(m.Timeout.Minutes()),
+			AdditionalDetails: m.AdditionalDetails,
+		})
+	}
+
+	return c, nil
+}
+
+// actionFromDest converts a step destination to an Action, replacing schedule and rotation IDs with names.
+func (cur *current) actionFromDest(d gadb.DestV1) Action {
+	switch d.Type {
+	case user.DestTypeUser:
+		return Action{User: d.Arg(user.FieldUserID)}
+	case schedule.DestTypeSchedule:
+		return Action{Schedule: cur.scheduleNames[d.Arg(schedule.FieldScheduleID)]}
+	case rotation.DestTypeRotation:
+		return Action{Rotation: cur.rotationNames[d.Arg(rotation.FieldRotationID)]}
+	}
+
+	return Action{Type: d.Type, Args: d.Args}
+}
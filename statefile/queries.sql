@@ -0,0 +1,23 @@
+-- name: StateFile_ServiceIDs :many
+SELECT
+    id
+FROM
+    services
+ORDER BY
+    lower(name);
+
+-- name: StateFile_EscalationPolicyIDs :many
+SELECT
+    id
+FROM
+    escalation_policies
+ORDER BY
+    lower(name);
+
+-- name: StateFile_RotationIDs :many
+SELECT
+    id
+FROM
+    rotations
+ORDER BY
+    lower(name);
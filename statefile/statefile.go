@@ -0,0 +1,179 @@
+// Package statefile exports and applies the configuration of services, escalation policies,
+// schedules, and rotations as a versioned YAML or JSON document.
+//
+// Entities are identified by name, and references between them (e.g., a service's escalation
+// policy) use names as well, so a file can be applied to a different database. Users are not
+// managed and are referenced by ID.
+package statefile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the current version of the file format.
+const Version = 1
+
+// State is the desired (or exported) configuration.
+type State struct {
+	Version int `json:"version" yaml:"version"`
+
+	Rotations          []Rotation         `json:"rotations,omitempty" yaml:"rotations,omitempty"`
+	Schedules          []Schedule         `json:"schedules,omitempty" yaml:"schedules,omitempty"`
+	EscalationPolicies []EscalationPolicy `json:"escalationPolicies,omitempty" yaml:"escalationPolicies,omitempty"`
+	Services           []Service          `json:"services,omitempty" yaml:"services,omitempty"`
+}
+
+// Rotation is the configuration of a rotation.
+type Rotation struct {
+	Name        string    `json:"name" yaml:"name"`
+	Description string    `json:"description,omitempty" yaml:"description,omitempty"`
+	Type        string    `json:"type" yaml:"type"`
+	ShiftLength int       `json:"shiftLength" yaml:"shiftLength"`
+	Start       time.Time `json:"start" yaml:"start"`
+	TimeZone    string    `json:"timeZone" yaml:"timeZone"`
+
+	// Participants is the ordered list of user IDs in the rotation.
+	Participants []string `json:"participants,omitempty" yaml:"participants,omitempty"`
+
+	// Regions is only used for follow_the_sun rotations.
+	Regions []RotationRegion `json:"regions,omitempty" yaml:"regions,omitempty"`
+}
+
+// RotationRegion is a group of follow-the-sun rotation participants in a single time zone.
+type RotationRegion struct {
+	TimeZone string         `json:"timeZone" yaml:"timeZone"`
+	Start    timeutil.Clock `json:"start" yaml:"start"`
+	Users    []string       `json:"users" yaml:"users"`
+}
+
+// Schedule is the configuration of a schedule.
+type Schedule struct {
+	Name        string         `json:"name" yaml:"name"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	TimeZone    string         `json:"timeZone" yaml:"timeZone"`
+	Rules       []ScheduleRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
+// ScheduleRule assigns a user or rotation to a schedule. Exactly one of User or Rotation must be set.
+type ScheduleRule struct {
+	User     string `json:"user,omitempty" yaml:"user,omitempty"`
+	Rotation string `json:"rotation,omitempty" yaml:"rotation,omitempty"`
+
+	WeekdayFilter timeutil.WeekdayFilter `json:"weekdayFilter" yaml:"weekdayFilter"`
+	Start         timeutil.Clock         `json:"start" yaml:"start"`
+	End           timeutil.Clock         `json:"end" yaml:"end"`
+}
+
+// EscalationPolicy is the configuration of an escalation policy.
+type EscalationPolicy struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Repeat      int    `json:"repeat" yaml:"repeat"`
+	Steps       []Step `json:"steps,omitempty" yaml:"steps,omitempty"`
+}
+
+// Step is a step of an escalation policy.
+type Step struct {
+	DelayMinutes int      `json:"delayMinutes" yaml:"delayMinutes"`
+	MultiAck     bool     `json:"multiAck,omitempty" yaml:"multiAck,omitempty"`
+	Actions      []Action `json:"actions,omitempty" yaml:"actions,omitempty"`
+
+	// WeekdayFilter, Start, and End restrict the step to a window of time in TimeZone (UTC if empty).
+	// If WeekdayFilter is omitted, the step applies every day.
+	WeekdayFilter *timeutil.WeekdayFilter `json:"weekdayFilter,omitempty" yaml:"weekdayFilter,omitempty"`
+	Start         timeutil.Clock          `json:"start,omitempty" yaml:"start,omitempty"`
+	End           timeutil.Clock          `json:"end,omitempty" yaml:"end,omitempty"`
+	TimeZone      string                  `json:"timeZone,omitempty" yaml:"timeZone,omitempty"`
+
+	// Matches must all be met by the alert for the step to apply.
+	Matches []StepMatch `json:"matches,omitempty" yaml:"matches,omitempty"`
+}
+
+// StepMatch requires the alert's metadata or service label value for Key to equal Value.
+type StepMatch struct {
+	Type  string `json:"type" yaml:"type"`
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// Action is a destination notified by an escalation policy step.
+//
+// Schedules and rotations are referenced by name (Schedule or Rotation), users by ID (User), and
+// any other destination by its type and args.
+type Action struct {
+	User     string `json:"user,omitempty" yaml:"user,omitempty"`
+	Schedule string `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	Rotation string `json:"rotation,omitempty" yaml:"rotation,omitempty"`
+
+	Type string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Args map[string]string `json:"args,omitempty" yaml:"args,omitempty"`
+}
+
+// Service is the configuration of a service.
+type Service struct {
+	Name             string             `json:"name" yaml:"name"`
+	Description      string             `json:"description,omitempty" yaml:"description,omitempty"`
+	EscalationPolicy string             `json:"escalationPolicy" yaml:"escalationPolicy"`
+	Labels           map[string]string  `json:"labels,omitempty" yaml:"labels,omitempty"`
+	IntegrationKeys  []IntegrationKey   `json:"integrationKeys,omitempty" yaml:"integrationKeys,omitempty"`
+	Heartbeats       []HeartbeatMonitor `json:"heartbeatMonitors,omitempty" yaml:"heartbeatMonitors,omitempty"`
+}
+
+// IntegrationKey describes an integration key of a service. The key itself is not exported.
+type IntegrationKey struct {
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type" yaml:"type"`
+}
+
+// HeartbeatMonitor is the configuration of a heartbeat monitor.
+type HeartbeatMonitor struct {
+	Name              string `json:"name" yaml:"name"`
+	TimeoutMinutes    int    `json:"timeoutMinutes" yaml:"timeoutMinutes"`
+	AdditionalDetails string `json:"additionalDetails,omitempty" yaml:"additionalDetails,omitempty"`
+}
+
+// Parse parses a YAML or JSON document.
+func Parse(data []byte) (*State, error) {
+	var s State
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	err := dec.Decode(&s)
+	if err != nil {
+		return nil, fmt.Errorf("parse state: %w", err)
+	}
+	if s.Version != Version {
+		return nil, validation.NewFieldError("version", fmt.Sprintf("unsupported version %d (expected %d)", s.Version, Version))
+	}
+
+	return &s, nil
+}
+
+// Marshal encodes the state as YAML, or JSON if format is "json".
+func (s State) Marshal(format string) ([]byte, error) {
+	switch format {
+	case "json":
+		return json.MarshalIndent(s, "", "  ")
+	case "yaml", "":
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		err := enc.Encode(s)
+		if err != nil {
+			return nil, err
+		}
+		err = enc.Close()
+		if err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	return nil, validation.NewFieldError("format", "must be yaml or json")
+}
@@ -0,0 +1,97 @@
+package statefile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/util/timeutil"
+)
+
+func TestParse(t *testing.T) {
+	st, err := Parse([]byte(`
+version: 1
+schedules:
+  - name: Support
+    timeZone: UTC
+    rules:
+      - user: 00000000-0000-0000-0000-000000000001
+        weekdayFilter: 0111110
+        start: "09:00"
+        end: "17:00"
+`))
+	require.NoError(t, err)
+	require.Len(t, st.Schedules, 1)
+	require.Len(t, st.Schedules[0].Rules, 1)
+	r := st.Schedules[0].Rules[0]
+	assert.Equal(t, timeutil.WeekdayFilter{0, 1, 1, 1, 1, 1, 0}, r.WeekdayFilter)
+	assert.Equal(t, timeutil.NewClock(9, 0), r.Start)
+	assert.Equal(t, timeutil.NewClock(17, 0), r.End)
+
+	_, err = Parse([]byte(`version: 2`))
+	assert.Error(t, err, "unsupported version")
+
+	_, err = Parse([]byte("version: 1\nservices:\n  - name: foo\n    bogus: true\n"))
+	assert.Error(t, err, "unknown field")
+}
+
+func TestState_Marshal(t *testing.T) {
+	st := State{
+		Version: Version,
+		Rotations: []Rotation{{
+			Name:         "Primary",
+			Type:         "weekly",
+			ShiftLength:  1,
+			Start:        time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+			TimeZone:     "UTC",
+			Participants: []string{"00000000-0000-0000-0000-000000000001"},
+		}},
+		Schedules: []Schedule{{
+			Name:     "Support",
+			TimeZone: "UTC",
+			Rules: []ScheduleRule{{
+				Rotation:      "Primary",
+				WeekdayFilter: timeutil.EveryDay(),
+				Start:         timeutil.NewClock(8, 30),
+				End:           timeutil.NewClock(8, 30),
+			}},
+		}},
+		EscalationPolicies: []EscalationPolicy{{
+			Name: "Default",
+			Steps: []Step{
+				{DelayMinutes: 5, Actions: []Action{{Schedule: "Support"}, {Type: "builtin-webhook", Args: map[string]string{"webhook_url": "https://example.com"}}}},
+				{
+					DelayMinutes:  10,
+					Actions:       []Action{{Rotation: "Primary"}},
+					WeekdayFilter: &timeutil.WeekdayFilter{0, 1, 1, 1, 1, 1, 0},
+					Start:         timeutil.NewClock(9, 0),
+					End:           timeutil.NewClock(17, 0),
+					TimeZone:      "America/Chicago",
+					Matches:       []StepMatch{{Type: "label", Key: "team", Value: "platform"}},
+				},
+			},
+		}},
+		Services: []Service{{
+			Name:             "API",
+			EscalationPolicy: "Default",
+			Labels:           map[string]string{"team": "platform"},
+			IntegrationKeys:  []IntegrationKey{{Name: "Grafana", Type: "grafana"}},
+			Heartbeats:       []HeartbeatMonitor{{Name: "Cron", TimeoutMinutes: 15}},
+		}},
+	}
+
+	for _, format := range []string{"yaml", "json"} {
+		t.Run(format, func(t *testing.T) {
+			data, err := st.Marshal(format)
+			require.NoError(t, err)
+
+			parsed, err := Parse(data)
+			require.NoError(t, err)
+			assert.Equal(t, st, *parsed)
+		})
+	}
+
+	_, err := st.Marshal("xml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package sysapiserver
+
+import (
+	"context"
+
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/user/contactmethod"
+	"github.com/target/goalert/validation/validate"
+)
+
+func cmToProto(cm contactmethod.ContactMethod) *sysapi.ContactMethod {
+	return &sysapi.ContactMethod{
+		Id:            cm.ID.String(),
+		UserId:        cm.UserID,
+		Name:          cm.Name,
+		Dest:          destToProto(cm.Dest),
+		Disabled:      cm.Disabled,
+		StatusUpdates: cm.StatusUpdates,
+	}
+}
+
+func (srv *Server) ListContactMethods(req *sysapi.ListContactMethodsRequest, rSrv sysapi.SysAPI_ListContactMethodsServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	cms, err := srv.ContactMethodStore.FindAll(ctx, srv.DB, req.UserId)
+	if err != nil {
+		return err
+	}
+	for _, cm := range cms {
+		err = rSrv.Send(cmToProto(cm))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateContactMethod creates a contact method. It is disabled until verified (e.g., from the user's profile).
+func (srv *Server) CreateContactMethod(ctx context.Context, req *sysapi.CreateContactMethodRequest) (*sysapi.ContactMethod, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	cm, err := srv.ContactMethodStore.Create(ctx, srv.DB, &contactmethod.ContactMethod{
+		UserID:        req.UserId,
+		Name:          req.Name,
+		Dest:          destFromProto(req.Dest),
+		Disabled:      true,
+		StatusUpdates: req.StatusUpdates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cmToProto(*cm), nil
+}
+
+func (srv *Server) UpdateContactMethod(ctx context.Context, req *sysapi.UpdateContactMethodRequest) (*sysapi.ContactMethod, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	id, err := validate.ParseUUID("ID", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	cm, err := srv.ContactMethodStore.FindOne(ctx, srv.DB, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cm.Name = req.Name
+	cm.StatusUpdates = req.StatusUpdates
+	err = srv.ContactMethodStore.Update(ctx, srv.DB, cm)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmToProto(*cm), nil
+}
+
+func (srv *Server) DeleteContactMethod(ctx context.Context, req *sysapi.DeleteContactMethodRequest) (*sysapi.DeleteContactMethodResponse, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	err := srv.ContactMethodStore.Delete(ctx, srv.DB, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sysapi.DeleteContactMethodResponse{}, nil
+}
@@ -0,0 +1,217 @@
+package sysapiserver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/validation"
+)
+
+func (srv *Server) policyToProto(ctx context.Context, tx *sql.Tx, p *escalation.Policy) (*sysapi.EscalationPolicy, error) {
+	steps, err := srv.EscalationStore.FindAllStepsTx(ctx, tx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sysapi.EscalationPolicy{
+		Id:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Repeat:      int32(p.Repeat),
+	}
+	for _, step := range steps {
+		dests, err := srv.EscalationStore.FindAllStepActionsTx(ctx, txOrDB(tx, srv.DB), step.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		st := &sysapi.EscalationPolicyStep{
+			DelayMinutes: int32(step.DelayMinutes),
+			MultiAck:     step.MultiAck,
+		}
+		for _, d := range dests {
+			st.Actions = append(st.Actions, destToProto(d))
+		}
+		res.Steps = append(res.Steps, st)
+	}
+
+	return res, nil
+}
+
+func txOrDB(tx *sql.Tx, db *sql.DB) gadb.DBTX {
+	if tx == nil {
+		return db
+	}
+
+	return tx
+}
+
+func (srv *Server) ListEscalationPolicies(req *sysapi.ListEscalationPoliciesRequest, rSrv sysapi.SysAPI_ListEscalationPoliciesServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	ids, err := gadb.New(srv.DB).SysAPI_EscalationPolicyIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return sendAll(ids,
+		func(ids []string) ([]escalation.Policy, error) { return srv.EscalationStore.FindManyPolicies(ctx, ids) },
+		func(p escalation.Policy) string { return p.ID },
+		func(p escalation.Policy) error {
+			res, err := srv.policyToProto(ctx, nil, &p)
+			if err != nil {
+				return err
+			}
+			return rSrv.Send(res)
+		},
+	)
+}
+
+func (srv *Server) GetEscalationPolicy(ctx context.Context, req *sysapi.GetEscalationPolicyRequest) (*sysapi.EscalationPolicy, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	p, err := srv.EscalationStore.FindOnePolicyTx(ctx, nil, req.EscalationPolicyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return srv.policyToProto(ctx, nil, p)
+}
+
+func (srv *Server) CreateEscalationPolicy(ctx context.Context, req *sysapi.CreateEscalationPolicyRequest) (*sysapi.EscalationPolicy, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	var res *sysapi.EscalationPolicy
+	err := srv.withTx(ctx, func(tx *sql.Tx) error {
+		p, err := srv.EscalationStore.CreatePolicyTx(ctx, tx, &escalation.Policy{
+			Name:        req.Name,
+			Description: req.Description,
+			Repeat:      int(req.Repeat),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = srv.setSteps(ctx, tx, p.ID, req.Steps)
+		if err != nil {
+			return err
+		}
+
+		res, err = srv.policyToProto(ctx, tx, p)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (srv *Server) UpdateEscalationPolicy(ctx context.Context, req *sysapi.UpdateEscalationPolicyRequest) (*sysapi.EscalationPolicy, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	var res *sysapi.EscalationPolicy
+	err := srv.withTx(ctx, func(tx *sql.Tx) error {
+		p, err := srv.EscalationStore.FindOnePolicyForUpdateTx(ctx, tx, req.GetEscalationPolicy().GetId())
+		if err != nil {
+			return err
+		}
+
+		p.Name = req.EscalationPolicy.Name
+		p.Description = req.EscalationPolicy.Description
+		p.Repeat = int(req.EscalationPolicy.Repeat)
+		err = srv.EscalationStore.UpdatePolicyTx(ctx, tx, p)
+		if err != nil {
+			return err
+		}
+
+		err = srv.setSteps(ctx, tx, p.ID, req.EscalationPolicy.Steps)
+		if err != nil {
+			return err
+		}
+
+		res, err = srv.policyToProto(ctx, tx, p)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// setSteps replaces the steps of a policy. Existing steps are updated in place, so step numbers
+// of active escalations remain valid.
+func (srv *Server) setSteps(ctx context.Context, tx *sql.Tx, policyID string, steps []*sysapi.EscalationPolicyStep) error {
+	cur, err := srv.EscalationStore.FindAllStepsTx(ctx, tx, policyID)
+	if err != nil {
+		return err
+	}
+
+	for i, step := range steps {
+		var st *escalation.Step
+		if i < len(cur) {
+			st = &cur[i]
+			err = srv.EscalationStore.UpdateStepDelayTx(ctx, tx, st.ID, int(step.DelayMinutes))
+			if err != nil {
+				return validation.AddPrefix(fmt.Sprintf("Steps[%d].", i), err)
+			}
+			err = srv.EscalationStore.UpdateStepMultiAckTx(ctx, tx, st.ID, step.MultiAck)
+			if err != nil {
+				return err
+			}
+
+			dests, err := srv.EscalationStore.FindAllStepActionsTx(ctx, tx, st.ID)
+			if err != nil {
+				return err
+			}
+			for _, d := range dests {
+				err = srv.EscalationStore.DeleteStepActionTx(ctx, tx, st.ID, d)
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			st, err = srv.EscalationStore.CreateStepTx(ctx, tx, &escalation.Step{
+				PolicyID:     policyID,
+				DelayMinutes: int(step.DelayMinutes),
+				MultiAck:     step.MultiAck,
+			})
+			if err != nil {
+				return validation.AddPrefix(fmt.Sprintf("Steps[%d].", i), err)
+			}
+		}
+
+		for j, act := range step.Actions {
+			err = srv.EscalationStore.AddStepActionTx(ctx, tx, st.ID, destFromProto(act))
+			if err != nil {
+				return validation.AddPrefix(fmt.Sprintf("Steps[%d].Actions[%d].", i, j), err)
+			}
+		}
+	}
+
+	for i := len(cur) - 1; i >= len(steps); i-- {
+		_, err = srv.EscalationStore.DeleteStepTx(ctx, tx, cur[i].ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (srv *Server) DeleteEscalationPolicy(ctx context.Context, req *sysapi.DeleteEscalationPolicyRequest) (*sysapi.DeleteEscalationPolicyResponse, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	err := srv.EscalationStore.DeleteManyPoliciesTx(ctx, nil, []string{req.EscalationPolicyId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &sysapi.DeleteEscalationPolicyResponse{}, nil
+}
@@ -0,0 +1,74 @@
+package sysapiserver
+
+import (
+	"context"
+
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/override"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/validation/validate"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func overrideToProto(o override.UserOverride) *sysapi.Override {
+	return &sysapi.Override{
+		Id:           o.ID,
+		ScheduleId:   o.Target.TargetID(),
+		AddUserId:    o.AddUserID,
+		RemoveUserId: o.RemoveUserID,
+		Start:        timestamppb.New(o.Start),
+		End:          timestamppb.New(o.End),
+	}
+}
+
+func (srv *Server) ListOverrides(req *sysapi.ListOverridesRequest, rSrv sysapi.SysAPI_ListOverridesServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	err := validate.UUID("ScheduleID", req.ScheduleId)
+	if err != nil {
+		return err
+	}
+
+	overrides, err := srv.OverrideStore.FindAllUserOverrides(ctx, timeFromProto(req.Start), timeFromProto(req.End), assignment.ScheduleTarget(req.ScheduleId))
+	if err != nil {
+		return err
+	}
+	for _, o := range overrides {
+		err = rSrv.Send(overrideToProto(o))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (srv *Server) CreateOverride(ctx context.Context, req *sysapi.CreateOverrideRequest) (*sysapi.Override, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	o := req.GetOverride()
+	n, err := srv.OverrideStore.CreateUserOverrideTx(ctx, nil, &override.UserOverride{
+		AddUserID:    o.GetAddUserId(),
+		RemoveUserID: o.GetRemoveUserId(),
+		Start:        timeFromProto(o.GetStart()),
+		End:          timeFromProto(o.GetEnd()),
+		Target:       assignment.ScheduleTarget(o.GetScheduleId()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return overrideToProto(*n), nil
+}
+
+func (srv *Server) DeleteOverride(ctx context.Context, req *sysapi.DeleteOverrideRequest) (*sysapi.DeleteOverrideResponse, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	err := srv.OverrideStore.DeleteUserOverrideTx(ctx, nil, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sysapi.DeleteOverrideResponse{}, nil
+}
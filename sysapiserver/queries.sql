@@ -0,0 +1,40 @@
+-- name: SysAPI_UserIDs :many
+SELECT
+    id
+FROM
+    users
+ORDER BY
+    lower(name),
+    id;
+
+-- name: SysAPI_ServiceIDs :many
+SELECT
+    id
+FROM
+    services
+ORDER BY
+    lower(name);
+
+-- name: SysAPI_EscalationPolicyIDs :many
+SELECT
+    id
+FROM
+    escalation_policies
+ORDER BY
+    lower(name);
+
+-- name: SysAPI_ScheduleIDs :many
+SELECT
+    id
+FROM
+    schedules
+ORDER BY
+    lower(name);
+
+-- name: SysAPI_RotationIDs :many
+SELECT
+    id
+FROM
+    rotations
+ORDER BY
+    lower(name);
@@ -0,0 +1,207 @@
+package sysapiserver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/validation"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (srv *Server) rotationToProto(ctx context.Context, tx *sql.Tx, r *rotation.Rotation) (*sysapi.Rotation, error) {
+	var parts []rotation.Participant
+	var err error
+	if tx == nil {
+		parts, err = srv.RotationStore.FindAllParticipants(ctx, r.ID)
+	} else {
+		parts, err = srv.RotationStore.FindAllParticipantsTx(ctx, tx, r.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sysapi.Rotation{
+		Id:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Type:        string(r.Type),
+		ShiftLength: int32(r.ShiftLength),
+		Start:       timestamppb.New(r.Start),
+		TimeZone:    r.Start.Location().String(),
+	}
+	for _, p := range parts {
+		res.ParticipantUserIds = append(res.ParticipantUserIds, p.Target.TargetID())
+	}
+
+	return res, nil
+}
+
+func (srv *Server) ListRotations(req *sysapi.ListRotationsRequest, rSrv sysapi.SysAPI_ListRotationsServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	ids, err := gadb.New(srv.DB).SysAPI_RotationIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return sendAll(ids,
+		func(ids []string) ([]rotation.Rotation, error) { return srv.RotationStore.FindMany(ctx, ids) },
+		func(r rotation.Rotation) string { return r.ID },
+		func(r rotation.Rotation) error {
+			res, err := srv.rotationToProto(ctx, nil, &r)
+			if err != nil {
+				return err
+			}
+			return rSrv.Send(res)
+		},
+	)
+}
+
+func (srv *Server) GetRotation(ctx context.Context, req *sysapi.GetRotationRequest) (*sysapi.Rotation, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	r, err := srv.RotationStore.FindRotation(ctx, req.RotationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return srv.rotationToProto(ctx, nil, r)
+}
+
+func rotationFromProto(r *sysapi.Rotation) (*rotation.Rotation, error) {
+	loc, err := util.LoadLocation(r.TimeZone)
+	if err != nil {
+		return nil, validation.NewFieldError("TimeZone", err.Error())
+	}
+
+	return &rotation.Rotation{
+		ID:          r.Id,
+		Name:        r.Name,
+		Description: r.Description,
+		Type:        rotation.Type(r.Type),
+		ShiftLength: int(r.ShiftLength),
+		Start:       timeFromProto(r.Start).In(loc),
+	}, nil
+}
+
+func (srv *Server) CreateRotation(ctx context.Context, req *sysapi.CreateRotationRequest) (*sysapi.Rotation, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	r, err := rotationFromProto(&sysapi.Rotation{
+		Name:        req.Name,
+		Description: req.Description,
+		Type:        req.Type,
+		ShiftLength: req.ShiftLength,
+		Start:       req.Start,
+		TimeZone:    req.TimeZone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res *sysapi.Rotation
+	err = srv.withTx(ctx, func(tx *sql.Tx) error {
+		r, err = srv.RotationStore.CreateRotationTx(ctx, tx, r)
+		if err != nil {
+			return err
+		}
+		if len(req.ParticipantUserIds) > 0 {
+			err = srv.RotationStore.AddRotationUsersTx(ctx, tx, r.ID, req.ParticipantUserIds)
+			if err != nil {
+				return err
+			}
+		}
+
+		res, err = srv.rotationToProto(ctx, tx, r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (srv *Server) UpdateRotation(ctx context.Context, req *sysapi.UpdateRotationRequest) (*sysapi.Rotation, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	r, err := rotationFromProto(req.GetRotation())
+	if err != nil {
+		return nil, err
+	}
+
+	var res *sysapi.Rotation
+	err = srv.withTx(ctx, func(tx *sql.Tx) error {
+		cur, err := srv.RotationStore.FindRotationForUpdateTx(ctx, tx, r.ID)
+		if err != nil {
+			return err
+		}
+		// regions are not part of the API, so keep the existing ones
+		r.Regions = cur.Regions
+		err = srv.RotationStore.UpdateRotationTx(ctx, tx, r)
+		if err != nil {
+			return err
+		}
+
+		err = srv.setParticipants(ctx, tx, r.ID, req.Rotation.ParticipantUserIds)
+		if err != nil {
+			return err
+		}
+
+		res, err = srv.rotationToProto(ctx, tx, r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// setParticipants replaces the participants of a rotation, updating existing positions in place
+// so the active participant is kept when possible.
+func (srv *Server) setParticipants(ctx context.Context, tx *sql.Tx, rotID string, userIDs []string) error {
+	parts, err := srv.RotationStore.FindAllParticipantsTx(ctx, tx, rotID)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(parts) && i < len(userIDs); i++ {
+		if parts[i].Target.TargetID() == userIDs[i] {
+			continue
+		}
+		err = srv.RotationStore.UpdateParticipantUserIDTx(ctx, tx, parts[i].ID, userIDs[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(parts) > len(userIDs) {
+		var ids []string
+		for _, p := range parts[len(userIDs):] {
+			ids = append(ids, p.ID)
+		}
+		return srv.RotationStore.DeleteRotationParticipantsTx(ctx, tx, ids)
+	}
+	if len(userIDs) > len(parts) {
+		return srv.RotationStore.AddRotationUsersTx(ctx, tx, rotID, userIDs[len(parts):])
+	}
+
+	return nil
+}
+
+func (srv *Server) DeleteRotation(ctx context.Context, req *sysapi.DeleteRotationRequest) (*sysapi.DeleteRotationResponse, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	err := srv.RotationStore.DeleteManyTx(ctx, nil, []string{req.RotationId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &sysapi.DeleteRotationResponse{}, nil
+}
@@ -0,0 +1,110 @@
+package sysapiserver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/validation"
+)
+
+func scheduleToProto(s schedule.Schedule) *sysapi.Schedule {
+	return &sysapi.Schedule{
+		Id:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		TimeZone:    s.TimeZone.String(),
+	}
+}
+
+func (srv *Server) ListSchedules(req *sysapi.ListSchedulesRequest, rSrv sysapi.SysAPI_ListSchedulesServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	ids, err := gadb.New(srv.DB).SysAPI_ScheduleIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return sendAll(ids,
+		func(ids []string) ([]schedule.Schedule, error) { return srv.ScheduleStore.FindMany(ctx, ids) },
+		func(s schedule.Schedule) string { return s.ID },
+		func(s schedule.Schedule) error { return rSrv.Send(scheduleToProto(s)) },
+	)
+}
+
+func (srv *Server) GetSchedule(ctx context.Context, req *sysapi.GetScheduleRequest) (*sysapi.Schedule, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	s, err := srv.ScheduleStore.FindOne(ctx, req.ScheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToProto(*s), nil
+}
+
+func (srv *Server) CreateSchedule(ctx context.Context, req *sysapi.CreateScheduleRequest) (*sysapi.Schedule, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	loc, err := util.LoadLocation(req.TimeZone)
+	if err != nil {
+		return nil, validation.NewFieldError("TimeZone", err.Error())
+	}
+
+	var s *schedule.Schedule
+	err = srv.withTx(ctx, func(tx *sql.Tx) (err error) {
+		s, err = srv.ScheduleStore.CreateScheduleTx(ctx, tx, &schedule.Schedule{
+			Name:        req.Name,
+			Description: req.Description,
+			TimeZone:    loc,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToProto(*s), nil
+}
+
+func (srv *Server) UpdateSchedule(ctx context.Context, req *sysapi.UpdateScheduleRequest) (*sysapi.Schedule, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	loc, err := util.LoadLocation(req.GetSchedule().GetTimeZone())
+	if err != nil {
+		return nil, validation.NewFieldError("TimeZone", err.Error())
+	}
+
+	var s *schedule.Schedule
+	err = srv.withTx(ctx, func(tx *sql.Tx) (err error) {
+		s, err = srv.ScheduleStore.FindOneForUpdate(ctx, tx, req.Schedule.Id)
+		if err != nil {
+			return err
+		}
+
+		s.Name = req.Schedule.Name
+		s.Description = req.Schedule.Description
+		s.TimeZone = loc
+		return srv.ScheduleStore.UpdateTx(ctx, tx, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToProto(*s), nil
+}
+
+func (srv *Server) DeleteSchedule(ctx context.Context, req *sysapi.DeleteScheduleRequest) (*sysapi.DeleteScheduleResponse, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	err := srv.ScheduleStore.DeleteManyTx(ctx, nil, []string{req.ScheduleId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &sysapi.DeleteScheduleResponse{}, nil
+}
@@ -2,14 +2,34 @@ package sysapiserver
 
 import (
 	"context"
+	"database/sql"
 
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/escalation"
+	"github.com/target/goalert/override"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/schedule"
+	"github.com/target/goalert/schedule/rotation"
+	"github.com/target/goalert/service"
 	"github.com/target/goalert/user"
+	"github.com/target/goalert/user/contactmethod"
 )
 
 type Server struct {
-	UserStore *user.Store
+	DB *sql.DB
+
+	UserStore          *user.Store
+	ContactMethodStore *contactmethod.Store
+	ServiceStore       *service.Store
+	EscalationStore    *escalation.Store
+	ScheduleStore      *schedule.Store
+	RotationStore      *rotation.Store
+	OverrideStore      *override.Store
+	AlertStore         *alert.Store
+	AlertLogStore      *alertlog.Store
+
 	sysapi.UnimplementedSysAPIServer
 }
 
@@ -0,0 +1,98 @@
+package sysapiserver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/service"
+)
+
+func serviceToProto(svc service.Service) *sysapi.Service {
+	return &sysapi.Service{
+		Id:                 svc.ID,
+		Name:               svc.Name,
+		Description:        svc.Description,
+		EscalationPolicyId: svc.EscalationPolicyID,
+	}
+}
+
+func (srv *Server) ListServices(req *sysapi.ListServicesRequest, rSrv sysapi.SysAPI_ListServicesServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	ids, err := gadb.New(srv.DB).SysAPI_ServiceIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return sendAll(ids,
+		func(ids []string) ([]service.Service, error) { return srv.ServiceStore.FindMany(ctx, ids) },
+		func(svc service.Service) string { return svc.ID },
+		func(svc service.Service) error { return rSrv.Send(serviceToProto(svc)) },
+	)
+}
+
+func (srv *Server) GetService(ctx context.Context, req *sysapi.GetServiceRequest) (*sysapi.Service, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	svc, err := srv.ServiceStore.FindOne(ctx, req.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return serviceToProto(*svc), nil
+}
+
+func (srv *Server) CreateService(ctx context.Context, req *sysapi.CreateServiceRequest) (*sysapi.Service, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	var svc *service.Service
+	err := srv.withTx(ctx, func(tx *sql.Tx) (err error) {
+		svc, err = srv.ServiceStore.CreateServiceTx(ctx, tx, &service.Service{
+			Name:               req.Name,
+			Description:        req.Description,
+			EscalationPolicyID: req.EscalationPolicyId,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serviceToProto(*svc), nil
+}
+
+func (srv *Server) UpdateService(ctx context.Context, req *sysapi.UpdateServiceRequest) (*sysapi.Service, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	var svc *service.Service
+	err := srv.withTx(ctx, func(tx *sql.Tx) (err error) {
+		svc, err = srv.ServiceStore.FindOneForUpdate(ctx, tx, req.GetService().GetId())
+		if err != nil {
+			return err
+		}
+
+		svc.Name = req.Service.Name
+		svc.Description = req.Service.Description
+		svc.EscalationPolicyID = req.Service.EscalationPolicyId
+		return srv.ServiceStore.UpdateTx(ctx, tx, svc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serviceToProto(*svc), nil
+}
+
+func (srv *Server) DeleteService(ctx context.Context, req *sysapi.DeleteServiceRequest) (*sysapi.DeleteServiceResponse, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	err := srv.ServiceStore.DeleteManyTx(ctx, nil, []string{req.ServiceId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &sysapi.DeleteServiceResponse{}, nil
+}
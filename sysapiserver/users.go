@@ -0,0 +1,100 @@
+package sysapiserver
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/user"
+)
+
+func userToProto(u user.User) *sysapi.User {
+	return &sysapi.User{
+		Id:    u.ID,
+		Name:  u.Name,
+		Email: u.Email,
+		Role:  string(u.Role),
+	}
+}
+
+func (srv *Server) ListUsers(req *sysapi.ListUsersRequest, rSrv sysapi.SysAPI_ListUsersServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	ids, err := gadb.New(srv.DB).SysAPI_UserIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return sendAll(ids,
+		func(ids []string) ([]user.User, error) { return srv.UserStore.FindMany(ctx, ids) },
+		func(u user.User) string { return u.ID },
+		func(u user.User) error { return rSrv.Send(userToProto(u)) },
+	)
+}
+
+func (srv *Server) GetUser(ctx context.Context, req *sysapi.GetUserRequest) (*sysapi.User, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	u, err := srv.UserStore.FindOne(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return userToProto(*u), nil
+}
+
+func (srv *Server) CreateUser(ctx context.Context, req *sysapi.CreateUserRequest) (*sysapi.User, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	role := permission.Role(req.Role)
+	if role == "" {
+		role = permission.RoleUser
+	}
+	u, err := srv.UserStore.Insert(ctx, &user.User{
+		Name:  req.Name,
+		Email: req.Email,
+		Role:  role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userToProto(*u), nil
+}
+
+func (srv *Server) UpdateUser(ctx context.Context, req *sysapi.UpdateUserRequest) (*sysapi.User, error) {
+	ctx = permission.SystemContext(ctx, "SystemAPI")
+
+	var u *user.User
+	err := srv.withTx(ctx, func(tx *sql.Tx) error {
+		cur, err := srv.UserStore.FindOneTx(ctx, tx, req.GetUser().GetId(), true)
+		if err != nil {
+			return err
+		}
+
+		cur.Name = req.User.Name
+		cur.Email = req.User.Email
+		err = srv.UserStore.UpdateTx(ctx, tx, cur)
+		if err != nil {
+			return err
+		}
+
+		if req.User.Role != "" && permission.Role(req.User.Role) != cur.Role {
+			cur.Role = permission.Role(req.User.Role)
+			err = srv.UserStore.SetUserRoleTx(ctx, tx, cur.ID, cur.Role)
+			if err != nil {
+				return err
+			}
+		}
+
+		u = cur
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userToProto(*u), nil
+}
@@ -0,0 +1,146 @@
+package sysapiserver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// listBatchSize is the number of entities loaded at once by List RPCs.
+const listBatchSize = 100
+
+// UnaryErrorInterceptor converts store errors to gRPC status errors.
+func UnaryErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, statusError(err)
+}
+
+// StreamErrorInterceptor converts store errors to gRPC status errors.
+func StreamErrorInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return statusError(handler(srv, ss))
+}
+
+// UnaryContextInterceptor returns an interceptor that applies fn to the context of each request, e.g., to add the current config.
+func UnaryContextInterceptor(fn func(context.Context) context.Context) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(fn(ctx), req)
+	}
+}
+
+// StreamContextInterceptor returns an interceptor that applies fn to the context of each stream, e.g., to add the current config.
+func StreamContextInterceptor(fn func(context.Context) context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: fn(ss.Context())})
+	}
+}
+
+// contextStream is a grpc.ServerStream with a replaced context.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context { return s.ctx }
+
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "not found")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case permission.IsPermissionError(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case validation.IsClientError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+func (srv *Server) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := srv.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer sqlutil.Rollback(ctx, "sysapi", tx)
+
+	err = fn(tx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// sendAll loads entities in batches, and sends them in the order of ids. Entities deleted after
+// the IDs were read are skipped.
+func sendAll[T any](ids []uuid.UUID, findMany func([]string) ([]T, error), getID func(T) string, send func(T) error) error {
+	for len(ids) > 0 {
+		batch := make([]string, min(len(ids), listBatchSize))
+		for i := range batch {
+			batch[i] = ids[i].String()
+		}
+		ids = ids[len(batch):]
+
+		items, err := findMany(batch)
+		if err != nil {
+			return err
+		}
+		byID := make(map[string]T, len(items))
+		for _, item := range items {
+			byID[getID(item)] = item
+		}
+		for _, id := range batch {
+			item, ok := byID[id]
+			if !ok {
+				continue
+			}
+			err = send(item)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func destFromProto(d *sysapi.Destination) gadb.DestV1 {
+	if d == nil {
+		return gadb.DestV1{}
+	}
+
+	return gadb.DestV1{Type: d.Type, Args: d.Args}
+}
+
+func destToProto(d gadb.DestV1) *sysapi.Destination {
+	return &sysapi.Destination{Type: d.Type, Args: d.Args}
+}
+
+// timeFromProto returns the zero time if ts is nil, so validation will report the missing field.
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
@@ -0,0 +1,82 @@
+package sysapiserver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/config"
+	"github.com/target/goalert/validation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	assert.NoError(t, statusError(nil))
+	assert.Equal(t, codes.NotFound, status.Code(statusError(fmt.Errorf("find: %w", sql.ErrNoRows))))
+	assert.Equal(t, codes.InvalidArgument, status.Code(statusError(validation.NewFieldError("Name", "required"))))
+	assert.Equal(t, codes.Canceled, status.Code(statusError(context.Canceled)))
+	assert.Equal(t, codes.Unknown, status.Code(statusError(fmt.Errorf("boom"))))
+
+	err := status.Error(codes.Aborted, "aborted")
+	assert.Equal(t, err, statusError(err), "existing status errors are unchanged")
+}
+
+func TestSendAll(t *testing.T) {
+	ids := make([]uuid.UUID, listBatchSize+5)
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+	deleted := ids[3].String()
+
+	var calls int
+	var sent []string
+	err := sendAll(ids,
+		func(batch []string) ([]string, error) {
+			calls++
+			var res []string
+			// return in reverse order, and omit the deleted ID
+			for i := len(batch) - 1; i >= 0; i-- {
+				if batch[i] != deleted {
+					res = append(res, batch[i])
+				}
+			}
+			return res, nil
+		},
+		func(id string) string { return id },
+		func(id string) error { sent = append(sent, id); return nil },
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	var expected []string
+	for _, id := range ids {
+		if id.String() != deleted {
+			expected = append(expected, id.String())
+		}
+	}
+	assert.Equal(t, expected, sent)
+}
+
+func TestContextInterceptors(t *testing.T) {
+	var cfg config.Config
+	cfg.General.ApplicationName = "test"
+	withCfg := func(ctx context.Context) context.Context { return cfg.Context(ctx) }
+
+	_, err := UnaryContextInterceptor(withCfg)(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		assert.Equal(t, "test", config.FromContext(ctx).General.ApplicationName)
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	err = StreamContextInterceptor(withCfg)(nil, &contextStream{ctx: context.Background()}, &grpc.StreamServerInfo{}, func(srv any, ss grpc.ServerStream) error {
+		assert.Equal(t, "test", config.FromContext(ss.Context()).General.ApplicationName)
+		return nil
+	})
+	require.NoError(t, err)
+}
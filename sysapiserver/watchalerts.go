@@ -0,0 +1,137 @@
+package sysapiserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/alert/alertlog"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/validation/validate"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// watchPollInterval is how often new alert log entries are checked for.
+	watchPollInterval = time.Second
+
+	watchBatchSize = 100
+)
+
+func alertToProto(a alert.Alert) *sysapi.Alert {
+	return &sysapi.Alert{
+		Id:        int64(a.ID),
+		ServiceId: a.ServiceID,
+		Status:    string(a.Status),
+		Summary:   a.Summary,
+		Details:   a.Details,
+		Severity:  string(a.Severity),
+		CreatedAt: timestamppb.New(a.CreatedAt),
+	}
+}
+
+func alertEventType(t alertlog.Type) sysapi.AlertEvent_Type {
+	switch t {
+	case alertlog.TypeCreated:
+		return sysapi.AlertEvent_TYPE_CREATED
+	case alertlog.TypeAcknowledged, alertlog.TypeClosed, alertlog.TypeSnoozed, alertlog.TypeSnoozeExpired:
+		return sysapi.AlertEvent_TYPE_STATUS_CHANGED
+	}
+
+	return sysapi.AlertEvent_TYPE_LOG
+}
+
+// WatchAlerts polls the alert log, sending an event for each new entry until the client disconnects.
+func (srv *Server) WatchAlerts(req *sysapi.WatchAlertsRequest, rSrv sysapi.SysAPI_WatchAlertsServer) error {
+	ctx := permission.SystemContext(rSrv.Context(), "SystemAPI")
+
+	if req.ServiceId != "" {
+		err := validate.UUID("ServiceID", req.ServiceId)
+		if err != nil {
+			return err
+		}
+	}
+
+	lastID := int(req.AfterEventId)
+	if lastID == 0 {
+		var err error
+		lastID, err = srv.AlertLogStore.LastID(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	cur := alertlog.NewCursor(lastID)
+	t := time.NewTicker(watchPollInterval)
+	defer t.Stop()
+	for {
+		n, err := srv.sendAlertEvents(ctx, rSrv, req.ServiceId, cur)
+		if err != nil {
+			return err
+		}
+		if n >= watchBatchSize {
+			// more entries are likely pending
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// sendAlertEvents sends events for log entries not yet read by cur, and returns the number of entries read.
+func (srv *Server) sendAlertEvents(ctx context.Context, rSrv sysapi.SysAPI_WatchAlertsServer, serviceID string, cur *alertlog.Cursor) (int, error) {
+	entries, err := srv.AlertLogStore.FindNext(ctx, cur, watchBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	var alertIDs []int
+	seen := make(map[int]bool)
+	for _, e := range entries {
+		if seen[e.AlertID()] {
+			continue
+		}
+		seen[e.AlertID()] = true
+		alertIDs = append(alertIDs, e.AlertID())
+	}
+	alerts, err := srv.AlertStore.FindMany(ctx, alertIDs)
+	if err != nil {
+		return 0, err
+	}
+	byID := make(map[int]alert.Alert, len(alerts))
+	for _, a := range alerts {
+		byID[a.ID] = a
+	}
+
+	for _, e := range entries {
+		a, ok := byID[e.AlertID()]
+		if !ok {
+			// alert was deleted
+			continue
+		}
+		if serviceID != "" && a.ServiceID != serviceID {
+			continue
+		}
+
+		err = rSrv.Send(&sysapi.AlertEvent{
+			Id:        int64(e.ID()),
+			Type:      alertEventType(e.Type()),
+			Timestamp: timestamppb.New(e.Timestamp()),
+			Alert:     alertToProto(a),
+			Message:   e.String(ctx),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(entries), nil
+}
@@ -0,0 +1,169 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/assignment"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// targetOwners returns the owning team ID for each target that belongs to a team.
+func (s *Store) targetOwners(ctx context.Context, db *gadb.Queries, tgts []assignment.Target) (map[uuid.UUID]uuid.UUID, error) {
+	var args gadb.TeamTargetOwnersParams
+	for _, tgt := range tgts {
+		id, err := validate.ParseUUID("TargetID", tgt.TargetID())
+		if err != nil {
+			return nil, err
+		}
+		switch tgt.TargetType() {
+		case assignment.TargetTypeService:
+			args.ServiceIds = append(args.ServiceIds, id)
+		case assignment.TargetTypeEscalationPolicy:
+			args.EscalationPolicyIds = append(args.EscalationPolicyIds, id)
+		case assignment.TargetTypeSchedule:
+			args.ScheduleIds = append(args.ScheduleIds, id)
+		case assignment.TargetTypeRotation:
+			args.RotationIds = append(args.RotationIds, id)
+		case assignment.TargetTypeIntegrationKey:
+			args.IntegrationKeyIds = append(args.IntegrationKeyIds, id)
+		case assignment.TargetTypeHeartbeatMonitor:
+			args.HeartbeatMonitorIds = append(args.HeartbeatMonitorIds, id)
+		default:
+			return nil, validation.NewFieldError("TargetType", "unsupported target type "+tgt.TargetType().String())
+		}
+	}
+
+	rows, err := db.TeamTargetOwners(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[uuid.UUID]uuid.UUID, len(rows))
+	for _, r := range rows {
+		owners[r.ID] = r.TeamID
+	}
+
+	return owners, nil
+}
+
+// checkMember returns nil if the current user is a member of all of the teams, or an admin.
+func (s *Store) checkMember(ctx context.Context, db *gadb.Queries, teamIDs []uuid.UUID) error {
+	if permission.Admin(ctx) || len(teamIDs) == 0 {
+		return nil
+	}
+	userID := permission.UserNullUUID(ctx)
+	if !userID.Valid {
+		return permission.NewAccessDenied("only team members may modify resources owned by a team")
+	}
+
+	memberOf, err := db.TeamMemberOf(ctx, gadb.TeamMemberOfParams{UserID: userID.UUID, TeamIds: teamIDs})
+	if err != nil {
+		return err
+	}
+	for _, id := range teamIDs {
+		if !slices.Contains(memberOf, id) {
+			return permission.NewAccessDenied("only team members may modify resources owned by a team")
+		}
+	}
+
+	return nil
+}
+
+// CheckModifyTx returns an access denied error unless the current user may modify all of the targets. Targets
+// not owned by a team may be modified by any user, otherwise only members of the owning team (or an admin) may
+// modify them.
+//
+// Supported target types are services, escalation policies, schedules, rotations, integration keys, and
+// heartbeat monitors. Integration keys and heartbeat monitors are owned by the team that owns their service.
+func (s *Store) CheckModifyTx(ctx context.Context, tx *sql.Tx, tgts ...assignment.Target) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+	if permission.Admin(ctx) || len(tgts) == 0 {
+		return nil
+	}
+
+	db := s.queries(tx)
+	owners, err := s.targetOwners(ctx, db, tgts)
+	if err != nil {
+		return err
+	}
+
+	var teamIDs []uuid.UUID
+	for _, id := range owners {
+		if !slices.Contains(teamIDs, id) {
+			teamIDs = append(teamIDs, id)
+		}
+	}
+
+	return s.checkMember(ctx, db, teamIDs)
+}
+
+// FindOwner returns the ID of the team that owns the target, or an empty string if it is not owned by a team.
+func (s *Store) FindOwner(ctx context.Context, tgt assignment.Target) (string, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return "", err
+	}
+
+	owners, err := s.targetOwners(ctx, s.queries(nil), []assignment.Target{tgt})
+	if err != nil {
+		return "", err
+	}
+	for _, id := range owners {
+		return id.String(), nil
+	}
+
+	return "", nil
+}
+
+// SetOwnerTx sets the team that owns a service, escalation policy, schedule, or rotation. An empty teamID
+// removes team ownership.
+//
+// The current user must be allowed to modify the target, and be a member of the new team (or an admin).
+func (s *Store) SetOwnerTx(ctx context.Context, tx *sql.Tx, tgt assignment.Target, teamID string) error {
+	err := s.CheckModifyTx(ctx, tx, tgt)
+	if err != nil {
+		return err
+	}
+	id, err := validate.ParseUUID("TargetID", tgt.TargetID())
+	if err != nil {
+		return err
+	}
+	var team uuid.NullUUID
+	if teamID != "" {
+		team.UUID, err = validate.ParseUUID("TeamID", teamID)
+		if err != nil {
+			return err
+		}
+		team.Valid = true
+	}
+
+	db := s.queries(tx)
+	if team.Valid {
+		err = s.checkMember(ctx, db, []uuid.UUID{team.UUID})
+		if err != nil {
+			return err
+		}
+	}
+
+	switch tgt.TargetType() {
+	case assignment.TargetTypeService:
+		return db.TeamSetServiceOwner(ctx, gadb.TeamSetServiceOwnerParams{ID: id, TeamID: team})
+	case assignment.TargetTypeEscalationPolicy:
+		return db.TeamSetEscalationPolicyOwner(ctx, gadb.TeamSetEscalationPolicyOwnerParams{ID: id, TeamID: team})
+	case assignment.TargetTypeSchedule:
+		return db.TeamSetScheduleOwner(ctx, gadb.TeamSetScheduleOwnerParams{ID: id, TeamID: team})
+	case assignment.TargetTypeRotation:
+		return db.TeamSetRotationOwner(ctx, gadb.TeamSetRotationOwnerParams{ID: id, TeamID: team})
+	}
+
+	return validation.NewFieldError("TargetType", "unsupported target type "+tgt.TargetType().String())
+}
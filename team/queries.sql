@@ -0,0 +1,186 @@
+-- name: TeamCreate :one
+INSERT INTO teams(name, description)
+    VALUES ($1, $2)
+RETURNING
+    id;
+
+-- name: TeamUpdate :exec
+UPDATE
+    teams
+SET
+    name = $2,
+    description = $3
+WHERE
+    id = $1;
+
+-- name: TeamDeleteMany :exec
+DELETE FROM teams
+WHERE id = ANY (@ids::uuid[]);
+
+-- name: TeamFindMany :many
+-- Get teams by ID, and whether each is a favorite of the given user.
+SELECT
+    t.id,
+    t.name,
+    t.description,
+    (fav.id IS NOT NULL)::bool AS is_favorite
+FROM
+    teams t
+    LEFT JOIN user_favorites fav ON fav.tgt_team_id = t.id
+        AND fav.user_id = sqlc.narg(user_id)
+WHERE
+    t.id = ANY (@ids::uuid[]);
+
+-- name: TeamFindOneForUpdate :one
+SELECT
+    id
+FROM
+    teams
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: TeamMembers :many
+-- Get the members of a team, owners first.
+SELECT
+    m.user_id,
+    m.role
+FROM
+    team_members m
+    JOIN users u ON u.id = m.user_id
+WHERE
+    m.team_id = $1
+ORDER BY
+    m.role DESC,
+    lower(u.name);
+
+-- name: TeamMemberRole :one
+SELECT
+    role
+FROM
+    team_members
+WHERE
+    team_id = $1
+    AND user_id = $2;
+
+-- name: TeamMemberSet :exec
+INSERT INTO team_members(team_id, user_id, role)
+    VALUES ($1, $2, $3)
+ON CONFLICT (team_id, user_id)
+    DO UPDATE SET
+        role = $3;
+
+-- name: TeamMemberDelete :exec
+DELETE FROM team_members
+WHERE team_id = $1
+    AND user_id = $2;
+
+-- name: TeamOwnerCount :one
+SELECT
+    count(*)
+FROM
+    team_members
+WHERE
+    team_id = $1
+    AND role = 'owner';
+
+-- name: TeamTargetOwners :many
+-- Get the owning team of each target that belongs to a team. Integration keys and heartbeat
+-- monitors belong to the team that owns their service.
+SELECT
+    id,
+    team_id::uuid
+FROM
+    services
+WHERE
+    id = ANY (@service_ids::uuid[])
+    AND team_id IS NOT NULL
+UNION ALL
+SELECT
+    id,
+    team_id::uuid
+FROM
+    escalation_policies
+WHERE
+    id = ANY (@escalation_policy_ids::uuid[])
+    AND team_id IS NOT NULL
+UNION ALL
+SELECT
+    id,
+    team_id::uuid
+FROM
+    schedules
+WHERE
+    id = ANY (@schedule_ids::uuid[])
+    AND team_id IS NOT NULL
+UNION ALL
+SELECT
+    id,
+    team_id::uuid
+FROM
+    rotations
+WHERE
+    id = ANY (@rotation_ids::uuid[])
+    AND team_id IS NOT NULL
+UNION ALL
+SELECT
+    k.id,
+    svc.team_id::uuid
+FROM
+    integration_keys k
+    JOIN services svc ON svc.id = k.service_id
+WHERE
+    k.id = ANY (@integration_key_ids::uuid[])
+    AND svc.team_id IS NOT NULL
+UNION ALL
+SELECT
+    hb.id,
+    svc.team_id::uuid
+FROM
+    heartbeat_monitors hb
+    JOIN services svc ON svc.id = hb.service_id
+WHERE
+    hb.id = ANY (@heartbeat_monitor_ids::uuid[])
+    AND svc.team_id IS NOT NULL;
+
+-- name: TeamMemberOf :many
+-- Get the IDs of the given teams the user is a member of.
+SELECT
+    team_id
+FROM
+    team_members
+WHERE
+    user_id = $1
+    AND team_id = ANY (@team_ids::uuid[]);
+
+-- name: TeamSetServiceOwner :exec
+UPDATE
+    services
+SET
+    team_id = $2
+WHERE
+    id = $1;
+
+-- name: TeamSetEscalationPolicyOwner :exec
+UPDATE
+    escalation_policies
+SET
+    team_id = $2
+WHERE
+    id = $1;
+
+-- name: TeamSetScheduleOwner :exec
+UPDATE
+    schedules
+SET
+    team_id = $2
+WHERE
+    id = $1;
+
+-- name: TeamSetRotationOwner :exec
+UPDATE
+    rotations
+SET
+    team_id = $2
+WHERE
+    id = $1;
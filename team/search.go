@@ -0,0 +1,166 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/search"
+	"github.com/target/goalert/util/sqlutil"
+	"github.com/target/goalert/validation/validate"
+)
+
+// SearchOptions allow filtering and paginating the list of teams.
+type SearchOptions struct {
+	Search string       `json:"s,omitempty"`
+	After  SearchCursor `json:"a,omitempty"`
+
+	// Omit specifies a list of team IDs to exclude from the results.
+	Omit []string `json:"o,omitempty"`
+
+	// MemberUserID, if set, will limit results to teams the user is a member of.
+	MemberUserID string `json:"m,omitempty"`
+
+	Limit int `json:"-"`
+
+	// FavoritesOnly controls filtering the results to those marked as favorites by FavoritesUserID.
+	FavoritesOnly bool `json:"b,omitempty"`
+
+	// FavoritesFirst indicates that teams marked as favorite (by FavoritesUserID) should be returned first (before any non-favorites).
+	FavoritesFirst bool `json:"f,omitempty"`
+
+	// FavoritesUserID is the userID associated with the team favorite.
+	FavoritesUserID string `json:"u,omitempty"`
+}
+
+// SearchCursor is used to indicate a position in a paginated list.
+type SearchCursor struct {
+	Name       string `json:"n,omitempty"`
+	IsFavorite bool   `json:"f,omitempty"`
+}
+
+var searchTemplate = template.Must(template.New("search").Funcs(search.Helpers()).Parse(`
+	SELECT
+		t.id,
+		t.name,
+		t.description,
+		fav IS DISTINCT FROM NULL
+	FROM teams t
+	{{if not .FavoritesOnly }}LEFT {{end}}JOIN user_favorites fav ON t.id = fav.tgt_team_id AND {{if .FavoritesUserID}}fav.user_id = :favUserID{{else}}false{{end}}
+	WHERE true
+	{{if .Omit}}
+		AND NOT t.id = any(:omit)
+	{{end}}
+	{{if .MemberUserID}}
+		AND t.id IN (SELECT team_id FROM team_members WHERE user_id = :memberUserID)
+	{{end}}
+	{{if .Search}}
+		AND ({{orderedPrefixSearch "search" "t.name"}} OR {{contains "search" "t.description"}} OR {{contains "search" "t.name"}})
+	{{end}}
+	{{if .After.Name}}
+		AND
+		{{if not .FavoritesFirst}}
+			lower(t.name) > lower(:afterName)
+		{{else if .After.IsFavorite}}
+			((fav IS DISTINCT FROM NULL AND lower(t.name) > lower(:afterName)) OR fav isnull)
+		{{else}}
+			(fav isnull AND lower(t.name) > lower(:afterName))
+		{{end}}
+	{{end}}
+	ORDER BY {{ .OrderBy }}
+	LIMIT {{.Limit}}
+`))
+
+type renderData SearchOptions
+
+func (opts renderData) OrderBy() string {
+	if opts.FavoritesFirst {
+		return "fav isnull, lower(t.name)"
+	}
+	return "lower(t.name)"
+}
+
+func (opts renderData) Normalize() (*renderData, error) {
+	if opts.Limit == 0 {
+		opts.Limit = search.DefaultMaxResults
+	}
+
+	err := validate.Many(
+		validate.Search("Search", opts.Search),
+		validate.Range("Limit", opts.Limit, 0, search.MaxResults),
+		validate.ManyUUID("Omit", opts.Omit, 50),
+	)
+	if opts.After.Name != "" {
+		err = validate.Many(err, validate.IDName("After.Name", opts.After.Name))
+	}
+	if opts.MemberUserID != "" {
+		err = validate.Many(err, validate.UUID("MemberUserID", opts.MemberUserID))
+	}
+	if opts.FavoritesOnly || opts.FavoritesFirst || opts.FavoritesUserID != "" {
+		err = validate.Many(err, validate.UUID("FavoritesUserID", opts.FavoritesUserID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &opts, err
+}
+
+func (opts renderData) QueryArgs() []sql.NamedArg {
+	return []sql.NamedArg{
+		sql.Named("search", opts.Search),
+		sql.Named("afterName", opts.After.Name),
+		sql.Named("omit", sqlutil.UUIDArray(opts.Omit)),
+		sql.Named("memberUserID", opts.MemberUserID),
+		sql.Named("favUserID", opts.FavoritesUserID),
+	}
+}
+
+// Search will return a list of teams matching the provided options.
+func (s *Store) Search(ctx context.Context, opts *SearchOptions) ([]Team, error) {
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+
+	userCheck := permission.User
+	if opts.FavoritesUserID != "" {
+		userCheck = permission.MatchUser(opts.FavoritesUserID)
+	}
+
+	err := permission.LimitCheckAny(ctx, permission.System, userCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := (*renderData)(opts).Normalize()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := search.RenderQuery(ctx, searchTemplate, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "render query")
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []Team
+	for rows.Next() {
+		var t Team
+		err = rows.Scan(&t.ID, &t.Name, &t.Description, &t.isUserFavorite)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,307 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/search"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Store manages teams and their members.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore will create a new Store.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	return &Store{db: db}, nil
+}
+
+func (s *Store) queries(tx *sql.Tx) *gadb.Queries {
+	db := gadb.New(s.db)
+	if tx != nil {
+		db = db.WithTx(tx)
+	}
+	return db
+}
+
+// checkOwner returns nil if the current user is an owner of the team, or an admin.
+func (s *Store) checkOwner(ctx context.Context, db *gadb.Queries, teamID uuid.UUID) error {
+	if permission.Admin(ctx) {
+		return nil
+	}
+
+	role, err := db.TeamMemberRole(ctx, gadb.TeamMemberRoleParams{
+		TeamID: teamID,
+		UserID: permission.UserNullUUID(ctx).UUID,
+	})
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && role != gadb.EnumTeamRoleOwner) {
+		return permission.NewAccessDenied("only team owners may manage the team")
+	}
+
+	return err
+}
+
+// CreateTx creates a new team, with the current user as its owner.
+func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, t *Team) (*Team, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	n, err := t.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	db := s.queries(tx)
+	id, err := db.TeamCreate(ctx, gadb.TeamCreateParams{Name: n.Name, Description: n.Description})
+	if err != nil {
+		return nil, err
+	}
+	n.ID = id.String()
+
+	userID := permission.UserNullUUID(ctx)
+	if !userID.Valid {
+		// system-created teams have no owner until one is added
+		return n, nil
+	}
+	err = db.TeamMemberSet(ctx, gadb.TeamMemberSetParams{
+		TeamID: id,
+		UserID: userID.UUID,
+		Role:   gadb.EnumTeamRoleOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// UpdateTx updates the name and description of a team. Only team owners or an admin may update a team.
+func (s *Store) UpdateTx(ctx context.Context, tx *sql.Tx, t *Team) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+	n, err := t.Normalize()
+	if err != nil {
+		return err
+	}
+	id, err := validate.ParseUUID("TeamID", n.ID)
+	if err != nil {
+		return err
+	}
+
+	db := s.queries(tx)
+	err = s.checkOwner(ctx, db, id)
+	if err != nil {
+		return err
+	}
+
+	return db.TeamUpdate(ctx, gadb.TeamUpdateParams{ID: id, Name: n.Name, Description: n.Description})
+}
+
+// DeleteManyTx deletes the given teams. A team that still owns services, escalation policies, schedules,
+// or rotations can not be deleted until they are reassigned or deleted.
+// Only team owners or an admin may delete a team.
+func (s *Store) DeleteManyTx(ctx context.Context, tx *sql.Tx, ids []string) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+	teamIDs, err := validate.ParseManyUUID("TeamID", ids, 50)
+	if err != nil {
+		return err
+	}
+
+	db := s.queries(tx)
+	for _, id := range teamIDs {
+		err = s.checkOwner(ctx, db, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return db.TeamDeleteMany(ctx, teamIDs)
+}
+
+// FindMany returns the teams with the given IDs. Missing teams are omitted from the result.
+func (s *Store) FindMany(ctx context.Context, ids []string) ([]Team, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+	teamIDs, err := validate.ParseManyUUID("TeamID", ids, search.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries(nil).TeamFindMany(ctx, gadb.TeamFindManyParams{
+		Ids:    teamIDs,
+		UserID: permission.UserNullUUID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Team, len(rows))
+	for i, r := range rows {
+		result[i] = Team{
+			ID:             r.ID.String(),
+			Name:           r.Name,
+			Description:    r.Description,
+			isUserFavorite: r.IsFavorite,
+		}
+	}
+
+	return result, nil
+}
+
+// FindOne returns a single team.
+func (s *Store) FindOne(ctx context.Context, id string) (*Team, error) {
+	teams, err := s.FindMany(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(teams) == 0 {
+		return nil, validation.NewFieldError("TeamID", "not found")
+	}
+
+	return &teams[0], nil
+}
+
+// FindMembers returns the members of a team, owners first.
+func (s *Store) FindMembers(ctx context.Context, teamID string) ([]Member, error) {
+	err := permission.LimitCheckAny(ctx, permission.All)
+	if err != nil {
+		return nil, err
+	}
+	id, err := validate.ParseUUID("TeamID", teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries(nil).TeamMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Member, len(rows))
+	for i, r := range rows {
+		result[i] = Member{
+			TeamID: teamID,
+			UserID: r.UserID.String(),
+			Role:   Role(r.Role),
+		}
+	}
+
+	return result, nil
+}
+
+// checkLastOwner returns an error if userID is the only owner of the team, unless the current user is an admin.
+func (s *Store) checkLastOwner(ctx context.Context, db *gadb.Queries, teamID, userID uuid.UUID) error {
+	if permission.Admin(ctx) {
+		return nil
+	}
+
+	role, err := db.TeamMemberRole(ctx, gadb.TeamMemberRoleParams{TeamID: teamID, UserID: userID})
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && role != gadb.EnumTeamRoleOwner) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	n, err := db.TeamOwnerCount(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if n < 2 {
+		return validation.NewFieldError("UserID", "cannot remove the last owner of a team")
+	}
+
+	return nil
+}
+
+// SetMemberTx adds a user to a team, or updates their role if they are already a member. Only team owners
+// or an admin may set members.
+func (s *Store) SetMemberTx(ctx context.Context, tx *sql.Tx, m Member) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+	n, err := m.Normalize()
+	if err != nil {
+		return err
+	}
+	teamID, userID := uuid.MustParse(n.TeamID), uuid.MustParse(n.UserID)
+
+	db := s.queries(tx)
+	_, err = db.TeamFindOneForUpdate(ctx, teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return validation.NewFieldError("TeamID", "not found")
+	}
+	if err != nil {
+		return err
+	}
+	err = s.checkOwner(ctx, db, teamID)
+	if err != nil {
+		return err
+	}
+	if n.Role != RoleOwner {
+		err = s.checkLastOwner(ctx, db, teamID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return db.TeamMemberSet(ctx, gadb.TeamMemberSetParams{
+		TeamID: teamID,
+		UserID: userID,
+		Role:   gadb.EnumTeamRole(n.Role),
+	})
+}
+
+// RemoveMemberTx removes a user from a team. Team owners or an admin may remove any member, and users may
+// remove themselves.
+func (s *Store) RemoveMemberTx(ctx context.Context, tx *sql.Tx, teamID, userID string) error {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return err
+	}
+	tID, err := validate.ParseUUID("TeamID", teamID)
+	if err != nil {
+		return err
+	}
+	uID, err := validate.ParseUUID("UserID", userID)
+	if err != nil {
+		return err
+	}
+
+	db := s.queries(tx)
+	_, err = db.TeamFindOneForUpdate(ctx, tID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return validation.NewFieldError("TeamID", "not found")
+	}
+	if err != nil {
+		return err
+	}
+	if !permission.MatchUser(userID)(ctx) {
+		err = s.checkOwner(ctx, db, tID)
+		if err != nil {
+			return err
+		}
+	}
+	err = s.checkLastOwner(ctx, db, tID, uID)
+	if err != nil {
+		return err
+	}
+
+	return db.TeamMemberDelete(ctx, gadb.TeamMemberDeleteParams{TeamID: tID, UserID: uID})
+}
@@ -0,0 +1,91 @@
+// Package team manages teams, their members, and the ownership of services, escalation policies,
+// schedules, and rotations by a team.
+package team
+
+import (
+	"io"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// Role is the role of a user within a team.
+type Role string
+
+const (
+	// RoleMember allows modifying anything owned by the team.
+	RoleMember Role = "member"
+
+	// RoleOwner additionally allows managing the team itself, including its members.
+	RoleOwner Role = "owner"
+)
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface.
+func (r *Role) UnmarshalGQL(v interface{}) error {
+	str, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return err
+	}
+
+	*r = Role(str)
+	switch *r {
+	case RoleMember, RoleOwner:
+		return nil
+	}
+
+	return validation.NewFieldError("Role", "unknown role "+str)
+}
+
+// MarshalGQL implements the graphql.Marshaler interface.
+func (r Role) MarshalGQL(w io.Writer) {
+	graphql.MarshalString(string(r)).MarshalGQL(w)
+}
+
+// A Team is a group of users that owns services, escalation policies, schedules, and rotations.
+type Team struct {
+	ID          string
+	Name        string
+	Description string
+
+	isUserFavorite bool
+}
+
+// IsUserFavorite returns a boolean value based on if the team is a favorite of the current user.
+func (t Team) IsUserFavorite() bool {
+	return t.isUserFavorite
+}
+
+// Normalize will validate and produce a normalized Team struct.
+func (t Team) Normalize() (*Team, error) {
+	err := validate.Many(
+		validate.IDName("Name", t.Name),
+		validate.Text("Description", t.Description, 0, 255),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// A Member is a user belonging to a team.
+type Member struct {
+	TeamID string
+	UserID string
+	Role   Role
+}
+
+// Normalize will validate and produce a normalized Member struct.
+func (m Member) Normalize() (*Member, error) {
+	err := validate.Many(
+		validate.UUID("TeamID", m.TeamID),
+		validate.UUID("UserID", m.UserID),
+		validate.OneOf("Role", m.Role, RoleMember, RoleOwner),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
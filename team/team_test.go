@@ -0,0 +1,43 @@
+package team
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeam_Normalize(t *testing.T) {
+	_, err := Team{Name: "platform", Description: "owns the platform"}.Normalize()
+	require.NoError(t, err)
+
+	check := func(desc string, tm Team) {
+		t.Helper()
+		_, err := tm.Normalize()
+		assert.Error(t, err, desc)
+	}
+	check("empty name", Team{})
+	check("long description", Team{Name: "platform", Description: strings.Repeat("a", 256)})
+}
+
+func TestMember_Normalize(t *testing.T) {
+	valid := Member{
+		TeamID: "a5f2b3e4-1c2d-4e5f-8a9b-0c1d2e3f4a5b",
+		UserID: "b5f2b3e4-1c2d-4e5f-8a9b-0c1d2e3f4a5b",
+		Role:   RoleMember,
+	}
+	_, err := valid.Normalize()
+	require.NoError(t, err)
+
+	check := func(desc string, fn func(m *Member)) {
+		t.Helper()
+		m := valid
+		fn(&m)
+		_, err := m.Normalize()
+		assert.Error(t, err, desc)
+	}
+	check("bad team ID", func(m *Member) { m.TeamID = "foo" })
+	check("bad user ID", func(m *Member) { m.UserID = "" })
+	check("unknown role", func(m *Member) { m.Role = "admin" })
+}
@@ -0,0 +1,152 @@
+package smoke
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/expflag"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestAlertSeverity checks that notification rules with a minimum severity only notify for alerts of at least that severity.
+func TestAlertSeverity(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes, min_severity)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0, 'critical');
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into integration_keys (id, type, name, service_id)
+	values
+		({{uuid "int_key"}}, 'generic', 'my key', {{uuid "sid"}});
+`
+	h := harness.NewHarness(t, sql, "alert-severity")
+	defer h.Close()
+
+	post := func(summary, severity string) {
+		t.Helper()
+		v := make(url.Values)
+		v.Set("summary", summary)
+		v.Set("severity", severity)
+
+		resp, err := http.Post(h.URL()+"/v1/api/alerts?key="+h.UUID("int_key"), "application/x-www-form-urlencoded", bytes.NewBufferString(v.Encode()))
+		require.NoError(t, err)
+		require.Equal(t, 204, resp.StatusCode, "http status code")
+		resp.Body.Close()
+	}
+
+	post("minor", "warning")
+	h.Trigger()
+	post("major", "critical")
+
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("major")
+}
+
+// TestAlertSeverityUIK checks that a universal integration key uses the severity param, and that
+// an unknown severity falls back to the default rather than rejecting the alert.
+func TestAlertSeverityUIK(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarnessWithFlags(t, sql, "alert-severity", expflag.FlagSet{expflag.UnivKeys})
+	defer h.Close()
+
+	resp := h.GraphQLQuery2(fmt.Sprintf(`mutation{ createIntegrationKey(input: {name: "key", type: universal, serviceID: "%s"}){ id, href } }`, h.UUID("sid")))
+	require.Empty(t, resp.Errors)
+	var keyData struct {
+		CreateIntegrationKey struct{ ID, Href string }
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &keyData))
+
+	resp = h.GraphQLQuery2(fmt.Sprintf(`
+		mutation{
+			updateKeyConfig(input: {
+				keyID: "%s",
+				defaultActions: [
+					{dest: {type: "builtin-alert"},
+						params: {
+							summary: "req.body['summary']",
+							severity: "req.body['severity']"
+						}}
+				]
+			})
+		}`, keyData.CreateIntegrationKey.ID))
+	require.Empty(t, resp.Errors)
+
+	resp = h.GraphQLQuery2(fmt.Sprintf(`mutation{ generateKeyToken(id: "%s")}`, keyData.CreateIntegrationKey.ID))
+	require.Empty(t, resp.Errors)
+	var gen struct{ GenerateKeyToken string }
+	require.NoError(t, json.Unmarshal(resp.Data, &gen))
+
+	post := func(body string) {
+		t.Helper()
+		req, err := http.NewRequest("POST", keyData.CreateIntegrationKey.Href, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+gen.GenerateKeyToken)
+		req.Header.Set("Content-Type", "application/json")
+		r, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer r.Body.Close()
+		data, _ := io.ReadAll(r.Body)
+		require.Equalf(t, http.StatusNoContent, r.StatusCode, "response: %s", data)
+	}
+
+	post(`{"summary": "major", "severity": "critical"}`)
+	post(`{"summary": "unknown", "severity": "bogus"}`)
+
+	res := h.GraphQLQuery2(fmt.Sprintf(`query{ alerts(input: {filterByServiceID: ["%s"]}){ nodes { summary severity } } }`, h.UUID("sid")))
+	require.Empty(t, res.Errors)
+	var data struct {
+		Alerts struct {
+			Nodes []struct{ Summary, Severity string }
+		}
+	}
+	require.NoError(t, json.Unmarshal(res.Data, &data))
+
+	sev := make(map[string]string)
+	for _, n := range data.Alerts.Nodes {
+		sev[n.Summary] = n.Severity
+	}
+	assert.Equal(t, map[string]string{"major": "critical", "unknown": "medium"}, sev)
+}
@@ -0,0 +1,137 @@
+package smoke
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestAlertSnooze checks that a snoozed alert is acknowledged, then re-triggered and escalated from the first step once the snooze expires.
+func TestAlertSnooze(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+insert into users (id, name, email)
+values
+	({{uuid "user"}}, 'bob', 'joe'),
+	({{uuid "user2"}}, 'bob2', 'joe2');
+
+insert into user_contact_methods (id, user_id, name, type, value)
+values
+	({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}}),
+	({{uuid "cm2"}}, {{uuid "user2"}}, 'personal', 'SMS', {{phone "2"}});
+
+insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+values
+	({{uuid "user"}}, {{uuid "cm1"}}, 0),
+	({{uuid "user2"}}, {{uuid "cm2"}}, 0);
+
+insert into escalation_policies (id, name)
+values
+	({{uuid "eid"}}, 'esc policy');
+
+insert into escalation_policy_steps (id, escalation_policy_id, delay)
+values
+	({{uuid "es1"}}, {{uuid "eid"}}, 10),
+	({{uuid "es2"}}, {{uuid "eid"}}, 60);
+
+insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+values
+	({{uuid "es1"}}, {{uuid "user"}}),
+	({{uuid "es2"}}, {{uuid "user2"}});
+
+insert into services (id, escalation_policy_id, name)
+values
+	({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "alert-snoozes")
+	defer h.Close()
+
+	h.CreateAlert(h.UUID("sid"), "testing")
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("testing")
+
+	res := h.GraphQLQuery2(`mutation { updateAlerts(input: {alertIDs: [1], snoozeMinutes: 30}) { id } }`)
+	require.Empty(t, res.Errors, "snooze alert")
+
+	status := func(t assert.TestingT) string {
+		res := h.GraphQLQuery2(`{alert(id: 1){status}}`)
+		assert.Empty(t, res.Errors, "errors")
+
+		var data struct{ Alert struct{ Status string } }
+		assert.NoError(t, json.Unmarshal(res.Data, &data))
+		return data.Alert.Status
+	}
+	assert.Equal(t, "StatusAcknowledged", status(t))
+
+	// acknowledged; the alert should not escalate to the second step
+	h.FastForward(20 * time.Minute)
+	h.Trigger()
+	assert.Equal(t, "StatusAcknowledged", status(t))
+
+	// snooze expired; escalation restarts from the first step
+	h.FastForward(11 * time.Minute)
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("testing")
+	assert.Equal(t, "StatusUnacknowledged", status(t))
+
+	h.FastForward(10 * time.Minute)
+	h.Twilio(t).Device(h.Phone("2")).ExpectSMS("testing")
+}
+
+// TestAlertSnoozeAck checks that acknowledging a snoozed alert cancels the snooze, so it is not re-triggered.
+func TestAlertSnoozeAck(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+insert into users (id, name, email)
+values
+	({{uuid "user"}}, 'bob', 'joe');
+
+insert into user_contact_methods (id, user_id, name, type, value)
+values
+	({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+values
+	({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+insert into escalation_policies (id, name)
+values
+	({{uuid "eid"}}, 'esc policy');
+
+insert into escalation_policy_steps (id, escalation_policy_id, delay)
+values
+	({{uuid "es1"}}, {{uuid "eid"}}, 10);
+
+insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+values
+	({{uuid "es1"}}, {{uuid "user"}});
+
+insert into services (id, escalation_policy_id, name)
+values
+	({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "alert-snoozes")
+	defer h.Close()
+
+	h.CreateAlert(h.UUID("sid"), "testing")
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("testing")
+
+	res := h.GraphQLQuery2(`mutation { updateAlerts(input: {alertIDs: [1], snoozeMinutes: 30}) { id } }`)
+	require.Empty(t, res.Errors, "snooze alert")
+
+	res = h.GraphQLQuery2(`mutation { updateAlerts(input: {alertIDs: [1], newStatus: StatusAcknowledged}) { id } }`)
+	require.Empty(t, res.Errors, "acknowledge alert")
+
+	h.FastForward(40 * time.Minute)
+	h.Trigger()
+
+	res = h.GraphQLQuery2(`{alert(id: 1){status}}`)
+	require.Empty(t, res.Errors, "errors")
+	var data struct{ Alert struct{ Status string } }
+	require.NoError(t, json.Unmarshal(res.Data, &data))
+	assert.Equal(t, "StatusAcknowledged", data.Alert.Status, "acknowledged alert should not be re-triggered")
+}
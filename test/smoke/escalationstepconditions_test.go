@@ -0,0 +1,57 @@
+package smoke
+
+import (
+	"testing"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestEscalationStepConditions tests that steps with unmet conditions are skipped.
+func TestEscalationStepConditions(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into users (id, name, email) 
+	values 
+		({{uuid "u1"}}, 'bob', 'joe'),
+		({{uuid "u2"}}, 'ben', 'frank');
+	insert into user_contact_methods (id, user_id, name, type, value) 
+	values
+		({{uuid "cm1"}}, {{uuid "u1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "u2"}}, 'personal', 'SMS', {{phone "2"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes) 
+	values
+		({{uuid "u1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "u2"}}, {{uuid "cm2"}}, 0);
+
+	insert into escalation_policies (id, name) 
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, step_number, delay, match_conditions) 
+	values
+		({{uuid "esid1"}}, {{uuid "eid"}}, 0, 60, '[{"type": "label", "key": "team", "value": "db"}]'),
+		({{uuid "esid2"}}, {{uuid "eid"}}, 1, 60, '[]');
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id) 
+	values 
+		({{uuid "esid1"}}, {{uuid "u1"}}),
+		({{uuid "esid2"}}, {{uuid "u2"}});
+
+	insert into services (id, escalation_policy_id, name) 
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into labels (tgt_service_id, key, value)
+	values
+		({{uuid "sid"}}, 'team', 'web');
+
+	insert into alerts (service_id, description) 
+	values
+		({{uuid "sid"}}, 'testing');
+`
+	h := harness.NewHarness(t, sql, "ep-step-conditions")
+	defer h.Close()
+
+	// first step does not match the service label, so it should be skipped without waiting for its delay
+	h.Twilio(t).Device(h.Phone("2")).ExpectSMS("testing")
+}
@@ -0,0 +1,146 @@
+package smoke
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/engine/eventsinkmanager"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestEventSink checks that alert status changes are delivered, in order, as CloudEvents.
+func TestEventSink(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "event-outbox")
+	defer h.Close()
+
+	var mx sync.Mutex
+	var events []eventsinkmanager.CloudEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "application/cloudevents+json; charset=utf-8", req.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+
+		var e eventsinkmanager.CloudEvent
+		if !assert.NoError(t, json.NewDecoder(req.Body).Decode(&e)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mx.Lock()
+		events = append(events, e)
+		mx.Unlock()
+	}))
+	defer srv.Close()
+
+	h.SetConfigValue("EventSink.URL", srv.URL)
+	h.SetConfigValue("EventSink.AuthorizationHeader", "Bearer token")
+	h.SetConfigValue("EventSink.Enable", "true")
+
+	a := h.CreateAlert(h.UUID("sid"), "disk full")
+	a.Ack()
+	a.Close()
+
+	var types []string
+	var closed eventsinkmanager.CloudEvent
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		mx.Lock()
+		defer mx.Unlock()
+
+		types = types[:0]
+		for _, e := range events {
+			if e.Subject != "alerts/1" || e.Type == "com.goalert.alert.escalated" {
+				continue
+			}
+			types = append(types, e.Type)
+			closed = e
+		}
+		assert.Len(t, types, 3)
+	}, 15*time.Second, 100*time.Millisecond)
+	assert.Equal(t, []string{"com.goalert.alert.created", "com.goalert.alert.acknowledged", "com.goalert.alert.closed"}, types)
+
+	assert.Equal(t, "1.0", closed.SpecVersion)
+	var data struct {
+		AlertID   int
+		ServiceID string
+		Status    string
+	}
+	require.NoError(t, json.Unmarshal(closed.Data, &data))
+	assert.Equal(t, a.ID(), data.AlertID)
+	assert.Equal(t, h.UUID("sid"), data.ServiceID)
+	assert.Equal(t, "closed", data.Status)
+}
+
+// TestEventSinkSubjectBlocked checks that a failing event only delays later events of the same subject.
+func TestEventSinkSubjectBlocked(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "event-outbox-retry")
+	defer h.Close()
+
+	var mx sync.Mutex
+	var events []eventsinkmanager.CloudEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var e eventsinkmanager.CloudEvent
+		if !assert.NoError(t, json.NewDecoder(req.Body).Decode(&e)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if e.Subject == "alerts/1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		mx.Lock()
+		events = append(events, e)
+		mx.Unlock()
+	}))
+	defer srv.Close()
+
+	h.SetConfigValue("EventSink.URL", srv.URL)
+	h.SetConfigValue("EventSink.Enable", "true")
+
+	h.CreateAlert(h.UUID("sid"), "failing")
+	h.CreateAlert(h.UUID("sid"), "working").Ack()
+
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		mx.Lock()
+		defer mx.Unlock()
+
+		var types []string
+		for _, e := range events {
+			assert.NotEqual(t, "alerts/1", e.Subject)
+			if e.Subject == "alerts/2" && e.Type != "com.goalert.alert.escalated" {
+				types = append(types, e.Type)
+			}
+		}
+		assert.Equal(t, []string{"com.goalert.alert.created", "com.goalert.alert.acknowledged"}, types)
+	}, 15*time.Second, 100*time.Millisecond)
+
+	var attempts int
+	err := h.App().DB().QueryRow(`select max(attempts) from event_outbox where subject = 'alerts/1'`).Scan(&attempts)
+	require.NoError(t, err)
+	assert.Positive(t, attempts, "failed event should be kept for retry")
+}
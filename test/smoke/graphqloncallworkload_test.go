@@ -0,0 +1,80 @@
+package smoke
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestGraphQLOnCallWorkload checks that the workload report includes historical shifts, per schedule and per team.
+func TestGraphQLOnCallWorkload(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "bob"}}, 'bob', 'bob@example.com');
+
+	insert into teams (id, name)
+	values
+		({{uuid "team"}}, 'ops');
+
+	insert into schedules (id, name, time_zone, team_id)
+	values
+		({{uuid "sched"}}, 'primary', 'UTC', {{uuid "team"}});
+
+	insert into schedule_on_call_users (schedule_id, user_id, start_time, end_time)
+	values
+		({{uuid "sched"}}, {{uuid "bob"}}, '2024-03-04 00:00:00Z', '2024-03-04 12:00:00Z');
+	`
+	h := harness.NewHarness(t, sql, "teams")
+	defer h.Close()
+
+	resp := h.GraphQLQuery2(`{
+		onCallWorkloadReport(input: {start: "2024-03-01T00:00:00Z", end: "2024-03-08T00:00:00Z"}) {
+			users { userID onCallHours offHoursOnCallHours pages }
+			teams { teamID teamName total { onCallHours } }
+			schedules { scheduleName total { onCallHours } }
+		}
+	}`)
+	require.Empty(t, resp.Errors)
+
+	var data struct {
+		OnCallWorkloadReport struct {
+			Users []struct {
+				UserID              string
+				OnCallHours         float64
+				OffHoursOnCallHours float64
+				Pages               int
+			}
+			Teams []struct {
+				TeamID   string
+				TeamName string
+				Total    struct{ OnCallHours float64 }
+			}
+			Schedules []struct {
+				ScheduleName string
+				Total        struct{ OnCallHours float64 }
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+
+	rep := data.OnCallWorkloadReport
+	require.Len(t, rep.Users, 1)
+	assert.Equal(t, h.UUID("bob"), rep.Users[0].UserID)
+	assert.Equal(t, 12.0, rep.Users[0].OnCallHours)
+	assert.Equal(t, 9.0, rep.Users[0].OffHoursOnCallHours)
+
+	require.Len(t, rep.Teams, 1)
+	assert.Equal(t, h.UUID("team"), rep.Teams[0].TeamID)
+	assert.Equal(t, "ops", rep.Teams[0].TeamName)
+	assert.Equal(t, 12.0, rep.Teams[0].Total.OnCallHours)
+
+	require.Len(t, rep.Schedules, 1)
+	assert.Equal(t, "primary", rep.Schedules[0].ScheduleName)
+	assert.Equal(t, 12.0, rep.Schedules[0].Total.OnCallHours)
+}
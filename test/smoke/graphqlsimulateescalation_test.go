@@ -0,0 +1,182 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestGraphQLSimulateEscalation tests that escalation can be simulated for a service without creating an alert.
+func TestGraphQLSimulateEscalation(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email) 
+	values 
+		({{uuid "u1"}}, 'bob', 'joe'),
+		({{uuid "u2"}}, 'ben', 'frank');
+	insert into user_contact_methods (id, user_id, name, type, value) 
+	values
+		({{uuid "cm1"}}, {{uuid "u1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "u2"}}, 'personal', 'SMS', {{phone "2"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes) 
+	values
+		({{uuid "u1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "u2"}}, {{uuid "cm2"}}, 1);
+
+	insert into escalation_policies (id, name) 
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, step_number, delay) 
+	values
+		({{uuid "esid1"}}, {{uuid "eid"}}, 0, 5),
+		({{uuid "esid2"}}, {{uuid "eid"}}, 1, 5);
+
+	insert into rotations (id, name, type, shift_length, start_time, time_zone)
+	values
+		({{uuid "rot"}}, 'rotation', 'daily', 1, now(), 'UTC');
+	insert into rotation_participants (rotation_id, position, user_id)
+	values
+		({{uuid "rot"}}, 0, {{uuid "u2"}});
+
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id, rotation_id) 
+	values 
+		({{uuid "esid1"}}, {{uuid "u1"}}, null),
+		({{uuid "esid2"}}, null, {{uuid "rot"}});
+
+	insert into services (id, escalation_policy_id, name) 
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "ep-step-conditions")
+	defer h.Close()
+
+	resp := h.GraphQLQueryT(t, fmt.Sprintf(`
+		query {
+			simulateEscalation(input: {serviceID: "%s", durationMinutes: 10}) {
+				minute
+				stepNumber
+				user { id }
+				contactMethod { id }
+			}
+		}
+	`, h.UUID("sid")))
+	require.Empty(t, resp.Errors)
+
+	var data struct {
+		SimulateEscalation []struct {
+			Minute        int
+			StepNumber    int
+			User          struct{ ID string }
+			ContactMethod struct{ ID string }
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	require.Len(t, data.SimulateEscalation, 2)
+
+	assert.Equal(t, 0, data.SimulateEscalation[0].Minute)
+	assert.Equal(t, h.UUID("u1"), data.SimulateEscalation[0].User.ID)
+	assert.Equal(t, h.UUID("cm1"), data.SimulateEscalation[0].ContactMethod.ID)
+
+	assert.Equal(t, 6, data.SimulateEscalation[1].Minute)
+	assert.Equal(t, 1, data.SimulateEscalation[1].StepNumber)
+	assert.Equal(t, h.UUID("u2"), data.SimulateEscalation[1].User.ID)
+}
+
+// TestGraphQLSimulateEscalationRouting tests that simulated escalation applies routing rules and maintenance windows,
+// and skips disabled contact methods.
+func TestGraphQLSimulateEscalationRouting(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email) 
+	values 
+		({{uuid "u1"}}, 'bob', 'joe'),
+		({{uuid "u2"}}, 'ben', 'frank');
+	insert into user_contact_methods (id, user_id, name, type, value, disabled) 
+	values
+		({{uuid "cm1"}}, {{uuid "u1"}}, 'personal', 'SMS', {{phone "1"}}, false),
+		({{uuid "cm2"}}, {{uuid "u1"}}, 'old', 'SMS', {{phone "2"}}, true),
+		({{uuid "cm3"}}, {{uuid "u2"}}, 'personal', 'SMS', {{phone "3"}}, false);
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes) 
+	values
+		({{uuid "u1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "u1"}}, {{uuid "cm2"}}, 0),
+		({{uuid "u2"}}, {{uuid "cm3"}}, 0);
+
+	insert into escalation_policies (id, name) 
+	values
+		({{uuid "eid1"}}, 'esc policy'),
+		({{uuid "eid2"}}, 'routed policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, step_number, delay) 
+	values
+		({{uuid "esid1"}}, {{uuid "eid1"}}, 0, 5),
+		({{uuid "esid2"}}, {{uuid "eid2"}}, 0, 5);
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id) 
+	values 
+		({{uuid "esid1"}}, {{uuid "u1"}}),
+		({{uuid "esid2"}}, {{uuid "u2"}});
+
+	insert into services (id, escalation_policy_id, name) 
+	values
+		({{uuid "sid1"}}, {{uuid "eid1"}}, 'service'),
+		({{uuid "sid2"}}, {{uuid "eid1"}}, 'maint service');
+
+	insert into service_routing_rules (id, service_id, position, name, condition_expr, escalation_policy_id, drop_alert)
+	values
+		({{uuid "rr1"}}, {{uuid "sid1"}}, 0, 'route', 'alert.metadata.team == "b"', {{uuid "eid2"}}, false),
+		({{uuid "rr2"}}, {{uuid "sid1"}}, 1, 'drop', 'alert.metadata.team == "none"', null, true),
+		({{uuid "rr3"}}, {{uuid "sid1"}}, 2, 'db', 'alert.summary contains "database" && alert.source == "grafana"', {{uuid "eid2"}}, false);
+
+	insert into service_maintenance_windows (id, service_id, start_time, end_time)
+	values
+		({{uuid "mw"}}, {{uuid "sid2"}}, now() - '1 hour'::interval, now() + '1 hour'::interval);
+`
+	h := harness.NewHarness(t, sql, "ep-step-conditions")
+	defer h.Close()
+
+	type notification struct {
+		User          struct{ ID string }
+		ContactMethod struct{ ID string }
+	}
+	simulate := func(serviceID, team, summary string) []notification {
+		t.Helper()
+		resp := h.GraphQLQueryT(t, fmt.Sprintf(`
+			query {
+				simulateEscalation(input: {serviceID: "%s", durationMinutes: 1, summary: "%s", source: "grafana", metadata: [{key: "team", value: "%s"}]}) {
+					user { id }
+					contactMethod { id }
+				}
+			}
+		`, serviceID, summary, team))
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			SimulateEscalation []notification
+		}
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+		return data.SimulateEscalation
+	}
+
+	res := simulate(h.UUID("sid1"), "a", "")
+	require.Len(t, res, 1, "disabled contact method should be skipped")
+	assert.Equal(t, h.UUID("u1"), res[0].User.ID)
+	assert.Equal(t, h.UUID("cm1"), res[0].ContactMethod.ID)
+
+	res = simulate(h.UUID("sid1"), "b", "")
+	require.Len(t, res, 1)
+	assert.Equal(t, h.UUID("u2"), res[0].User.ID, "routing rule escalation policy should be used")
+
+	res = simulate(h.UUID("sid1"), "a", "database down")
+	require.Len(t, res, 1)
+	assert.Equal(t, h.UUID("u2"), res[0].User.ID, "routing rule matching summary and source should be used")
+
+	assert.Empty(t, simulate(h.UUID("sid1"), "none", ""), "dropped alert")
+	assert.Empty(t, simulate(h.UUID("sid2"), "a", ""), "maintenance window")
+}
@@ -0,0 +1,20 @@
+package harness
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/pkg/sysapi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// SysAPI returns a client for the system API of the backend.
+func (h *Harness) SysAPI(t *testing.T) sysapi.SysAPIClient {
+	t.Helper()
+	conn, err := grpc.NewClient(h.App().SysAPIAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err, "connect to system API")
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return sysapi.NewSysAPIClient(conn)
+}
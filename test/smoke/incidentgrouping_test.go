@@ -0,0 +1,94 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestIncidentGrouping checks that alerts from services with the same label value are grouped into a single
+// incident, that only the first alert is escalated, and that acknowledging the incident acknowledges every alert.
+func TestIncidentGrouping(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid1"}}, {{uuid "eid"}}, 'db'),
+		({{uuid "sid2"}}, {{uuid "eid"}}, 'web');
+
+	insert into labels (tgt_service_id, key, value)
+	values
+		({{uuid "sid1"}}, 'example.com/team', 'ops'),
+		({{uuid "sid2"}}, 'example.com/team', 'ops');
+`
+	h := harness.NewHarness(t, sql, "incidents")
+	defer h.Close()
+
+	h.SetConfigValue("Incidents.Enable", "true")
+	h.SetConfigValue("Incidents.LabelKeys", "example.com/team")
+
+	a1 := h.CreateAlert(h.UUID("sid1"), "first")
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("first")
+
+	a2 := h.CreateAlert(h.UUID("sid2"), "second")
+	h.Trigger() // second alert should not be escalated
+
+	var data struct {
+		Alert struct {
+			Incident struct {
+				ID             int
+				Title          string
+				PrimaryAlertID int
+				Alerts         []struct{ AlertID int }
+			}
+		}
+	}
+	res := h.GraphQLQuery2(fmt.Sprintf(`{alert(id: %d) { incident { id, title, primaryAlertID, alerts { alertID } } } }`, a2.ID()))
+	require.Empty(t, res.Errors, "errors")
+	require.NoError(t, json.Unmarshal(res.Data, &data))
+
+	inc := data.Alert.Incident
+	assert.Equal(t, "example.com/team=ops", inc.Title)
+	assert.Equal(t, a1.ID(), inc.PrimaryAlertID)
+	require.Len(t, inc.Alerts, 2)
+
+	res = h.GraphQLQuery2(fmt.Sprintf(`mutation { updateIncident(input: {id: %d, newStatus: StatusAcknowledged}) }`, inc.ID))
+	require.Empty(t, res.Errors, "errors")
+
+	var status struct {
+		Alert struct{ Status string }
+	}
+	res = h.GraphQLQuery2(fmt.Sprintf(`{alert(id: %d) { status } }`, a2.ID()))
+	require.Empty(t, res.Errors, "errors")
+	require.NoError(t, json.Unmarshal(res.Data, &status))
+	assert.Equal(t, "StatusAcknowledged", status.Alert.Status)
+}
@@ -0,0 +1,68 @@
+package smoke
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestMSTeams checks that alerts and status updates are posted as adaptive cards to
+// Microsoft Teams incoming webhooks.
+func TestMSTeams(t *testing.T) {
+	t.Parallel()
+
+	ch := make(chan string, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		ch <- string(data)
+	}))
+	defer ts.Close()
+
+	sql := `
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+
+	insert into notification_channels (id, name, dest)
+	values
+		({{uuid "chan"}}, 'teams', '{"Type": "builtin-msteams-webhook", "Args": {"msteams_webhook_url": "` + ts.URL + `"}}');
+
+	insert into escalation_policy_actions (escalation_policy_step_id, channel_id)
+	values
+		({{uuid "esid"}}, {{uuid "chan"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "webhook-signing-secrets")
+	defer h.Close()
+
+	h.SetConfigValue("MSTeams.Enable", "true")
+
+	a := h.CreateAlert(h.UUID("sid"), "disk full")
+
+	body := <-ch
+	assert.Contains(t, body, `"AdaptiveCard"`)
+	assert.Contains(t, body, `Alert #1: disk full`)
+	assert.Contains(t, body, `/alerts/1?action=acknowledge`)
+
+	a.Ack()
+	h.Trigger()
+
+	body = <-ch
+	assert.Contains(t, body, `"Acknowledged"`)
+	assert.NotContains(t, body, `action=acknowledge`)
+}
@@ -0,0 +1,56 @@
+package smoke
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestNotificationRuleConditions checks that notification rules only apply during their time window.
+func TestNotificationRuleConditions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	inactive := now.Add(2 * time.Hour)
+
+	sql := fmt.Sprintf(`
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "user"}}, 'work', 'SMS', {{phone "2"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes, start_time, end_time, time_zone)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0, '%s', '%s', 'UTC'),
+		({{uuid "user"}}, {{uuid "cm2"}}, 0, '00:00', '00:00', 'America/Chicago');
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`, inactive.Format("15:04"), inactive.Add(time.Hour).Format("15:04"))
+
+	h := harness.NewHarness(t, sql, "notification-rule-conditions")
+	defer h.Close()
+
+	h.CreateAlert(h.UUID("sid"), "testing")
+
+	h.Twilio(t).Device(h.Phone("2")).ExpectSMS("testing")
+}
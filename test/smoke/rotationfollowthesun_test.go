@@ -0,0 +1,90 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestRotation_FollowTheSun checks that a follow-the-sun rotation hands off between regions.
+func TestRotation_FollowTheSun(t *testing.T) {
+	t.Parallel()
+
+	plus12 := time.FixedZone("", 12*3600)
+	n := time.Now()
+
+	// first region took over an hour ago, the second takes over in an hour
+	utcStart := n.Add(-time.Hour).UTC().Format("15:04")
+	plus12Start := n.Add(time.Hour).In(plus12).Format("15:04")
+
+	sql := fmt.Sprintf(`
+	insert into users (id, name, email)
+	values
+		({{uuid "uid1"}}, 'bob', 'joe'),
+		({{uuid "uid2"}}, 'ben', 'frank');
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+
+	insert into rotations (id, name, type, start_time, shift_length, time_zone, regions)
+	values
+		({{uuid "rot1"}}, 'default rotation', 'follow_the_sun', now(), 1, 'Etc/UTC',
+			jsonb_build_array(
+				jsonb_build_object('time_zone', 'Etc/UTC', 'start', '%s', 'user_ids', jsonb_build_array({{uuid "uid1"}})),
+				jsonb_build_object('time_zone', 'Etc/GMT-12', 'start', '%s', 'user_ids', jsonb_build_array({{uuid "uid2"}}))
+			));
+
+	insert into rotation_participants (rotation_id, user_id, position)
+	values
+		({{uuid "rot1"}}, {{uuid "uid1"}}, 0),
+		({{uuid "rot1"}}, {{uuid "uid2"}}, 1);
+
+	insert into escalation_policy_actions (escalation_policy_step_id, rotation_id)
+	values
+		({{uuid "esid"}}, {{uuid "rot1"}});
+
+	insert into services (id, escalation_policy_id, name) values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+	`, utcStart, plus12Start)
+	h := harness.NewHarness(t, sql, "rotation-regions")
+	defer h.Close()
+
+	sid := h.UUID("sid")
+	uid1 := h.UUID("uid1")
+	uid2 := h.UUID("uid2")
+
+	resp := h.GraphQLQuery2(fmt.Sprintf(`{rotation(id: "%s") { type regions { timeZone start userIDs } }}`, h.UUID("rot1")))
+	require.Empty(t, resp.Errors)
+
+	var data struct {
+		Rotation struct {
+			Type    string
+			Regions []struct {
+				TimeZone string
+				Start    string
+				UserIDs  []string
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Equal(t, "follow_the_sun", data.Rotation.Type)
+	require.Len(t, data.Rotation.Regions, 2)
+	assert.Equal(t, "Etc/GMT-12", data.Rotation.Regions[1].TimeZone)
+	assert.Equal(t, plus12Start, data.Rotation.Regions[1].Start)
+	assert.Equal(t, []string{uid2}, data.Rotation.Regions[1].UserIDs)
+
+	h.WaitAndAssertOnCallUsers(sid, uid1)
+
+	h.FastForward(2 * time.Hour)
+
+	h.WaitAndAssertOnCallUsers(sid, uid2)
+}
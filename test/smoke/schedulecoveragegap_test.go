@@ -0,0 +1,72 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestScheduleCoverageGap checks that gaps in schedule on-call coverage are reported and alerted on.
+func TestScheduleCoverageGap(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email) 
+	values 
+		({{uuid "user"}}, 'bob', 'joe');
+	insert into user_contact_methods (id, user_id, name, type, value) 
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes) 
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name) 
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id) 
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id) 
+	values 
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name) 
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into schedules (id, name, time_zone) 
+	values
+		({{uuid "sched"}}, 'empty schedule', 'UTC');
+`
+	h := harness.NewHarness(t, sql, "ep-step-conditions")
+	defer h.Close()
+
+	resp := h.GraphQLQueryT(t, fmt.Sprintf(`
+		query {
+			schedule(id: "%s") {
+				coverageGaps { start end }
+			}
+		}
+	`, h.UUID("sched")))
+	require.Empty(t, resp.Errors)
+
+	var data struct {
+		Schedule struct {
+			CoverageGaps []struct{ Start, End string }
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Len(t, data.Schedule.CoverageGaps, 1, "schedule with no rules should have a single gap")
+
+	cfg := h.Config()
+	cfg.Schedules.CoverageCheckHours = 24
+	cfg.Schedules.CoverageAlertServiceID = h.UUID("sid")
+	h.RestartGoAlertWithConfig(cfg)
+
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("empty schedule", "gaps")
+}
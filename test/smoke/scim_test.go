@@ -0,0 +1,157 @@
+package smoke
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestSCIM checks that users can be provisioned and deactivated, and groups map to rotation participants.
+//
+// Alice already logged in with OIDC, so she is adopted rather than duplicated.
+func TestSCIM(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "bob"}}, 'bob', 'bob@example.com'),
+		({{uuid "alice"}}, 'alice', 'alice@example.com');
+
+	insert into auth_subjects (provider_id, subject_id, user_id)
+	values
+		('oidc', 'alice@example.com', {{uuid "alice"}});
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "ep"}}, 'esc policy');
+
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "step"}}, {{uuid "ep"}});
+
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "step"}}, {{uuid "alice"}});
+	`
+	h := harness.NewHarness(t, sql, "scim-provisioning")
+	defer h.Close()
+
+	h.SetConfigValue("SCIM.Enable", "true")
+	h.SetConfigValue("SCIM.AuthProvider", "oidc")
+
+	gqlResp := h.GraphQLQueryUserVarsT(t, harness.DefaultGraphQLAdminUserID, `
+	mutation($expires: ISOTimestamp!){
+		createGQLAPIKey(input:{
+			name:"scim",
+			description:"desc",
+			expiresAt: $expires,
+			role: admin,
+			query: "",
+			scim: true
+		}) {token}
+	}`, "", map[string]string{"expires": time.Now().Add(time.Hour).Format(time.RFC3339)})
+	require.Empty(t, gqlResp.Errors)
+	var keyResp struct{ CreateGQLAPIKey struct{ Token string } }
+	require.NoError(t, json.Unmarshal(gqlResp.Data, &keyResp))
+	tok := keyResp.CreateGQLAPIKey.Token
+
+	doReq := func(method, path string, body any, tok string) (int, map[string]any) {
+		t.Helper()
+		var data []byte
+		if body != nil {
+			var err error
+			data, err = json.Marshal(body)
+			require.NoError(t, err)
+		}
+		req, err := http.NewRequest(method, h.URL()+"/api/v2/scim"+path, bytes.NewReader(data))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/scim+json")
+		if tok != "" {
+			req.Header.Set("Authorization", "Bearer "+tok)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var res map[string]any
+		_ = json.NewDecoder(resp.Body).Decode(&res)
+		return resp.StatusCode, res
+	}
+
+	status, _ := doReq("GET", "/Users", nil, "")
+	assert.Equal(t, http.StatusUnauthorized, status, "no token")
+	status, _ = doReq("GET", "/Users", nil, h.GraphQLToken(harness.DefaultGraphQLAdminUserID))
+	assert.Equal(t, http.StatusUnauthorized, status, "session token")
+
+	status, user := doReq("POST", "/Users", map[string]any{
+		"schemas":  []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+		"userName": "alice@example.com",
+		"name":     map[string]any{"givenName": "Alice", "familyName": "Smith"},
+		"emails":   []map[string]any{{"value": "alice@example.com", "primary": true}},
+		"roles":    []map[string]any{{"value": "admin"}},
+	}, tok)
+	require.Equal(t, http.StatusCreated, status, user)
+	aliceID := user["id"].(string)
+	assert.Equal(t, h.UUID("alice"), aliceID, "existing user adopted")
+	assert.Equal(t, "Alice Smith", user["displayName"])
+	assert.Equal(t, true, user["active"])
+
+	status, _ = doReq("POST", "/Users", map[string]any{"userName": "ALICE@example.com"}, tok)
+	assert.Equal(t, http.StatusConflict, status, "duplicate userName")
+
+	status, list := doReq("GET", `/Users?filter=userName+eq+"alice@example.com"`, nil, tok)
+	require.Equal(t, http.StatusOK, status)
+	assert.EqualValues(t, 1, list["totalResults"])
+
+	// group members become rotation participants
+	status, group := doReq("POST", "/Groups", map[string]any{
+		"displayName": "SCIM Rotation",
+		"members":     []map[string]any{{"value": aliceID}, {"value": h.UUID("bob")}},
+	}, tok)
+	require.Equal(t, http.StatusCreated, status, group)
+	rotID := group["id"].(string)
+	assert.Len(t, group["members"], 2)
+
+	status, group = doReq("PATCH", "/Groups/"+rotID, map[string]any{
+		"schemas": []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": []map[string]any{
+			{"op": "remove", "path": `members[value eq "` + h.UUID("bob") + `"]`},
+		},
+	}, tok)
+	require.Equal(t, http.StatusOK, status, group)
+	require.Len(t, group["members"], 1)
+
+	// deactivation removes the user from rotations and escalation policies
+	status, user = doReq("PATCH", "/Users/"+aliceID, map[string]any{
+		"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": []map[string]any{{"op": "Replace", "path": "active", "value": "False"}},
+	}, tok)
+	require.Equal(t, http.StatusOK, status, user)
+	assert.Equal(t, false, user["active"])
+
+	_, group = doReq("GET", "/Groups/"+rotID, nil, tok)
+	assert.Empty(t, group["members"])
+
+	resp := h.GraphQLQuery2(`{ escalationPolicy(id: "` + h.UUID("ep") + `") { steps { targets { id } } } }`)
+	require.Empty(t, resp.Errors)
+	var epData struct {
+		EscalationPolicy struct {
+			Steps []struct{ Targets []struct{ ID string } }
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &epData))
+	require.Len(t, epData.EscalationPolicy.Steps, 1)
+	assert.Empty(t, epData.EscalationPolicy.Steps[0].Targets)
+
+	status, _ = doReq("DELETE", "/Users/"+aliceID, nil, tok)
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _ = doReq("GET", "/Users/"+aliceID, nil, tok)
+	assert.Equal(t, http.StatusNotFound, status)
+}
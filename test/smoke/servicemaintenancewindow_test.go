@@ -0,0 +1,131 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestServiceMaintenanceWindow checks that alerts from integration keys are suppressed, or created without
+// escalation, while a maintenance window is active.
+func TestServiceMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "suppress"}}, {{uuid "eid"}}, 'suppress'),
+		({{uuid "noesc"}}, {{uuid "eid"}}, 'no escalation'),
+		({{uuid "normal"}}, {{uuid "eid"}}, 'normal');
+
+	insert into service_maintenance_windows (id, service_id, start_time, end_time, suppress_alerts)
+	values
+		({{uuid "w1"}}, {{uuid "suppress"}}, now() - '1 hour'::interval, now() + '1 hour'::interval, true),
+		({{uuid "w2"}}, {{uuid "noesc"}}, now() - '1 hour'::interval, now() + '1 hour'::interval, false),
+		({{uuid "w3"}}, {{uuid "normal"}}, now() - '2 hour'::interval, now() - '1 hour'::interval, true);
+
+	insert into integration_keys (id, type, name, service_id)
+	values
+		({{uuid "key_suppress"}}, 'generic', 'my key', {{uuid "suppress"}}),
+		({{uuid "key_noesc"}}, 'generic', 'my key', {{uuid "noesc"}}),
+		({{uuid "key_normal"}}, 'generic', 'my key', {{uuid "normal"}});
+`
+	h := harness.NewHarness(t, sql, "service-maintenance-windows")
+	defer h.Close()
+
+	createAlert := func(key, summary string) int {
+		t.Helper()
+		req, err := http.NewRequest("POST", h.URL()+"/v1/api/alerts?key="+h.UUID(key), strings.NewReader(`{"summary": "`+summary+`"}`))
+		require.NoError(t, err)
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, 200, resp.StatusCode, "http status code")
+
+		var res struct{ AlertID int }
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		return res.AlertID
+	}
+
+	assert.Zero(t, createAlert("key_suppress", "suppressed"), "alert should be suppressed")
+
+	id := createAlert("key_noesc", "not escalated")
+	assert.NotZero(t, id, "alert should be created")
+
+	createAlert("key_normal", "escalated")
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("escalated")
+
+	h.Trigger()
+
+	var data struct {
+		Alert struct {
+			Status       string
+			RecentEvents struct {
+				Nodes []struct{ Message string }
+			}
+		}
+		Service struct {
+			MaintenanceWindows []struct {
+				ID             string
+				SuppressAlerts bool
+				Active         bool
+			}
+			SuppressedAlerts []struct {
+				WindowID string
+				Summary  string
+			}
+		}
+	}
+	res := h.GraphQLQuery2(fmt.Sprintf(`{
+		alert(id: %d) { status, recentEvents { nodes { message } } }
+		service(id: "%s") { maintenanceWindows { id, suppressAlerts, active } suppressedAlerts { windowID, summary } }
+	}`, id, h.UUID("suppress")))
+	require.Empty(t, res.Errors, "errors")
+	require.NoError(t, json.Unmarshal(res.Data, &data))
+
+	assert.Equal(t, "StatusUnacknowledged", data.Alert.Status)
+	require.NotEmpty(t, data.Alert.RecentEvents.Nodes)
+	assert.Contains(t, data.Alert.RecentEvents.Nodes[0].Message, "maintenance window")
+
+	require.Len(t, data.Service.MaintenanceWindows, 1)
+	assert.Equal(t, h.UUID("w1"), data.Service.MaintenanceWindows[0].ID)
+	assert.True(t, data.Service.MaintenanceWindows[0].SuppressAlerts)
+	assert.True(t, data.Service.MaintenanceWindows[0].Active)
+
+	require.Len(t, data.Service.SuppressedAlerts, 1, "suppressed alert should be recorded")
+	assert.Equal(t, h.UUID("w1"), data.Service.SuppressedAlerts[0].WindowID)
+	assert.Equal(t, "suppressed", data.Service.SuppressedAlerts[0].Summary)
+}
@@ -0,0 +1,104 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestServiceRoutingRules checks that service routing rules can escalate new alerts with a different
+// escalation policy, set a priority, or drop them.
+func TestServiceRoutingRules(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "u1"}}, 'bob', 'joe'),
+		({{uuid "u2"}}, 'ben', 'josh');
+
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "u1"}}, 'personal', 'SMS', {{phone "1"}}),
+		({{uuid "cm2"}}, {{uuid "u2"}}, 'personal', 'SMS', {{phone "2"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "u1"}}, {{uuid "cm1"}}, 0),
+		({{uuid "u2"}}, {{uuid "cm2"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "low"}}, 'low urgency'),
+		({{uuid "high"}}, 'high urgency');
+
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "low_step"}}, {{uuid "low"}}),
+		({{uuid "high_step"}}, {{uuid "high"}});
+
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "low_step"}}, {{uuid "u1"}}),
+		({{uuid "high_step"}}, {{uuid "u2"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "low"}}, 'service');
+
+	insert into service_routing_rules (id, service_id, position, name, condition_expr, escalation_policy_id, priority, drop_alert)
+	values
+		({{uuid "r1"}}, {{uuid "sid"}}, 0, 'critical', 'alert.summary contains "critical"', {{uuid "high"}}, 'P1', false),
+		({{uuid "r2"}}, {{uuid "sid"}}, 1, 'noise', 'alert.metadata.env == "test"', null, '', true);
+
+	insert into integration_keys (id, type, name, service_id)
+	values
+		({{uuid "key"}}, 'generic', 'my key', {{uuid "sid"}});
+`
+	h := harness.NewHarness(t, sql, "service-routing-rules")
+	defer h.Close()
+
+	createAlert := func(body string) int {
+		t.Helper()
+		req, err := http.NewRequest("POST", h.URL()+"/v1/api/alerts?key="+h.UUID("key"), strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, 200, resp.StatusCode, "http status code")
+
+		var res struct{ AlertID int }
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		return res.AlertID
+	}
+
+	assert.Zero(t, createAlert(`{"summary": "dropped", "meta": {"env": "test"}}`), "alert should be dropped")
+
+	id := createAlert(`{"summary": "critical failure"}`)
+	h.Twilio(t).Device(h.Phone("2")).ExpectSMS("critical failure")
+
+	createAlert(`{"summary": "minor issue"}`)
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("minor issue")
+
+	var data struct {
+		Alert struct {
+			Priority string
+		}
+	}
+	res := h.GraphQLQuery2(fmt.Sprintf(`{ alert(id: %d) { priority: metaValue(key: "priority") } }`, id))
+	require.Empty(t, res.Errors, "errors")
+	require.NoError(t, json.Unmarshal(res.Data, &data))
+	assert.Equal(t, "P1", data.Alert.Priority)
+
+	res = h.GraphQLQuery2(fmt.Sprintf(`mutation { deleteAll(input: [{ type: escalationPolicy, id: "%s" }]) }`, h.UUID("high")))
+	assert.NotEmpty(t, res.Errors, "escalation policy used by a routing rule should not be deleted")
+}
@@ -0,0 +1,100 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestShiftSwap checks that a shift swap request notifies the requested user, and that accepting
+// it creates overrides on the schedule.
+func TestShiftSwap(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email) 
+	values 
+		({{uuid "ann"}}, 'ann', 'ann@example.com'),
+		({{uuid "bob"}}, 'bob', 'bob@example.com');
+	insert into user_contact_methods (id, user_id, name, type, value) 
+	values
+		({{uuid "cm1"}}, {{uuid "bob"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into schedules (id, name, time_zone) 
+	values
+		({{uuid "sched"}}, 'primary', 'UTC');
+`
+	h := harness.NewHarness(t, sql, "shift-swap-requests")
+	defer h.Close()
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	ts := func(d time.Duration) string { return start.Add(d).Format(time.RFC3339) }
+
+	resp := h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`
+		mutation {
+			createShiftSwapRequest(input: {
+				scheduleID: "%s"
+				toUserID: "%s"
+				start: "%s"
+				end: "%s"
+				tradeStart: "%s"
+				tradeEnd: "%s"
+				note: "vacation"
+			}) { id status }
+		}
+	`, h.UUID("sched"), h.UUID("bob"), ts(0), ts(8*time.Hour), ts(24*time.Hour), ts(32*time.Hour)))
+	require.Empty(t, resp.Errors)
+
+	var created struct {
+		CreateShiftSwapRequest struct{ ID, Status string }
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &created))
+	assert.Equal(t, "pending", created.CreateShiftSwapRequest.Status)
+	id := created.CreateShiftSwapRequest.ID
+
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("ann", "cover", "primary")
+
+	// only the requested user can accept
+	resp = h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`mutation { acceptShiftSwapRequest(id: "%s") { id } }`, id))
+	assert.NotEmpty(t, resp.Errors, "requesting user should not be able to accept")
+
+	resp = h.GraphQLQueryUserT(t, h.UUID("bob"), fmt.Sprintf(`mutation { acceptShiftSwapRequest(id: "%s") { id } }`, id))
+	require.Empty(t, resp.Errors)
+
+	resp = h.GraphQLQueryT(t, fmt.Sprintf(`
+		query {
+			schedule(id: "%s") {
+				shiftSwapRequests { id status }
+			}
+			userOverrides(input: { scheduleID: "%s" }) {
+				nodes { addUserID removeUserID }
+			}
+		}
+	`, h.UUID("sched"), h.UUID("sched")))
+	require.Empty(t, resp.Errors)
+
+	var data struct {
+		Schedule struct {
+			ShiftSwapRequests []struct{ ID, Status string }
+		}
+		UserOverrides struct {
+			Nodes []struct{ AddUserID, RemoveUserID string }
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	require.Len(t, data.Schedule.ShiftSwapRequests, 1)
+	assert.Equal(t, "accepted", data.Schedule.ShiftSwapRequests[0].Status)
+	assert.ElementsMatch(t, []struct{ AddUserID, RemoveUserID string }{
+		{AddUserID: h.UUID("bob"), RemoveUserID: h.UUID("ann")},
+		{AddUserID: h.UUID("ann"), RemoveUserID: h.UUID("bob")},
+	}, data.UserOverrides.Nodes)
+
+	// resolved requests can't be changed
+	resp = h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`mutation { cancelShiftSwapRequest(id: "%s") { id } }`, id))
+	assert.NotEmpty(t, resp.Errors, "accepted request should not be canceled")
+}
@@ -0,0 +1,240 @@
+package smoke
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/app"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/statefile"
+	"github.com/target/goalert/test/smoke/harness"
+	"github.com/target/goalert/util/timeutil"
+)
+
+// TestStateFile checks that a state file can be applied, exported, and re-applied without changes.
+func TestStateFile(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "u1"}}, 'bob', 'bob@example.com'),
+		({{uuid "u2"}}, 'joe', 'joe@example.com');
+`
+	h := harness.NewHarness(t, sql, "notification-rule-conditions")
+	defer h.Close()
+
+	a := h.App()
+	s := &statefile.Stores{
+		DB:            a.DB(),
+		Services:      a.ServiceStore,
+		Escalation:    a.EscalationStore,
+		Schedules:     a.ScheduleStore,
+		ScheduleRules: a.ScheduleRuleStore,
+		Rotations:     a.RotationStore,
+		IntKeys:       a.IntegrationKeyStore,
+		Heartbeats:    a.HeartbeatStore,
+		Labels:        a.LabelStore,
+		Registry:      a.DestRegistry,
+	}
+	ctx := permission.SystemContext(context.Background(), "Test")
+
+	desired, err := statefile.Parse([]byte(`
+version: 1
+rotations:
+  - name: Primary
+    type: weekly
+    shiftLength: 1
+    start: 2024-01-01T09:00:00-06:00
+    timeZone: America/Chicago
+    participants: [` + h.UUID("u1") + `, ` + h.UUID("u2") + `]
+schedules:
+  - name: Support
+    timeZone: America/Chicago
+    rules:
+      - rotation: primary
+        weekdayFilter: "1111111"
+        start: "00:00"
+        end: "00:00"
+escalationPolicies:
+  - name: Default
+    repeat: 2
+    steps:
+      - delayMinutes: 5
+        actions:
+          - schedule: Support
+      - delayMinutes: 10
+        actions:
+          - user: ` + h.UUID("u2") + `
+        weekdayFilter: "0111110"
+        start: "09:00"
+        end: "17:00"
+        timeZone: America/Chicago
+        matches:
+          - type: metadata
+            key: env
+            value: prod
+services:
+  - name: API
+    escalationPolicy: default
+    labels:
+      team: platform
+    integrationKeys:
+      - name: Grafana
+        type: grafana
+    heartbeatMonitors:
+      - name: Cron
+        timeoutMinutes: 15
+`))
+	require.NoError(t, err)
+
+	apply := func(st *statefile.State) []statefile.Change {
+		t.Helper()
+		tx, err := a.DB().BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer tx.Rollback()
+
+		changes, err := s.Apply(ctx, tx, st)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		return changes
+	}
+	export := func() *statefile.State {
+		t.Helper()
+		tx, err := a.DB().BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer tx.Rollback()
+
+		st, err := s.Export(ctx, tx)
+		require.NoError(t, err)
+		return st
+	}
+
+	assert.NotEmpty(t, apply(desired))
+
+	exported := export()
+	require.Len(t, exported.Services, 1)
+	assert.Equal(t, "Default", exported.Services[0].EscalationPolicy)
+	assert.Equal(t, map[string]string{"team": "platform"}, exported.Services[0].Labels)
+	require.Len(t, exported.EscalationPolicies, 1)
+	require.Len(t, exported.EscalationPolicies[0].Steps, 2)
+	assert.Equal(t, "Support", exported.EscalationPolicies[0].Steps[0].Actions[0].Schedule)
+	assert.Nil(t, exported.EscalationPolicies[0].Steps[0].WeekdayFilter, "every day is the default")
+	step := exported.EscalationPolicies[0].Steps[1]
+	assert.Equal(t, &timeutil.WeekdayFilter{0, 1, 1, 1, 1, 1, 0}, step.WeekdayFilter)
+	assert.Equal(t, timeutil.NewClock(9, 0), step.Start)
+	assert.Equal(t, timeutil.NewClock(17, 0), step.End)
+	assert.Equal(t, "America/Chicago", step.TimeZone)
+	assert.Equal(t, []statefile.StepMatch{{Type: "metadata", Key: "env", Value: "prod"}}, step.Matches)
+	require.Len(t, exported.Schedules, 1)
+	assert.Equal(t, "Primary", exported.Schedules[0].Rules[0].Rotation)
+	require.Len(t, exported.Rotations, 1)
+	assert.Equal(t, []string{h.UUID("u1"), h.UUID("u2")}, exported.Rotations[0].Participants)
+
+	assert.Empty(t, apply(exported), "re-applying an export should be a no-op")
+
+	exported.EscalationPolicies[0].Steps[1].Matches[0].Value = "staging"
+	assert.Len(t, apply(exported), 1) // step conditions
+	assert.Equal(t, "staging", export().EscalationPolicies[0].Steps[1].Matches[0].Value)
+
+	// remove a step, a participant, and the heartbeat monitor
+	exported.EscalationPolicies[0].Steps = exported.EscalationPolicies[0].Steps[:1]
+	exported.Rotations[0].Participants = exported.Rotations[0].Participants[1:]
+	exported.Services[0].Heartbeats = nil
+	assert.Len(t, apply(exported), 4) // step, participant update, participant removal, heartbeat
+
+	updated := export()
+	assert.Len(t, updated.EscalationPolicies[0].Steps, 1)
+	assert.Equal(t, []string{h.UUID("u2")}, updated.Rotations[0].Participants)
+	assert.Empty(t, updated.Services[0].Heartbeats)
+}
+
+// TestStateFileActions checks that the stores used by the export-state and apply commands can round-trip
+// a webhook action, and refuse to export actions they can not apply.
+func TestStateFileActions(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "u1"}}, 'bob', 'bob@example.com');
+`
+	h := harness.NewHarness(t, sql, "teams")
+	defer h.Close()
+
+	db := h.App().DB()
+	ctx, s, err := app.StateStores(permission.SystemContext(context.Background(), "Test"), db, nil)
+	require.NoError(t, err)
+
+	desired, err := statefile.Parse([]byte(`
+version: 1
+escalationPolicies:
+  - name: Default
+    steps:
+      - delayMinutes: 5
+        actions:
+          - user: ` + h.UUID("u1") + `
+          - type: builtin-webhook
+            args:
+              webhook_url: https://example.com/hook
+`))
+	require.NoError(t, err)
+
+	apply := func(st *statefile.State) []statefile.Change {
+		t.Helper()
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer tx.Rollback()
+
+		changes, err := s.Apply(ctx, tx, st)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		return changes
+	}
+	export := func() (*statefile.State, error) {
+		t.Helper()
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer tx.Rollback()
+
+		return s.Export(ctx, tx)
+	}
+
+	assert.NotEmpty(t, apply(desired))
+
+	exported, err := export()
+	require.NoError(t, err)
+	require.Len(t, exported.EscalationPolicies, 1)
+	require.Len(t, exported.EscalationPolicies[0].Steps, 1)
+	assert.Contains(t, exported.EscalationPolicies[0].Steps[0].Actions, statefile.Action{
+		Type: "builtin-webhook",
+		Args: map[string]string{"webhook_url": "https://example.com/hook"},
+	})
+
+	assert.Empty(t, apply(exported), "re-applying an export should be a no-op")
+
+	_, err = db.ExecContext(ctx, fmt.Sprintf(`
+		insert into notification_channels (id, name, dest)
+		values
+			('%[1]s', 'slack', '{"Type": "builtin-slack-channel", "Args": {"slack_channel_id": "C1"}}');
+
+		insert into escalation_policies (id, name)
+		values
+			('%[2]s', 'slack policy');
+
+		insert into escalation_policy_steps (id, escalation_policy_id)
+		values
+			('%[3]s', '%[2]s');
+
+		insert into escalation_policy_actions (escalation_policy_step_id, channel_id)
+		values
+			('%[3]s', '%[1]s');
+	`, h.UUID("nc"), h.UUID("ep"), h.UUID("step")))
+	require.NoError(t, err)
+
+	_, err = export()
+	assert.ErrorContains(t, err, "not supported", "actions that can not be applied should not be exported")
+}
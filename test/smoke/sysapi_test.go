@@ -0,0 +1,77 @@
+package smoke
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/pkg/sysapi"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestSysAPI checks that users, contact methods, and escalation policies with actions can be managed
+// through the system API.
+func TestSysAPI(t *testing.T) {
+	t.Parallel()
+
+	h := harness.NewHarness(t, "", "teams")
+	defer h.Close()
+
+	ctx := context.Background()
+	c := h.SysAPI(t)
+
+	usr, err := c.CreateUser(ctx, &sysapi.CreateUserRequest{Name: "bob", Email: "bob@example.com", Role: "user"})
+	require.NoError(t, err)
+
+	cm, err := c.CreateContactMethod(ctx, &sysapi.CreateContactMethodRequest{
+		UserId: usr.Id,
+		Name:   "personal",
+		Dest:   &sysapi.Destination{Type: "builtin-twilio-sms", Args: map[string]string{"phone_number": h.Phone("1")}},
+	})
+	require.NoError(t, err)
+	assert.True(t, cm.Disabled, "new contact methods should be disabled until verified")
+
+	stream, err := c.ListContactMethods(ctx, &sysapi.ListContactMethodsRequest{UserId: usr.Id})
+	require.NoError(t, err)
+	var cms []*sysapi.ContactMethod
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		cms = append(cms, m)
+	}
+	require.Len(t, cms, 1)
+	assert.Equal(t, cm.Id, cms[0].Id)
+
+	pol, err := c.CreateEscalationPolicy(ctx, &sysapi.CreateEscalationPolicyRequest{
+		Name:   "default",
+		Repeat: 1,
+		Steps: []*sysapi.EscalationPolicyStep{{
+			DelayMinutes: 5,
+			Actions: []*sysapi.Destination{
+				{Type: "builtin-user", Args: map[string]string{"user_id": usr.Id}},
+				{Type: "builtin-webhook", Args: map[string]string{"webhook_url": "https://example.com/hook"}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	pol, err = c.GetEscalationPolicy(ctx, &sysapi.GetEscalationPolicyRequest{EscalationPolicyId: pol.Id})
+	require.NoError(t, err)
+	require.Len(t, pol.Steps, 1)
+	assert.EqualValues(t, 5, pol.Steps[0].DelayMinutes)
+	require.Len(t, pol.Steps[0].Actions, 2)
+
+	var types []string
+	for _, act := range pol.Steps[0].Actions {
+		types = append(types, act.Type)
+	}
+	assert.ElementsMatch(t, []string{"builtin-user", "builtin-webhook"}, types)
+
+	_, err = c.CreateService(ctx, &sysapi.CreateServiceRequest{Name: "api", EscalationPolicyId: pol.Id})
+	require.NoError(t, err)
+}
@@ -0,0 +1,114 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestTeams checks that services owned by a team may only be modified by members of that team, or an admin,
+// and that a team can not be deleted while it still owns a service.
+func TestTeams(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "ann"}}, 'ann', 'ann@example.com', 'user'),
+		({{uuid "bob"}}, 'bob', 'bob@example.com', 'user');
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "ep"}}, 'esc policy');
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "svc"}}, {{uuid "ep"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "teams")
+	defer h.Close()
+
+	resp := h.GraphQLQueryUserT(t, h.UUID("ann"), `mutation { createTeam(input: { name: "platform" }) { id } }`)
+	require.Empty(t, resp.Errors)
+
+	var created struct {
+		CreateTeam struct{ ID string }
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &created))
+	teamID := created.CreateTeam.ID
+
+	// non-members can not assign things to a team
+	resp = h.GraphQLQueryUserT(t, h.UUID("bob"), fmt.Sprintf(`mutation { updateService(input: { id: "%s", teamID: "%s" }) }`, h.UUID("svc"), teamID))
+	assert.NotEmpty(t, resp.Errors, "non-member should not be able to assign the service to the team")
+
+	resp = h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`mutation { updateService(input: { id: "%s", teamID: "%s" }) }`, h.UUID("svc"), teamID))
+	require.Empty(t, resp.Errors)
+
+	updateDesc := func(userID, desc string) *harness.QLResponse {
+		t.Helper()
+		return h.GraphQLQueryUserT(t, userID, fmt.Sprintf(`mutation { updateService(input: { id: "%s", description: "%s" }) }`, h.UUID("svc"), desc))
+	}
+
+	assert.NotEmpty(t, updateDesc(h.UUID("bob"), "bob").Errors, "non-member should not be able to modify a team-owned service")
+	assert.Empty(t, updateDesc(h.UUID("ann"), "ann").Errors, "member should be able to modify a team-owned service")
+	assert.Empty(t, updateDesc(harness.DefaultGraphQLAdminUserID, "admin").Errors, "admin should be able to modify a team-owned service")
+
+	// only owners may add members
+	resp = h.GraphQLQueryUserT(t, h.UUID("bob"), fmt.Sprintf(`mutation { setTeamMember(input: { teamID: "%s", userID: "%s", role: member }) }`, teamID, h.UUID("bob")))
+	assert.NotEmpty(t, resp.Errors, "non-owner should not be able to add members")
+
+	resp = h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`mutation { setTeamMember(input: { teamID: "%s", userID: "%s", role: member }) }`, teamID, h.UUID("bob")))
+	require.Empty(t, resp.Errors)
+
+	assert.Empty(t, updateDesc(h.UUID("bob"), "bob").Errors, "new member should be able to modify a team-owned service")
+
+	// the last owner can not leave the team
+	resp = h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`mutation { setTeamMember(input: { teamID: "%s", userID: "%s" }) }`, teamID, h.UUID("ann")))
+	assert.NotEmpty(t, resp.Errors, "last owner should not be able to leave the team")
+
+	resp = h.GraphQLQueryT(t, fmt.Sprintf(`
+		query {
+			service(id: "%s") { description team { id name } }
+			teams(input: { search: "plat" }) { nodes { id members { userID role } } }
+		}
+	`, h.UUID("svc")))
+	require.Empty(t, resp.Errors)
+
+	var data struct {
+		Service struct {
+			Description string
+			Team        struct{ ID, Name string }
+		}
+		Teams struct {
+			Nodes []struct {
+				ID      string
+				Members []struct{ UserID, Role string }
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Equal(t, "bob", data.Service.Description)
+	assert.Equal(t, teamID, data.Service.Team.ID)
+	assert.Equal(t, "platform", data.Service.Team.Name)
+	require.Len(t, data.Teams.Nodes, 1)
+	require.Len(t, data.Teams.Nodes[0].Members, 2)
+	assert.Equal(t, h.UUID("ann"), data.Teams.Nodes[0].Members[0].UserID)
+	assert.Equal(t, "owner", data.Teams.Nodes[0].Members[0].Role)
+	assert.Equal(t, "member", data.Teams.Nodes[0].Members[1].Role)
+
+	deleteTeam := func() *harness.QLResponse {
+		t.Helper()
+		return h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`mutation { deleteAll(input: [{ id: "%s", type: team }]) }`, teamID))
+	}
+	resp = deleteTeam()
+	require.Len(t, resp.Errors, 1, "team that owns a service should not be deleted")
+	assert.Contains(t, resp.Errors[0].Message, "team still owns services")
+
+	resp = h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`mutation { updateService(input: { id: "%s", teamID: "" }) }`, h.UUID("svc")))
+	require.Empty(t, resp.Errors)
+	assert.Empty(t, deleteTeam().Errors, "team should be deleted once it owns nothing")
+}
@@ -0,0 +1,102 @@
+package smoke
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/devtools/mockticket"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestTicketing checks that a ticket is opened for an alert, that its status follows the alert,
+// and that signed callbacks from the ticketing system update the alert.
+func TestTicketing(t *testing.T) {
+	t.Parallel()
+
+	sql := `
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+
+	insert into notification_channels (id, name, dest)
+	values
+		({{uuid "chan"}}, 'OPS', '{"Type": "builtin-ticket", "Args": {"queue": "OPS"}}');
+
+	insert into escalation_policy_actions (escalation_policy_step_id, channel_id)
+	values
+		({{uuid "esid"}}, {{uuid "chan"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+`
+	h := harness.NewHarness(t, sql, "webhook-signing-secrets")
+	defer h.Close()
+
+	mock := mockticket.NewServer(mockticket.Config{
+		APIKey:         "api-key",
+		CallbackURL:    h.URL() + "/api/v2/ticketing/callback",
+		CallbackSecret: "callback-secret",
+	})
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	h.SetConfigValue("Ticketing.APIURL", srv.URL)
+	h.SetConfigValue("Ticketing.APIKey", "api-key")
+	h.SetConfigValue("Ticketing.CallbackSecret", "callback-secret")
+	h.SetConfigValue("Ticketing.FieldMapping", `title=sprintf("[%s] %s", alert.serviceName, alert.summary)`)
+	h.SetConfigValue("Ticketing.Enable", "true")
+
+	a := h.CreateAlert(h.UUID("sid"), "disk full")
+
+	var tkt mockticket.Ticket
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		tickets := mock.Tickets()
+		if assert.Len(t, tickets, 1) {
+			tkt = tickets[0]
+		}
+	}, 15*time.Second, 100*time.Millisecond)
+	assert.Equal(t, "OPS", tkt.Fields["queue"])
+	assert.Equal(t, "[service] disk full", tkt.Fields["title"])
+	assert.Equal(t, "open", tkt.Status)
+
+	var data struct {
+		Alert struct {
+			Status   string
+			TicketID string
+		}
+	}
+	resp := h.GraphQLQuery2(`{alert(id: 1){status, ticketID: metaValue(key: "ticket_id")}}`)
+	require.Empty(t, resp.Errors)
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Equal(t, tkt.ID, data.Alert.TicketID, "ticket ID stored in alert metadata")
+
+	a.Ack()
+	h.Trigger()
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		tkt, _ = mock.Ticket(tkt.ID)
+		assert.Equal(t, "acknowledged", tkt.Status)
+	}, 15*time.Second, 100*time.Millisecond)
+
+	err := mock.SendCallback(context.Background(), tkt.ID, "close")
+	require.NoError(t, err)
+
+	resp = h.GraphQLQuery2(`{alert(id: 1){status, ticketID: metaValue(key: "ticket_id")}}`)
+	require.Empty(t, resp.Errors)
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Equal(t, "StatusClosed", data.Alert.Status, "closed by callback")
+
+	h.Trigger()
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		tkt, _ = mock.Ticket(tkt.ID)
+		assert.Equal(t, "closed", tkt.Status)
+	}, 15*time.Second, 100*time.Millisecond)
+}
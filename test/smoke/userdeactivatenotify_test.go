@@ -0,0 +1,65 @@
+package smoke
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/permission"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestUserDeactivateNotify checks that a deactivated user is not sent any further notifications for existing alerts.
+func TestUserDeactivateNotify(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'SMS', {{phone "1"}});
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0),
+		({{uuid "user"}}, {{uuid "cm1"}}, 5);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id, delay)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}}, 60);
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service');
+
+	insert into alerts (service_id, summary)
+	values
+		({{uuid "sid"}}, 'testing');
+	`
+	h := harness.NewHarness(t, sql, "scim-provisioning")
+	defer h.Close()
+
+	h.Twilio(t).Device(h.Phone("1")).ExpectSMS("testing")
+
+	ctx := permission.SystemContext(context.Background(), "Smoketest")
+	require.NoError(t, h.App().UserStore.DeactivateTx(ctx, nil, h.UUID("user")))
+
+	var cycles int
+	err := h.App().DB().QueryRow(`select count(*) from notification_policy_cycles where user_id = $1`, h.UUID("user")).Scan(&cycles)
+	require.NoError(t, err)
+	assert.Zero(t, cycles, "notification cycles should be removed")
+
+	// the 5-minute rule would have sent another message
+	h.FastForward(5 * time.Minute)
+	h.Trigger()
+}
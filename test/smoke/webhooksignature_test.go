@@ -0,0 +1,170 @@
+package smoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/notification/webhook"
+	"github.com/target/goalert/test/smoke/harness"
+)
+
+// TestWebhookSignature checks that outgoing webhook requests are signed with the
+// per-URL secret and that failed requests are retried.
+func TestWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Body      []byte
+		Timestamp string
+		Signature string
+		MessageID string
+	}
+	ch := make(chan request, 2)
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		ch <- request{
+			Body:      data,
+			Timestamp: r.Header.Get(webhook.HeaderTimestamp),
+			Signature: r.Header.Get(webhook.HeaderSignature),
+			MessageID: r.Header.Get(webhook.HeaderMessageID),
+		}
+
+		if atomic.AddInt32(&count, 1) == 1 {
+			// fail the first attempt so it is retried
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	sql := `
+	insert into users (id, name, email)
+	values
+		({{uuid "user"}}, 'bob', 'joe');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "user"}}, 'personal', 'WEBHOOK', '` + ts.URL + `');
+
+	insert into user_notification_rules (user_id, contact_method_id, delay_minutes)
+	values
+		({{uuid "user"}}, {{uuid "cm1"}}, 0);
+
+	insert into escalation_policies (id, name)
+	values
+		({{uuid "eid"}}, 'esc policy');
+	insert into escalation_policy_steps (id, escalation_policy_id)
+	values
+		({{uuid "esid"}}, {{uuid "eid"}});
+	insert into escalation_policy_actions (escalation_policy_step_id, user_id)
+	values
+		({{uuid "esid"}}, {{uuid "user"}});
+
+	insert into services (id, escalation_policy_id, name, description)
+	values
+		({{uuid "sid"}}, {{uuid "eid"}}, 'service', 'testing');
+
+	insert into alerts (service_id, summary, details, status, dedup_key)
+	values
+		({{uuid "sid"}}, 'testing summary', 'testing details', 'triggered', 'auto:1:foo');
+`
+
+	h := harness.NewHarness(t, sql, "webhook-signing-secrets")
+	defer h.Close()
+
+	resp := h.GraphQLQuery2(fmt.Sprintf(`query{webhookSigningSecret(url: %s)}`, strconv.Quote(ts.URL)))
+	require.Empty(t, resp.Errors)
+	var res struct{ WebhookSigningSecret string }
+	err := json.Unmarshal(resp.Data, &res)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.WebhookSigningSecret)
+
+	first := <-ch
+	assert.True(t, webhook.ValidSignature(res.WebhookSigningSecret, time.Now(), 5*time.Minute, first.Timestamp, first.Signature, first.Body), "valid signature")
+
+	h.FastForward(time.Minute)
+	h.Trigger()
+
+	second := <-ch
+	assert.Equal(t, first.MessageID, second.MessageID, "retry should use the same message ID")
+	assert.True(t, webhook.ValidSignature(res.WebhookSigningSecret, time.Now(), 5*time.Minute, second.Timestamp, second.Signature, second.Body), "valid signature")
+
+	// every attempt is recorded in the message log
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		resp := h.GraphQLQuery2(fmt.Sprintf(`query{messageStatusHistory(id: %s){status details}}`, strconv.Quote(first.MessageID)))
+		if !assert.Empty(t, resp.Errors) {
+			return
+		}
+		var data struct {
+			MessageStatusHistory []struct{ Status, Details string }
+		}
+		if !assert.NoError(t, json.Unmarshal(resp.Data, &data)) {
+			return
+		}
+
+		var sending, failed int
+		for _, hist := range data.MessageStatusHistory {
+			switch hist.Status {
+			case "sending":
+				sending++
+			case "failed":
+				failed++
+				assert.Equal(t, "HTTP 503 Service Unavailable", hist.Details)
+			}
+		}
+		assert.Equal(t, 2, sending, "attempts")
+		assert.Equal(t, 1, failed, "failed attempts")
+		if assert.NotEmpty(t, data.MessageStatusHistory) {
+			assert.Equal(t, "sent", data.MessageStatusHistory[0].Status, "current status")
+		}
+	}, 15*time.Second, time.Second)
+}
+
+// TestWebhookSignatureAccess checks that users may only access the signing secret for a URL
+// that is not shared with a notification channel or another user.
+func TestWebhookSignatureAccess(t *testing.T) {
+	t.Parallel()
+
+	const sql = `
+	insert into users (id, name, email, role)
+	values
+		({{uuid "ann"}}, 'ann', 'ann@example.com', 'user'),
+		({{uuid "bob"}}, 'bob', 'bob@example.com', 'user');
+	insert into user_contact_methods (id, user_id, name, type, value)
+	values
+		({{uuid "cm1"}}, {{uuid "ann"}}, 'own', 'WEBHOOK', 'https://example.com/ann'),
+		({{uuid "cm2"}}, {{uuid "ann"}}, 'channel', 'WEBHOOK', 'https://example.com/channel'),
+		({{uuid "cm3"}}, {{uuid "ann"}}, 'shared', 'WEBHOOK', 'https://example.com/shared'),
+		({{uuid "cm4"}}, {{uuid "bob"}}, 'shared', 'WEBHOOK', 'https://example.com/shared');
+	insert into notification_channels (id, type, name, value)
+	values
+		({{uuid "nc"}}, 'WEBHOOK', 'policy hook', 'https://example.com/channel');
+`
+	h := harness.NewHarness(t, sql, "webhook-signing-secrets")
+	defer h.Close()
+
+	query := func(url string) *harness.QLResponse {
+		t.Helper()
+		return h.GraphQLQueryUserT(t, h.UUID("ann"), fmt.Sprintf(`query{webhookSigningSecret(url: %s)}`, strconv.Quote(url)))
+	}
+
+	assert.Empty(t, query("https://example.com/ann").Errors, "own URL")
+	assert.NotEmpty(t, query("https://example.com/channel").Errors, "URL used by a notification channel")
+	assert.NotEmpty(t, query("https://example.com/shared").Errors, "URL used by another user")
+	assert.NotEmpty(t, query("https://example.com/other").Errors, "URL without a contact method")
+
+	resp := h.GraphQLQuery2(fmt.Sprintf(`query{webhookSigningSecret(url: %s)}`, strconv.Quote("https://example.com/channel")))
+	assert.Empty(t, resp.Errors, "admin")
+}
@@ -1,17 +1,18 @@
 -- name: UserFavSet :exec
-INSERT INTO user_favorites(user_id, tgt_service_id, tgt_schedule_id, tgt_rotation_id, tgt_escalation_policy_id, tgt_user_id)
-    VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO user_favorites(user_id, tgt_service_id, tgt_schedule_id, tgt_rotation_id, tgt_escalation_policy_id, tgt_user_id, tgt_team_id)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)
 ON CONFLICT
     DO NOTHING;
 
 -- name: UserFavUnset :exec
 DELETE FROM user_favorites
 WHERE user_id = $1
-    AND tgt_service_id = $2
-    OR tgt_schedule_id = $3
-    OR tgt_rotation_id = $4
-    OR tgt_escalation_policy_id = $5
-    OR tgt_user_id = $6;
+    AND (tgt_service_id = $2
+        OR tgt_schedule_id = $3
+        OR tgt_rotation_id = $4
+        OR tgt_escalation_policy_id = $5
+        OR tgt_user_id = $6
+        OR tgt_team_id = $7);
 
 -- name: UserFavFindAll :many
 SELECT
@@ -19,7 +20,8 @@ SELECT
     tgt_schedule_id,
     tgt_rotation_id,
     tgt_escalation_policy_id,
-    tgt_user_id
+    tgt_user_id,
+    tgt_team_id
 FROM
     user_favorites
 WHERE
@@ -33,5 +35,7 @@ WHERE
         OR (tgt_escalation_policy_id NOTNULL
             AND @allow_escalation_policies::bool)
         OR (tgt_user_id NOTNULL
-            AND @allow_users::bool));
+            AND @allow_users::bool)
+        OR (tgt_team_id NOTNULL
+            AND @allow_teams::bool));
 
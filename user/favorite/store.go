@@ -31,7 +31,8 @@ func (s *Store) Set(ctx context.Context, tx gadb.DBTX, userID string, tgt assign
 		validate.UUID("TargetID", tgt.TargetID()),
 		validate.UUID("UserID", userID),
 		validate.OneOf("TargetType", tgt.TargetType(), assignment.TargetTypeService,
-			assignment.TargetTypeSchedule, assignment.TargetTypeRotation, assignment.TargetTypeEscalationPolicy, assignment.TargetTypeUser),
+			assignment.TargetTypeSchedule, assignment.TargetTypeRotation, assignment.TargetTypeEscalationPolicy, assignment.TargetTypeUser,
+			assignment.TargetTypeTeam),
 	)
 	if err != nil {
 		return err
@@ -49,6 +50,8 @@ func (s *Store) Set(ctx context.Context, tx gadb.DBTX, userID string, tgt assign
 		args.TgtEscalationPolicyID = uuid.NullUUID{Valid: true, UUID: uuid.MustParse(tgt.TargetID())}
 	case assignment.TargetTypeUser:
 		args.TgtUserID = uuid.NullUUID{Valid: true, UUID: uuid.MustParse(tgt.TargetID())}
+	case assignment.TargetTypeTeam:
+		args.TgtTeamID = uuid.NullUUID{Valid: true, UUID: uuid.MustParse(tgt.TargetID())}
 	}
 
 	err = gadb.New(tx).UserFavSet(ctx, args)
@@ -71,7 +74,8 @@ func (s *Store) Unset(ctx context.Context, tx gadb.DBTX, userID string, tgt assi
 		validate.UUID("TargetID", tgt.TargetID()),
 		validate.UUID("UserID", userID),
 		validate.OneOf("TargetType", tgt.TargetType(), assignment.TargetTypeService,
-			assignment.TargetTypeSchedule, assignment.TargetTypeRotation, assignment.TargetTypeEscalationPolicy, assignment.TargetTypeUser),
+			assignment.TargetTypeSchedule, assignment.TargetTypeRotation, assignment.TargetTypeEscalationPolicy, assignment.TargetTypeUser,
+			assignment.TargetTypeTeam),
 	)
 	if err != nil {
 		return err
@@ -89,6 +93,8 @@ func (s *Store) Unset(ctx context.Context, tx gadb.DBTX, userID string, tgt assi
 		args.TgtEscalationPolicyID = uuid.NullUUID{Valid: true, UUID: uuid.MustParse(tgt.TargetID())}
 	case assignment.TargetTypeUser:
 		args.TgtUserID = uuid.NullUUID{Valid: true, UUID: uuid.MustParse(tgt.TargetID())}
+	case assignment.TargetTypeTeam:
+		args.TgtTeamID = uuid.NullUUID{Valid: true, UUID: uuid.MustParse(tgt.TargetID())}
 	}
 
 	err = gadb.New(tx).UserFavUnset(ctx, args)
@@ -124,6 +130,7 @@ func (s *Store) FindAll(ctx context.Context, tx gadb.DBTX, userID string, filter
 		AllowRotations:          len(filter) == 0 || slices.Contains(filter, assignment.TargetTypeRotation),
 		AllowEscalationPolicies: len(filter) == 0 || slices.Contains(filter, assignment.TargetTypeEscalationPolicy),
 		AllowUsers:              len(filter) == 0 || slices.Contains(filter, assignment.TargetTypeUser),
+		AllowTeams:              len(filter) == 0 || slices.Contains(filter, assignment.TargetTypeTeam),
 	}
 
 	favs, err := gadb.New(tx).UserFavFindAll(ctx, args)
@@ -147,6 +154,8 @@ func (s *Store) FindAll(ctx context.Context, tx gadb.DBTX, userID string, filter
 			targets = append(targets, assignment.EscalationPolicyTarget(fav.TgtEscalationPolicyID.UUID.String()))
 		case fav.TgtUserID.Valid:
 			targets = append(targets, assignment.UserTarget(fav.TgtUserID.UUID.String()))
+		case fav.TgtTeamID.Valid:
+			targets = append(targets, assignment.TeamTarget(fav.TgtTeamID.UUID.String()))
 		}
 	}
 
@@ -1,7 +1,11 @@
 package notificationrule
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/util/timeutil"
 	"github.com/target/goalert/validation/validate"
 )
 
@@ -10,6 +14,17 @@ type NotificationRule struct {
 	UserID          string    `json:"-"`
 	DelayMinutes    int       `json:"delay"`
 	ContactMethodID uuid.UUID `json:"contact_method_id"`
+
+	// MinSeverity, if set, restricts the rule to alerts of at least this severity.
+	MinSeverity alert.Severity `json:"min_severity,omitempty"`
+
+	// WeekdayFilter, Start, and End restrict the rule to a window of time on the enabled days,
+	// in the user's TimeZone. If Start and End are equal, the rule applies all day. If End is
+	// before Start, the window ends the following day.
+	WeekdayFilter timeutil.WeekdayFilter `json:"weekday_filter"`
+	Start         timeutil.Clock         `json:"start"`
+	End           timeutil.Clock         `json:"end"`
+	TimeZone      *time.Location         `json:"-"`
 }
 
 func validateDelay(d int) error {
@@ -25,9 +40,43 @@ func (n NotificationRule) Normalize(update bool) (*NotificationRule, error) {
 			validate.UUID("UserID", n.UserID),
 		)
 	}
+	if n.MinSeverity != "" {
+		err = validate.Many(err, validate.OneOf("MinSeverity", n.MinSeverity,
+			alert.SeverityCritical, alert.SeverityHigh, alert.SeverityMedium, alert.SeverityLow, alert.SeverityInfo,
+		))
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if n.WeekdayFilter.IsNever() {
+		// a rule that never applies is not useful, so no days means every day
+		n.WeekdayFilter = timeutil.EveryDay()
+	}
+	if n.TimeZone == nil {
+		n.TimeZone = time.UTC
+	}
+	n.Start = timeutil.Clock(time.Duration(n.Start).Truncate(time.Minute))
+	n.End = timeutil.Clock(time.Duration(n.End).Truncate(time.Minute))
+
 	return &n, nil
 }
+
+// IsAlways returns true if the rule applies at all times.
+func (n NotificationRule) IsAlways() bool {
+	return (n.WeekdayFilter.IsAlways() || n.WeekdayFilter.IsNever()) && n.Start == n.End
+}
+
+// ActiveAt returns true if the rule applies at the given time.
+//
+// It must be kept in sync with the conditions used by the engine when sending notifications.
+func (n NotificationRule) ActiveAt(t time.Time) bool {
+	if n.IsAlways() {
+		return true
+	}
+	if n.TimeZone != nil {
+		t = t.In(n.TimeZone)
+	}
+
+	return n.WeekdayFilter.InWindow(n.Start, n.End, t)
+}
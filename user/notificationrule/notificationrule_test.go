@@ -2,8 +2,13 @@ package notificationrule
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/target/goalert/alert"
+	"github.com/target/goalert/util/timeutil"
 )
 
 func TestNotificationRule_Normalize(t *testing.T) {
@@ -25,9 +30,11 @@ func TestNotificationRule_Normalize(t *testing.T) {
 
 	valid := []NotificationRule{
 		{DelayMinutes: 5, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb"},
+		{DelayMinutes: 5, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb", MinSeverity: alert.SeverityHigh},
 	}
 	invalid := []NotificationRule{
 		{},
+		{DelayMinutes: 5, ContactMethodID: uuid.MustParse("ececacc0-4764-012d-7bfb-002500d5dece"), UserID: "bcefacc0-4764-012d-7bfb-002500d5decb", MinSeverity: "urgent"},
 	}
 	for _, nr := range valid {
 		test(true, nr)
@@ -36,3 +43,45 @@ func TestNotificationRule_Normalize(t *testing.T) {
 		test(false, nr)
 	}
 }
+
+func TestNotificationRule_ActiveAt(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+
+	var weekdays timeutil.WeekdayFilter
+	for d := time.Monday; d <= time.Friday; d++ {
+		weekdays.SetDay(d, true)
+	}
+
+	check := func(desc string, nr NotificationRule, ts string, exp bool) {
+		t.Helper()
+		tm, err := time.ParseInLocation("2006-01-02 15:04", ts, loc)
+		require.NoError(t, err)
+		assert.Equal(t, exp, nr.ActiveAt(tm), desc+" at "+ts)
+	}
+
+	always := NotificationRule{}
+	check("always", always, "2026-10-17 03:00", true)
+
+	workHours := NotificationRule{WeekdayFilter: weekdays, Start: timeutil.NewClock(9, 0), End: timeutil.NewClock(17, 0), TimeZone: loc}
+	check("work hours", workHours, "2026-10-16 09:00", true) // Friday
+	check("work hours", workHours, "2026-10-16 16:59", true)
+	check("work hours", workHours, "2026-10-16 17:00", false)
+	check("work hours", workHours, "2026-10-16 08:59", false)
+	check("work hours", workHours, "2026-10-17 12:00", false) // Saturday
+
+	nights := NotificationRule{WeekdayFilter: weekdays, Start: timeutil.NewClock(17, 0), End: timeutil.NewClock(9, 0), TimeZone: loc}
+	check("nights", nights, "2026-10-16 23:00", true) // Friday night
+	check("nights", nights, "2026-10-17 08:00", true) // Saturday morning, started Friday
+	check("nights", nights, "2026-10-17 23:00", false)
+	check("nights", nights, "2026-10-19 08:00", false) // Monday morning, started Sunday
+	check("nights", nights, "2026-10-19 12:00", false)
+
+	weekdaysAllDay := NotificationRule{WeekdayFilter: weekdays, TimeZone: loc}
+	check("weekdays", weekdaysAllDay, "2026-10-16 23:59", true)
+	check("weekdays", weekdaysAllDay, "2026-10-17 00:00", false)
+
+	// 3pm UTC is 10am in Chicago
+	utc := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	assert.True(t, workHours.ActiveAt(utc), "converts to rule time zone")
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/google/uuid"
+	"github.com/target/goalert/alert"
 	"github.com/target/goalert/permission"
 	"github.com/target/goalert/util"
 	"github.com/target/goalert/util/sqlutil"
@@ -27,8 +28,15 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 	p := prep.P
 	s := &Store{db: db}
 
-	s.insert = p("INSERT INTO user_notification_rules (id,user_id,delay_minutes,contact_method_id) VALUES ($1,$2,$3,$4)")
-	s.findAll = p("SELECT id,user_id,delay_minutes,contact_method_id FROM user_notification_rules WHERE user_id = $1")
+	s.insert = p(`
+		INSERT INTO user_notification_rules (id,user_id,delay_minutes,contact_method_id,min_severity,weekday_filter,start_time,end_time,time_zone)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+	`)
+	s.findAll = p(`
+		SELECT id,user_id,delay_minutes,contact_method_id,min_severity,weekday_filter,start_time,end_time,time_zone
+		FROM user_notification_rules
+		WHERE user_id = $1
+	`)
 	s.delete = p("DELETE FROM user_notification_rules WHERE id = any($1)")
 	s.lookupUserID = p("SELECT user_id FROM user_notification_rules WHERE id = any($1)")
 
@@ -56,7 +64,11 @@ func (s *Store) CreateTx(ctx context.Context, tx *sql.Tx, n *NotificationRule) (
 
 	n.ID = uuid.New().String()
 
-	_, err = wrapTx(ctx, tx, s.insert).ExecContext(ctx, n.ID, n.UserID, n.DelayMinutes, n.ContactMethodID)
+	_, err = wrapTx(ctx, tx, s.insert).ExecContext(ctx,
+		n.ID, n.UserID, n.DelayMinutes, n.ContactMethodID,
+		sql.NullString{String: string(n.MinSeverity), Valid: n.MinSeverity != ""},
+		n.WeekdayFilter, n.Start, n.End, n.TimeZone.String(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +150,14 @@ func (s *Store) FindAll(ctx context.Context, userID string) ([]NotificationRule,
 	notificationrules := []NotificationRule{}
 	for rows.Next() {
 		var n NotificationRule
-		err = rows.Scan(&n.ID, &n.UserID, &n.DelayMinutes, &n.ContactMethodID)
+		var minSev sql.NullString
+		var tz string
+		err = rows.Scan(&n.ID, &n.UserID, &n.DelayMinutes, &n.ContactMethodID, &minSev, &n.WeekdayFilter, &n.Start, &n.End, &tz)
+		if err != nil {
+			return nil, err
+		}
+		n.MinSeverity = alert.Severity(minSev.String)
+		n.TimeZone, err = util.LoadLocation(tz)
 		if err != nil {
 			return nil, err
 		}
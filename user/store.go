@@ -39,6 +39,15 @@ type Store struct {
 	rotSetActive       *sql.Stmt
 	lockRotTables      *sql.Stmt
 
+	deactivate       *sql.Stmt
+	reactivate       *sql.Stmt
+	deleteEPActions  *sql.Stmt
+	deleteSchedRules *sql.Stmt
+	deleteOverrides  *sql.Stmt
+	deleteSessions   *sql.Stmt
+	deleteNPCycles   *sql.Stmt
+	deletePendingMsg *sql.Stmt
+
 	findOneForUpdate *sql.Stmt
 
 	findOneBySubject *sql.Stmt
@@ -119,7 +128,17 @@ func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
 			WHERE u.id = any($1)
 		`),
 
-		deleteOne:          p.P(`DELETE FROM users WHERE id = $1`),
+		deleteOne: p.P(`DELETE FROM users WHERE id = $1`),
+
+		deactivate:       p.P(`UPDATE users SET deactivated_at = now() WHERE id = $1 AND deactivated_at ISNULL`),
+		reactivate:       p.P(`UPDATE users SET deactivated_at = NULL WHERE id = $1`),
+		deleteEPActions:  p.P(`DELETE FROM escalation_policy_actions WHERE user_id = $1`),
+		deleteSchedRules: p.P(`DELETE FROM schedule_rules WHERE tgt_user_id = $1`),
+		deleteOverrides:  p.P(`DELETE FROM user_overrides WHERE add_user_id = $1`),
+		deleteSessions:   p.P(`DELETE FROM auth_user_sessions WHERE user_id = $1`),
+		deleteNPCycles:   p.P(`DELETE FROM notification_policy_cycles WHERE user_id = $1`),
+		deletePendingMsg: p.P(`DELETE FROM outgoing_messages WHERE user_id = $1 AND last_status = 'pending'`),
+
 		userRotations:      p.P(`SELECT DISTINCT rotation_id FROM rotation_participants WHERE user_id = $1`),
 		rotationParts:      p.P(`SELECT id, user_id FROM rotation_participants WHERE rotation_id = $1 ORDER BY position`),
 		updateRotationPart: p.P(`UPDATE rotation_participants SET user_id = $2 WHERE id = $1`),
@@ -380,6 +399,127 @@ func (s *Store) retryDeleteTx(ctx context.Context, tx *sql.Tx, id string) error
 }
 
 func (s *Store) _deleteTx(ctx context.Context, tx *sql.Tx, id string) error {
+	err := s.removeFromAllRotations(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, s.deleteOne).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete user row: %w", err)
+	}
+	return nil
+}
+
+// DeactivateTx will deactivate the given user ID. The user is removed from all rotations, escalation policies,
+// schedule rules and overrides in the same way as when deleting, all sessions are ended, and any active
+// notification cycles and unsent messages are removed, but the user record and contact information is kept.
+// Deactivated users are not allowed to login.
+//
+// If tx is nil, a transaction will be started and committed before returning.
+func (s *Store) DeactivateTx(ctx context.Context, tx *sql.Tx, id string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		return err
+	}
+	err = validate.UUID("UserID", id)
+	if err != nil {
+		return err
+	}
+
+	var ownsTx bool
+	if tx == nil {
+		ownsTx = true
+		tx, err = s.db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer sqlutil.Rollback(ctx, "user: deactivate", tx)
+	}
+
+	err = s.removeFromAllRotations(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, s.deleteEPActions).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete escalation policy actions: %w", err)
+	}
+	_, err = tx.StmtContext(ctx, s.deleteSchedRules).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete schedule rules: %w", err)
+	}
+	_, err = tx.StmtContext(ctx, s.deleteOverrides).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete user overrides: %w", err)
+	}
+	_, err = tx.StmtContext(ctx, s.deleteSessions).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("end sessions: %w", err)
+	}
+	_, err = tx.StmtContext(ctx, s.deleteNPCycles).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete notification cycles: %w", err)
+	}
+	_, err = tx.StmtContext(ctx, s.deletePendingMsg).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete pending messages: %w", err)
+	}
+
+	_, err = tx.StmtContext(ctx, s.deactivate).ExecContext(ctx, id)
+	if err != nil {
+		return fmt.Errorf("deactivate user: %w", err)
+	}
+
+	if ownsTx {
+		return tx.Commit()
+	}
+
+	return nil
+}
+
+// ReactivateTx will allow a previously deactivated user to login again. Rotations, escalation policies,
+// and schedules are not restored.
+func (s *Store) ReactivateTx(ctx context.Context, tx *sql.Tx, id string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		return err
+	}
+	err = validate.UUID("UserID", id)
+	if err != nil {
+		return err
+	}
+
+	_, err = withTx(ctx, tx, s.reactivate).ExecContext(ctx, id)
+	return err
+}
+
+// RemoveFromRotationTx will remove all occurrences of the user from the given rotation, preserving
+// the currently active participant where possible.
+func (s *Store) RemoveFromRotationTx(ctx context.Context, tx *sql.Tx, userID, rotationID string) error {
+	err := permission.LimitCheckAny(ctx, permission.System, permission.Admin)
+	if err != nil {
+		return err
+	}
+	err = validate.Many(
+		validate.UUID("UserID", userID),
+		validate.UUID("RotationID", rotationID),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, s.lockRotTables).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.removeUserFromRotation(ctx, tx, userID, rotationID)
+}
+
+// removeFromAllRotations will remove the user from every rotation they are a participant of.
+func (s *Store) removeFromAllRotations(ctx context.Context, tx *sql.Tx, id string) error {
 	_, err := tx.StmtContext(ctx, s.lockRotTables).ExecContext(ctx)
 	if err != nil {
 		return err
@@ -412,10 +552,6 @@ func (s *Store) _deleteTx(ctx context.Context, tx *sql.Tx, id string) error {
 		}
 	}
 
-	_, err = tx.StmtContext(ctx, s.deleteOne).ExecContext(ctx, id)
-	if err != nil {
-		return fmt.Errorf("delete user row: %w", err)
-	}
 	return nil
 }
 
@@ -438,6 +574,9 @@ func (s *Store) removeUserFromRotation(ctx context.Context, tx *sql.Tx, userID,
 		}
 		participants = append(participants, p)
 	}
+	if len(participants) == 0 {
+		return nil
+	}
 
 	var activeIndex int
 	err = tx.StmtContext(ctx, s.rotActiveIndex).QueryRowContext(ctx, rotationID).Scan(&activeIndex)
@@ -41,6 +41,22 @@ func MapDBError(err error) error {
 			return validation.NewFieldError("UserID", "user does not exist")
 		case "auth_basic_users_user_id_fkey":
 			return validation.NewFieldError("UserID", "user does not exist")
+		case "shift_swap_requests_schedule_id_fkey":
+			return validation.NewFieldError("ScheduleID", "schedule does not exist")
+		case "shift_swap_requests_from_user_id_fkey":
+			return validation.NewFieldError("FromUserID", "user does not exist")
+		case "shift_swap_requests_to_user_id_fkey":
+			return validation.NewFieldError("ToUserID", "user does not exist")
+		case "team_members_user_id_fkey":
+			return validation.NewFieldError("UserID", "user does not exist")
+		case "team_members_team_id_fkey":
+			return validation.NewFieldError("TeamID", "team does not exist")
+		case "services_team_id_fkey", "escalation_policies_team_id_fkey", "schedules_team_id_fkey", "rotations_team_id_fkey":
+			if strings.Contains(dbErr.Detail, "is still referenced") {
+				owned := strings.ReplaceAll(strings.TrimSuffix(dbErr.ConstraintName, "_team_id_fkey"), "_", " ")
+				return validation.NewFieldError("TeamID", "team still owns "+owned+", they must be reassigned or deleted first")
+			}
+			return validation.NewFieldError("TeamID", "team does not exist")
 		}
 	case "23505": // unique constraint
 		if dbErr.ConstraintName == "idx_int_key_name_svc_ext" {
@@ -104,6 +120,13 @@ func MapDBError(err error) error {
 		if strings.Contains(dbErr.Detail, "is not present") {
 			return validation.NewFieldError("EscalationPolicyID", "does not exist")
 		}
+	case "service_routing_rules_escalation_policy_id_fkey":
+		if strings.Contains(dbErr.Detail, "is still referenced") {
+			return validation.NewFieldError("EscalationPolicyID", "is currently in use by a routing rule")
+		}
+		if strings.Contains(dbErr.Detail, "is not present") {
+			return validation.NewFieldError("EscalationPolicyID", "does not exist")
+		}
 	}
 
 	return err
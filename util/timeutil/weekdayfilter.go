@@ -99,6 +99,23 @@ func (f WeekdayFilter) Day(d time.Weekday) bool {
 	return f[int(d)%7] == 1
 }
 
+// InWindow will return true if t falls between start and end on an enabled day, in the location of t.
+// If start and end are equal, the window is the entire day. If end is before start, the window
+// ends on the following day.
+func (f WeekdayFilter) InWindow(start, end Clock, t time.Time) bool {
+	day := t.Weekday()
+	clock := NewClockFromTime(t)
+	switch {
+	case start == end:
+		return f.Day(day)
+	case start < end:
+		return f.Day(day) && clock >= start && clock < end
+	}
+
+	// window ends the following day
+	return (f.Day(day) && clock >= start) || (f.Day(day-1) && clock < end)
+}
+
 // SetDay will update the filter for the given weekday.
 func (f *WeekdayFilter) SetDay(d time.Weekday, enabled bool) {
 	if enabled {
@@ -179,3 +179,25 @@ func TestWeekdayFilter_DaysSince(t *testing.T) {
 	check(WeekdayFilter{1, 1, 1, 0, 0, 1, 1}, time.Monday, false, 4)
 
 }
+
+func TestWeekdayFilter_InWindow(t *testing.T) {
+	weekend := WeekdayFilter{1, 0, 0, 0, 0, 0, 1}
+	check := func(f WeekdayFilter, start, end Clock, ts string, exp bool) {
+		t.Helper()
+		tm, err := time.Parse(time.RFC3339, ts)
+		require.NoError(t, err)
+		assert.Equalf(t, exp, f.InWindow(start, end, tm), "%s %s-%s at %s (%s)", f, start, end, ts, tm.Weekday())
+	}
+
+	// 2024-01-06 is a Saturday
+	check(weekend, 0, 0, "2024-01-06T12:00:00Z", true)
+	check(weekend, 0, 0, "2024-01-08T12:00:00Z", false)
+	check(everyDay, NewClock(9, 0), NewClock(17, 0), "2024-01-08T09:00:00Z", true)
+	check(everyDay, NewClock(9, 0), NewClock(17, 0), "2024-01-08T17:00:00Z", false)
+
+	// overnight window belongs to the day it starts
+	check(weekend, NewClock(22, 0), NewClock(6, 0), "2024-01-07T23:00:00Z", true)
+	check(weekend, NewClock(22, 0), NewClock(6, 0), "2024-01-08T05:00:00Z", true)
+	check(weekend, NewClock(22, 0), NewClock(6, 0), "2024-01-08T23:00:00Z", false)
+	check(weekend, NewClock(22, 0), NewClock(6, 0), "2024-01-06T05:00:00Z", false)
+}
@@ -28,6 +28,7 @@ const fromExistingQuery = gql`
       description
       role
       query
+      scim
       createdAt
       expiresAt
     }
@@ -87,6 +88,7 @@ export default function AdminAPIKeyCreateDialog(props: {
           description: oldKey.description,
           query: oldKey.query,
           role: oldKey.role,
+          scim: oldKey.scim,
           expiresAt: nextExpiration(oldKey.expiresAt, oldKey.createdAt),
         }
       : {
@@ -95,6 +97,7 @@ export default function AdminAPIKeyCreateDialog(props: {
           expiresAt: DateTime.utc().plus({ days: 7 }).toISO(),
           query: '',
           role: 'user',
+          scim: false,
         },
   )
 
@@ -109,6 +112,7 @@ export default function AdminAPIKeyCreateDialog(props: {
           query: value.query,
           expiresAt: value.expiresAt,
           role: value.role,
+          scim: value.scim,
         },
       },
       { additionalTypenames: ['GQLAPIKey'] },
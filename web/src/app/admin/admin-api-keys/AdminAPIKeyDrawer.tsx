@@ -47,6 +47,7 @@ const query = gql`
       expiresAt
       query
       role
+      scim
     }
   }
 `
@@ -177,18 +178,25 @@ export default function AdminAPIKeyDrawer(props: Props): React.JSX.Element {
               <ListItemText primary='Role' secondary={apiKey.role} />
             </ListItem>
             <ListItem divider>
-              <ListItemText
-                primary='Query'
-                secondary={
-                  <Button
-                    variant='outlined'
-                    onClick={() => setShowQuery(true)}
-                    sx={{ mt: 0.5 }}
-                  >
-                    Show Query
-                  </Button>
-                }
-              />
+              {apiKey.scim ? (
+                <ListItemText
+                  primary='Usage'
+                  secondary='SCIM user provisioning'
+                />
+              ) : (
+                <ListItemText
+                  primary='Query'
+                  secondary={
+                    <Button
+                      variant='outlined'
+                      onClick={() => setShowQuery(true)}
+                      sx={{ mt: 0.5 }}
+                    >
+                      Show Query
+                    </Button>
+                  }
+                />
+              )}
             </ListItem>
             <ActionBy
               label='Created'
@@ -4,7 +4,13 @@ import { FormContainer, FormField, HelperText } from '../../forms'
 import { FieldError } from '../../util/errutil'
 import { CreateGQLAPIKeyInput } from '../../../schema'
 import AdminAPIKeyExpirationField from './AdminAPIKeyExpirationField'
-import { TextField, MenuItem, FormControl } from '@mui/material'
+import {
+  TextField,
+  MenuItem,
+  FormControl,
+  FormControlLabel,
+  Checkbox,
+} from '@mui/material'
 import GraphQLEditor from '../../editor/GraphQLEditor'
 
 type AdminAPIKeyFormProps = {
@@ -21,8 +27,14 @@ export default function AdminAPIKeyForm(
   props: AdminAPIKeyFormProps,
 ): React.JSX.Element {
   const queryError = props.errors.find((e) => e.field === 'query')?.message
+  const isSCIM = !!props.value.scim
+
+  // SCIM keys are always admin, and don't have a query
+  const onChange = (value: CreateGQLAPIKeyInput): void =>
+    props.onChange(value.scim ? { ...value, role: 'admin', query: '' } : value)
+
   return (
-    <FormContainer optionalLabels {...props}>
+    <FormContainer optionalLabels {...props} onChange={onChange}>
       <Grid container spacing={2}>
         <Grid item xs={12}>
           <FormField fullWidth name='name' required component={TextField} />
@@ -46,7 +58,7 @@ export default function AdminAPIKeyForm(
             select
             required
             name='role'
-            disabled={!props.create}
+            disabled={!props.create || isSCIM}
           >
             <MenuItem value='user' key='user'>
               User
@@ -66,7 +78,16 @@ export default function AdminAPIKeyForm(
             disabled={!props.create}
           />
         </Grid>
-        <Grid item xs={12}>
+        {props.create && (
+          <Grid item xs={12}>
+            <FormControlLabel
+              control={<FormField component={Checkbox} checkbox name='scim' />}
+              label='Use for SCIM user provisioning instead of GraphQL'
+              labelPlacement='end'
+            />
+          </Grid>
+        )}
+        <Grid item xs={12} sx={{ display: isSCIM ? 'none' : undefined }}>
           <FormControl error={!!queryError} fullWidth>
             <GraphQLEditor
               value={props.value.query}
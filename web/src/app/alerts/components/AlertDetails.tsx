@@ -40,6 +40,8 @@ import { useIsWidthDown } from '../../util/useWidth'
 import ReactGA from 'react-ga4'
 import { useConfigValue } from '../../util/RequireConfig'
 import { renderChipsDest } from '../../escalation-policies/stepUtil'
+import { useURLParam } from '../../actions'
+import FormDialog from '../../dialogs/FormDialog'
 interface AlertDetailsProps {
   data: Alert
 }
@@ -134,6 +136,10 @@ export default function AlertDetails(
     _showExactTimes = _showExactTimes === 'true'
   }
 
+  // Chat destinations that can't call back to GoAlert (e.g., incoming webhook cards)
+  // link here with `?action=acknowledge` or `?action=close` to be confirmed by the user.
+  const [linkAction, setLinkAction] = useURLParam<string>('action', '')
+
   const [fullDescription, setFullDescription] = useState(false)
   const [showExactTimes, setShowExactTimes] = useState(_showExactTimes)
 
@@ -328,6 +334,16 @@ export default function AlertDetails(
 
   const { data: alert } = props
 
+  let pendingLinkAction = ''
+  if (linkAction === 'close' && alert.status !== 'StatusClosed') {
+    pendingLinkAction = 'close'
+  } else if (
+    linkAction === 'acknowledge' &&
+    alert.status === 'StatusUnacknowledged'
+  ) {
+    pendingLinkAction = 'acknowledge'
+  }
+
   let extraNotices: Notice[] = alert.pendingNotifications.map((n) => ({
     type: 'WARNING',
     message: `Notification Pending for ${n.destination}`,
@@ -360,6 +376,22 @@ export default function AlertDetails(
 
   return (
     <Grid container spacing={2}>
+      {pendingLinkAction && (
+        <FormDialog
+          title='Are you sure?'
+          confirm
+          subTitle={`This will ${pendingLinkAction} alert #${alert.alertID}.`}
+          onClose={() => setLinkAction('')}
+          onSubmit={() => {
+            if (pendingLinkAction === 'close') {
+              alertAction('alert_closed', close)
+            } else {
+              alertAction('alert_acknowledged', ack)
+            }
+            setLinkAction('')
+          }}
+        />
+      )}
       <ServiceNotices
         serviceID={alert?.service?.id ?? ''}
         extraNotices={extraNotices as Notice[]}
@@ -4,6 +4,8 @@ import CardContent from '@mui/material/CardContent'
 import Typography from '@mui/material/Typography'
 import integrationKeys from './sections/IntegrationKeys.md'
 import webhooks from './sections/Webhooks.md'
+import ticketing from './sections/Ticketing.md'
+import eventSink from './sections/EventSink.md'
 import Markdown from '../util/Markdown'
 import { useConfigValue } from '../util/RequireConfig'
 import { pathPrefix } from '../env'
@@ -16,10 +18,13 @@ const useStyles = makeStyles({
 })
 
 export default function Documentation(): React.JSX.Element {
-  const [publicURL, webhookEnabled] = useConfigValue(
-    'General.PublicURL',
-    'Webhook.Enable',
-  )
+  const [publicURL, webhookEnabled, ticketingEnabled, eventSinkEnabled] =
+    useConfigValue(
+      'General.PublicURL',
+      'Webhook.Enable',
+      'Ticketing.Enable',
+      'EventSink.Enable',
+    )
   const classes = useStyles()
 
   // NOTE list markdown documents here
@@ -27,6 +32,12 @@ export default function Documentation(): React.JSX.Element {
   if (webhookEnabled) {
     markdownDocs.push({ doc: webhooks, id: 'webhooks' })
   }
+  if (ticketingEnabled) {
+    markdownDocs.push({ doc: ticketing, id: 'ticketing' })
+  }
+  if (eventSinkEnabled) {
+    markdownDocs.push({ doc: eventSink, id: 'event-sink' })
+  }
 
   markdownDocs = markdownDocs.map((md) => ({
     id: md.id,
@@ -44,7 +55,7 @@ export default function Documentation(): React.JSX.Element {
     if (!el) return
 
     el.scrollIntoView()
-  }, [webhookEnabled, publicURL])
+  }, [webhookEnabled, ticketingEnabled, eventSinkEnabled, publicURL])
 
   return (
     <React.Fragment>
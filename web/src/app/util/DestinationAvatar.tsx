@@ -8,6 +8,8 @@ import {
   Today as ScheduleIcon,
   Webhook as WebhookIcon,
   Email,
+  ConfirmationNumber as TicketIcon,
+  Chat as ChatIcon,
 } from '@mui/icons-material'
 
 const builtInIcons: { [key: string]: React.ReactNode } = {
@@ -16,6 +18,8 @@ const builtInIcons: { [key: string]: React.ReactNode } = {
   'builtin://schedule': <ScheduleIcon />,
   'builtin://webhook': <WebhookIcon />,
   'builtin://email': <Email />,
+  'builtin://ticket': <TicketIcon />,
+  'builtin://msteams': <ChatIcon />,
 }
 
 export type DestinationAvatarProps = {
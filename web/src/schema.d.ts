@@ -15,6 +15,7 @@ export interface Alert {
   createdAt: ISOTimestamp
   details: string
   id: string
+  incident?: null | Incident
   meta?: null | AlertMetadata[]
   metaValue: string
   metrics?: null | AlertMetric
@@ -23,6 +24,7 @@ export interface Alert {
   recentEvents: AlertLogEntryConnection
   service?: null | Service
   serviceID: string
+  severity: AlertSeverity
   state?: null | AlertState
   status: AlertStatus
   summary: string
@@ -90,6 +92,7 @@ export interface AlertSearchOptions {
   createdBefore?: null | ISOTimestamp
   favoritesOnly?: null | boolean
   filterByServiceID?: null | string[]
+  filterBySeverity?: null | AlertSeverity[]
   filterByStatus?: null | AlertStatus[]
   first?: null | number
   includeNotified?: null | boolean
@@ -102,6 +105,8 @@ export interface AlertSearchOptions {
 
 export type AlertSearchSort = 'dateID' | 'dateIDReverse' | 'statusID'
 
+export type AlertSeverity = 'critical' | 'high' | 'info' | 'low' | 'medium'
+
 export interface AlertState {
   lastEscalation: ISOTimestamp
   repeatCount: number
@@ -229,6 +234,7 @@ export interface CreateAlertInput {
   meta?: null | AlertMetadataInput[]
   sanitize?: null | boolean
   serviceID: string
+  severity?: null | AlertSeverity
   summary: string
 }
 
@@ -245,16 +251,22 @@ export interface CreateEscalationPolicyInput {
   name: string
   repeat?: null | number
   steps?: null | CreateEscalationPolicyStepInput[]
+  teamID?: null | string
 }
 
 export interface CreateEscalationPolicyStepInput {
   actions?: null | DestinationInput[]
   delayMinutes: number
+  end?: null | ClockTime
   escalationPolicyID?: null | string
+  matches?: null | EscalationPolicyStepMatchInput[]
   multiAck?: null | boolean
   newRotation?: null | CreateRotationInput
   newSchedule?: null | CreateScheduleInput
+  start?: null | ClockTime
   targets?: null | TargetInput[]
+  timeZone?: null | string
+  weekdayFilter?: null | WeekdayFilter
 }
 
 export interface CreateGQLAPIKeyInput {
@@ -263,6 +275,7 @@ export interface CreateGQLAPIKeyInput {
   name: string
   query: string
   role: UserRole
+  scim?: null | boolean
 }
 
 export interface CreateHeartbeatMonitorInput {
@@ -280,13 +293,25 @@ export interface CreateIntegrationKeyInput {
   type: IntegrationKeyType
 }
 
+export interface CreateMaintenanceWindowInput {
+  description?: null | string
+  end: ISOTimestamp
+  serviceID: string
+  start: ISOTimestamp
+  suppressAlerts?: null | boolean
+  timeZone?: null | string
+  weekdayFilter?: null | WeekdayFilter
+}
+
 export interface CreateRotationInput {
   description?: null | string
   favorite?: null | boolean
   labels?: null | SetLabelInput[]
   name: string
+  regions?: null | RotationRegionInput[]
   shiftLength?: null | number
   start: ISOTimestamp
+  teamID?: null | string
   timeZone: string
   type: RotationType
   userIDs?: null | string[]
@@ -299,6 +324,7 @@ export interface CreateScheduleInput {
   name: string
   newUserOverrides?: null | CreateUserOverrideInput[]
   targets?: null | ScheduleTargetInput[]
+  teamID?: null | string
   timeZone: string
 }
 
@@ -311,6 +337,24 @@ export interface CreateServiceInput {
   newEscalationPolicy?: null | CreateEscalationPolicyInput
   newHeartbeatMonitors?: null | CreateHeartbeatMonitorInput[]
   newIntegrationKeys?: null | CreateIntegrationKeyInput[]
+  teamID?: null | string
+}
+
+export interface CreateShiftSwapRequestInput {
+  end: ISOTimestamp
+  fromUserID?: null | string
+  note?: null | string
+  scheduleID: string
+  start: ISOTimestamp
+  toUserID: string
+  tradeEnd?: null | ISOTimestamp
+  tradeStart?: null | ISOTimestamp
+}
+
+export interface CreateTeamInput {
+  description?: null | string
+  favorite?: null | boolean
+  name: string
 }
 
 export interface CreateUserCalendarSubscriptionInput {
@@ -343,7 +387,12 @@ export interface CreateUserInput {
 export interface CreateUserNotificationRuleInput {
   contactMethodID?: null | string
   delayMinutes: number
+  end?: null | ClockTime
+  minSeverity?: null | AlertSeverity
+  start?: null | ClockTime
+  timeZone?: null | string
   userID?: null | string
+  weekdayFilter?: null | WeekdayFilter
 }
 
 export interface CreateUserOverrideInput {
@@ -508,6 +557,7 @@ export interface EscalationPolicy {
   notices: Notice[]
   repeat: number
   steps: EscalationPolicyStep[]
+  team?: null | Team
 }
 
 export interface EscalationPolicyConnection {
@@ -522,18 +572,38 @@ export interface EscalationPolicySearchOptions {
   first?: null | number
   omit?: null | string[]
   search?: null | string
+  teamIDs?: null | string[]
 }
 
 export interface EscalationPolicyStep {
   actions: Destination[]
   delayMinutes: number
+  end: ClockTime
   escalationPolicy?: null | EscalationPolicy
   id: string
+  matches: EscalationPolicyStepMatch[]
   multiAck: boolean
+  start: ClockTime
   stepNumber: number
   targets: Target[]
+  timeZone: string
+  weekdayFilter: WeekdayFilter
+}
+
+export interface EscalationPolicyStepMatch {
+  key: string
+  type: EscalationPolicyStepMatchType
+  value: string
+}
+
+export interface EscalationPolicyStepMatchInput {
+  key: string
+  type: EscalationPolicyStepMatchType
+  value: string
 }
 
+export type EscalationPolicyStepMatchType = 'label' | 'metadata'
+
 export interface Expr {
   conditionToExpr: string
   exprToCondition: Condition
@@ -591,6 +661,7 @@ export interface GQLAPIKey {
   name: string
   query: string
   role: UserRole
+  scim: boolean
   updatedAt: ISOTimestamp
   updatedBy?: null | User
 }
@@ -623,6 +694,15 @@ export type ISORInterval = string
 
 export type ISOTimestamp = string
 
+export interface Incident {
+  alerts: Alert[]
+  createdAt: ISOTimestamp
+  id: number
+  primaryAlertID: number
+  status: AlertStatus
+  title: string
+}
+
 export type InlineDisplayInfo =
   | DestinationDisplayInfo
   | DestinationDisplayInfoError
@@ -735,6 +815,18 @@ export interface LinkAccountInfo {
   userDetails: string
 }
 
+export interface MaintenanceWindow {
+  active: boolean
+  description: string
+  end: ISOTimestamp
+  id: string
+  serviceID: string
+  start: ISOTimestamp
+  suppressAlerts: boolean
+  timeZone: string
+  weekdayFilter?: null | WeekdayFilter
+}
+
 export interface MessageLogConnection {
   nodes: DebugMessage[]
   pageInfo: PageInfo
@@ -761,7 +853,9 @@ export interface MessageStatusHistory {
 }
 
 export interface Mutation {
+  acceptShiftSwapRequest: ShiftSwapRequest
   addAuthSubject: boolean
+  cancelShiftSwapRequest: ShiftSwapRequest
   clearTemporarySchedules: boolean
   closeMatchingAlert: boolean
   createAlert?: null | Alert
@@ -771,9 +865,12 @@ export interface Mutation {
   createGQLAPIKey: CreatedGQLAPIKey
   createHeartbeatMonitor?: null | HeartbeatMonitor
   createIntegrationKey?: null | IntegrationKey
+  createMaintenanceWindow: MaintenanceWindow
   createRotation?: null | Rotation
   createSchedule?: null | Schedule
   createService?: null | Service
+  createShiftSwapRequest: ShiftSwapRequest
+  createTeam?: null | Team
   createUser?: null | User
   createUserCalendarSubscription: UserCalendarSubscription
   createUserContactMethod?: null | UserContactMethod
@@ -781,9 +878,11 @@ export interface Mutation {
   createUserOverride?: null | UserOverride
   debugCarrierInfo: DebugCarrierInfo
   debugSendSMS?: null | DebugSendSMSInfo
+  declineShiftSwapRequest: ShiftSwapRequest
   deleteAll: boolean
   deleteAuthSubject: boolean
   deleteGQLAPIKey: boolean
+  deleteMaintenanceWindow: boolean
   deleteSecondaryToken: boolean
   endAllAuthSessionsByCurrentUser: boolean
   escalateAlerts?: null | Alert[]
@@ -791,6 +890,7 @@ export interface Mutation {
   linkAccount: boolean
   promoteSecondaryToken: boolean
   reEncryptKeyringsAndConfig: boolean
+  resetWebhookSigningSecret: string
   sendContactMethodVerification: boolean
   sendSignal: boolean
   setAlertNoiseReason: boolean
@@ -798,7 +898,9 @@ export interface Mutation {
   setFavorite: boolean
   setLabel: boolean
   setScheduleOnCallNotificationRules: boolean
+  setServiceRoutingRules: boolean
   setSystemLimits: boolean
+  setTeamMember: boolean
   setTemporarySchedule: boolean
   swoAction: boolean
   testContactMethod: boolean
@@ -809,11 +911,14 @@ export interface Mutation {
   updateEscalationPolicyStep: boolean
   updateGQLAPIKey: boolean
   updateHeartbeatMonitor: boolean
+  updateIncident: boolean
   updateKeyConfig: boolean
+  updateMaintenanceWindow: boolean
   updateRotation: boolean
   updateSchedule: boolean
   updateScheduleTarget: boolean
   updateService: boolean
+  updateTeam: boolean
   updateUser: boolean
   updateUserCalendarSubscription: boolean
   updateUserContactMethod: boolean
@@ -874,6 +979,49 @@ export interface OnCallShift {
   userID: string
 }
 
+export interface OnCallWorkloadReport {
+  end: ISOTimestamp
+  schedules: OnCallWorkloadScheduleStats[]
+  start: ISOTimestamp
+  teams: OnCallWorkloadTeamStats[]
+  timeZone: string
+  users: OnCallWorkloadStats[]
+}
+
+export interface OnCallWorkloadReportInput {
+  end?: null | ISOTimestamp
+  nightEnd?: null | ClockTime
+  nightStart?: null | ClockTime
+  start?: null | ISOTimestamp
+  timeZone?: null | string
+  workdayEnd?: null | ClockTime
+  workdayStart?: null | ClockTime
+}
+
+export interface OnCallWorkloadScheduleStats {
+  scheduleID: string
+  scheduleName: string
+  total: OnCallWorkloadStats
+  users: OnCallWorkloadStats[]
+}
+
+export interface OnCallWorkloadStats {
+  acknowledgements: number
+  nightPages: number
+  offHoursOnCallHours: Float
+  onCallHours: Float
+  pages: number
+  userID: string
+  userName: string
+}
+
+export interface OnCallWorkloadTeamStats {
+  teamID: string
+  teamName: string
+  total: OnCallWorkloadStats
+  users: OnCallWorkloadStats[]
+}
+
 export interface PageInfo {
   endCursor?: null | string
   hasNextPage: boolean
@@ -912,6 +1060,7 @@ export interface Query {
   generateSlackAppManifest: string
   gqlAPIKeys: GQLAPIKey[]
   heartbeatMonitor?: null | HeartbeatMonitor
+  incident?: null | Incident
   integrationKey?: null | IntegrationKey
   integrationKeyTypes: IntegrationKeyTypeInfo[]
   integrationKeys: IntegrationKeyConnection
@@ -921,6 +1070,7 @@ export interface Query {
   linkAccountInfo?: null | LinkAccountInfo
   messageLogs: MessageLogConnection
   messageStatusHistory: MessageStatusHistory[]
+  onCallWorkloadReport: OnCallWorkloadReport
   phoneNumberInfo?: null | PhoneNumberInfo
   rotation?: null | Rotation
   rotations: RotationConnection
@@ -928,12 +1078,15 @@ export interface Query {
   schedules: ScheduleConnection
   service?: null | Service
   services: ServiceConnection
+  simulateEscalation: SimulatedNotification[]
   slackChannel?: null | SlackChannel
   slackChannels: SlackChannelConnection
   slackUserGroup?: null | SlackUserGroup
   slackUserGroups: SlackUserGroupConnection
   swoStatus: SWOStatus
   systemLimits: SystemLimit[]
+  team?: null | Team
+  teams: TeamConnection
   timeZones: TimeZoneConnection
   user?: null | User
   userCalendarSubscription?: null | UserCalendarSubscription
@@ -941,6 +1094,7 @@ export interface Query {
   userOverride?: null | UserOverride
   userOverrides: UserOverrideConnection
   users: UserConnection
+  webhookSigningSecret: string
 }
 
 export interface Rotation {
@@ -951,8 +1105,10 @@ export interface Rotation {
   labels: Label[]
   name: string
   nextHandoffTimes: ISOTimestamp[]
+  regions: RotationRegion[]
   shiftLength: number
   start: ISOTimestamp
+  team?: null | Team
   timeZone: string
   type: RotationType
   userIDs: string[]
@@ -964,6 +1120,19 @@ export interface RotationConnection {
   pageInfo: PageInfo
 }
 
+export interface RotationRegion {
+  start: ClockTime
+  timeZone: string
+  userIDs: string[]
+  users: User[]
+}
+
+export interface RotationRegionInput {
+  start: ClockTime
+  timeZone: string
+  userIDs: string[]
+}
+
 export interface RotationSearchOptions {
   after?: null | string
   favoritesFirst?: null | boolean
@@ -971,9 +1140,15 @@ export interface RotationSearchOptions {
   first?: null | number
   omit?: null | string[]
   search?: null | string
+  teamIDs?: null | string[]
 }
 
-export type RotationType = 'daily' | 'hourly' | 'monthly' | 'weekly'
+export type RotationType =
+  | 'daily'
+  | 'follow_the_sun'
+  | 'hourly'
+  | 'monthly'
+  | 'weekly'
 
 export type SWOAction = 'execute' | 'reset'
 
@@ -1014,15 +1189,18 @@ export interface SWOStatus {
 
 export interface Schedule {
   assignedTo: Target[]
+  coverageGaps: ScheduleCoverageGap[]
   description: string
   id: string
   isFavorite: boolean
   labels: Label[]
   name: string
   onCallNotificationRules: OnCallNotificationRule[]
+  shiftSwapRequests: ShiftSwapRequest[]
   shifts: OnCallShift[]
   target?: null | ScheduleTarget
   targets: ScheduleTarget[]
+  team?: null | Team
   temporarySchedules: TemporarySchedule[]
   timeZone: string
 }
@@ -1032,6 +1210,11 @@ export interface ScheduleConnection {
   pageInfo: PageInfo
 }
 
+export interface ScheduleCoverageGap {
+  end: ISOTimestamp
+  start: ISOTimestamp
+}
+
 export interface ScheduleRule {
   end: ClockTime
   id: string
@@ -1055,6 +1238,7 @@ export interface ScheduleSearchOptions {
   first?: null | number
   omit?: null | string[]
   search?: null | string
+  teamIDs?: null | string[]
 }
 
 export interface ScheduleTarget {
@@ -1092,10 +1276,14 @@ export interface Service {
   isFavorite: boolean
   labels: Label[]
   maintenanceExpiresAt?: null | ISOTimestamp
+  maintenanceWindows: MaintenanceWindow[]
   name: string
   notices: Notice[]
   onCallUsers: ServiceOnCallUser[]
   recentEvents: AlertLogEntryConnection
+  routingRules: ServiceRoutingRule[]
+  suppressedAlerts: SuppressedAlert[]
+  team?: null | Team
 }
 
 export interface ServiceAlertStatsOptions {
@@ -1115,6 +1303,23 @@ export interface ServiceOnCallUser {
   userName: string
 }
 
+export interface ServiceRoutingRule {
+  condition: ExprBooleanExpression
+  drop: boolean
+  escalationPolicy?: null | EscalationPolicy
+  id: string
+  name: string
+  priority: string
+}
+
+export interface ServiceRoutingRuleInput {
+  condition: ExprBooleanExpression
+  drop?: null | boolean
+  escalationPolicyID?: null | string
+  name: string
+  priority?: null | string
+}
+
 export interface ServiceSearchOptions {
   after?: null | string
   favoritesFirst?: null | boolean
@@ -1123,6 +1328,7 @@ export interface ServiceSearchOptions {
   omit?: null | string[]
   only?: null | string[]
   search?: null | string
+  teamIDs?: null | string[]
 }
 
 export interface SetAlertNoiseReasonInput {
@@ -1152,6 +1358,17 @@ export interface SetScheduleShiftInput {
   userID: string
 }
 
+export interface SetServiceRoutingRulesInput {
+  rules: ServiceRoutingRuleInput[]
+  serviceID: string
+}
+
+export interface SetTeamMemberInput {
+  role?: null | TeamRole
+  teamID: string
+  userID: string
+}
+
 export interface SetTemporaryScheduleInput {
   clearEnd?: null | ISOTimestamp
   clearStart?: null | ISOTimestamp
@@ -1161,6 +1378,47 @@ export interface SetTemporaryScheduleInput {
   start: ISOTimestamp
 }
 
+export interface ShiftSwapRequest {
+  createdAt: ISOTimestamp
+  end: ISOTimestamp
+  fromUser?: null | User
+  fromUserID: string
+  id: string
+  note: string
+  resolvedAt?: null | ISOTimestamp
+  schedule?: null | Schedule
+  scheduleID: string
+  start: ISOTimestamp
+  status: ShiftSwapStatus
+  toUser?: null | User
+  toUserID: string
+  tradeEnd?: null | ISOTimestamp
+  tradeStart?: null | ISOTimestamp
+}
+
+export type ShiftSwapStatus = 'accepted' | 'canceled' | 'declined' | 'pending'
+
+export interface SimulateEscalationInput {
+  details?: null | string
+  durationMinutes?: null | number
+  metadata?: null | AlertMetadataInput[]
+  serviceID: string
+  severity?: null | AlertSeverity
+  source?: null | string
+  start?: null | ISOTimestamp
+  summary?: null | string
+}
+
+export interface SimulatedNotification {
+  channel?: null | Destination
+  contactMethod?: null | UserContactMethod
+  minute: number
+  repeat: number
+  stepNumber: number
+  time: ISOTimestamp
+  user?: null | User
+}
+
 export interface SlackChannel {
   id: string
   name: string
@@ -1212,6 +1470,14 @@ export interface StringConnection {
 
 export type StringMap = Record<string, string>
 
+export interface SuppressedAlert {
+  dedupKey: string
+  serviceID: string
+  summary: string
+  suppressedAt: ISOTimestamp
+  windowID?: null | string
+}
+
 export interface SystemLimit {
   description: string
   id: SystemLimitID
@@ -1263,10 +1529,42 @@ export type TargetType =
   | 'service'
   | 'slackChannel'
   | 'slackUserGroup'
+  | 'team'
   | 'user'
   | 'userOverride'
   | 'userSession'
 
+export interface Team {
+  description: string
+  id: string
+  isFavorite: boolean
+  members: TeamMember[]
+  name: string
+}
+
+export interface TeamConnection {
+  nodes: Team[]
+  pageInfo: PageInfo
+}
+
+export interface TeamMember {
+  role: TeamRole
+  user?: null | User
+  userID: string
+}
+
+export type TeamRole = 'member' | 'owner'
+
+export interface TeamSearchOptions {
+  after?: null | string
+  favoritesFirst?: null | boolean
+  favoritesOnly?: null | boolean
+  first?: null | number
+  myTeamsOnly?: null | boolean
+  omit?: null | string[]
+  search?: null | string
+}
+
 export interface TemporarySchedule {
   end: ISOTimestamp
   shifts: OnCallShift[]
@@ -1315,6 +1613,7 @@ export interface UpdateAlertsInput {
   alertIDs: number[]
   newStatus?: null | AlertStatus
   noiseReason?: null | string
+  snoozeMinutes?: null | number
 }
 
 export interface UpdateBasicAuthInput {
@@ -1329,14 +1628,20 @@ export interface UpdateEscalationPolicyInput {
   name?: null | string
   repeat?: null | number
   stepIDs?: null | string[]
+  teamID?: null | string
 }
 
 export interface UpdateEscalationPolicyStepInput {
   actions?: null | DestinationInput[]
   delayMinutes?: null | number
+  end?: null | ClockTime
   id: string
+  matches?: null | EscalationPolicyStepMatchInput[]
   multiAck?: null | boolean
+  start?: null | ClockTime
   targets?: null | TargetInput[]
+  timeZone?: null | string
+  weekdayFilter?: null | WeekdayFilter
 }
 
 export interface UpdateGQLAPIKeyInput {
@@ -1353,6 +1658,11 @@ export interface UpdateHeartbeatMonitorInput {
   timeoutMinutes?: null | number
 }
 
+export interface UpdateIncidentInput {
+  id: number
+  newStatus: AlertStatus
+}
+
 export interface UpdateKeyConfigInput {
   defaultActions?: null | ActionInput[]
   deleteRule?: null | string
@@ -1363,13 +1673,25 @@ export interface UpdateKeyConfigInput {
   setRuleOrder?: null | string[]
 }
 
+export interface UpdateMaintenanceWindowInput {
+  description?: null | string
+  end?: null | ISOTimestamp
+  id: string
+  start?: null | ISOTimestamp
+  suppressAlerts?: null | boolean
+  timeZone?: null | string
+  weekdayFilter?: null | WeekdayFilter
+}
+
 export interface UpdateRotationInput {
   activeUserIndex?: null | number
   description?: null | string
   id: string
   name?: null | string
+  regions?: null | RotationRegionInput[]
   shiftLength?: null | number
   start?: null | ISOTimestamp
+  teamID?: null | string
   timeZone?: null | string
   type?: null | RotationType
   userIDs?: null | string[]
@@ -1379,6 +1701,7 @@ export interface UpdateScheduleInput {
   description?: null | string
   id: string
   name?: null | string
+  teamID?: null | string
   timeZone?: null | string
 }
 
@@ -1388,6 +1711,13 @@ export interface UpdateServiceInput {
   id: string
   maintenanceExpiresAt?: null | ISOTimestamp
   name?: null | string
+  teamID?: null | string
+}
+
+export interface UpdateTeamInput {
+  description?: null | string
+  id: string
+  name?: null | string
 }
 
 export interface UpdateUserCalendarSubscriptionInput {
@@ -1471,10 +1801,16 @@ export interface UserContactMethod {
 }
 
 export interface UserNotificationRule {
+  active: boolean
   contactMethod?: null | UserContactMethod
   contactMethodID: string
   delayMinutes: number
+  end: ClockTime
   id: string
+  minSeverity?: null | AlertSeverity
+  start: ClockTime
+  timeZone: string
+  weekdayFilter: WeekdayFilter
 }
 
 export interface UserOverride {
@@ -1640,11 +1976,18 @@ type ConfigID =
   | 'General.DisableLabelCreation'
   | 'General.DisableCalendarSubscriptions'
   | 'Services.RequiredLabels'
+  | 'Schedules.CoverageCheckHours'
+  | 'Schedules.CoverageAlertServiceID'
   | 'Maintenance.AlertCleanupDays'
   | 'Maintenance.AlertAutoCloseDays'
   | 'Maintenance.AutoCloseAckedAlerts'
   | 'Maintenance.APIKeyExpireDays'
   | 'Maintenance.ScheduleCleanupDays'
+  | 'Incidents.Enable'
+  | 'Incidents.WindowMinutes'
+  | 'Incidents.LabelKeys'
+  | 'Incidents.DedupPrefixes'
+  | 'Incidents.Expressions'
   | 'Auth.RefererURLs'
   | 'Auth.DisableBasic'
   | 'GitHub.Enable'
@@ -1653,6 +1996,7 @@ type ConfigID =
   | 'GitHub.ClientSecret'
   | 'GitHub.AllowedUsers'
   | 'GitHub.AllowedOrgs'
+  | 'GitHub.AdminOrgs'
   | 'GitHub.EnterpriseURL'
   | 'OIDC.Enable'
   | 'OIDC.NewUsers'
@@ -1664,6 +2008,39 @@ type ConfigID =
   | 'OIDC.UserInfoEmailPath'
   | 'OIDC.UserInfoEmailVerifiedPath'
   | 'OIDC.UserInfoNamePath'
+  | 'OIDC.UserInfoGroupsPath'
+  | 'OIDC.AdminGroups'
+  | 'LDAP.Enable'
+  | 'LDAP.NewUsers'
+  | 'LDAP.OverrideName'
+  | 'LDAP.URL'
+  | 'LDAP.StartTLS'
+  | 'LDAP.InsecureSkipVerify'
+  | 'LDAP.BindDN'
+  | 'LDAP.BindPassword'
+  | 'LDAP.BaseDN'
+  | 'LDAP.UserFilter'
+  | 'LDAP.SubjectAttribute'
+  | 'LDAP.NameAttribute'
+  | 'LDAP.EmailAttribute'
+  | 'LDAP.GroupAttribute'
+  | 'LDAP.AllowedGroups'
+  | 'LDAP.AdminGroups'
+  | 'SAML.Enable'
+  | 'SAML.NewUsers'
+  | 'SAML.OverrideName'
+  | 'SAML.IdPMetadataURL'
+  | 'SAML.IdPMetadata'
+  | 'SAML.EntityID'
+  | 'SAML.Certificate'
+  | 'SAML.PrivateKey'
+  | 'SAML.AllowIdPInitiated'
+  | 'SAML.SubjectAttribute'
+  | 'SAML.NameAttribute'
+  | 'SAML.EmailAttribute'
+  | 'SCIM.Enable'
+  | 'SCIM.AuthProvider'
+  | 'SCIM.AuthSubjectID'
   | 'Mailgun.Enable'
   | 'Mailgun.APIKey'
   | 'Mailgun.EmailDomain'
@@ -1674,6 +2051,7 @@ type ConfigID =
   | 'Slack.SigningSecret'
   | 'Slack.InteractiveMessages'
   | 'Slack.DisableBroadcastThreadReplies'
+  | 'MSTeams.Enable'
   | 'Twilio.Enable'
   | 'Twilio.VoiceName'
   | 'Twilio.VoiceLanguage'
@@ -1695,5 +2073,16 @@ type ConfigID =
   | 'SMTP.Password'
   | 'Webhook.Enable'
   | 'Webhook.AllowedURLs'
+  | 'Ticketing.Enable'
+  | 'Ticketing.APIURL'
+  | 'Ticketing.APIKey'
+  | 'Ticketing.FieldMapping'
+  | 'Ticketing.OpenStatus'
+  | 'Ticketing.AcknowledgedStatus'
+  | 'Ticketing.ClosedStatus'
+  | 'Ticketing.CallbackSecret'
+  | 'EventSink.Enable'
+  | 'EventSink.URL'
+  | 'EventSink.AuthorizationHeader'
   | 'Feedback.Enable'
   | 'Feedback.OverrideURL'
@@ -0,0 +1,80 @@
+package workload
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+var csvHeader = []string{
+	"team_id",
+	"team_name",
+	"schedule_id",
+	"schedule_name",
+	"user_id",
+	"user_name",
+	"on_call_hours",
+	"off_hours_on_call_hours",
+	"pages",
+	"night_pages",
+	"acknowledgements",
+}
+
+func hours(d time.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', 2, 64)
+}
+
+func csvRecord(teamID, teamName, schedID, schedName string, s Stats) []string {
+	return []string{
+		teamID,
+		teamName,
+		schedID,
+		schedName,
+		s.UserID,
+		s.UserName,
+		hours(s.OnCall),
+		hours(s.OffHoursOnCall),
+		strconv.Itoa(s.Pages),
+		strconv.Itoa(s.NightPages),
+		strconv.Itoa(s.Acks),
+	}
+}
+
+// WriteCSV will write the report in CSV format.
+//
+// Rows with an empty team and schedule are the overall totals for each user, followed by
+// rows for each user on each team, and then on each schedule.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range r.Users {
+		err = cw.Write(csvRecord("", "", "", "", u))
+		if err != nil {
+			return err
+		}
+	}
+	for _, team := range r.Teams {
+		for _, u := range team.Users {
+			err = cw.Write(csvRecord(team.TeamID, team.TeamName, "", "", u))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, sched := range r.Schedules {
+		for _, u := range sched.Users {
+			err = cw.Write(csvRecord("", "", sched.ScheduleID, sched.ScheduleName, u))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,94 @@
+package workload
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/target/goalert/util"
+	"github.com/target/goalert/util/errutil"
+	"github.com/target/goalert/util/log"
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+)
+
+// ServeCSV will serve the workload report as a CSV file.
+//
+// The optional query parameters `start` and `end` (RFC3339) select the time range, defaulting
+// to the last 30 days. `timeZone`, `workdayStart`, `workdayEnd`, `nightStart`, and `nightEnd`
+// override the defaults for working hours and night-time.
+func (s *Store) ServeCSV(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	opts := DefaultOptions(time.Now())
+	parseTime := func(name string, t *time.Time) error {
+		v := req.FormValue(name)
+		if v == "" {
+			return nil
+		}
+		var err error
+		*t, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			return validation.NewFieldError(name, "must be an RFC3339 timestamp")
+		}
+		return nil
+	}
+	parseClock := func(name string, c *timeutil.Clock) error {
+		v := req.FormValue(name)
+		if v == "" {
+			return nil
+		}
+		var err error
+		*c, err = timeutil.ParseClock(v)
+		if err != nil {
+			return validation.NewFieldError(name, err.Error())
+		}
+		return nil
+	}
+
+	end := req.FormValue("end")
+	err := parseTime("end", &opts.End)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+	if end != "" {
+		opts.Start = opts.End.AddDate(0, 0, -30)
+	}
+	if errutil.HTTPError(ctx, w, parseTime("start", &opts.Start)) {
+		return
+	}
+	if tz := req.FormValue("timeZone"); tz != "" {
+		opts.TimeZone, err = util.LoadLocation(tz)
+		if err != nil {
+			err = validation.NewFieldError("timeZone", err.Error())
+		}
+		if errutil.HTTPError(ctx, w, err) {
+			return
+		}
+	}
+	for _, c := range []struct {
+		name string
+		c    *timeutil.Clock
+	}{
+		{"workdayStart", &opts.WorkdayStart},
+		{"workdayEnd", &opts.WorkdayEnd},
+		{"nightStart", &opts.NightStart},
+		{"nightEnd", &opts.NightEnd},
+	} {
+		if errutil.HTTPError(ctx, w, parseClock(c.name, c.c)) {
+			return
+		}
+	}
+
+	r, err := s.Report(ctx, opts)
+	if errutil.HTTPError(ctx, w, err) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="on-call-workload-%s-%s.csv"`, r.Start.Format("2006-01-02"), r.End.Format("2006-01-02")))
+	err = r.WriteCSV(w)
+	if err != nil {
+		log.Log(ctx, fmt.Errorf("write workload report: %w", err))
+	}
+}
@@ -0,0 +1,51 @@
+-- name: WorkloadShifts :many
+-- Get all on-call shifts overlapping the given time range, with the team owning each schedule.
+SELECT
+    s.user_id,
+    u.name AS user_name,
+    s.schedule_id,
+    sched.name AS schedule_name,
+    t.id AS team_id,
+    t.name AS team_name,
+    s.start_time,
+    s.end_time
+FROM
+    schedule_on_call_users s
+    JOIN users u ON u.id = s.user_id
+    JOIN schedules sched ON sched.id = s.schedule_id
+    LEFT JOIN teams t ON t.id = sched.team_id
+WHERE
+    tstzrange(s.start_time, s.end_time) && tstzrange(@start_time::timestamptz, @end_time::timestamptz);
+
+-- name: WorkloadPages :many
+-- Get all alert notifications sent to users within the given time range.
+SELECT
+    om.user_id,
+    u.name AS user_name,
+    om.alert_id,
+    om.sent_at
+FROM
+    outgoing_messages om
+    JOIN users u ON u.id = om.user_id
+WHERE
+    om.message_type IN ('alert_notification', 'alert_notification_bundle')
+    AND om.sent_at >= @start_time::timestamptz
+    AND om.sent_at < @end_time::timestamptz;
+
+-- name: WorkloadAcks :many
+-- Get all alert acknowledgements by users within the given time range.
+SELECT
+    l.sub_user_id AS user_id,
+    u.name AS user_name,
+    l.timestamp
+FROM
+    alert_logs l
+    JOIN users u ON u.id = l.sub_user_id
+WHERE
+    l.event = 'acknowledged'
+    AND l.timestamp >= @start_time::timestamptz
+    AND l.timestamp < @end_time::timestamptz;
+
+-- name: WorkloadNow :one
+SELECT
+    now()::timestamptz;
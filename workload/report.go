@@ -0,0 +1,439 @@
+package workload
+
+import (
+	"sort"
+	"time"
+
+	"github.com/target/goalert/util/timeutil"
+	"github.com/target/goalert/validation"
+	"github.com/target/goalert/validation/validate"
+)
+
+// MaxRange is the longest time range a report can cover.
+const MaxRange = 366 * 24 * time.Hour
+
+// Options configure a workload report.
+type Options struct {
+	Start time.Time
+	End   time.Time
+
+	// TimeZone is used to determine working hours and night-time.
+	TimeZone *time.Location
+
+	// WorkdayStart and WorkdayEnd are the working hours on weekdays (Monday through Friday).
+	// Time on-call outside of them is considered off-hours.
+	WorkdayStart timeutil.Clock
+	WorkdayEnd   timeutil.Clock
+
+	// NightStart and NightEnd are the hours (every day) when a page is considered a night-time interruption.
+	NightStart timeutil.Clock
+	NightEnd   timeutil.Clock
+}
+
+// DefaultOptions returns the default options for a report covering the 30 days before end.
+func DefaultOptions(end time.Time) Options {
+	return Options{
+		Start:        end.AddDate(0, 0, -30),
+		End:          end,
+		TimeZone:     time.UTC,
+		WorkdayStart: timeutil.NewClock(9, 0),
+		WorkdayEnd:   timeutil.NewClock(17, 0),
+		NightStart:   timeutil.NewClock(22, 0),
+		NightEnd:     timeutil.NewClock(7, 0),
+	}
+}
+
+// Normalize will validate and return a normalized copy of the options.
+func (opts Options) Normalize() (*Options, error) {
+	if opts.TimeZone == nil {
+		opts.TimeZone = time.UTC
+	}
+	opts.Start = opts.Start.In(opts.TimeZone)
+	opts.End = opts.End.In(opts.TimeZone)
+
+	if !opts.End.After(opts.Start) {
+		return nil, validation.NewFieldError("End", "must be after Start")
+	}
+	if opts.End.Sub(opts.Start) > MaxRange {
+		return nil, validation.NewFieldError("End", "must be within 366 days of Start")
+	}
+
+	const maxClock = 24*60 - 1
+	err := validate.Many(
+		validate.Range("WorkdayStart", int(opts.WorkdayStart/timeutil.Clock(time.Minute)), 0, maxClock),
+		validate.Range("WorkdayEnd", int(opts.WorkdayEnd/timeutil.Clock(time.Minute)), 0, maxClock),
+		validate.Range("NightStart", int(opts.NightStart/timeutil.Clock(time.Minute)), 0, maxClock),
+		validate.Range("NightEnd", int(opts.NightEnd/timeutil.Clock(time.Minute)), 0, maxClock),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if opts.WorkdayEnd <= opts.WorkdayStart {
+		return nil, validation.NewFieldError("WorkdayEnd", "must be after WorkdayStart")
+	}
+
+	return &opts, nil
+}
+
+// Stats are the workload totals for a single user.
+type Stats struct {
+	UserID   string
+	UserName string
+
+	// OnCall is the total time on-call. Overlapping shifts are only counted once.
+	OnCall time.Duration
+
+	// OffHoursOnCall is the portion of OnCall outside of working hours.
+	OffHoursOnCall time.Duration
+
+	// Pages is the number of alerts the user was notified about. Bundled notifications count as one page.
+	Pages int
+
+	// NightPages is the number of Pages that were first sent during night-time.
+	NightPages int
+
+	// Acks is the number of alerts the user acknowledged.
+	Acks int
+}
+
+// ScheduleStats are the workload totals for users on a single schedule.
+//
+// Pages and acknowledgements are only counted for a schedule if the user was on-call for it at the time.
+type ScheduleStats struct {
+	ScheduleID   string
+	ScheduleName string
+
+	Users []Stats
+}
+
+// Total returns the combined totals of all users on the schedule.
+func (s ScheduleStats) Total() Stats { return total(s.Users) }
+
+func total(users []Stats) Stats {
+	var total Stats
+	for _, u := range users {
+		total.OnCall += u.OnCall
+		total.OffHoursOnCall += u.OffHoursOnCall
+		total.Pages += u.Pages
+		total.NightPages += u.NightPages
+		total.Acks += u.Acks
+	}
+
+	return total
+}
+
+// TeamStats are the workload totals for users on schedules owned by a single team.
+//
+// Pages and acknowledgements are only counted for a team if the user was on-call for one of its schedules at the time.
+type TeamStats struct {
+	TeamID   string
+	TeamName string
+
+	Users []Stats
+}
+
+// Total returns the combined totals of all users on the team's schedules.
+func (t TeamStats) Total() Stats { return total(t.Users) }
+
+// Report is the on-call workload of users over a period of time.
+type Report struct {
+	Options
+
+	Users     []Stats
+	Teams     []TeamStats
+	Schedules []ScheduleStats
+}
+
+// Shift is a single on-call shift for a user on a schedule. A zero End indicates the shift is still active.
+//
+// TeamID and TeamName are empty if the schedule is not owned by a team.
+type Shift struct {
+	UserID       string
+	UserName     string
+	ScheduleID   string
+	ScheduleName string
+	TeamID       string
+	TeamName     string
+	Start        time.Time
+	End          time.Time
+}
+
+// Event is a page or acknowledgement for a user.
+type Event struct {
+	UserID   string
+	UserName string
+
+	// AlertID is used to count multiple notifications for the same alert as a single page.
+	AlertID int
+	Time    time.Time
+}
+
+type interval struct {
+	Start, End time.Time
+}
+
+// mergeIntervals returns the union of the provided intervals, sorted by start time.
+func mergeIntervals(ivs []interval) []interval {
+	sort.Slice(ivs, func(i, j int) bool { return ivs[i].Start.Before(ivs[j].Start) })
+
+	var res []interval
+	for _, iv := range ivs {
+		if len(res) > 0 && !iv.Start.After(res[len(res)-1].End) {
+			if iv.End.After(res[len(res)-1].End) {
+				res[len(res)-1].End = iv.End
+			}
+			continue
+		}
+		res = append(res, iv)
+	}
+
+	return res
+}
+
+// workingTime returns the amount of working hours between start and end.
+func (opts Options) workingTime(start, end time.Time) time.Duration {
+	var total time.Duration
+	day := start.In(opts.TimeZone)
+	y, m, d := day.Date()
+	for n := 0; ; n++ {
+		day = time.Date(y, m, d+n, 12, 0, 0, 0, opts.TimeZone)
+		dayStart := opts.WorkdayStart.FirstOfDay(day)
+		if !dayStart.Before(end) {
+			break
+		}
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+
+		dayEnd := opts.WorkdayEnd.FirstOfDay(day)
+		if dayStart.Before(start) {
+			dayStart = start
+		}
+		if dayEnd.After(end) {
+			dayEnd = end
+		}
+		if dayEnd.After(dayStart) {
+			total += dayEnd.Sub(dayStart)
+		}
+	}
+
+	return total
+}
+
+// isNight returns true if t falls within night-time.
+func (opts Options) isNight(t time.Time) bool {
+	c := timeutil.NewClockFromTime(t.In(opts.TimeZone))
+	if opts.NightStart <= opts.NightEnd {
+		return c >= opts.NightStart && c < opts.NightEnd
+	}
+
+	return c >= opts.NightStart || c < opts.NightEnd
+}
+
+// clip limits the shift to the report time range, returning false if there is no overlap.
+// Active shifts are considered to end at now.
+func (opts Options) clip(s Shift, now time.Time) (interval, bool) {
+	iv := interval{Start: s.Start, End: s.End}
+	if iv.End.IsZero() {
+		iv.End = now
+	}
+	if iv.Start.Before(opts.Start) {
+		iv.Start = opts.Start
+	}
+	if iv.End.After(opts.End) {
+		iv.End = opts.End
+	}
+
+	return iv, iv.End.After(iv.Start)
+}
+
+type statsCalc struct {
+	opts  Options
+	stats map[string]*Stats
+	ivs   map[string][]interval
+	order []string
+}
+
+func newStatsCalc(opts Options) *statsCalc {
+	return &statsCalc{opts: opts, stats: make(map[string]*Stats), ivs: make(map[string][]interval)}
+}
+
+func (c *statsCalc) user(id, name string) *Stats {
+	s, ok := c.stats[id]
+	if !ok {
+		s = &Stats{UserID: id, UserName: name}
+		c.stats[id] = s
+		c.order = append(c.order, id)
+	}
+
+	return s
+}
+
+func (c *statsCalc) result() []Stats {
+	res := make([]Stats, 0, len(c.order))
+	for _, id := range c.order {
+		s := c.stats[id]
+		for _, iv := range mergeIntervals(c.ivs[id]) {
+			s.OnCall += iv.End.Sub(iv.Start)
+			s.OffHoursOnCall += iv.End.Sub(iv.Start) - c.opts.workingTime(iv.Start, iv.End)
+		}
+		res = append(res, *s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].UserName != res[j].UserName {
+			return res[i].UserName < res[j].UserName
+		}
+		return res[i].UserID < res[j].UserID
+	})
+
+	return res
+}
+
+// dedupPages returns pages with multiple notifications for the same user and alert removed, keeping the earliest.
+func dedupPages(pages []Event) []Event {
+	type key struct {
+		UserID  string
+		AlertID int
+	}
+	first := make(map[key]int)
+	var res []Event
+	for _, p := range pages {
+		if p.AlertID == 0 {
+			res = append(res, p)
+			continue
+		}
+		k := key{UserID: p.UserID, AlertID: p.AlertID}
+		idx, ok := first[k]
+		if !ok {
+			first[k] = len(res)
+			res = append(res, p)
+			continue
+		}
+		if p.Time.Before(res[idx].Time) {
+			res[idx] = p
+		}
+	}
+
+	return res
+}
+
+// calcReport will calculate the workload report from the provided data. Shifts still active are considered to end at now.
+func calcReport(opts Options, now time.Time, shifts []Shift, pages, acks []Event) *Report {
+	pages = dedupPages(pages)
+
+	// overall user stats
+	users := newStatsCalc(opts)
+	for _, s := range shifts {
+		st := users.user(s.UserID, s.UserName)
+		iv, ok := opts.clip(s, now)
+		if !ok {
+			continue
+		}
+		users.ivs[st.UserID] = append(users.ivs[st.UserID], iv)
+	}
+	for _, p := range pages {
+		st := users.user(p.UserID, p.UserName)
+		st.Pages++
+		if opts.isNight(p.Time) {
+			st.NightPages++
+		}
+	}
+	for _, a := range acks {
+		users.user(a.UserID, a.UserName).Acks++
+	}
+
+	schedules := groupStats(opts, now, shifts, pages, acks, func(s Shift) (string, string) { return s.ScheduleID, s.ScheduleName })
+	teams := groupStats(opts, now, shifts, pages, acks, func(s Shift) (string, string) { return s.TeamID, s.TeamName })
+
+	r := &Report{
+		Options: opts,
+		Users:   users.result(),
+	}
+	for _, g := range schedules {
+		r.Schedules = append(r.Schedules, ScheduleStats{ScheduleID: g.id, ScheduleName: g.name, Users: g.users})
+	}
+	for _, g := range teams {
+		r.Teams = append(r.Teams, TeamStats{TeamID: g.id, TeamName: g.name, Users: g.users})
+	}
+
+	return r
+}
+
+type groupResult struct {
+	id, name string
+	users    []Stats
+}
+
+// groupStats calculates user stats for each group of shifts, sorted by group name. Shifts with an empty group ID are ignored.
+//
+// Pages and acknowledgements are only counted for a group if the user was on-call for one of its shifts at the time.
+func groupStats(opts Options, now time.Time, shifts []Shift, pages, acks []Event, group func(Shift) (id, name string)) []groupResult {
+	type groupInfo struct {
+		name   string
+		calc   *statsCalc
+		shifts []Shift
+	}
+	groups := make(map[string]*groupInfo)
+	var ids []string
+	for _, s := range shifts {
+		id, name := group(s)
+		if id == "" {
+			continue
+		}
+		info, ok := groups[id]
+		if !ok {
+			info = &groupInfo{name: name, calc: newStatsCalc(opts)}
+			groups[id] = info
+			ids = append(ids, id)
+		}
+		info.shifts = append(info.shifts, s)
+		st := info.calc.user(s.UserID, s.UserName)
+		iv, ok := opts.clip(s, now)
+		if !ok {
+			continue
+		}
+		info.calc.ivs[st.UserID] = append(info.calc.ivs[st.UserID], iv)
+	}
+
+	onCallAt := func(shifts []Shift, userID string, t time.Time) bool {
+		for _, s := range shifts {
+			if s.UserID != userID || t.Before(s.Start) {
+				continue
+			}
+			if s.End.IsZero() || t.Before(s.End) {
+				return true
+			}
+		}
+		return false
+	}
+
+	res := make([]groupResult, 0, len(ids))
+	for _, id := range ids {
+		info := groups[id]
+		for _, p := range pages {
+			if !onCallAt(info.shifts, p.UserID, p.Time) {
+				continue
+			}
+			st := info.calc.user(p.UserID, p.UserName)
+			st.Pages++
+			if opts.isNight(p.Time) {
+				st.NightPages++
+			}
+		}
+		for _, a := range acks {
+			if !onCallAt(info.shifts, a.UserID, a.Time) {
+				continue
+			}
+			info.calc.user(a.UserID, a.UserName).Acks++
+		}
+		res = append(res, groupResult{id: id, name: info.name, users: info.calc.result()})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].name != res[j].name {
+			return res[i].name < res[j].name
+		}
+		return res[i].id < res[j].id
+	})
+
+	return res
+}
@@ -0,0 +1,148 @@
+package workload
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions_WorkingTime(t *testing.T) {
+	opts := DefaultOptions(time.Now())
+
+	// Fri 2024-03-01 12:00 UTC to Mon 2024-03-04 12:00 UTC
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	// 5 hours Friday afternoon, 3 hours Monday morning
+	assert.Equal(t, 8*time.Hour, opts.workingTime(start, end))
+
+	// weekend only
+	assert.Equal(t, time.Duration(0), opts.workingTime(start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)))
+}
+
+func TestOptions_IsNight(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	opts := DefaultOptions(time.Now())
+	opts.TimeZone = chicago
+
+	assert.True(t, opts.isNight(time.Date(2024, 3, 1, 23, 0, 0, 0, chicago)))
+	assert.True(t, opts.isNight(time.Date(2024, 3, 1, 6, 59, 0, 0, chicago)))
+	assert.False(t, opts.isNight(time.Date(2024, 3, 1, 7, 0, 0, 0, chicago)))
+
+	// 12:00 UTC is 6:00 AM in Chicago
+	assert.True(t, opts.isNight(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestCalcReport(t *testing.T) {
+	opts := DefaultOptions(time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC))
+	opts.Start = time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC) // Monday
+	now := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	shifts := []Shift{
+		// bob is on two schedules at once on Monday, 00:00-12:00
+		{UserID: "bob", UserName: "Bob", ScheduleID: "s1", ScheduleName: "Primary", TeamID: "t1", TeamName: "Ops", Start: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)},
+		{UserID: "bob", UserName: "Bob", ScheduleID: "s2", ScheduleName: "Secondary", Start: time.Date(2024, 3, 4, 6, 0, 0, 0, time.UTC), End: time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)},
+
+		// ann is still on-call
+		{UserID: "ann", UserName: "Ann", ScheduleID: "s1", ScheduleName: "Primary", TeamID: "t1", TeamName: "Ops", Start: time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)},
+	}
+	pages := []Event{
+		// two notifications for the same alert count as one page
+		{UserID: "bob", UserName: "Bob", AlertID: 1, Time: time.Date(2024, 3, 4, 3, 0, 0, 0, time.UTC)},
+		{UserID: "bob", UserName: "Bob", AlertID: 1, Time: time.Date(2024, 3, 4, 3, 1, 0, 0, time.UTC)},
+		{UserID: "bob", UserName: "Bob", AlertID: 2, Time: time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)},
+
+		// ann was paged before her shift
+		{UserID: "ann", UserName: "Ann", AlertID: 3, Time: time.Date(2024, 3, 6, 10, 0, 0, 0, time.UTC)},
+	}
+	acks := []Event{
+		{UserID: "bob", UserName: "Bob", Time: time.Date(2024, 3, 4, 10, 5, 0, 0, time.UTC)},
+	}
+
+	r := calcReport(opts, now, shifts, pages, acks)
+
+	require.Len(t, r.Users, 2)
+	assert.Equal(t, Stats{
+		UserID:         "ann",
+		UserName:       "Ann",
+		OnCall:         24 * time.Hour,
+		OffHoursOnCall: 16 * time.Hour,
+		Pages:          1,
+	}, r.Users[0])
+	assert.Equal(t, Stats{
+		UserID:         "bob",
+		UserName:       "Bob",
+		OnCall:         12 * time.Hour,
+		OffHoursOnCall: 9 * time.Hour,
+		Pages:          2,
+		NightPages:     1,
+		Acks:           1,
+	}, r.Users[1])
+
+	require.Len(t, r.Schedules, 2)
+	assert.Equal(t, "Primary", r.Schedules[0].ScheduleName)
+	require.Len(t, r.Schedules[0].Users, 2)
+	assert.Equal(t, 0, r.Schedules[0].Users[0].Pages, "ann was not on-call when paged")
+	assert.Equal(t, 2, r.Schedules[0].Users[1].Pages)
+
+	assert.Equal(t, "Secondary", r.Schedules[1].ScheduleName)
+	require.Len(t, r.Schedules[1].Users, 1)
+	assert.Equal(t, Stats{
+		UserID:         "bob",
+		UserName:       "Bob",
+		OnCall:         6 * time.Hour,
+		OffHoursOnCall: 3 * time.Hour,
+		Pages:          1,
+		Acks:           1,
+	}, r.Schedules[1].Users[0])
+	assert.Equal(t, 36*time.Hour, r.Schedules[0].Total().OnCall)
+
+	require.Len(t, r.Teams, 1, "schedules without a team are not included")
+	assert.Equal(t, "Ops", r.Teams[0].TeamName)
+	require.Len(t, r.Teams[0].Users, 2)
+	assert.Equal(t, 0, r.Teams[0].Users[0].Pages, "ann was not on-call when paged")
+	assert.Equal(t, Stats{
+		UserID:         "bob",
+		UserName:       "Bob",
+		OnCall:         12 * time.Hour,
+		OffHoursOnCall: 9 * time.Hour,
+		Pages:          2,
+		NightPages:     1,
+		Acks:           1,
+	}, r.Teams[0].Users[1])
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteCSV(&buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 8)
+	assert.Equal(t, "team_id,team_name,schedule_id,schedule_name,user_id,user_name,on_call_hours,off_hours_on_call_hours,pages,night_pages,acknowledgements", lines[0])
+	assert.Equal(t, ",,,,bob,Bob,12.00,9.00,2,1,1", lines[2])
+	assert.Equal(t, "t1,Ops,,,bob,Bob,12.00,9.00,2,1,1", lines[4])
+	assert.Equal(t, ",,s2,Secondary,bob,Bob,6.00,3.00,1,0,1", lines[7])
+}
+
+func TestOptions_Normalize(t *testing.T) {
+	opts := DefaultOptions(time.Now())
+	_, err := opts.Normalize()
+	assert.NoError(t, err)
+
+	bad := opts
+	bad.Start = bad.End
+	_, err = bad.Normalize()
+	assert.Error(t, err, "empty range")
+
+	bad = opts
+	bad.Start = bad.End.AddDate(-2, 0, 0)
+	_, err = bad.Normalize()
+	assert.Error(t, err, "range too long")
+
+	bad = opts
+	bad.WorkdayEnd = bad.WorkdayStart
+	_, err = bad.Normalize()
+	assert.Error(t, err, "empty working hours")
+}
@@ -0,0 +1,90 @@
+package workload
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/target/goalert/gadb"
+	"github.com/target/goalert/permission"
+)
+
+// Store generates on-call workload reports.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore will create a new Store with the given parameters.
+func NewStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	return &Store{db: db}, nil
+}
+
+// Report will calculate the on-call workload of all users for the given options.
+//
+// On-call time comes from schedule shift history, pages from sent alert notifications,
+// and acknowledgements from the alert log, so the report is limited by how long each is retained.
+func (s *Store) Report(ctx context.Context, opts Options) (*Report, error) {
+	err := permission.LimitCheckAny(ctx, permission.User)
+	if err != nil {
+		return nil, err
+	}
+	o, err := opts.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	q := gadb.New(s.db)
+	now, err := q.WorkloadNow(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get current time: %w", err)
+	}
+
+	shiftRows, err := q.WorkloadShifts(ctx, gadb.WorkloadShiftsParams{StartTime: o.Start, EndTime: o.End})
+	if err != nil {
+		return nil, fmt.Errorf("lookup shifts: %w", err)
+	}
+	shifts := make([]Shift, len(shiftRows))
+	for i, r := range shiftRows {
+		shifts[i] = Shift{
+			UserID:       r.UserID.String(),
+			UserName:     r.UserName,
+			ScheduleID:   r.ScheduleID.String(),
+			ScheduleName: r.ScheduleName,
+			TeamName:     r.TeamName.String,
+			Start:        r.StartTime,
+			End:          r.EndTime.Time,
+		}
+		if r.TeamID.Valid {
+			shifts[i].TeamID = r.TeamID.UUID.String()
+		}
+	}
+
+	pageRows, err := q.WorkloadPages(ctx, gadb.WorkloadPagesParams{StartTime: o.Start, EndTime: o.End})
+	if err != nil {
+		return nil, fmt.Errorf("lookup pages: %w", err)
+	}
+	pages := make([]Event, len(pageRows))
+	for i, r := range pageRows {
+		pages[i] = Event{
+			UserID:   r.UserID.UUID.String(),
+			UserName: r.UserName,
+			AlertID:  int(r.AlertID.Int64),
+			Time:     r.SentAt.Time,
+		}
+	}
+
+	ackRows, err := q.WorkloadAcks(ctx, gadb.WorkloadAcksParams{StartTime: o.Start, EndTime: o.End})
+	if err != nil {
+		return nil, fmt.Errorf("lookup acknowledgements: %w", err)
+	}
+	acks := make([]Event, len(ackRows))
+	for i, r := range ackRows {
+		acks[i] = Event{
+			UserID:   r.UserID.UUID.String(),
+			UserName: r.UserName,
+			Time:     r.Timestamp.Time,
+		}
+	}
+
+	return calcReport(*o, now, shifts, pages, acks), nil
+}